@@ -0,0 +1,166 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/rpc"
+	"storj.io/common/sync2"
+	"storj.io/storj/private/nodeadminpb"
+	"storj.io/storj/private/version"
+	"storj.io/storj/private/version/checker"
+)
+
+// healthCheckInterval is how often the storage node is checked while
+// waiting for it to become healthy.
+const healthCheckInterval = 5 * time.Second
+
+// HealthConfig defines how the storage node is verified after an update.
+type HealthConfig struct {
+	Timeout time.Duration `help:"how long the updated storage node has to become healthy before it is rolled back, 0 disables the verification" default:"5m0s"`
+	Uptime  time.Duration `help:"how long the updated storage node has to stay up to be considered healthy" default:"1m0s"`
+}
+
+// verifyHealth waits until the storage node responds on its private address,
+// reports the version it was updated to and has been up for the configured
+// time. The node only starts its servers after its preflight checks passed.
+func verifyHealth(ctx context.Context, address string, to version.SemVer, config HealthConfig) error {
+	return waitFor(ctx, config.Timeout, func(ctx context.Context) error {
+		running, err := nodeVersion(ctx, address)
+		if err != nil {
+			return err
+		}
+		if running.Compare(to) != 0 {
+			return errs.New("storage node runs %s instead of %s", running.String(), to.String())
+		}
+
+		uptime, err := nodeUptime(ctx, address)
+		if err != nil {
+			return err
+		}
+		if uptime < config.Uptime {
+			return errs.New("storage node is up for only %s", uptime)
+		}
+		return nil
+	})
+}
+
+// waitFor calls check until it succeeds or timeout elapsed and returns the
+// last error of check in the latter case.
+func waitFor(ctx context.Context, timeout time.Duration, check func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		err := check(ctx)
+		if err == nil {
+			return nil
+		}
+		if !sync2.Sleep(ctx, healthCheckInterval) {
+			return errs.New("not healthy after %s: %v", timeout, err)
+		}
+	}
+}
+
+func nodeUptime(ctx context.Context, address string) (_ time.Duration, err error) {
+	conn, err := rpc.NewDefaultDialer(nil).DialAddressUnencrypted(ctx, address)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	resp, err := pb.NewDRPCPieceStoreInspectorClient(conn.Raw()).Dashboard(ctx, &pb.DashboardRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return ptypes.Duration(resp.Uptime)
+}
+
+func nodeVersion(ctx context.Context, address string) (_ version.SemVer, err error) {
+	conn, err := rpc.NewDefaultDialer(nil).DialAddressUnencrypted(ctx, address)
+	if err != nil {
+		return version.SemVer{}, err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	resp, err := nodeadminpb.NewDRPCNodeAdminClient(conn.Raw()).GetVersion(ctx, &nodeadminpb.GetVersionRequest{})
+	if err != nil {
+		return version.SemVer{}, err
+	}
+	return version.NewSemVer(resp.Version)
+}
+
+func notifyNode(ctx context.Context, address string, req *nodeadminpb.NotifyRequest) (err error) {
+	conn, err := rpc.NewDefaultDialer(nil).DialAddressUnencrypted(ctx, address)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	_, err = nodeadminpb.NewDRPCNodeAdminClient(conn.Raw()).Notify(ctx, req)
+	return err
+}
+
+// failedPath returns the path the binary of a version is kept at after it
+// failed its health verification. The updater doesn't update to the version
+// again, while the binary exists.
+func failedPath(binPath string, ver version.SemVer) string {
+	return prependExtension(binPath, "failed."+ver.String())
+}
+
+// rollback replaces the binary, which failed its health verification, with
+// its backup and restarts the service. The failure is reported to the
+// version control server and, once the previous version is up, to the
+// notifications of the node.
+func rollback(ctx context.Context, binPath, backupPath, serviceName string, from, to version.SemVer, reason error) error {
+	zap.S().Errorf("%s %s failed health verification, rolling back to %s: %v", serviceName, to.String(), from.String(), reason)
+
+	if err := os.Rename(binPath, failedPath(binPath, to)); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := os.Rename(backupPath, binPath); err != nil {
+		return errs.Wrap(err)
+	}
+
+	zap.S().Infof("Restarting service %s", serviceName)
+	if err := restartService(serviceName); err != nil {
+		return errs.New("Unable to restart service: %v", err)
+	}
+	zap.S().Infof("Rolled back %s to %s", serviceName, from.String())
+
+	client := checker.New(runCfg.ClientConfig)
+	err := client.ReportFailure(ctx, version.UpdateFailure{
+		Process: serviceName,
+		NodeID:  nodeID,
+		From:    from.String(),
+		To:      to.String(),
+		Reason:  reason.Error(),
+	})
+	if err != nil {
+		zap.S().Errorf("Unable to report failed update: %v", err)
+	}
+
+	err = waitFor(ctx, runCfg.Health.Timeout, func(ctx context.Context) error {
+		return notifyNode(ctx, runCfg.Server.PrivateAddress, &nodeadminpb.NotifyRequest{
+			Type:  "update-failed",
+			Title: fmt.Sprintf("Update to %s failed", to.String()),
+			Message: fmt.Sprintf("The storage node wasn't healthy after the update to %s and was rolled back to %s: %v",
+				to.String(), from.String(), reason),
+		})
+	})
+	if err != nil {
+		zap.S().Errorf("Unable to notify storage node about failed update: %v", err)
+	}
+
+	return errs.New("update to %s failed health verification and was rolled back: %v", to.String(), reason)
+}
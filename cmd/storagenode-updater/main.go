@@ -65,6 +65,11 @@ var (
 
 		BinaryLocation string `help:"the storage node executable binary location" default:"storagenode.exe"`
 		ServiceName    string `help:"storage node OS service name" default:"storagenode"`
+
+		Health HealthConfig
+		Server struct {
+			PrivateAddress string `help:"the private address of the storage node, used to verify it after an update" default:"127.0.0.1:7778"`
+		}
 		// deprecated
 		Log string `help:"deprecated, use --log.output" default:""`
 	}
@@ -187,6 +192,11 @@ func update(ctx context.Context, binPath, serviceName string) (err error) {
 		return nil
 	}
 
+	if fileExists(failedPath(binPath, suggestedVersion)) {
+		zap.S().Infof("New %s version %s failed health verification before, skipping it", serviceName, suggestedVersion.String())
+		return nil
+	}
+
 	tempArchive, err := ioutil.TempFile("", serviceName)
 	if err != nil {
 		return errs.New("cannot create temporary archive: %v", err)
@@ -206,6 +216,11 @@ func update(ctx context.Context, binPath, serviceName string) (err error) {
 	}
 	zap.S().Infof("Finished downloading %s to %s", downloadURL, tempArchive.Name())
 
+	if err := verifyArchive(tempArchive.Name(), serviceName, processVersion.Suggested, suggestedVersion); err != nil {
+		refuseUpdate(ctx, serviceName, suggestedVersion, err)
+		return errs.Wrap(err)
+	}
+
 	newVersionPath := prependExtension(binPath, suggestedVersion.String())
 	err = unpackBinary(ctx, tempArchive.Name(), newVersionPath)
 	if err != nil {
@@ -248,6 +263,15 @@ func update(ctx context.Context, binPath, serviceName string) (err error) {
 	}
 	zap.S().Infof("Service %s restarted successfully", serviceName)
 
+	// NB: the updater restarting itself can't verify the new version
+	if serviceName != updaterServiceName && runCfg.Health.Timeout > 0 {
+		zap.S().Infof("Verifying health of service %s", serviceName)
+		if err := verifyHealth(ctx, runCfg.Server.PrivateAddress, suggestedVersion, runCfg.Health); err != nil {
+			return rollback(ctx, binPath, backupPath, serviceName, currentVersion, suggestedVersion, err)
+		}
+		zap.S().Infof("Service %s is healthy", serviceName)
+	}
+
 	// TODO remove old binary ??
 	return nil
 }
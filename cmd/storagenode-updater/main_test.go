@@ -6,14 +6,19 @@ package main_test
 import (
 	"archive/zip"
 	"compress/flate"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strconv"
 	"testing"
 	"time"
@@ -36,6 +41,10 @@ const (
 	newVersion = "v0.19.5"
 )
 
+// releaseKey signs the test updates, its public key is embedded in the
+// compiled updaters.
+var releaseKey = ed25519.NewKeyFromSeed(testrand.BytesInt(ed25519.SeedSize))
+
 func TestAutoUpdater(t *testing.T) {
 	// TODO cleanup `.exe` extension for different OS
 
@@ -78,7 +87,7 @@ func TestAutoUpdater(t *testing.T) {
 	}
 
 	// run versioncontrol and update zips http servers
-	versionControlPeer, cleanupVersionControl := testVersionControlWithUpdates(ctx, t, updateBins)
+	versionControlPeer, cleanupVersionControl := testVersionControlWithUpdates(ctx, t, updateBins, releaseKey)
 	defer cleanupVersionControl()
 
 	logPath := ctx.File("storagenode-updater.log")
@@ -95,6 +104,8 @@ func TestAutoUpdater(t *testing.T) {
 		"--identity.cert-path", identConfig.CertPath,
 		"--identity.key-path", identConfig.KeyPath,
 		"--log", logPath,
+		// NB: the fake storage node doesn't serve the private endpoint.
+		"--health.timeout", "0s",
 	}
 
 	// NB: updater currently uses `log.SetOutput` so all output after that call
@@ -130,6 +141,154 @@ func TestAutoUpdater(t *testing.T) {
 	require.NotZero(t, backupUpdaterInfo.Size())
 }
 
+func TestAutoUpdater_Rollback(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	oldSemVer, err := version.NewSemVer(oldVersion)
+	require.NoError(t, err)
+
+	newSemVer, err := version.NewSemVer(newVersion)
+	require.NoError(t, err)
+
+	oldBin := CompileWithVersion(ctx, "storj.io/storj/cmd/storagenode-updater", version.Info{
+		Timestamp: time.Now(),
+		Version:   oldSemVer,
+	})
+	storagenodePath := ctx.File("fake", "storagenode.exe")
+	copyBin(ctx, t, oldBin, storagenodePath)
+
+	updaterPath := ctx.File("fake", "storagenode-updater.exe")
+	move(t, oldBin, updaterPath)
+
+	newBin := CompileWithVersion(ctx, "storj.io/storj/cmd/storagenode-updater", version.Info{
+		Timestamp: time.Now(),
+		Version:   newSemVer,
+	})
+
+	versionControlPeer, cleanupVersionControl := testVersionControlWithUpdates(ctx, t, map[string]string{
+		"storagenode":         newBin,
+		"storagenode-updater": newBin,
+	}, releaseKey)
+	defer cleanupVersionControl()
+
+	identConfig := testIdentityFiles(ctx, t)
+
+	// the fake storage node doesn't serve the private endpoint, so it never
+	// becomes healthy
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	privateAddress := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	runUpdater := func(logPath string) string {
+		args := []string{"run",
+			"--config-dir", ctx.Dir(),
+			"--server-address", "http://" + versionControlPeer.Addr(),
+			"--binary-location", storagenodePath,
+			"--check-interval", "0s",
+			"--identity.cert-path", identConfig.CertPath,
+			"--identity.key-path", identConfig.KeyPath,
+			"--log", logPath,
+			"--health.timeout", "1s",
+			"--server.private-address", privateAddress,
+		}
+		out, err := exec.Command(updaterPath, args...).CombinedOutput()
+		logData, logErr := ioutil.ReadFile(logPath)
+		if !assert.NoError(t, logErr) {
+			t.Log(string(out))
+		}
+		require.NoError(t, err)
+		t.Log(string(logData))
+		return string(logData)
+	}
+
+	logStr := runUpdater(ctx.File("storagenode-updater.log"))
+	assert.Contains(t, logStr, "storagenode "+newVersion+" failed health verification")
+	assert.Contains(t, logStr, "Rolled back storagenode to "+oldVersion)
+	assert.NotContains(t, logStr, "Unable to report failed update")
+
+	// the previous binary is restored and the failed one is kept
+	out, err := exec.Command(storagenodePath, "version").CombinedOutput()
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "Version: "+oldVersion)
+
+	failedInfo, err := os.Stat(ctx.File("fake", "storagenode.failed."+newVersion+".exe"))
+	require.NoError(t, err)
+	require.NotZero(t, failedInfo.Size())
+
+	// the failed version isn't installed again
+	logStr = runUpdater(ctx.File("storagenode-updater-2.log"))
+	assert.Contains(t, logStr, "failed health verification before, skipping it")
+	assert.NotContains(t, logStr, "Rolled back")
+}
+
+func TestAutoUpdater_InvalidSignature(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	oldSemVer, err := version.NewSemVer(oldVersion)
+	require.NoError(t, err)
+
+	newSemVer, err := version.NewSemVer(newVersion)
+	require.NoError(t, err)
+
+	oldBin := CompileWithVersion(ctx, "storj.io/storj/cmd/storagenode-updater", version.Info{
+		Timestamp: time.Now(),
+		Version:   oldSemVer,
+	})
+	storagenodePath := ctx.File("fake", "storagenode.exe")
+	copyBin(ctx, t, oldBin, storagenodePath)
+
+	updaterPath := ctx.File("fake", "storagenode-updater.exe")
+	move(t, oldBin, updaterPath)
+
+	newBin := CompileWithVersion(ctx, "storj.io/storj/cmd/storagenode-updater", version.Info{
+		Timestamp: time.Now(),
+		Version:   newSemVer,
+	})
+
+	// the updates are signed by a key which isn't a release key
+	otherKey := ed25519.NewKeyFromSeed(testrand.BytesInt(ed25519.SeedSize))
+	versionControlPeer, cleanupVersionControl := testVersionControlWithUpdates(ctx, t, map[string]string{
+		"storagenode":         newBin,
+		"storagenode-updater": newBin,
+	}, otherKey)
+	defer cleanupVersionControl()
+
+	identConfig := testIdentityFiles(ctx, t)
+	logPath := ctx.File("storagenode-updater.log")
+
+	args := []string{"run",
+		"--config-dir", ctx.Dir(),
+		"--server-address", "http://" + versionControlPeer.Addr(),
+		"--binary-location", storagenodePath,
+		"--check-interval", "0s",
+		"--identity.cert-path", identConfig.CertPath,
+		"--identity.key-path", identConfig.KeyPath,
+		"--log", logPath,
+		"--health.timeout", "0s",
+	}
+	out, err := exec.Command(updaterPath, args...).CombinedOutput()
+	logData, logErr := ioutil.ReadFile(logPath)
+	if !assert.NoError(t, logErr) {
+		t.Log(string(out))
+	}
+	require.NoError(t, err)
+	logStr := string(logData)
+	t.Log(logStr)
+
+	assert.Contains(t, logStr, "Refusing to update storagenode to "+newVersion)
+	assert.Contains(t, logStr, "Refusing to update storagenode-updater to "+newVersion)
+	assert.NotContains(t, logStr, "restarted successfully")
+
+	// neither binary was replaced
+	_, err = os.Stat(ctx.File("fake", "storagenode.old."+oldVersion+".exe"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(ctx.File("fake", "storagenode-updater.old.exe"))
+	assert.True(t, os.IsNotExist(err))
+}
+
 // CompileWithVersion compiles the specified package with the version variables set
 // to the passed version info values and returns the executable name.
 func CompileWithVersion(ctx *testcontext.Context, pkg string, info version.Info) string {
@@ -138,6 +297,7 @@ func CompileWithVersion(ctx *testcontext.Context, pkg string, info version.Info)
 		"storj.io/storj/private/version.buildCommitHash": info.CommitHash,
 		"storj.io/storj/private/version.buildVersion":    info.Version.String(),
 		"storj.io/storj/private/version.buildRelease":    strconv.FormatBool(info.Release),
+		"main.releaseKeys": base64.StdEncoding.EncodeToString(releaseKey.Public().(ed25519.PublicKey)),
 	}
 	return ctx.CompileWithLDFlagsX(pkg, ldFlagsX)
 }
@@ -184,15 +344,26 @@ func testIdentityFiles(ctx *testcontext.Context, t *testing.T) identity.Config {
 	return identConfig
 }
 
-func testVersionControlWithUpdates(ctx *testcontext.Context, t *testing.T, updateBins map[string]string) (peer *versioncontrol.Peer, cleanup func()) {
+func testVersionControlWithUpdates(ctx *testcontext.Context, t *testing.T, updateBins map[string]string, signingKey ed25519.PrivateKey) (peer *versioncontrol.Peer, cleanup func()) {
 	t.Helper()
 
+	newSemVer, err := version.NewSemVer(newVersion)
+	require.NoError(t, err)
+
 	var mux http.ServeMux
+	signatures := map[string]string{}
 	for name, src := range updateBins {
 		dst := ctx.File("updates", name+".zip")
 		zipBin(ctx, t, dst, src)
 		zipData, err := ioutil.ReadFile(dst)
 		require.NoError(t, err)
+		platform := version.Platform(runtime.GOOS, runtime.GOARCH)
+		signatures[name] = platform + ":" + version.SignRelease(signingKey, version.ReleaseManifest{
+			Process:  name,
+			Version:  newSemVer,
+			Platform: platform,
+			SHA256:   sha256.Sum256(zipData),
+		})
 
 		mux.HandleFunc("/"+name, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			_, err := w.Write(zipData)
@@ -222,8 +393,9 @@ func testVersionControlWithUpdates(ctx *testcontext.Context, t *testing.T, updat
 		Binary: versioncontrol.ProcessesConfig{
 			Storagenode: versioncontrol.ProcessConfig{
 				Suggested: versioncontrol.VersionConfig{
-					Version: newVersion,
-					URL:     ts.URL + "/storagenode",
+					Version:    newVersion,
+					URL:        ts.URL + "/storagenode",
+					Signatures: signatures["storagenode"],
 				},
 				Rollout: versioncontrol.RolloutConfig{
 					Seed:   storagenodeSeed,
@@ -232,8 +404,9 @@ func testVersionControlWithUpdates(ctx *testcontext.Context, t *testing.T, updat
 			},
 			StoragenodeUpdater: versioncontrol.ProcessConfig{
 				Suggested: versioncontrol.VersionConfig{
-					Version: newVersion,
-					URL:     ts.URL + "/storagenode-updater",
+					Version:    newVersion,
+					URL:        ts.URL + "/storagenode-updater",
+					Signatures: signatures["storagenode-updater"],
 				},
 				Rollout: versioncontrol.RolloutConfig{
 					Seed:   updaterSeed,
@@ -242,7 +415,7 @@ func testVersionControlWithUpdates(ctx *testcontext.Context, t *testing.T, updat
 			},
 		},
 	}
-	peer, err := versioncontrol.New(zaptest.NewLogger(t), config)
+	peer, err = versioncontrol.New(zaptest.NewLogger(t), config)
 	require.NoError(t, err)
 	ctx.Go(func() error {
 		return peer.Run(ctx)
@@ -0,0 +1,88 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/nodeadminpb"
+	"storj.io/storj/private/version"
+)
+
+// releaseKeys are the comma separated base64 encoded ed25519 public keys
+// downloaded archives must be signed with. They are embedded at build time
+// with
+//
+//	-ldflags "-X main.releaseKeys=<key>,<key>"
+//
+// which the release build does from the RELEASE_KEYS variable of the Makefile.
+//
+// To rotate the release key, updaters embedding both the old and the new key
+// are released before archives are signed with the new key.
+var releaseKeys = ""
+
+// refused records the versions, which were refused because of their
+// signature, to notify the storage node only once per version.
+var refused = map[string]bool{}
+
+// verifyArchive checks the signature, which the version control server
+// published for the platform, of the manifest of the downloaded archive. The
+// manifest covers the process, the version and the platform the archive was
+// released for and its hash.
+func verifyArchive(archivePath, serviceName string, suggested version.Version, suggestedVersion version.SemVer) (err error) {
+	keys, err := version.ParseReleaseKeys(releaseKeys)
+	if err != nil {
+		return err
+	}
+
+	archive, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, archive.Close()) }()
+
+	platform := version.Platform(runtime.GOOS, runtime.GOARCH)
+	manifest := version.ReleaseManifest{
+		Process:  serviceName,
+		Version:  suggestedVersion,
+		Platform: platform,
+	}
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, archive); err != nil {
+		return err
+	}
+	copy(manifest.SHA256[:], hash.Sum(nil))
+
+	return keys.Verify(manifest, suggested.Signatures[platform])
+}
+
+// refuseUpdate notifies the storage node about an update, which was refused
+// because of its signature.
+func refuseUpdate(ctx context.Context, serviceName string, ver version.SemVer, reason error) {
+	zap.S().Errorf("Refusing to update %s to %s: %v", serviceName, ver.String(), reason)
+
+	key := serviceName + " " + ver.String()
+	if refused[key] {
+		return
+	}
+	refused[key] = true
+
+	err := notifyNode(ctx, runCfg.Server.PrivateAddress, &nodeadminpb.NotifyRequest{
+		Type:    "update-failed",
+		Title:   fmt.Sprintf("Update to %s refused", ver.String()),
+		Message: fmt.Sprintf("The update of %s to %s was refused, because its signature couldn't be verified: %v", serviceName, ver.String(), reason),
+	})
+	if err != nil {
+		zap.S().Errorf("Unable to notify storage node about refused update: %v", err)
+	}
+}
@@ -0,0 +1,354 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/segmentio/go-prompt"
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/private/nodeadminpb"
+)
+
+var (
+	adminCmd = &cobra.Command{
+		Use:         "admin",
+		Short:       "Administrate the running storage node",
+		Annotations: map[string]string{"type": "helper"},
+	}
+	adminSatellitesCmd = &cobra.Command{
+		Use:   "satellites",
+		Short: "List satellites with their status",
+		Args:  cobra.NoArgs,
+		RunE:  cmdAdminSatellites,
+	}
+	adminChoresCmd = &cobra.Command{
+		Use:   "chores",
+		Short: "List the chores which can be triggered",
+		Args:  cobra.NoArgs,
+		RunE:  cmdAdminChores,
+	}
+	adminTriggerCmd = &cobra.Command{
+		Use:   "trigger <chore>",
+		Short: "Run a chore and wait until it completed",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminTrigger,
+	}
+	adminRestoreTrashCmd = &cobra.Command{
+		Use:   "restore-trash <satellite-id>",
+		Short: "Restore the trashed pieces of a satellite",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminRestoreTrash,
+	}
+	adminPiecesCmd = &cobra.Command{
+		Use:   "pieces <satellite-id>",
+		Short: "List the pieces stored for a satellite with their sizes",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminPieces,
+	}
+	adminRetainQueueCmd = &cobra.Command{
+		Use:   "retain-queue",
+		Short: "List the retain requests which are queued or being processed",
+		Args:  cobra.NoArgs,
+		RunE:  cmdAdminRetainQueue,
+	}
+	adminPendingSatellitesCmd = &cobra.Command{
+		Use:   "pending-satellites",
+		Short: "List the satellites added to the trust lists which must be accepted to be trusted",
+		Args:  cobra.NoArgs,
+		RunE:  cmdAdminPendingSatellites,
+	}
+	adminAcceptSatelliteCmd = &cobra.Command{
+		Use:   "accept-satellite <satellite-id>",
+		Short: "Trust a satellite added to the trust lists",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminAcceptSatellite,
+	}
+	adminUntrustedSatellitesCmd = &cobra.Command{
+		Use:   "untrusted-satellites",
+		Short: "List the satellites which are no longer trusted and the state of their data",
+		Args:  cobra.NoArgs,
+		RunE:  cmdAdminUntrustedSatellites,
+	}
+	adminPurgeSatelliteCmd = &cobra.Command{
+		Use:   "purge-satellite <satellite-id>",
+		Short: "Delete all data of a satellite which is no longer trusted",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAdminPurgeSatellite,
+	}
+
+	adminPieceLimit int
+)
+
+func init() {
+	adminPiecesCmd.Flags().IntVar(&adminPieceLimit, "limit", 1000, "maximum number of pieces to list")
+
+	for _, cmd := range []*cobra.Command{adminSatellitesCmd, adminChoresCmd, adminTriggerCmd, adminRestoreTrashCmd, adminPiecesCmd, adminRetainQueueCmd, adminPendingSatellitesCmd, adminAcceptSatelliteCmd, adminUntrustedSatellitesCmd, adminPurgeSatelliteCmd} {
+		adminCmd.AddCommand(cmd)
+	}
+}
+
+type adminClient struct {
+	conn *rpc.Conn
+}
+
+func dialAdminClient(ctx context.Context, address string) (*adminClient, error) {
+	conn, err := rpc.NewDefaultDialer(nil).DialAddressUnencrypted(ctx, address)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &adminClient{conn: conn}, nil
+}
+
+func (client *adminClient) admin() nodeadminpb.DRPCNodeAdminClient {
+	return nodeadminpb.NewDRPCNodeAdminClient(client.conn.Raw())
+}
+
+func (client *adminClient) close() error {
+	return client.conn.Close()
+}
+
+// withAdminClient calls fn with a client connected to the private address of
+// the storage node and flushes the output written to w.
+func withAdminClient(cmd *cobra.Command, fn func(ctx context.Context, client *adminClient, w *tabwriter.Writer) error) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	client, err := dialAdminClient(ctx, diagCfg.Server.PrivateAddress)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() {
+		if err := client.close(); err != nil {
+			zap.S().Debug("closing admin client failed", err)
+		}
+	}()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer func() { err = errs.Combine(err, w.Flush()) }()
+
+	return fn(ctx, client, w)
+}
+
+func cmdAdminSatellites(cmd *cobra.Command, args []string) error {
+	return withAdminClient(cmd, func(ctx context.Context, client *adminClient, w *tabwriter.Writer) error {
+		resp, err := client.admin().ListSatellites(ctx, &nodeadminpb.ListSatellitesRequest{})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		fmt.Fprintln(w, "Node ID\tAddress\tTrusted\tStatus\tSpace Used\t")
+		for _, satellite := range resp.GetSatellites() {
+			id, err := storj.NodeIDFromBytes(satellite.GetId())
+			if err != nil {
+				return errs.Wrap(err)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\t\n", id, satellite.GetAddress(), satellite.GetTrusted(),
+				satellite.GetStatus(), memory.Size(satellite.GetSpaceUsed()).Base10String())
+		}
+		return nil
+	})
+}
+
+func cmdAdminChores(cmd *cobra.Command, args []string) error {
+	return withAdminClient(cmd, func(ctx context.Context, client *adminClient, w *tabwriter.Writer) error {
+		resp, err := client.admin().ListChores(ctx, &nodeadminpb.ListChoresRequest{})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		fmt.Fprintln(w, "Name\tInterval\tLast Triggered\tDuration\t")
+		for _, chore := range resp.GetChores() {
+			lastTriggered, duration := "never", ""
+			if chore.GetLastTriggered() != 0 {
+				lastTriggered = time.Unix(chore.GetLastTriggered(), 0).Format(time.RFC3339)
+				duration = time.Duration(chore.GetLastDuration()).String()
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n", chore.GetName(), time.Duration(chore.GetInterval()), lastTriggered, duration)
+		}
+		return nil
+	})
+}
+
+func cmdAdminTrigger(cmd *cobra.Command, args []string) error {
+	return withAdminClient(cmd, func(ctx context.Context, client *adminClient, w *tabwriter.Writer) error {
+		resp, err := client.admin().TriggerChore(ctx, &nodeadminpb.TriggerChoreRequest{Name: args[0]})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		fmt.Fprintf(w, "Chore %s completed in %s.\n", args[0], time.Duration(resp.GetDuration()))
+		return nil
+	})
+}
+
+func cmdAdminRestoreTrash(cmd *cobra.Command, args []string) error {
+	satelliteID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return withAdminClient(cmd, func(ctx context.Context, client *adminClient, w *tabwriter.Writer) error {
+		_, err := client.admin().RestoreTrash(ctx, &nodeadminpb.RestoreTrashRequest{SatelliteId: satelliteID.Bytes()})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		fmt.Fprintf(w, "Restored the trash of satellite %s.\n", satelliteID)
+		return nil
+	})
+}
+
+func cmdAdminPieces(cmd *cobra.Command, args []string) error {
+	satelliteID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return withAdminClient(cmd, func(ctx context.Context, client *adminClient, w *tabwriter.Writer) error {
+		resp, err := client.admin().ListPieces(ctx, &nodeadminpb.ListPiecesRequest{
+			SatelliteId: satelliteID.Bytes(),
+			Limit:       int32(adminPieceLimit),
+		})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		fmt.Fprintln(w, "Piece ID\tSize\tStored At\t")
+		for _, piece := range resp.GetPieces() {
+			pieceID, err := storj.PieceIDFromBytes(piece.GetId())
+			if err != nil {
+				return errs.Wrap(err)
+			}
+			fmt.Fprintf(w, "%s\t%d\t%s\t\n", pieceID, piece.GetSize_(), time.Unix(piece.GetStoredAt(), 0).Format(time.RFC3339))
+		}
+		more := ""
+		if resp.GetMore() {
+			more = " (more pieces are stored)"
+		}
+		fmt.Fprintf(w, "\nListed %d pieces%s, %s in total.\n", len(resp.GetPieces()), more,
+			memory.Size(resp.GetTotalSize()).Base10String())
+		return nil
+	})
+}
+
+func cmdAdminRetainQueue(cmd *cobra.Command, args []string) error {
+	return withAdminClient(cmd, func(ctx context.Context, client *adminClient, w *tabwriter.Writer) error {
+		resp, err := client.admin().ListRetainRequests(ctx, &nodeadminpb.ListRetainRequestsRequest{})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		fmt.Fprintf(w, "Retain requests are %s.\n", resp.GetStatus())
+		if len(resp.GetRequests()) == 0 {
+			fmt.Fprintln(w, "No retain requests queued.")
+			return nil
+		}
+		fmt.Fprintln(w, "\nSatellite ID\tCreated Before\tProcessing\t")
+		for _, request := range resp.GetRequests() {
+			satelliteID, err := storj.NodeIDFromBytes(request.GetSatelliteId())
+			if err != nil {
+				return errs.Wrap(err)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%t\t\n", satelliteID, time.Unix(request.GetCreatedBefore(), 0).Format(time.RFC3339), request.GetProcessing())
+		}
+		return nil
+	})
+}
+
+func cmdAdminPendingSatellites(cmd *cobra.Command, args []string) error {
+	return withAdminClient(cmd, func(ctx context.Context, client *adminClient, w *tabwriter.Writer) error {
+		resp, err := client.admin().ListPendingSatellites(ctx, &nodeadminpb.ListPendingSatellitesRequest{})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		if len(resp.GetSatellites()) == 0 {
+			fmt.Fprintln(w, "No satellites pending acceptance.")
+			return nil
+		}
+		fmt.Fprintln(w, "Node ID\tAddress\tSource\tSigned By\tFetched At\t")
+		for _, satellite := range resp.GetSatellites() {
+			id, err := storj.NodeIDFromBytes(satellite.GetId())
+			if err != nil {
+				return errs.Wrap(err)
+			}
+			signedBy := satellite.GetSignedBy()
+			if signedBy == "" {
+				signedBy = "unsigned"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n", id, satellite.GetAddress(), satellite.GetSource(), signedBy,
+				time.Unix(satellite.GetFetchedAt(), 0).Format(time.RFC3339))
+		}
+		return nil
+	})
+}
+
+func cmdAdminAcceptSatellite(cmd *cobra.Command, args []string) error {
+	satelliteID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return withAdminClient(cmd, func(ctx context.Context, client *adminClient, w *tabwriter.Writer) error {
+		_, err := client.admin().AcceptSatellite(ctx, &nodeadminpb.AcceptSatelliteRequest{SatelliteId: satelliteID.Bytes()})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		fmt.Fprintf(w, "Satellite %s is trusted.\n", satelliteID)
+		return nil
+	})
+}
+
+func cmdAdminUntrustedSatellites(cmd *cobra.Command, args []string) error {
+	return withAdminClient(cmd, func(ctx context.Context, client *adminClient, w *tabwriter.Writer) error {
+		resp, err := client.admin().ListUntrustedSatellites(ctx, &nodeadminpb.ListUntrustedSatellitesRequest{})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		if len(resp.GetSatellites()) == 0 {
+			fmt.Fprintln(w, "No data of untrusted satellites.")
+			return nil
+		}
+		fmt.Fprintln(w, "Node ID\tState\tUntrusted At\tNext Action At\tReclaimed\t")
+		for _, satellite := range resp.GetSatellites() {
+			id, err := storj.NodeIDFromBytes(satellite.GetId())
+			if err != nil {
+				return errs.Wrap(err)
+			}
+			next := "-"
+			if satellite.GetNextActionAt() != 0 {
+				next = time.Unix(satellite.GetNextActionAt(), 0).Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n", id, satellite.GetState(),
+				time.Unix(satellite.GetUntrustedAt(), 0).Format(time.RFC3339), next,
+				memory.Size(satellite.GetBytesReclaimed()).Base10String())
+		}
+		return nil
+	})
+}
+
+func cmdAdminPurgeSatellite(cmd *cobra.Command, args []string) error {
+	satelliteID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if !prompt.Confirm(fmt.Sprintf("All data stored for satellite %s will be deleted right away. This action can not be undone. Are you sure you want to continue? y/n\n", satelliteID)) {
+		return nil
+	}
+	return withAdminClient(cmd, func(ctx context.Context, client *adminClient, w *tabwriter.Writer) error {
+		resp, err := client.admin().PurgeSatellite(ctx, &nodeadminpb.PurgeSatelliteRequest{SatelliteId: satelliteID.Bytes()})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		fmt.Fprintf(w, "Deleted %s of satellite %s.\n", memory.Size(resp.GetBytesDeleted()).Base10String(), satelliteID)
+		return nil
+	})
+}
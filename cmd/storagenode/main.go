@@ -4,6 +4,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -23,6 +24,7 @@ import (
 	"storj.io/storj/pkg/revocation"
 	"storj.io/storj/private/version"
 	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/storagenodedb"
 )
 
@@ -81,6 +83,13 @@ var (
 		RunE:        cmdGracefulExitStatus,
 		Annotations: map[string]string{"type": "helper"},
 	}
+	migrateStorageCmd = &cobra.Command{
+		Use:         "migrate-storage [path] [backend]",
+		Short:       "Migrate pieces to another directory or backend, or display the migration status",
+		Args:        cobra.MaximumNArgs(2),
+		RunE:        cmdMigrateStorage,
+		Annotations: map[string]string{"type": "helper"},
+	}
 
 	runCfg       StorageNodeFlags
 	setupCfg     StorageNodeFlags
@@ -114,6 +123,8 @@ func init() {
 	rootCmd.AddCommand(dashboardCmd)
 	rootCmd.AddCommand(gracefulExitInitCmd)
 	rootCmd.AddCommand(gracefulExitStatusCmd)
+	rootCmd.AddCommand(migrateStorageCmd)
+	rootCmd.AddCommand(adminCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(configCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
@@ -121,15 +132,26 @@ func init() {
 	process.Bind(dashboardCmd, &dashboardCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
 	process.Bind(gracefulExitInitCmd, &diagCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
 	process.Bind(gracefulExitStatusCmd, &diagCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	process.Bind(migrateStorageCmd, &diagCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	for _, cmd := range adminCmd.Commands() {
+		process.Bind(cmd, &diagCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	}
 }
 
-func databaseConfig(config storagenode.Config) storagenodedb.Config {
-	return storagenodedb.Config{
-		Storage: config.Storage.Path,
-		Info:    filepath.Join(config.Storage.Path, "piecestore.db"),
-		Info2:   filepath.Join(config.Storage.Path, "info.db"),
-		Pieces:  config.Storage.Path,
+func databaseConfig(config storagenode.Config) (storagenodedb.Config, error) {
+	locations, err := config.Storage.Locations()
+	if err != nil {
+		return storagenodedb.Config{}, err
 	}
+
+	return storagenodedb.Config{
+		Storage:        config.Storage.Path,
+		Info:           filepath.Join(config.Storage.Path, "piecestore.db"),
+		Info2:          filepath.Join(config.Storage.Path, "info.db"),
+		Pieces:         config.Storage.Path,
+		PieceLocations: locations,
+		PieceBackend:   config.Storage.Backend,
+	}, nil
 }
 
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
@@ -152,7 +174,12 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
-	db, err := storagenodedb.New(log.Named("db"), databaseConfig(runCfg.Config))
+	dbConfig, err := databaseConfig(runCfg.Config)
+	if err != nil {
+		return err
+	}
+
+	db, err := storagenodedb.New(log.Named("db"), dbConfig)
 	if err != nil {
 		return errs.New("Error starting master database on storagenode: %+v", err)
 	}
@@ -185,15 +212,33 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		zap.S().Warn("Failed to initialize telemetry batcher: ", err)
 	}
 
+	preflightEnabled, err := cmd.Flags().GetBool("preflight.database-check")
+	if err != nil {
+		return errs.New("Cannot retrieve preflight.database-check flag: %+v", err)
+	}
+
+	var repairs []storagenodedb.Repair
+	if preflightEnabled {
+		repairs, err = db.Repair(ctx)
+		if err != nil {
+			return errs.New("Error repairing corrupt databases on storagenode: %+v", err)
+		}
+	}
+
 	err = db.CreateTables(ctx)
 	if err != nil {
 		return errs.New("Error creating tables for master database on storagenode: %+v", err)
 	}
 
-	preflightEnabled, err := cmd.Flags().GetBool("preflight.database-check")
+	for _, repair := range repairs {
+		notifyRepair(ctx, peer, repair)
+	}
+
+	err = db.ConvertPieces(ctx)
 	if err != nil {
-		return errs.New("Cannot retrieve preflight.database-check flag: %+v", err)
+		return errs.New("Error converting pieces to pack files: %+v", err)
 	}
+
 	if preflightEnabled {
 		err = db.Preflight(ctx)
 		if err != nil {
@@ -201,6 +246,17 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		}
 	}
 
+	integrityCheckEnabled, err := cmd.Flags().GetBool("preflight.database-integrity-check")
+	if err != nil {
+		return errs.New("Cannot retrieve preflight.database-integrity-check flag: %+v", err)
+	}
+	if integrityCheckEnabled {
+		err = db.CheckIntegrity(ctx)
+		if err != nil {
+			return errs.New("Error during integrity check for storagenode databases: %+v", err)
+		}
+	}
+
 	if err := peer.Storage2.CacheService.Init(ctx); err != nil {
 		zap.S().Error("Failed to initialize CacheService: ", err)
 	}
@@ -211,6 +267,26 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 	return errs.Combine(runError, closeError)
 }
 
+// notifyRepair notifies the operator about a corrupt database, which was replaced.
+func notifyRepair(ctx context.Context, peer *storagenode.Peer, repair storagenodedb.Repair) {
+	message := fmt.Sprintf("The %s database was corrupt and was recreated empty. Its contents are rebuilt by the node.", repair.Database)
+	if !repair.BackupTime.IsZero() {
+		message = fmt.Sprintf("The %s database was corrupt and was restored from the backup made at %s. Changes since the backup are lost.",
+			repair.Database, repair.BackupTime.Format(time.RFC1123))
+	}
+	message += fmt.Sprintf(" The corrupt database was moved to %s.", repair.CorruptPath)
+
+	_, err := peer.Notifications.Service.Receive(ctx, notifications.NewNotification{
+		SenderID: peer.ID(),
+		Type:     notifications.TypeCustom,
+		Title:    "Database repaired",
+		Message:  message,
+	})
+	if err != nil {
+		zap.S().Warn("Failed to notify about the repaired database: ", err)
+	}
+}
+
 func cmdSetup(cmd *cobra.Command, args []string) (err error) {
 	setupDir, err := filepath.Abs(confDir)
 	if err != nil {
@@ -282,7 +358,12 @@ func cmdDiag(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
-	db, err := storagenodedb.New(zap.L().Named("db"), databaseConfig(diagCfg))
+	dbConfig, err := databaseConfig(diagCfg)
+	if err != nil {
+		return err
+	}
+
+	db, err := storagenodedb.New(zap.L().Named("db"), dbConfig)
 	if err != nil {
 		return errs.New("Error starting master database on storage node: %v", err)
 	}
@@ -0,0 +1,130 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/rpc"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/private/storagemigrationpb"
+	"storj.io/storj/storagenode/piecestore"
+)
+
+// storageMigrationPollInterval is how often the progress of the migration is displayed.
+const storageMigrationPollInterval = 5 * time.Second
+
+type storageMigrationClient struct {
+	conn *rpc.Conn
+}
+
+func dialStorageMigrationClient(ctx context.Context, address string) (*storageMigrationClient, error) {
+	conn, err := rpc.NewDefaultDialer(nil).DialAddressUnencrypted(ctx, address)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &storageMigrationClient{conn: conn}, nil
+}
+
+func (client *storageMigrationClient) startMigration(ctx context.Context, req *storagemigrationpb.StartMigrationRequest) (*storagemigrationpb.StartMigrationResponse, error) {
+	return storagemigrationpb.NewDRPCStorageMigrationClient(client.conn.Raw()).StartMigration(ctx, req)
+}
+
+func (client *storageMigrationClient) getMigrationStatus(ctx context.Context) (*storagemigrationpb.GetMigrationStatusResponse, error) {
+	return storagemigrationpb.NewDRPCStorageMigrationClient(client.conn.Raw()).GetMigrationStatus(ctx, &storagemigrationpb.GetMigrationStatusRequest{})
+}
+
+func (client *storageMigrationClient) close() error {
+	return client.conn.Close()
+}
+
+func cmdMigrateStorage(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	client, err := dialStorageMigrationClient(ctx, diagCfg.Server.PrivateAddress)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() {
+		if err := client.close(); err != nil {
+			zap.S().Debug("closing storage migration client failed", err)
+		}
+	}()
+
+	if len(args) == 0 {
+		status, err := client.getMigrationStatus(ctx)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		displayMigrationStatus(status)
+		return nil
+	}
+
+	path, err := filepath.Abs(args[0])
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	backend := piecestore.BackendFiles
+	if len(args) > 1 {
+		backend = args[1]
+	} else if diagCfg.Storage.Backend != "" {
+		backend = diagCfg.Storage.Backend
+	}
+
+	_, err = client.startMigration(ctx, &storagemigrationpb.StartMigrationRequest{
+		Path:    path,
+		Backend: backend,
+	})
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	fmt.Printf("Migrating pieces to %s (%s). The node keeps serving pieces during the migration, and continues it after a restart.\n", path, backend)
+
+	ticker := time.NewTicker(storageMigrationPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		status, err := client.getMigrationStatus(ctx)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		displayMigrationStatus(status)
+
+		if status.GetFinished() {
+			return nil
+		}
+		if !status.GetRunning() && status.GetError() != "" {
+			return errs.New("storage migration failed, run the command again to continue it")
+		}
+	}
+}
+
+func displayMigrationStatus(status *storagemigrationpb.GetMigrationStatusResponse) {
+	switch {
+	case status.GetPath() == "":
+		fmt.Println("No storage migration in progress.")
+	case status.GetFinished():
+		fmt.Printf("Finished migrating %d pieces (%s) to %s (%s). The previous piece directory can be removed.\n",
+			status.GetCopied(), memory.Size(status.GetCopiedBytes()).Base10String(), status.GetPath(), status.GetBackend())
+	case status.GetError() != "":
+		fmt.Printf("Migration to %s (%s) stopped after copying %d pieces (%s): %s\n",
+			status.GetPath(), status.GetBackend(), status.GetCopied(), memory.Size(status.GetCopiedBytes()).Base10String(), status.GetError())
+	default:
+		fmt.Printf("Migrating to %s (%s): copied %d pieces (%s)\n",
+			status.GetPath(), status.GetBackend(), status.GetCopied(), memory.Size(status.GetCopiedBytes()).Base10String())
+	}
+}
@@ -0,0 +1,6 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package corruptionpb contains protobuf messages and drpc services for
+// reporting corrupted pieces which are not yet part of storj.io/common/pb.
+package corruptionpb
@@ -0,0 +1,85 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sqliteutil
+
+import (
+	"context"
+	"strings"
+
+	sqlite3 "github.com/mattn/go-sqlite3"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/private/tagsql"
+)
+
+var (
+	// ErrIntegrity is error class for failing to run the integrity check.
+	ErrIntegrity = errs.Class("integrity check")
+
+	// ErrCorrupt is error class for databases failing the integrity check.
+	ErrCorrupt = errs.Class("database is corrupt")
+
+	// ErrBackup is error class for Backup.
+	ErrBackup = errs.Class("backup")
+)
+
+// CheckIntegrity runs the full sqlite3 integrity check on the database and
+// returns an ErrCorrupt error describing the problems found.
+func CheckIntegrity(ctx context.Context, db tagsql.DB) error {
+	return checkPragma(ctx, db, "PRAGMA integrity_check")
+}
+
+// QuickCheck runs the sqlite3 quick check on the database, which finds most
+// corruptions considerably faster than CheckIntegrity.
+func QuickCheck(ctx context.Context, db tagsql.DB) error {
+	return checkPragma(ctx, db, "PRAGMA quick_check")
+}
+
+// checkPragma runs an integrity checking pragma, which returns a single "ok"
+// row or rows describing the problems.
+func checkPragma(ctx context.Context, db tagsql.DB, pragma string) (err error) {
+	rows, err := db.QueryContext(ctx, pragma)
+	if err != nil {
+		return ErrIntegrity.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var problems []string
+	for rows.Next() {
+		var result string
+		if err := rows.Scan(&result); err != nil {
+			return ErrIntegrity.Wrap(err)
+		}
+		if result != "ok" {
+			problems = append(problems, result)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return ErrIntegrity.Wrap(err)
+	}
+
+	if len(problems) > 0 {
+		return ErrCorrupt.New("%s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// IsCorruptError checks if given error is about a corrupt database file, which
+// can't be fixed by retrying.
+func IsCorruptError(err error) bool {
+	return ErrCorrupt.Has(err) || errs.IsFunc(err, func(err error) bool {
+		if e, ok := err.(sqlite3.Error); ok {
+			if e.Code == sqlite3.ErrCorrupt || e.Code == sqlite3.ErrNotADB {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Backup copies the contents of srcDB into destDB with the sqlite3 online
+// backup API, while srcDB is in use.
+func Backup(ctx context.Context, srcDB, destDB tagsql.DB) error {
+	return ErrBackup.Wrap(backupDBs(ctx, srcDB, destDB))
+}
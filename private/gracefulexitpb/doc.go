@@ -0,0 +1,7 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package gracefulexitpb contains protobuf messages and drpc services for
+// pausing graceful exits and partial exits which are not yet part of
+// storj.io/common/pb.
+package gracefulexitpb
@@ -0,0 +1,439 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: partialexit.proto
+
+package gracefulexitpb
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type StartPartialExitRequest struct {
+	// bytes is how much data the node wants to transfer away.
+	Bytes                int64    `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartPartialExitRequest) Reset()         { *m = StartPartialExitRequest{} }
+func (m *StartPartialExitRequest) String() string { return proto.CompactTextString(m) }
+func (*StartPartialExitRequest) ProtoMessage()    {}
+func (*StartPartialExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abe3ec5166a46144, []int{0}
+}
+func (m *StartPartialExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartPartialExitRequest.Unmarshal(m, b)
+}
+func (m *StartPartialExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartPartialExitRequest.Marshal(b, m, deterministic)
+}
+func (m *StartPartialExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartPartialExitRequest.Merge(m, src)
+}
+func (m *StartPartialExitRequest) XXX_Size() int {
+	return xxx_messageInfo_StartPartialExitRequest.Size(m)
+}
+func (m *StartPartialExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartPartialExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartPartialExitRequest proto.InternalMessageInfo
+
+func (m *StartPartialExitRequest) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+type StartPartialExitResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartPartialExitResponse) Reset()         { *m = StartPartialExitResponse{} }
+func (m *StartPartialExitResponse) String() string { return proto.CompactTextString(m) }
+func (*StartPartialExitResponse) ProtoMessage()    {}
+func (*StartPartialExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abe3ec5166a46144, []int{1}
+}
+func (m *StartPartialExitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartPartialExitResponse.Unmarshal(m, b)
+}
+func (m *StartPartialExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartPartialExitResponse.Marshal(b, m, deterministic)
+}
+func (m *StartPartialExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartPartialExitResponse.Merge(m, src)
+}
+func (m *StartPartialExitResponse) XXX_Size() int {
+	return xxx_messageInfo_StartPartialExitResponse.Size(m)
+}
+func (m *StartPartialExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartPartialExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartPartialExitResponse proto.InternalMessageInfo
+
+type GetPartialExitRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPartialExitRequest) Reset()         { *m = GetPartialExitRequest{} }
+func (m *GetPartialExitRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPartialExitRequest) ProtoMessage()    {}
+func (*GetPartialExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abe3ec5166a46144, []int{2}
+}
+func (m *GetPartialExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPartialExitRequest.Unmarshal(m, b)
+}
+func (m *GetPartialExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPartialExitRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPartialExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPartialExitRequest.Merge(m, src)
+}
+func (m *GetPartialExitRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPartialExitRequest.Size(m)
+}
+func (m *GetPartialExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPartialExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPartialExitRequest proto.InternalMessageInfo
+
+type GetPartialExitResponse struct {
+	RequestedBytes   int64 `protobuf:"varint,1,opt,name=requested_bytes,json=requestedBytes,proto3" json:"requested_bytes,omitempty"`
+	QueuedBytes      int64 `protobuf:"varint,2,opt,name=queued_bytes,json=queuedBytes,proto3" json:"queued_bytes,omitempty"`
+	TransferredBytes int64 `protobuf:"varint,3,opt,name=transferred_bytes,json=transferredBytes,proto3" json:"transferred_bytes,omitempty"`
+	// timestamps are in unix seconds, zero when not set.
+	RequestedAt          int64    `protobuf:"varint,4,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	QueuedAt             int64    `protobuf:"varint,5,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
+	FinishedAt           int64    `protobuf:"varint,6,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPartialExitResponse) Reset()         { *m = GetPartialExitResponse{} }
+func (m *GetPartialExitResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPartialExitResponse) ProtoMessage()    {}
+func (*GetPartialExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abe3ec5166a46144, []int{3}
+}
+func (m *GetPartialExitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPartialExitResponse.Unmarshal(m, b)
+}
+func (m *GetPartialExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPartialExitResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPartialExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPartialExitResponse.Merge(m, src)
+}
+func (m *GetPartialExitResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPartialExitResponse.Size(m)
+}
+func (m *GetPartialExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPartialExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPartialExitResponse proto.InternalMessageInfo
+
+func (m *GetPartialExitResponse) GetRequestedBytes() int64 {
+	if m != nil {
+		return m.RequestedBytes
+	}
+	return 0
+}
+
+func (m *GetPartialExitResponse) GetQueuedBytes() int64 {
+	if m != nil {
+		return m.QueuedBytes
+	}
+	return 0
+}
+
+func (m *GetPartialExitResponse) GetTransferredBytes() int64 {
+	if m != nil {
+		return m.TransferredBytes
+	}
+	return 0
+}
+
+func (m *GetPartialExitResponse) GetRequestedAt() int64 {
+	if m != nil {
+		return m.RequestedAt
+	}
+	return 0
+}
+
+func (m *GetPartialExitResponse) GetQueuedAt() int64 {
+	if m != nil {
+		return m.QueuedAt
+	}
+	return 0
+}
+
+func (m *GetPartialExitResponse) GetFinishedAt() int64 {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return 0
+}
+
+type CancelPartialExitRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelPartialExitRequest) Reset()         { *m = CancelPartialExitRequest{} }
+func (m *CancelPartialExitRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelPartialExitRequest) ProtoMessage()    {}
+func (*CancelPartialExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abe3ec5166a46144, []int{4}
+}
+func (m *CancelPartialExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelPartialExitRequest.Unmarshal(m, b)
+}
+func (m *CancelPartialExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelPartialExitRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelPartialExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelPartialExitRequest.Merge(m, src)
+}
+func (m *CancelPartialExitRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelPartialExitRequest.Size(m)
+}
+func (m *CancelPartialExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelPartialExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelPartialExitRequest proto.InternalMessageInfo
+
+type CancelPartialExitResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelPartialExitResponse) Reset()         { *m = CancelPartialExitResponse{} }
+func (m *CancelPartialExitResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelPartialExitResponse) ProtoMessage()    {}
+func (*CancelPartialExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abe3ec5166a46144, []int{5}
+}
+func (m *CancelPartialExitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelPartialExitResponse.Unmarshal(m, b)
+}
+func (m *CancelPartialExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelPartialExitResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelPartialExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelPartialExitResponse.Merge(m, src)
+}
+func (m *CancelPartialExitResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelPartialExitResponse.Size(m)
+}
+func (m *CancelPartialExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelPartialExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelPartialExitResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*StartPartialExitRequest)(nil), "gracefulexit.StartPartialExitRequest")
+	proto.RegisterType((*StartPartialExitResponse)(nil), "gracefulexit.StartPartialExitResponse")
+	proto.RegisterType((*GetPartialExitRequest)(nil), "gracefulexit.GetPartialExitRequest")
+	proto.RegisterType((*GetPartialExitResponse)(nil), "gracefulexit.GetPartialExitResponse")
+	proto.RegisterType((*CancelPartialExitRequest)(nil), "gracefulexit.CancelPartialExitRequest")
+	proto.RegisterType((*CancelPartialExitResponse)(nil), "gracefulexit.CancelPartialExitResponse")
+}
+
+func init() { proto.RegisterFile("partialexit.proto", fileDescriptor_abe3ec5166a46144) }
+
+var fileDescriptor_abe3ec5166a46144 = []byte{
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xdd, 0x4e, 0xc2, 0x40,
+	0x10, 0x85, 0x03, 0x08, 0xd1, 0x81, 0xd4, 0x76, 0xa3, 0x52, 0xcb, 0x85, 0x5a, 0x7f, 0x30, 0x31,
+	0xa9, 0x89, 0x3e, 0x41, 0x31, 0x86, 0x5b, 0x83, 0x77, 0x7a, 0x61, 0xb6, 0x30, 0xd5, 0x26, 0xa4,
+	0x2d, 0xbb, 0xd3, 0x04, 0x9f, 0xcb, 0x37, 0xf3, 0x09, 0x0c, 0xbb, 0xb5, 0x16, 0x28, 0x72, 0xd9,
+	0x73, 0xbe, 0x99, 0x69, 0xcf, 0x29, 0x58, 0x29, 0x17, 0x14, 0xf1, 0x29, 0xce, 0x23, 0xf2, 0x52,
+	0x91, 0x50, 0xc2, 0x3a, 0xef, 0x82, 0x8f, 0x31, 0xcc, 0x94, 0xe6, 0xde, 0x42, 0xf7, 0x99, 0xb8,
+	0xa0, 0x27, 0xcd, 0x3d, 0xce, 0x23, 0x1a, 0xe1, 0x2c, 0x43, 0x49, 0xec, 0x00, 0x9a, 0xc1, 0x27,
+	0xa1, 0xb4, 0x6b, 0xa7, 0xb5, 0xeb, 0xc6, 0x48, 0x3f, 0xb8, 0x0e, 0xd8, 0xeb, 0x03, 0x32, 0x4d,
+	0x62, 0x89, 0x6e, 0x17, 0x0e, 0x87, 0x58, 0xb1, 0xca, 0xfd, 0xae, 0xc1, 0xd1, 0x10, 0xab, 0x66,
+	0x58, 0x1f, 0xf6, 0x85, 0xa6, 0x70, 0xf2, 0x56, 0xbe, 0x67, 0x14, 0xf2, 0x60, 0xa1, 0xb2, 0x33,
+	0xe8, 0xcc, 0x32, 0xcc, 0x0a, 0xaa, 0xae, 0xa8, 0xb6, 0xd6, 0x34, 0x72, 0x03, 0x16, 0x09, 0x1e,
+	0xcb, 0x10, 0x85, 0x28, 0xb8, 0x86, 0xe2, 0xcc, 0x92, 0x51, 0xec, 0xfb, 0x3b, 0xcc, 0xc9, 0xde,
+	0xd1, 0xfb, 0x0a, 0xcd, 0x27, 0xd6, 0x83, 0xbd, 0xfc, 0x24, 0x27, 0xbb, 0xa9, 0xfc, 0x5d, 0x2d,
+	0xf8, 0xc4, 0x4e, 0xa0, 0x1d, 0x46, 0x71, 0x24, 0x3f, 0xb4, 0xdd, 0x52, 0x36, 0xfc, 0x4a, 0x3e,
+	0x2d, 0x92, 0x7a, 0xe0, 0xf1, 0x18, 0xa7, 0x15, 0x81, 0xf4, 0xe0, 0xb8, 0xc2, 0xd3, 0x91, 0xdc,
+	0x7d, 0xd5, 0xa1, 0x5d, 0xd2, 0x19, 0x07, 0x73, 0x35, 0x72, 0x76, 0xe9, 0x95, 0x6b, 0xf4, 0x36,
+	0x74, 0xe8, 0x5c, 0x6d, 0xc3, 0xf2, 0x16, 0x5e, 0xc1, 0x58, 0xee, 0x87, 0x9d, 0x2f, 0x4f, 0x56,
+	0xf6, 0xea, 0x5c, 0xfc, 0x0f, 0xe5, 0xcb, 0x27, 0x60, 0xad, 0x7d, 0x2c, 0x5b, 0x79, 0xb3, 0x4d,
+	0x49, 0x39, 0xfd, 0xad, 0x9c, 0xbe, 0x32, 0x30, 0x5f, 0x8c, 0x32, 0x99, 0x06, 0x41, 0x4b, 0xfd,
+	0xf0, 0xf7, 0x3f, 0x03, 0x00, 0x82, 0x04, 0x43, 0xe2, 0x05, 0x03, 0x00, 0x00,
+}
+
+type DRPCPartialExitClient interface {
+	DRPCConn() drpc.Conn
+
+	StartPartialExit(ctx context.Context, in *StartPartialExitRequest) (*StartPartialExitResponse, error)
+	GetPartialExit(ctx context.Context, in *GetPartialExitRequest) (*GetPartialExitResponse, error)
+	CancelPartialExit(ctx context.Context, in *CancelPartialExitRequest) (*CancelPartialExitResponse, error)
+}
+
+type drpcPartialExitClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCPartialExitClient(cc drpc.Conn) DRPCPartialExitClient {
+	return &drpcPartialExitClient{cc}
+}
+
+func (c *drpcPartialExitClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcPartialExitClient) StartPartialExit(ctx context.Context, in *StartPartialExitRequest) (*StartPartialExitResponse, error) {
+	out := new(StartPartialExitResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.PartialExit/StartPartialExit", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcPartialExitClient) GetPartialExit(ctx context.Context, in *GetPartialExitRequest) (*GetPartialExitResponse, error) {
+	out := new(GetPartialExitResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.PartialExit/GetPartialExit", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcPartialExitClient) CancelPartialExit(ctx context.Context, in *CancelPartialExitRequest) (*CancelPartialExitResponse, error) {
+	out := new(CancelPartialExitResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.PartialExit/CancelPartialExit", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCPartialExitServer interface {
+	StartPartialExit(context.Context, *StartPartialExitRequest) (*StartPartialExitResponse, error)
+	GetPartialExit(context.Context, *GetPartialExitRequest) (*GetPartialExitResponse, error)
+	CancelPartialExit(context.Context, *CancelPartialExitRequest) (*CancelPartialExitResponse, error)
+}
+
+type DRPCPartialExitDescription struct{}
+
+func (DRPCPartialExitDescription) NumMethods() int { return 3 }
+
+func (DRPCPartialExitDescription) Method(n int) (string, drpc.Handler, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/gracefulexit.PartialExit/StartPartialExit",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCPartialExitServer).
+					StartPartialExit(
+						ctx,
+						in1.(*StartPartialExitRequest),
+					)
+			}, DRPCPartialExitServer.StartPartialExit, true
+	case 1:
+		return "/gracefulexit.PartialExit/GetPartialExit",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCPartialExitServer).
+					GetPartialExit(
+						ctx,
+						in1.(*GetPartialExitRequest),
+					)
+			}, DRPCPartialExitServer.GetPartialExit, true
+	case 2:
+		return "/gracefulexit.PartialExit/CancelPartialExit",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCPartialExitServer).
+					CancelPartialExit(
+						ctx,
+						in1.(*CancelPartialExitRequest),
+					)
+			}, DRPCPartialExitServer.CancelPartialExit, true
+	default:
+		return "", nil, nil, false
+	}
+}
+
+func DRPCRegisterPartialExit(srv drpc.Server, impl DRPCPartialExitServer) {
+	srv.Register(impl, DRPCPartialExitDescription{})
+}
+
+type DRPCPartialExit_StartPartialExitStream interface {
+	drpc.Stream
+	SendAndClose(*StartPartialExitResponse) error
+}
+
+type drpcPartialExitStartPartialExitStream struct {
+	drpc.Stream
+}
+
+func (x *drpcPartialExitStartPartialExitStream) SendAndClose(m *StartPartialExitResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCPartialExit_GetPartialExitStream interface {
+	drpc.Stream
+	SendAndClose(*GetPartialExitResponse) error
+}
+
+type drpcPartialExitGetPartialExitStream struct {
+	drpc.Stream
+}
+
+func (x *drpcPartialExitGetPartialExitStream) SendAndClose(m *GetPartialExitResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCPartialExit_CancelPartialExitStream interface {
+	drpc.Stream
+	SendAndClose(*CancelPartialExitResponse) error
+}
+
+type drpcPartialExitCancelPartialExitStream struct {
+	drpc.Stream
+}
+
+func (x *drpcPartialExitCancelPartialExitStream) SendAndClose(m *CancelPartialExitResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pause.proto
+
+package gracefulexitpb
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type PauseRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseRequest) Reset()         { *m = PauseRequest{} }
+func (m *PauseRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseRequest) ProtoMessage()    {}
+func (*PauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23152a782c4e5b51, []int{0}
+}
+func (m *PauseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseRequest.Unmarshal(m, b)
+}
+func (m *PauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseRequest.Merge(m, src)
+}
+func (m *PauseRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseRequest.Size(m)
+}
+func (m *PauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseRequest proto.InternalMessageInfo
+
+type PauseResponse struct {
+	// remaining is how long in nanoseconds the node may still pause before
+	// the time is counted as inactive.
+	Remaining            int64    `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseResponse) Reset()         { *m = PauseResponse{} }
+func (m *PauseResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseResponse) ProtoMessage()    {}
+func (*PauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23152a782c4e5b51, []int{1}
+}
+func (m *PauseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseResponse.Unmarshal(m, b)
+}
+func (m *PauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseResponse.Marshal(b, m, deterministic)
+}
+func (m *PauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseResponse.Merge(m, src)
+}
+func (m *PauseResponse) XXX_Size() int {
+	return xxx_messageInfo_PauseResponse.Size(m)
+}
+func (m *PauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseResponse proto.InternalMessageInfo
+
+func (m *PauseResponse) GetRemaining() int64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+type ResumeRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeRequest) Reset()         { *m = ResumeRequest{} }
+func (m *ResumeRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeRequest) ProtoMessage()    {}
+func (*ResumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23152a782c4e5b51, []int{2}
+}
+func (m *ResumeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeRequest.Unmarshal(m, b)
+}
+func (m *ResumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeRequest.Merge(m, src)
+}
+func (m *ResumeRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeRequest.Size(m)
+}
+func (m *ResumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeRequest proto.InternalMessageInfo
+
+type ResumeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeResponse) Reset()         { *m = ResumeResponse{} }
+func (m *ResumeResponse) String() string { return proto.CompactTextString(m) }
+func (*ResumeResponse) ProtoMessage()    {}
+func (*ResumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23152a782c4e5b51, []int{3}
+}
+func (m *ResumeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeResponse.Unmarshal(m, b)
+}
+func (m *ResumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeResponse.Marshal(b, m, deterministic)
+}
+func (m *ResumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeResponse.Merge(m, src)
+}
+func (m *ResumeResponse) XXX_Size() int {
+	return xxx_messageInfo_ResumeResponse.Size(m)
+}
+func (m *ResumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*PauseRequest)(nil), "gracefulexit.PauseRequest")
+	proto.RegisterType((*PauseResponse)(nil), "gracefulexit.PauseResponse")
+	proto.RegisterType((*ResumeRequest)(nil), "gracefulexit.ResumeRequest")
+	proto.RegisterType((*ResumeResponse)(nil), "gracefulexit.ResumeResponse")
+}
+
+func init() { proto.RegisterFile("pause.proto", fileDescriptor_23152a782c4e5b51) }
+
+var fileDescriptor_23152a782c4e5b51 = []byte{
+	// 178 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2e, 0x48, 0x2c, 0x2d,
+	0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x49, 0x2f, 0x4a, 0x4c, 0x4e, 0x4d, 0x2b,
+	0xcd, 0x49, 0xad, 0xc8, 0x2c, 0x51, 0xe2, 0xe3, 0xe2, 0x09, 0x00, 0x49, 0x06, 0xa5, 0x16, 0x96,
+	0xa6, 0x16, 0x97, 0x28, 0xe9, 0x72, 0xf1, 0x42, 0xf9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42,
+	0x32, 0x5c, 0x9c, 0x45, 0xa9, 0xb9, 0x89, 0x99, 0x79, 0x99, 0x79, 0xe9, 0x12, 0x8c, 0x0a, 0x8c,
+	0x1a, 0xcc, 0x41, 0x08, 0x01, 0x25, 0x7e, 0x2e, 0xde, 0xa0, 0xd4, 0xe2, 0xd2, 0x5c, 0xb8, 0x7e,
+	0x01, 0x2e, 0x3e, 0x98, 0x00, 0xc4, 0x00, 0xa3, 0x59, 0x8c, 0x5c, 0x82, 0xee, 0x50, 0x2b, 0x5d,
+	0x2b, 0x32, 0x4b, 0xc0, 0xc6, 0x0b, 0x39, 0x70, 0xb1, 0x42, 0x18, 0x52, 0x7a, 0xc8, 0xee, 0xd1,
+	0x43, 0x76, 0x8c, 0x94, 0x34, 0x56, 0x39, 0xa8, 0xc3, 0x9c, 0xb9, 0xd8, 0x20, 0x36, 0x09, 0xa1,
+	0x29, 0x43, 0x71, 0x90, 0x94, 0x0c, 0x76, 0x49, 0x88, 0x21, 0x4e, 0x02, 0x51, 0x7c, 0xc8, 0xd2,
+	0x05, 0x49, 0x49, 0x6c, 0xe0, 0x50, 0x32, 0x06, 0x0c, 0x00, 0xba, 0xd5, 0xb2, 0x5f, 0x34, 0x01,
+	0x00, 0x00,
+}
+
+type DRPCGracefulExitPauseClient interface {
+	DRPCConn() drpc.Conn
+
+	Pause(ctx context.Context, in *PauseRequest) (*PauseResponse, error)
+	Resume(ctx context.Context, in *ResumeRequest) (*ResumeResponse, error)
+}
+
+type drpcGracefulExitPauseClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCGracefulExitPauseClient(cc drpc.Conn) DRPCGracefulExitPauseClient {
+	return &drpcGracefulExitPauseClient{cc}
+}
+
+func (c *drpcGracefulExitPauseClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcGracefulExitPauseClient) Pause(ctx context.Context, in *PauseRequest) (*PauseResponse, error) {
+	out := new(PauseResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.GracefulExitPause/Pause", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcGracefulExitPauseClient) Resume(ctx context.Context, in *ResumeRequest) (*ResumeResponse, error) {
+	out := new(ResumeResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.GracefulExitPause/Resume", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCGracefulExitPauseServer interface {
+	Pause(context.Context, *PauseRequest) (*PauseResponse, error)
+	Resume(context.Context, *ResumeRequest) (*ResumeResponse, error)
+}
+
+type DRPCGracefulExitPauseDescription struct{}
+
+func (DRPCGracefulExitPauseDescription) NumMethods() int { return 2 }
+
+func (DRPCGracefulExitPauseDescription) Method(n int) (string, drpc.Handler, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/gracefulexit.GracefulExitPause/Pause",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCGracefulExitPauseServer).
+					Pause(
+						ctx,
+						in1.(*PauseRequest),
+					)
+			}, DRPCGracefulExitPauseServer.Pause, true
+	case 1:
+		return "/gracefulexit.GracefulExitPause/Resume",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCGracefulExitPauseServer).
+					Resume(
+						ctx,
+						in1.(*ResumeRequest),
+					)
+			}, DRPCGracefulExitPauseServer.Resume, true
+	default:
+		return "", nil, nil, false
+	}
+}
+
+func DRPCRegisterGracefulExitPause(srv drpc.Server, impl DRPCGracefulExitPauseServer) {
+	srv.Register(impl, DRPCGracefulExitPauseDescription{})
+}
+
+type DRPCGracefulExitPause_PauseStream interface {
+	drpc.Stream
+	SendAndClose(*PauseResponse) error
+}
+
+type drpcGracefulExitPausePauseStream struct {
+	drpc.Stream
+}
+
+func (x *drpcGracefulExitPausePauseStream) SendAndClose(m *PauseResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCGracefulExitPause_ResumeStream interface {
+	drpc.Stream
+	SendAndClose(*ResumeResponse) error
+}
+
+type drpcGracefulExitPauseResumeStream struct {
+	drpc.Stream
+}
+
+func (x *drpcGracefulExitPauseResumeStream) SendAndClose(m *ResumeResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
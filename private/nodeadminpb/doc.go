@@ -0,0 +1,6 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodeadminpb contains protobuf messages and drpc services for
+// administrating a storage node locally over its private address.
+package nodeadminpb
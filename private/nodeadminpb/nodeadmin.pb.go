@@ -0,0 +1,1908 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nodeadmin.proto
+
+package nodeadminpb
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type ListSatellitesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSatellitesRequest) Reset()         { *m = ListSatellitesRequest{} }
+func (m *ListSatellitesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSatellitesRequest) ProtoMessage()    {}
+func (*ListSatellitesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{0}
+}
+func (m *ListSatellitesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSatellitesRequest.Unmarshal(m, b)
+}
+func (m *ListSatellitesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSatellitesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSatellitesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSatellitesRequest.Merge(m, src)
+}
+func (m *ListSatellitesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSatellitesRequest.Size(m)
+}
+func (m *ListSatellitesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSatellitesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSatellitesRequest proto.InternalMessageInfo
+
+type Satellite struct {
+	Id      []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// trusted is whether the satellite is in the trusted satellite list.
+	Trusted bool `protobuf:"varint,3,opt,name=trusted,proto3" json:"trusted,omitempty"`
+	// status is the graceful exit status: normal, exiting, exit-succeeded or exit-failed.
+	Status               string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	SpaceUsed            int64    `protobuf:"varint,5,opt,name=space_used,json=spaceUsed,proto3" json:"space_used,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Satellite) Reset()         { *m = Satellite{} }
+func (m *Satellite) String() string { return proto.CompactTextString(m) }
+func (*Satellite) ProtoMessage()    {}
+func (*Satellite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{1}
+}
+func (m *Satellite) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Satellite.Unmarshal(m, b)
+}
+func (m *Satellite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Satellite.Marshal(b, m, deterministic)
+}
+func (m *Satellite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Satellite.Merge(m, src)
+}
+func (m *Satellite) XXX_Size() int {
+	return xxx_messageInfo_Satellite.Size(m)
+}
+func (m *Satellite) XXX_DiscardUnknown() {
+	xxx_messageInfo_Satellite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Satellite proto.InternalMessageInfo
+
+func (m *Satellite) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *Satellite) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Satellite) GetTrusted() bool {
+	if m != nil {
+		return m.Trusted
+	}
+	return false
+}
+
+func (m *Satellite) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Satellite) GetSpaceUsed() int64 {
+	if m != nil {
+		return m.SpaceUsed
+	}
+	return 0
+}
+
+type ListSatellitesResponse struct {
+	Satellites           []*Satellite `protobuf:"bytes,1,rep,name=satellites,proto3" json:"satellites,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListSatellitesResponse) Reset()         { *m = ListSatellitesResponse{} }
+func (m *ListSatellitesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSatellitesResponse) ProtoMessage()    {}
+func (*ListSatellitesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{2}
+}
+func (m *ListSatellitesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSatellitesResponse.Unmarshal(m, b)
+}
+func (m *ListSatellitesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSatellitesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSatellitesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSatellitesResponse.Merge(m, src)
+}
+func (m *ListSatellitesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSatellitesResponse.Size(m)
+}
+func (m *ListSatellitesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSatellitesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSatellitesResponse proto.InternalMessageInfo
+
+func (m *ListSatellitesResponse) GetSatellites() []*Satellite {
+	if m != nil {
+		return m.Satellites
+	}
+	return nil
+}
+
+type ListChoresRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListChoresRequest) Reset()         { *m = ListChoresRequest{} }
+func (m *ListChoresRequest) String() string { return proto.CompactTextString(m) }
+func (*ListChoresRequest) ProtoMessage()    {}
+func (*ListChoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{3}
+}
+func (m *ListChoresRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChoresRequest.Unmarshal(m, b)
+}
+func (m *ListChoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChoresRequest.Marshal(b, m, deterministic)
+}
+func (m *ListChoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChoresRequest.Merge(m, src)
+}
+func (m *ListChoresRequest) XXX_Size() int {
+	return xxx_messageInfo_ListChoresRequest.Size(m)
+}
+func (m *ListChoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChoresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChoresRequest proto.InternalMessageInfo
+
+type Chore struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// interval is how often the chore runs, in nanoseconds.
+	Interval int64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// last_triggered is when the chore was last triggered by an administrator,
+	// in seconds since the unix epoch, or 0.
+	LastTriggered int64 `protobuf:"varint,3,opt,name=last_triggered,json=lastTriggered,proto3" json:"last_triggered,omitempty"`
+	// last_duration is how long the last triggered run took, in nanoseconds.
+	LastDuration         int64    `protobuf:"varint,4,opt,name=last_duration,json=lastDuration,proto3" json:"last_duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Chore) Reset()         { *m = Chore{} }
+func (m *Chore) String() string { return proto.CompactTextString(m) }
+func (*Chore) ProtoMessage()    {}
+func (*Chore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{4}
+}
+func (m *Chore) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Chore.Unmarshal(m, b)
+}
+func (m *Chore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Chore.Marshal(b, m, deterministic)
+}
+func (m *Chore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Chore.Merge(m, src)
+}
+func (m *Chore) XXX_Size() int {
+	return xxx_messageInfo_Chore.Size(m)
+}
+func (m *Chore) XXX_DiscardUnknown() {
+	xxx_messageInfo_Chore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Chore proto.InternalMessageInfo
+
+func (m *Chore) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Chore) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Chore) GetLastTriggered() int64 {
+	if m != nil {
+		return m.LastTriggered
+	}
+	return 0
+}
+
+func (m *Chore) GetLastDuration() int64 {
+	if m != nil {
+		return m.LastDuration
+	}
+	return 0
+}
+
+type ListChoresResponse struct {
+	Chores               []*Chore `protobuf:"bytes,1,rep,name=chores,proto3" json:"chores,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListChoresResponse) Reset()         { *m = ListChoresResponse{} }
+func (m *ListChoresResponse) String() string { return proto.CompactTextString(m) }
+func (*ListChoresResponse) ProtoMessage()    {}
+func (*ListChoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{5}
+}
+func (m *ListChoresResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChoresResponse.Unmarshal(m, b)
+}
+func (m *ListChoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChoresResponse.Marshal(b, m, deterministic)
+}
+func (m *ListChoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChoresResponse.Merge(m, src)
+}
+func (m *ListChoresResponse) XXX_Size() int {
+	return xxx_messageInfo_ListChoresResponse.Size(m)
+}
+func (m *ListChoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChoresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChoresResponse proto.InternalMessageInfo
+
+func (m *ListChoresResponse) GetChores() []*Chore {
+	if m != nil {
+		return m.Chores
+	}
+	return nil
+}
+
+type TriggerChoreRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerChoreRequest) Reset()         { *m = TriggerChoreRequest{} }
+func (m *TriggerChoreRequest) String() string { return proto.CompactTextString(m) }
+func (*TriggerChoreRequest) ProtoMessage()    {}
+func (*TriggerChoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{6}
+}
+func (m *TriggerChoreRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerChoreRequest.Unmarshal(m, b)
+}
+func (m *TriggerChoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerChoreRequest.Marshal(b, m, deterministic)
+}
+func (m *TriggerChoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerChoreRequest.Merge(m, src)
+}
+func (m *TriggerChoreRequest) XXX_Size() int {
+	return xxx_messageInfo_TriggerChoreRequest.Size(m)
+}
+func (m *TriggerChoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerChoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerChoreRequest proto.InternalMessageInfo
+
+func (m *TriggerChoreRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type TriggerChoreResponse struct {
+	// duration is how long the run took, in nanoseconds.
+	Duration             int64    `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerChoreResponse) Reset()         { *m = TriggerChoreResponse{} }
+func (m *TriggerChoreResponse) String() string { return proto.CompactTextString(m) }
+func (*TriggerChoreResponse) ProtoMessage()    {}
+func (*TriggerChoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{7}
+}
+func (m *TriggerChoreResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerChoreResponse.Unmarshal(m, b)
+}
+func (m *TriggerChoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerChoreResponse.Marshal(b, m, deterministic)
+}
+func (m *TriggerChoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerChoreResponse.Merge(m, src)
+}
+func (m *TriggerChoreResponse) XXX_Size() int {
+	return xxx_messageInfo_TriggerChoreResponse.Size(m)
+}
+func (m *TriggerChoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerChoreResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerChoreResponse proto.InternalMessageInfo
+
+func (m *TriggerChoreResponse) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type RestoreTrashRequest struct {
+	SatelliteId          []byte   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3" json:"satellite_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreTrashRequest) Reset()         { *m = RestoreTrashRequest{} }
+func (m *RestoreTrashRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreTrashRequest) ProtoMessage()    {}
+func (*RestoreTrashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{8}
+}
+func (m *RestoreTrashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTrashRequest.Unmarshal(m, b)
+}
+func (m *RestoreTrashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTrashRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreTrashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTrashRequest.Merge(m, src)
+}
+func (m *RestoreTrashRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreTrashRequest.Size(m)
+}
+func (m *RestoreTrashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTrashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTrashRequest proto.InternalMessageInfo
+
+func (m *RestoreTrashRequest) GetSatelliteId() []byte {
+	if m != nil {
+		return m.SatelliteId
+	}
+	return nil
+}
+
+type RestoreTrashResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreTrashResponse) Reset()         { *m = RestoreTrashResponse{} }
+func (m *RestoreTrashResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreTrashResponse) ProtoMessage()    {}
+func (*RestoreTrashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{9}
+}
+func (m *RestoreTrashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTrashResponse.Unmarshal(m, b)
+}
+func (m *RestoreTrashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTrashResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreTrashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTrashResponse.Merge(m, src)
+}
+func (m *RestoreTrashResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreTrashResponse.Size(m)
+}
+func (m *RestoreTrashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTrashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTrashResponse proto.InternalMessageInfo
+
+type ListPiecesRequest struct {
+	SatelliteId []byte `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3" json:"satellite_id,omitempty"`
+	// limit is the maximum number of pieces returned.
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPiecesRequest) Reset()         { *m = ListPiecesRequest{} }
+func (m *ListPiecesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPiecesRequest) ProtoMessage()    {}
+func (*ListPiecesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{10}
+}
+func (m *ListPiecesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPiecesRequest.Unmarshal(m, b)
+}
+func (m *ListPiecesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPiecesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPiecesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPiecesRequest.Merge(m, src)
+}
+func (m *ListPiecesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPiecesRequest.Size(m)
+}
+func (m *ListPiecesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPiecesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPiecesRequest proto.InternalMessageInfo
+
+func (m *ListPiecesRequest) GetSatelliteId() []byte {
+	if m != nil {
+		return m.SatelliteId
+	}
+	return nil
+}
+
+func (m *ListPiecesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type Piece struct {
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// size is the size of the piece content, excluding the piece header.
+	Size_ int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// stored_at is when the piece was stored, in seconds since the unix epoch.
+	StoredAt             int64    `protobuf:"varint,3,opt,name=stored_at,json=storedAt,proto3" json:"stored_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Piece) Reset()         { *m = Piece{} }
+func (m *Piece) String() string { return proto.CompactTextString(m) }
+func (*Piece) ProtoMessage()    {}
+func (*Piece) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{11}
+}
+func (m *Piece) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Piece.Unmarshal(m, b)
+}
+func (m *Piece) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Piece.Marshal(b, m, deterministic)
+}
+func (m *Piece) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Piece.Merge(m, src)
+}
+func (m *Piece) XXX_Size() int {
+	return xxx_messageInfo_Piece.Size(m)
+}
+func (m *Piece) XXX_DiscardUnknown() {
+	xxx_messageInfo_Piece.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Piece proto.InternalMessageInfo
+
+func (m *Piece) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *Piece) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *Piece) GetStoredAt() int64 {
+	if m != nil {
+		return m.StoredAt
+	}
+	return 0
+}
+
+type ListPiecesResponse struct {
+	Pieces []*Piece `protobuf:"bytes,1,rep,name=pieces,proto3" json:"pieces,omitempty"`
+	// total_size is the space used by all pieces of the satellite.
+	TotalSize int64 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// more is set when the satellite has more pieces than the listed ones.
+	More                 bool     `protobuf:"varint,4,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPiecesResponse) Reset()         { *m = ListPiecesResponse{} }
+func (m *ListPiecesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPiecesResponse) ProtoMessage()    {}
+func (*ListPiecesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{12}
+}
+func (m *ListPiecesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPiecesResponse.Unmarshal(m, b)
+}
+func (m *ListPiecesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPiecesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPiecesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPiecesResponse.Merge(m, src)
+}
+func (m *ListPiecesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPiecesResponse.Size(m)
+}
+func (m *ListPiecesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPiecesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPiecesResponse proto.InternalMessageInfo
+
+func (m *ListPiecesResponse) GetPieces() []*Piece {
+	if m != nil {
+		return m.Pieces
+	}
+	return nil
+}
+
+func (m *ListPiecesResponse) GetTotalSize() int64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *ListPiecesResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type ListRetainRequestsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRetainRequestsRequest) Reset()         { *m = ListRetainRequestsRequest{} }
+func (m *ListRetainRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRetainRequestsRequest) ProtoMessage()    {}
+func (*ListRetainRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{13}
+}
+func (m *ListRetainRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRetainRequestsRequest.Unmarshal(m, b)
+}
+func (m *ListRetainRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRetainRequestsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRetainRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRetainRequestsRequest.Merge(m, src)
+}
+func (m *ListRetainRequestsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRetainRequestsRequest.Size(m)
+}
+func (m *ListRetainRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRetainRequestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRetainRequestsRequest proto.InternalMessageInfo
+
+type RetainRequest struct {
+	SatelliteId []byte `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3" json:"satellite_id,omitempty"`
+	// created_before is the time before which pieces not in the filter are
+	// garbage, in seconds since the unix epoch.
+	CreatedBefore        int64    `protobuf:"varint,2,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	Processing           bool     `protobuf:"varint,3,opt,name=processing,proto3" json:"processing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetainRequest) Reset()         { *m = RetainRequest{} }
+func (m *RetainRequest) String() string { return proto.CompactTextString(m) }
+func (*RetainRequest) ProtoMessage()    {}
+func (*RetainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{14}
+}
+func (m *RetainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainRequest.Unmarshal(m, b)
+}
+func (m *RetainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainRequest.Marshal(b, m, deterministic)
+}
+func (m *RetainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainRequest.Merge(m, src)
+}
+func (m *RetainRequest) XXX_Size() int {
+	return xxx_messageInfo_RetainRequest.Size(m)
+}
+func (m *RetainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainRequest proto.InternalMessageInfo
+
+func (m *RetainRequest) GetSatelliteId() []byte {
+	if m != nil {
+		return m.SatelliteId
+	}
+	return nil
+}
+
+func (m *RetainRequest) GetCreatedBefore() int64 {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return 0
+}
+
+func (m *RetainRequest) GetProcessing() bool {
+	if m != nil {
+		return m.Processing
+	}
+	return false
+}
+
+type ListRetainRequestsResponse struct {
+	// status is whether retain requests are enabled, disabled or only logged.
+	Status               string           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Requests             []*RetainRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListRetainRequestsResponse) Reset()         { *m = ListRetainRequestsResponse{} }
+func (m *ListRetainRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRetainRequestsResponse) ProtoMessage()    {}
+func (*ListRetainRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{15}
+}
+func (m *ListRetainRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRetainRequestsResponse.Unmarshal(m, b)
+}
+func (m *ListRetainRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRetainRequestsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListRetainRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRetainRequestsResponse.Merge(m, src)
+}
+func (m *ListRetainRequestsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRetainRequestsResponse.Size(m)
+}
+func (m *ListRetainRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRetainRequestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRetainRequestsResponse proto.InternalMessageInfo
+
+func (m *ListRetainRequestsResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ListRetainRequestsResponse) GetRequests() []*RetainRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+type ListPendingSatellitesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPendingSatellitesRequest) Reset()         { *m = ListPendingSatellitesRequest{} }
+func (m *ListPendingSatellitesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPendingSatellitesRequest) ProtoMessage()    {}
+func (*ListPendingSatellitesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{16}
+}
+func (m *ListPendingSatellitesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPendingSatellitesRequest.Unmarshal(m, b)
+}
+func (m *ListPendingSatellitesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPendingSatellitesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPendingSatellitesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPendingSatellitesRequest.Merge(m, src)
+}
+func (m *ListPendingSatellitesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPendingSatellitesRequest.Size(m)
+}
+func (m *ListPendingSatellitesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPendingSatellitesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPendingSatellitesRequest proto.InternalMessageInfo
+
+type PendingSatellite struct {
+	Id      []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// source is the trust source which listed the satellite.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// signed_by is the public key the list was signed with, if it was verified.
+	SignedBy string `protobuf:"bytes,4,opt,name=signed_by,json=signedBy,proto3" json:"signed_by,omitempty"`
+	// fetched_at is when the list was fetched, in seconds since the unix epoch.
+	FetchedAt            int64    `protobuf:"varint,5,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingSatellite) Reset()         { *m = PendingSatellite{} }
+func (m *PendingSatellite) String() string { return proto.CompactTextString(m) }
+func (*PendingSatellite) ProtoMessage()    {}
+func (*PendingSatellite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{17}
+}
+func (m *PendingSatellite) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingSatellite.Unmarshal(m, b)
+}
+func (m *PendingSatellite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingSatellite.Marshal(b, m, deterministic)
+}
+func (m *PendingSatellite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSatellite.Merge(m, src)
+}
+func (m *PendingSatellite) XXX_Size() int {
+	return xxx_messageInfo_PendingSatellite.Size(m)
+}
+func (m *PendingSatellite) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSatellite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSatellite proto.InternalMessageInfo
+
+func (m *PendingSatellite) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *PendingSatellite) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PendingSatellite) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *PendingSatellite) GetSignedBy() string {
+	if m != nil {
+		return m.SignedBy
+	}
+	return ""
+}
+
+func (m *PendingSatellite) GetFetchedAt() int64 {
+	if m != nil {
+		return m.FetchedAt
+	}
+	return 0
+}
+
+type ListPendingSatellitesResponse struct {
+	Satellites           []*PendingSatellite `protobuf:"bytes,1,rep,name=satellites,proto3" json:"satellites,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListPendingSatellitesResponse) Reset()         { *m = ListPendingSatellitesResponse{} }
+func (m *ListPendingSatellitesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPendingSatellitesResponse) ProtoMessage()    {}
+func (*ListPendingSatellitesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{18}
+}
+func (m *ListPendingSatellitesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPendingSatellitesResponse.Unmarshal(m, b)
+}
+func (m *ListPendingSatellitesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPendingSatellitesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPendingSatellitesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPendingSatellitesResponse.Merge(m, src)
+}
+func (m *ListPendingSatellitesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPendingSatellitesResponse.Size(m)
+}
+func (m *ListPendingSatellitesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPendingSatellitesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPendingSatellitesResponse proto.InternalMessageInfo
+
+func (m *ListPendingSatellitesResponse) GetSatellites() []*PendingSatellite {
+	if m != nil {
+		return m.Satellites
+	}
+	return nil
+}
+
+type AcceptSatelliteRequest struct {
+	SatelliteId          []byte   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3" json:"satellite_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptSatelliteRequest) Reset()         { *m = AcceptSatelliteRequest{} }
+func (m *AcceptSatelliteRequest) String() string { return proto.CompactTextString(m) }
+func (*AcceptSatelliteRequest) ProtoMessage()    {}
+func (*AcceptSatelliteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{19}
+}
+func (m *AcceptSatelliteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptSatelliteRequest.Unmarshal(m, b)
+}
+func (m *AcceptSatelliteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptSatelliteRequest.Marshal(b, m, deterministic)
+}
+func (m *AcceptSatelliteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptSatelliteRequest.Merge(m, src)
+}
+func (m *AcceptSatelliteRequest) XXX_Size() int {
+	return xxx_messageInfo_AcceptSatelliteRequest.Size(m)
+}
+func (m *AcceptSatelliteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptSatelliteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptSatelliteRequest proto.InternalMessageInfo
+
+func (m *AcceptSatelliteRequest) GetSatelliteId() []byte {
+	if m != nil {
+		return m.SatelliteId
+	}
+	return nil
+}
+
+type AcceptSatelliteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptSatelliteResponse) Reset()         { *m = AcceptSatelliteResponse{} }
+func (m *AcceptSatelliteResponse) String() string { return proto.CompactTextString(m) }
+func (*AcceptSatelliteResponse) ProtoMessage()    {}
+func (*AcceptSatelliteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{20}
+}
+func (m *AcceptSatelliteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptSatelliteResponse.Unmarshal(m, b)
+}
+func (m *AcceptSatelliteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptSatelliteResponse.Marshal(b, m, deterministic)
+}
+func (m *AcceptSatelliteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptSatelliteResponse.Merge(m, src)
+}
+func (m *AcceptSatelliteResponse) XXX_Size() int {
+	return xxx_messageInfo_AcceptSatelliteResponse.Size(m)
+}
+func (m *AcceptSatelliteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptSatelliteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptSatelliteResponse proto.InternalMessageInfo
+
+type ListUntrustedSatellitesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListUntrustedSatellitesRequest) Reset()         { *m = ListUntrustedSatellitesRequest{} }
+func (m *ListUntrustedSatellitesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUntrustedSatellitesRequest) ProtoMessage()    {}
+func (*ListUntrustedSatellitesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{21}
+}
+func (m *ListUntrustedSatellitesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUntrustedSatellitesRequest.Unmarshal(m, b)
+}
+func (m *ListUntrustedSatellitesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUntrustedSatellitesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListUntrustedSatellitesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUntrustedSatellitesRequest.Merge(m, src)
+}
+func (m *ListUntrustedSatellitesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUntrustedSatellitesRequest.Size(m)
+}
+func (m *ListUntrustedSatellitesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUntrustedSatellitesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUntrustedSatellitesRequest proto.InternalMessageInfo
+
+type UntrustedSatellite struct {
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// state is whether the data is kept, trashed or deleted.
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// untrusted_at is when the satellite was noticed as untrusted, in seconds
+	// since the unix epoch.
+	UntrustedAt int64 `protobuf:"varint,3,opt,name=untrusted_at,json=untrustedAt,proto3" json:"untrusted_at,omitempty"`
+	// next_action_at is when the data is trashed or deleted, in seconds since
+	// the unix epoch, or 0 if nothing is scheduled.
+	NextActionAt         int64    `protobuf:"varint,4,opt,name=next_action_at,json=nextActionAt,proto3" json:"next_action_at,omitempty"`
+	BytesReclaimed       int64    `protobuf:"varint,5,opt,name=bytes_reclaimed,json=bytesReclaimed,proto3" json:"bytes_reclaimed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UntrustedSatellite) Reset()         { *m = UntrustedSatellite{} }
+func (m *UntrustedSatellite) String() string { return proto.CompactTextString(m) }
+func (*UntrustedSatellite) ProtoMessage()    {}
+func (*UntrustedSatellite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{22}
+}
+func (m *UntrustedSatellite) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UntrustedSatellite.Unmarshal(m, b)
+}
+func (m *UntrustedSatellite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UntrustedSatellite.Marshal(b, m, deterministic)
+}
+func (m *UntrustedSatellite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UntrustedSatellite.Merge(m, src)
+}
+func (m *UntrustedSatellite) XXX_Size() int {
+	return xxx_messageInfo_UntrustedSatellite.Size(m)
+}
+func (m *UntrustedSatellite) XXX_DiscardUnknown() {
+	xxx_messageInfo_UntrustedSatellite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UntrustedSatellite proto.InternalMessageInfo
+
+func (m *UntrustedSatellite) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *UntrustedSatellite) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *UntrustedSatellite) GetUntrustedAt() int64 {
+	if m != nil {
+		return m.UntrustedAt
+	}
+	return 0
+}
+
+func (m *UntrustedSatellite) GetNextActionAt() int64 {
+	if m != nil {
+		return m.NextActionAt
+	}
+	return 0
+}
+
+func (m *UntrustedSatellite) GetBytesReclaimed() int64 {
+	if m != nil {
+		return m.BytesReclaimed
+	}
+	return 0
+}
+
+type ListUntrustedSatellitesResponse struct {
+	Satellites           []*UntrustedSatellite `protobuf:"bytes,1,rep,name=satellites,proto3" json:"satellites,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListUntrustedSatellitesResponse) Reset()         { *m = ListUntrustedSatellitesResponse{} }
+func (m *ListUntrustedSatellitesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUntrustedSatellitesResponse) ProtoMessage()    {}
+func (*ListUntrustedSatellitesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{23}
+}
+func (m *ListUntrustedSatellitesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUntrustedSatellitesResponse.Unmarshal(m, b)
+}
+func (m *ListUntrustedSatellitesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUntrustedSatellitesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListUntrustedSatellitesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUntrustedSatellitesResponse.Merge(m, src)
+}
+func (m *ListUntrustedSatellitesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUntrustedSatellitesResponse.Size(m)
+}
+func (m *ListUntrustedSatellitesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUntrustedSatellitesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUntrustedSatellitesResponse proto.InternalMessageInfo
+
+func (m *ListUntrustedSatellitesResponse) GetSatellites() []*UntrustedSatellite {
+	if m != nil {
+		return m.Satellites
+	}
+	return nil
+}
+
+type PurgeSatelliteRequest struct {
+	SatelliteId          []byte   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3" json:"satellite_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgeSatelliteRequest) Reset()         { *m = PurgeSatelliteRequest{} }
+func (m *PurgeSatelliteRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgeSatelliteRequest) ProtoMessage()    {}
+func (*PurgeSatelliteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{24}
+}
+func (m *PurgeSatelliteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeSatelliteRequest.Unmarshal(m, b)
+}
+func (m *PurgeSatelliteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeSatelliteRequest.Marshal(b, m, deterministic)
+}
+func (m *PurgeSatelliteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeSatelliteRequest.Merge(m, src)
+}
+func (m *PurgeSatelliteRequest) XXX_Size() int {
+	return xxx_messageInfo_PurgeSatelliteRequest.Size(m)
+}
+func (m *PurgeSatelliteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeSatelliteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeSatelliteRequest proto.InternalMessageInfo
+
+func (m *PurgeSatelliteRequest) GetSatelliteId() []byte {
+	if m != nil {
+		return m.SatelliteId
+	}
+	return nil
+}
+
+type PurgeSatelliteResponse struct {
+	BytesDeleted         int64    `protobuf:"varint,1,opt,name=bytes_deleted,json=bytesDeleted,proto3" json:"bytes_deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgeSatelliteResponse) Reset()         { *m = PurgeSatelliteResponse{} }
+func (m *PurgeSatelliteResponse) String() string { return proto.CompactTextString(m) }
+func (*PurgeSatelliteResponse) ProtoMessage()    {}
+func (*PurgeSatelliteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{25}
+}
+func (m *PurgeSatelliteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeSatelliteResponse.Unmarshal(m, b)
+}
+func (m *PurgeSatelliteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeSatelliteResponse.Marshal(b, m, deterministic)
+}
+func (m *PurgeSatelliteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeSatelliteResponse.Merge(m, src)
+}
+func (m *PurgeSatelliteResponse) XXX_Size() int {
+	return xxx_messageInfo_PurgeSatelliteResponse.Size(m)
+}
+func (m *PurgeSatelliteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeSatelliteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeSatelliteResponse proto.InternalMessageInfo
+
+func (m *PurgeSatelliteResponse) GetBytesDeleted() int64 {
+	if m != nil {
+		return m.BytesDeleted
+	}
+	return 0
+}
+
+type NotifyRequest struct {
+	// type is the name of the notification type, e.g. update-failed.
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NotifyRequest) Reset()         { *m = NotifyRequest{} }
+func (m *NotifyRequest) String() string { return proto.CompactTextString(m) }
+func (*NotifyRequest) ProtoMessage()    {}
+func (*NotifyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{26}
+}
+func (m *NotifyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotifyRequest.Unmarshal(m, b)
+}
+func (m *NotifyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotifyRequest.Marshal(b, m, deterministic)
+}
+func (m *NotifyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotifyRequest.Merge(m, src)
+}
+func (m *NotifyRequest) XXX_Size() int {
+	return xxx_messageInfo_NotifyRequest.Size(m)
+}
+func (m *NotifyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotifyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotifyRequest proto.InternalMessageInfo
+
+func (m *NotifyRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *NotifyRequest) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *NotifyRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type NotifyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NotifyResponse) Reset()         { *m = NotifyResponse{} }
+func (m *NotifyResponse) String() string { return proto.CompactTextString(m) }
+func (*NotifyResponse) ProtoMessage()    {}
+func (*NotifyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{27}
+}
+func (m *NotifyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotifyResponse.Unmarshal(m, b)
+}
+func (m *NotifyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotifyResponse.Marshal(b, m, deterministic)
+}
+func (m *NotifyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotifyResponse.Merge(m, src)
+}
+func (m *NotifyResponse) XXX_Size() int {
+	return xxx_messageInfo_NotifyResponse.Size(m)
+}
+func (m *NotifyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotifyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotifyResponse proto.InternalMessageInfo
+
+type GetVersionRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVersionRequest) Reset()         { *m = GetVersionRequest{} }
+func (m *GetVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetVersionRequest) ProtoMessage()    {}
+func (*GetVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{28}
+}
+func (m *GetVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVersionRequest.Unmarshal(m, b)
+}
+func (m *GetVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVersionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVersionRequest.Merge(m, src)
+}
+func (m *GetVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetVersionRequest.Size(m)
+}
+func (m *GetVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVersionRequest proto.InternalMessageInfo
+
+type GetVersionResponse struct {
+	// version is the semantic version of the running storage node, e.g. v1.2.3.
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	CommitHash           string   `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	Release              bool     `protobuf:"varint,3,opt,name=release,proto3" json:"release,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVersionResponse) Reset()         { *m = GetVersionResponse{} }
+func (m *GetVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetVersionResponse) ProtoMessage()    {}
+func (*GetVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7078245bf50ceda6, []int{29}
+}
+func (m *GetVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVersionResponse.Unmarshal(m, b)
+}
+func (m *GetVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVersionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVersionResponse.Merge(m, src)
+}
+func (m *GetVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetVersionResponse.Size(m)
+}
+func (m *GetVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVersionResponse proto.InternalMessageInfo
+
+func (m *GetVersionResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *GetVersionResponse) GetCommitHash() string {
+	if m != nil {
+		return m.CommitHash
+	}
+	return ""
+}
+
+func (m *GetVersionResponse) GetRelease() bool {
+	if m != nil {
+		return m.Release
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*ListSatellitesRequest)(nil), "nodeadmin.ListSatellitesRequest")
+	proto.RegisterType((*Satellite)(nil), "nodeadmin.Satellite")
+	proto.RegisterType((*ListSatellitesResponse)(nil), "nodeadmin.ListSatellitesResponse")
+	proto.RegisterType((*ListChoresRequest)(nil), "nodeadmin.ListChoresRequest")
+	proto.RegisterType((*Chore)(nil), "nodeadmin.Chore")
+	proto.RegisterType((*ListChoresResponse)(nil), "nodeadmin.ListChoresResponse")
+	proto.RegisterType((*TriggerChoreRequest)(nil), "nodeadmin.TriggerChoreRequest")
+	proto.RegisterType((*TriggerChoreResponse)(nil), "nodeadmin.TriggerChoreResponse")
+	proto.RegisterType((*RestoreTrashRequest)(nil), "nodeadmin.RestoreTrashRequest")
+	proto.RegisterType((*RestoreTrashResponse)(nil), "nodeadmin.RestoreTrashResponse")
+	proto.RegisterType((*ListPiecesRequest)(nil), "nodeadmin.ListPiecesRequest")
+	proto.RegisterType((*Piece)(nil), "nodeadmin.Piece")
+	proto.RegisterType((*ListPiecesResponse)(nil), "nodeadmin.ListPiecesResponse")
+	proto.RegisterType((*ListRetainRequestsRequest)(nil), "nodeadmin.ListRetainRequestsRequest")
+	proto.RegisterType((*RetainRequest)(nil), "nodeadmin.RetainRequest")
+	proto.RegisterType((*ListRetainRequestsResponse)(nil), "nodeadmin.ListRetainRequestsResponse")
+	proto.RegisterType((*ListPendingSatellitesRequest)(nil), "nodeadmin.ListPendingSatellitesRequest")
+	proto.RegisterType((*PendingSatellite)(nil), "nodeadmin.PendingSatellite")
+	proto.RegisterType((*ListPendingSatellitesResponse)(nil), "nodeadmin.ListPendingSatellitesResponse")
+	proto.RegisterType((*AcceptSatelliteRequest)(nil), "nodeadmin.AcceptSatelliteRequest")
+	proto.RegisterType((*AcceptSatelliteResponse)(nil), "nodeadmin.AcceptSatelliteResponse")
+	proto.RegisterType((*ListUntrustedSatellitesRequest)(nil), "nodeadmin.ListUntrustedSatellitesRequest")
+	proto.RegisterType((*UntrustedSatellite)(nil), "nodeadmin.UntrustedSatellite")
+	proto.RegisterType((*ListUntrustedSatellitesResponse)(nil), "nodeadmin.ListUntrustedSatellitesResponse")
+	proto.RegisterType((*PurgeSatelliteRequest)(nil), "nodeadmin.PurgeSatelliteRequest")
+	proto.RegisterType((*PurgeSatelliteResponse)(nil), "nodeadmin.PurgeSatelliteResponse")
+	proto.RegisterType((*NotifyRequest)(nil), "nodeadmin.NotifyRequest")
+	proto.RegisterType((*NotifyResponse)(nil), "nodeadmin.NotifyResponse")
+	proto.RegisterType((*GetVersionRequest)(nil), "nodeadmin.GetVersionRequest")
+	proto.RegisterType((*GetVersionResponse)(nil), "nodeadmin.GetVersionResponse")
+}
+
+func init() { proto.RegisterFile("nodeadmin.proto", fileDescriptor_7078245bf50ceda6) }
+
+var fileDescriptor_7078245bf50ceda6 = []byte{
+	// 1108 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0x06, 0x2d, 0xcb, 0x91, 0xc6, 0x96, 0xec, 0xac, 0x1d, 0x59, 0xa6, 0x23, 0x5b, 0xde, 0xc4,
+	0x88, 0xd2, 0x43, 0x0e, 0x6e, 0x0e, 0x45, 0x83, 0x14, 0x90, 0x1b, 0xa0, 0x09, 0x10, 0xb8, 0x01,
+	0x1d, 0x17, 0x45, 0x51, 0x40, 0x5d, 0x93, 0x63, 0x69, 0x03, 0x89, 0x54, 0xb8, 0xab, 0xa0, 0xea,
+	0xb5, 0x40, 0xcf, 0x3d, 0xf5, 0x57, 0xf4, 0x47, 0x16, 0xfb, 0x20, 0x45, 0x4a, 0xa4, 0xe3, 0xf4,
+	0xa6, 0xfd, 0xe6, 0xb1, 0xf3, 0xda, 0x6f, 0x28, 0xd8, 0x0e, 0xa3, 0x00, 0x59, 0x30, 0xe1, 0xe1,
+	0xb3, 0x69, 0x1c, 0xc9, 0x88, 0xd4, 0x53, 0x80, 0xee, 0xc3, 0x83, 0xb7, 0x5c, 0xc8, 0x4b, 0x26,
+	0x71, 0x3c, 0xe6, 0x12, 0x85, 0x87, 0x1f, 0x67, 0x28, 0x24, 0xfd, 0xcb, 0x81, 0x7a, 0x8a, 0x92,
+	0x26, 0xac, 0xf1, 0xa0, 0xed, 0x74, 0x9d, 0xde, 0x96, 0xb7, 0xc6, 0x03, 0xd2, 0x86, 0x7b, 0x2c,
+	0x08, 0x62, 0x14, 0xa2, 0xbd, 0xd6, 0x75, 0x7a, 0x75, 0x2f, 0x39, 0x2a, 0x89, 0x8c, 0x67, 0x42,
+	0x62, 0xd0, 0xae, 0x74, 0x9d, 0x5e, 0xcd, 0x4b, 0x8e, 0xa4, 0x05, 0x1b, 0x42, 0x32, 0x39, 0x13,
+	0xed, 0x75, 0x6d, 0x62, 0x4f, 0xa4, 0x03, 0x20, 0xa6, 0xcc, 0xc7, 0xc1, 0x4c, 0x60, 0xd0, 0xae,
+	0x76, 0x9d, 0x5e, 0xc5, 0xab, 0x6b, 0xe4, 0x4a, 0x60, 0x40, 0x2f, 0xa0, 0xb5, 0x1c, 0xa1, 0x98,
+	0x46, 0xa1, 0x40, 0xf2, 0x1c, 0x40, 0xa4, 0x68, 0xdb, 0xe9, 0x56, 0x7a, 0x9b, 0x67, 0x7b, 0xcf,
+	0x16, 0xc9, 0xa6, 0x26, 0x5e, 0x46, 0x8f, 0xee, 0xc2, 0x7d, 0xe5, 0xef, 0xfb, 0x51, 0x14, 0x2f,
+	0xb2, 0xfd, 0xd3, 0x81, 0xaa, 0x46, 0x08, 0x81, 0xf5, 0x90, 0x4d, 0x50, 0xe7, 0x5a, 0xf7, 0xf4,
+	0x6f, 0xe2, 0x42, 0x8d, 0x87, 0x12, 0xe3, 0x4f, 0x6c, 0xac, 0xd3, 0xad, 0x78, 0xe9, 0x99, 0x9c,
+	0x42, 0x73, 0xcc, 0x84, 0x1c, 0xc8, 0x98, 0x0f, 0x87, 0x18, 0xdb, 0xb4, 0x2b, 0x5e, 0x43, 0xa1,
+	0xef, 0x13, 0x90, 0x3c, 0x02, 0x0d, 0x0c, 0x82, 0x59, 0xcc, 0x24, 0x8f, 0x42, 0x5d, 0x83, 0x8a,
+	0xb7, 0xa5, 0xc0, 0x57, 0x16, 0xa3, 0xdf, 0x01, 0xc9, 0x86, 0x66, 0xd3, 0xec, 0xc1, 0x86, 0xaf,
+	0x11, 0x9b, 0xe2, 0x4e, 0x26, 0x45, 0xad, 0xea, 0x59, 0x39, 0x7d, 0x0a, 0xbb, 0xf6, 0x46, 0x83,
+	0x9b, 0xe4, 0x8a, 0x52, 0xa2, 0x67, 0xb0, 0x97, 0x57, 0xb5, 0x97, 0xb9, 0x50, 0x4b, 0x43, 0x74,
+	0x4c, 0xaa, 0xc9, 0x99, 0x7e, 0x03, 0xbb, 0x1e, 0x0a, 0x19, 0xc5, 0xf8, 0x3e, 0x66, 0x62, 0x94,
+	0xb8, 0x3f, 0x81, 0xad, 0xb4, 0xbc, 0x83, 0x74, 0x4a, 0x36, 0x53, 0xec, 0x4d, 0x40, 0x5b, 0xb0,
+	0x97, 0xb7, 0x34, 0xb7, 0xd1, 0xb7, 0xa6, 0x17, 0xef, 0x38, 0xfa, 0x28, 0xee, 0xee, 0x8f, 0xec,
+	0x41, 0x75, 0xcc, 0x27, 0x5c, 0xea, 0x6e, 0x54, 0x3d, 0x73, 0xa0, 0xaf, 0xa1, 0xaa, 0x3d, 0xad,
+	0x4c, 0x2b, 0x81, 0x75, 0xc1, 0xff, 0x40, 0xdb, 0x3b, 0xfd, 0x9b, 0x1c, 0x42, 0x5d, 0x07, 0x14,
+	0x0c, 0x98, 0xb4, 0x2d, 0xab, 0x19, 0xa0, 0x2f, 0xe9, 0x47, 0xd3, 0x88, 0x24, 0xae, 0x45, 0x23,
+	0xa6, 0x1a, 0x29, 0x68, 0x84, 0x56, 0xf5, 0xac, 0x5c, 0x8d, 0xb4, 0x8c, 0x24, 0x1b, 0x0f, 0xf4,
+	0xb5, 0xc6, 0x7b, 0x5d, 0x23, 0x97, 0xea, 0x6e, 0x02, 0xeb, 0x93, 0x28, 0x46, 0x3d, 0x03, 0x35,
+	0x4f, 0xff, 0xa6, 0x87, 0x70, 0xa0, 0xae, 0xf4, 0x50, 0x32, 0x1e, 0xda, 0x52, 0xa4, 0xe3, 0x39,
+	0x87, 0x46, 0x4e, 0x70, 0x97, 0x1a, 0x9d, 0x42, 0xd3, 0x8f, 0x91, 0x49, 0x0c, 0x06, 0xd7, 0x78,
+	0x13, 0xc5, 0x49, 0xfa, 0x0d, 0x8b, 0x9e, 0x6b, 0x90, 0x1c, 0x01, 0x4c, 0xe3, 0xc8, 0x47, 0x21,
+	0x78, 0x38, 0xb4, 0x4f, 0x36, 0x83, 0xd0, 0x0f, 0xe0, 0x16, 0xc5, 0x65, 0x4b, 0xb2, 0x78, 0xd3,
+	0x4e, 0xee, 0x4d, 0x3f, 0x87, 0x5a, 0x6c, 0x75, 0xdb, 0x6b, 0xba, 0x58, 0xed, 0x4c, 0xb1, 0x72,
+	0xce, 0xbc, 0x54, 0x93, 0x1e, 0xc1, 0x43, 0x5d, 0x76, 0x0c, 0x03, 0x1e, 0x0e, 0x57, 0x39, 0xe9,
+	0x6f, 0x07, 0x76, 0x96, 0x85, 0x5f, 0x40, 0x4d, 0x2a, 0xd8, 0x68, 0x16, 0xfb, 0xa6, 0x23, 0x75,
+	0xcf, 0x9e, 0xf4, 0x28, 0xf0, 0x61, 0xa8, 0x0a, 0x35, 0xb7, 0xdc, 0x54, 0x33, 0xc0, 0xf9, 0x5c,
+	0xb5, 0xf2, 0x06, 0xa5, 0x3f, 0x32, 0x83, 0x62, 0xd9, 0xc9, 0x22, 0x7d, 0x49, 0x7f, 0x85, 0x4e,
+	0x49, 0xc8, 0xb6, 0x42, 0x2f, 0x0a, 0x48, 0xea, 0x30, 0x3b, 0x38, 0x4b, 0x96, 0x39, 0xae, 0x7a,
+	0x01, 0xad, 0xbe, 0xef, 0xe3, 0x74, 0xc1, 0x7e, 0x5f, 0xf0, 0xe8, 0x0e, 0x60, 0x7f, 0xc5, 0xd8,
+	0xbe, 0xbb, 0x2e, 0x1c, 0xa9, 0xa8, 0xaf, 0x42, 0xcb, 0xcd, 0xab, 0xa5, 0xfe, 0xd7, 0x01, 0xb2,
+	0x2a, 0x5e, 0x29, 0xf6, 0x1e, 0x54, 0x55, 0xc7, 0xd1, 0x96, 0xda, 0x1c, 0x54, 0x70, 0xb3, 0xc4,
+	0x76, 0xf1, 0xbc, 0x36, 0x53, 0xac, 0x2f, 0xc9, 0x63, 0x68, 0x86, 0xf8, 0xbb, 0x1c, 0x30, 0x5f,
+	0x51, 0x8b, 0x52, 0xb2, 0x84, 0xa8, 0xd0, 0xbe, 0x06, 0xfb, 0x92, 0x3c, 0x81, 0xed, 0xeb, 0xb9,
+	0x44, 0x31, 0x88, 0xd1, 0x1f, 0x33, 0x3e, 0x49, 0xf7, 0x43, 0x53, 0xc3, 0x5e, 0x82, 0xd2, 0xdf,
+	0xe0, 0xb8, 0x34, 0x21, 0xdb, 0x88, 0x97, 0x05, 0x8d, 0xe8, 0x64, 0x1a, 0xb1, 0x6a, 0x9b, 0x6b,
+	0xc5, 0xb7, 0xf0, 0xe0, 0xdd, 0x2c, 0x1e, 0xe2, 0xff, 0xe9, 0xc4, 0x4b, 0x68, 0x2d, 0xdb, 0xda,
+	0xa0, 0x1e, 0x41, 0xc3, 0x24, 0x18, 0xe0, 0x18, 0x25, 0x1a, 0xeb, 0x8a, 0xb7, 0xa5, 0xc1, 0x57,
+	0x06, 0xa3, 0x97, 0xd0, 0xb8, 0x88, 0x24, 0xbf, 0x99, 0x67, 0x08, 0x5d, 0xce, 0xa7, 0x29, 0xa1,
+	0xab, 0xdf, 0xaa, 0x13, 0x92, 0xcb, 0x71, 0xda, 0x09, 0x7d, 0x50, 0x8f, 0x61, 0x82, 0x42, 0xb0,
+	0x61, 0x32, 0xf3, 0xc9, 0x91, 0xee, 0x40, 0x33, 0x71, 0x6a, 0x87, 0x62, 0x17, 0xee, 0xff, 0x80,
+	0xf2, 0x27, 0x8c, 0x05, 0x8f, 0x92, 0xc7, 0x49, 0x39, 0x90, 0x2c, 0x68, 0xc3, 0x6e, 0xc3, 0xbd,
+	0x4f, 0x06, 0xb2, 0x31, 0x24, 0x47, 0x72, 0x0c, 0x9b, 0x7e, 0x34, 0x99, 0x70, 0x39, 0x18, 0x31,
+	0x31, 0xb2, 0xc1, 0x80, 0x81, 0x5e, 0x33, 0x31, 0x52, 0xa6, 0x31, 0x8e, 0x91, 0x09, 0x4c, 0xbe,
+	0x0f, 0xec, 0xf1, 0xec, 0x9f, 0x1a, 0xd4, 0x2f, 0xa2, 0x00, 0xfb, 0xaa, 0x1d, 0xe4, 0x0a, 0x9a,
+	0xf9, 0xb5, 0x4f, 0xba, 0x99, 0x66, 0x15, 0x7e, 0xb3, 0xb8, 0x27, 0xb7, 0x68, 0xd8, 0xc8, 0xdf,
+	0x00, 0x2c, 0x56, 0x2c, 0x79, 0xb8, 0x64, 0x90, 0xfb, 0x28, 0x70, 0x3b, 0x25, 0x52, 0xeb, 0xea,
+	0x47, 0xd8, 0xca, 0xae, 0x50, 0x72, 0x94, 0x51, 0x2f, 0x58, 0xc3, 0xee, 0x71, 0xa9, 0x7c, 0xe1,
+	0x30, 0xbb, 0x25, 0x73, 0x0e, 0x0b, 0x16, 0xaf, 0x7b, 0x5c, 0x2a, 0xcf, 0x27, 0x6b, 0xd6, 0xd8,
+	0x4a, 0xb2, 0xb9, 0xad, 0xeb, 0x76, 0x4a, 0xa4, 0xd6, 0x15, 0x33, 0x1b, 0x31, 0xbf, 0x06, 0xc8,
+	0xe3, 0x25, 0xa3, 0xc2, 0xed, 0xe5, 0x9e, 0x7e, 0x46, 0xcb, 0x5e, 0xf1, 0xc1, 0x7c, 0x8a, 0xae,
+	0x50, 0x29, 0x79, 0xb2, 0x1c, 0x5a, 0xc9, 0x7e, 0x70, 0x7b, 0x9f, 0x57, 0xb4, 0x77, 0xfd, 0x0c,
+	0xdb, 0x4b, 0xdc, 0x48, 0xb2, 0xc3, 0x53, 0x4c, 0xba, 0x2e, 0xbd, 0x4d, 0xc5, 0x7a, 0x9e, 0xc2,
+	0x7e, 0x09, 0x13, 0x91, 0xa7, 0x4b, 0xe1, 0x95, 0xd3, 0xaf, 0xfb, 0xd5, 0x5d, 0x54, 0xed, 0x8d,
+	0x57, 0xd0, 0xcc, 0xb3, 0x4b, 0xee, 0xa5, 0x14, 0x92, 0x96, 0x7b, 0x72, 0x8b, 0x46, 0xca, 0x97,
+	0x1b, 0x86, 0x20, 0x48, 0x76, 0x75, 0xe7, 0x88, 0xc8, 0x3d, 0x28, 0x90, 0x2c, 0x66, 0x6f, 0x41,
+	0x1c, 0xb9, 0xd9, 0x5b, 0x21, 0x19, 0xb7, 0x53, 0x22, 0x35, 0xae, 0xce, 0x1b, 0xbf, 0x6c, 0xa6,
+	0xf2, 0xe9, 0xf5, 0xf5, 0x86, 0xfe, 0x13, 0xf3, 0xf5, 0x7f, 0x03, 0x00, 0x53, 0x26, 0x11, 0x27,
+	0xd7, 0x0c, 0x00, 0x00,
+}
+
+type DRPCNodeAdminClient interface {
+	DRPCConn() drpc.Conn
+
+	ListSatellites(ctx context.Context, in *ListSatellitesRequest) (*ListSatellitesResponse, error)
+	ListChores(ctx context.Context, in *ListChoresRequest) (*ListChoresResponse, error)
+	TriggerChore(ctx context.Context, in *TriggerChoreRequest) (*TriggerChoreResponse, error)
+	RestoreTrash(ctx context.Context, in *RestoreTrashRequest) (*RestoreTrashResponse, error)
+	ListPieces(ctx context.Context, in *ListPiecesRequest) (*ListPiecesResponse, error)
+	ListRetainRequests(ctx context.Context, in *ListRetainRequestsRequest) (*ListRetainRequestsResponse, error)
+	ListPendingSatellites(ctx context.Context, in *ListPendingSatellitesRequest) (*ListPendingSatellitesResponse, error)
+	AcceptSatellite(ctx context.Context, in *AcceptSatelliteRequest) (*AcceptSatelliteResponse, error)
+	ListUntrustedSatellites(ctx context.Context, in *ListUntrustedSatellitesRequest) (*ListUntrustedSatellitesResponse, error)
+	PurgeSatellite(ctx context.Context, in *PurgeSatelliteRequest) (*PurgeSatelliteResponse, error)
+	Notify(ctx context.Context, in *NotifyRequest) (*NotifyResponse, error)
+	GetVersion(ctx context.Context, in *GetVersionRequest) (*GetVersionResponse, error)
+}
+
+type drpcNodeAdminClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCNodeAdminClient(cc drpc.Conn) DRPCNodeAdminClient {
+	return &drpcNodeAdminClient{cc}
+}
+
+func (c *drpcNodeAdminClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcNodeAdminClient) ListSatellites(ctx context.Context, in *ListSatellitesRequest) (*ListSatellitesResponse, error) {
+	out := new(ListSatellitesResponse)
+	err := c.cc.Invoke(ctx, "/nodeadmin.NodeAdmin/ListSatellites", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcNodeAdminClient) ListChores(ctx context.Context, in *ListChoresRequest) (*ListChoresResponse, error) {
+	out := new(ListChoresResponse)
+	err := c.cc.Invoke(ctx, "/nodeadmin.NodeAdmin/ListChores", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcNodeAdminClient) TriggerChore(ctx context.Context, in *TriggerChoreRequest) (*TriggerChoreResponse, error) {
+	out := new(TriggerChoreResponse)
+	err := c.cc.Invoke(ctx, "/nodeadmin.NodeAdmin/TriggerChore", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcNodeAdminClient) RestoreTrash(ctx context.Context, in *RestoreTrashRequest) (*RestoreTrashResponse, error) {
+	out := new(RestoreTrashResponse)
+	err := c.cc.Invoke(ctx, "/nodeadmin.NodeAdmin/RestoreTrash", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcNodeAdminClient) ListPieces(ctx context.Context, in *ListPiecesRequest) (*ListPiecesResponse, error) {
+	out := new(ListPiecesResponse)
+	err := c.cc.Invoke(ctx, "/nodeadmin.NodeAdmin/ListPieces", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcNodeAdminClient) ListRetainRequests(ctx context.Context, in *ListRetainRequestsRequest) (*ListRetainRequestsResponse, error) {
+	out := new(ListRetainRequestsResponse)
+	err := c.cc.Invoke(ctx, "/nodeadmin.NodeAdmin/ListRetainRequests", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcNodeAdminClient) ListPendingSatellites(ctx context.Context, in *ListPendingSatellitesRequest) (*ListPendingSatellitesResponse, error) {
+	out := new(ListPendingSatellitesResponse)
+	err := c.cc.Invoke(ctx, "/nodeadmin.NodeAdmin/ListPendingSatellites", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcNodeAdminClient) AcceptSatellite(ctx context.Context, in *AcceptSatelliteRequest) (*AcceptSatelliteResponse, error) {
+	out := new(AcceptSatelliteResponse)
+	err := c.cc.Invoke(ctx, "/nodeadmin.NodeAdmin/AcceptSatellite", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcNodeAdminClient) ListUntrustedSatellites(ctx context.Context, in *ListUntrustedSatellitesRequest) (*ListUntrustedSatellitesResponse, error) {
+	out := new(ListUntrustedSatellitesResponse)
+	err := c.cc.Invoke(ctx, "/nodeadmin.NodeAdmin/ListUntrustedSatellites", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcNodeAdminClient) PurgeSatellite(ctx context.Context, in *PurgeSatelliteRequest) (*PurgeSatelliteResponse, error) {
+	out := new(PurgeSatelliteResponse)
+	err := c.cc.Invoke(ctx, "/nodeadmin.NodeAdmin/PurgeSatellite", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcNodeAdminClient) Notify(ctx context.Context, in *NotifyRequest) (*NotifyResponse, error) {
+	out := new(NotifyResponse)
+	err := c.cc.Invoke(ctx, "/nodeadmin.NodeAdmin/Notify", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcNodeAdminClient) GetVersion(ctx context.Context, in *GetVersionRequest) (*GetVersionResponse, error) {
+	out := new(GetVersionResponse)
+	err := c.cc.Invoke(ctx, "/nodeadmin.NodeAdmin/GetVersion", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCNodeAdminServer interface {
+	ListSatellites(context.Context, *ListSatellitesRequest) (*ListSatellitesResponse, error)
+	ListChores(context.Context, *ListChoresRequest) (*ListChoresResponse, error)
+	TriggerChore(context.Context, *TriggerChoreRequest) (*TriggerChoreResponse, error)
+	RestoreTrash(context.Context, *RestoreTrashRequest) (*RestoreTrashResponse, error)
+	ListPieces(context.Context, *ListPiecesRequest) (*ListPiecesResponse, error)
+	ListRetainRequests(context.Context, *ListRetainRequestsRequest) (*ListRetainRequestsResponse, error)
+	ListPendingSatellites(context.Context, *ListPendingSatellitesRequest) (*ListPendingSatellitesResponse, error)
+	AcceptSatellite(context.Context, *AcceptSatelliteRequest) (*AcceptSatelliteResponse, error)
+	ListUntrustedSatellites(context.Context, *ListUntrustedSatellitesRequest) (*ListUntrustedSatellitesResponse, error)
+	PurgeSatellite(context.Context, *PurgeSatelliteRequest) (*PurgeSatelliteResponse, error)
+	Notify(context.Context, *NotifyRequest) (*NotifyResponse, error)
+	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
+}
+
+type DRPCNodeAdminDescription struct{}
+
+func (DRPCNodeAdminDescription) NumMethods() int { return 12 }
+
+func (DRPCNodeAdminDescription) Method(n int) (string, drpc.Handler, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/nodeadmin.NodeAdmin/ListSatellites",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeAdminServer).
+					ListSatellites(
+						ctx,
+						in1.(*ListSatellitesRequest),
+					)
+			}, DRPCNodeAdminServer.ListSatellites, true
+	case 1:
+		return "/nodeadmin.NodeAdmin/ListChores",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeAdminServer).
+					ListChores(
+						ctx,
+						in1.(*ListChoresRequest),
+					)
+			}, DRPCNodeAdminServer.ListChores, true
+	case 2:
+		return "/nodeadmin.NodeAdmin/TriggerChore",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeAdminServer).
+					TriggerChore(
+						ctx,
+						in1.(*TriggerChoreRequest),
+					)
+			}, DRPCNodeAdminServer.TriggerChore, true
+	case 3:
+		return "/nodeadmin.NodeAdmin/RestoreTrash",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeAdminServer).
+					RestoreTrash(
+						ctx,
+						in1.(*RestoreTrashRequest),
+					)
+			}, DRPCNodeAdminServer.RestoreTrash, true
+	case 4:
+		return "/nodeadmin.NodeAdmin/ListPieces",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeAdminServer).
+					ListPieces(
+						ctx,
+						in1.(*ListPiecesRequest),
+					)
+			}, DRPCNodeAdminServer.ListPieces, true
+	case 5:
+		return "/nodeadmin.NodeAdmin/ListRetainRequests",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeAdminServer).
+					ListRetainRequests(
+						ctx,
+						in1.(*ListRetainRequestsRequest),
+					)
+			}, DRPCNodeAdminServer.ListRetainRequests, true
+	case 6:
+		return "/nodeadmin.NodeAdmin/ListPendingSatellites",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeAdminServer).
+					ListPendingSatellites(
+						ctx,
+						in1.(*ListPendingSatellitesRequest),
+					)
+			}, DRPCNodeAdminServer.ListPendingSatellites, true
+	case 7:
+		return "/nodeadmin.NodeAdmin/AcceptSatellite",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeAdminServer).
+					AcceptSatellite(
+						ctx,
+						in1.(*AcceptSatelliteRequest),
+					)
+			}, DRPCNodeAdminServer.AcceptSatellite, true
+	case 8:
+		return "/nodeadmin.NodeAdmin/ListUntrustedSatellites",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeAdminServer).
+					ListUntrustedSatellites(
+						ctx,
+						in1.(*ListUntrustedSatellitesRequest),
+					)
+			}, DRPCNodeAdminServer.ListUntrustedSatellites, true
+	case 9:
+		return "/nodeadmin.NodeAdmin/PurgeSatellite",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeAdminServer).
+					PurgeSatellite(
+						ctx,
+						in1.(*PurgeSatelliteRequest),
+					)
+			}, DRPCNodeAdminServer.PurgeSatellite, true
+	case 10:
+		return "/nodeadmin.NodeAdmin/Notify",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeAdminServer).
+					Notify(
+						ctx,
+						in1.(*NotifyRequest),
+					)
+			}, DRPCNodeAdminServer.Notify, true
+	case 11:
+		return "/nodeadmin.NodeAdmin/GetVersion",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeAdminServer).
+					GetVersion(
+						ctx,
+						in1.(*GetVersionRequest),
+					)
+			}, DRPCNodeAdminServer.GetVersion, true
+	default:
+		return "", nil, nil, false
+	}
+}
+
+func DRPCRegisterNodeAdmin(srv drpc.Server, impl DRPCNodeAdminServer) {
+	srv.Register(impl, DRPCNodeAdminDescription{})
+}
+
+type DRPCNodeAdmin_ListSatellitesStream interface {
+	drpc.Stream
+	SendAndClose(*ListSatellitesResponse) error
+}
+
+type drpcNodeAdminListSatellitesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeAdminListSatellitesStream) SendAndClose(m *ListSatellitesResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCNodeAdmin_ListChoresStream interface {
+	drpc.Stream
+	SendAndClose(*ListChoresResponse) error
+}
+
+type drpcNodeAdminListChoresStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeAdminListChoresStream) SendAndClose(m *ListChoresResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCNodeAdmin_TriggerChoreStream interface {
+	drpc.Stream
+	SendAndClose(*TriggerChoreResponse) error
+}
+
+type drpcNodeAdminTriggerChoreStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeAdminTriggerChoreStream) SendAndClose(m *TriggerChoreResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCNodeAdmin_RestoreTrashStream interface {
+	drpc.Stream
+	SendAndClose(*RestoreTrashResponse) error
+}
+
+type drpcNodeAdminRestoreTrashStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeAdminRestoreTrashStream) SendAndClose(m *RestoreTrashResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCNodeAdmin_ListPiecesStream interface {
+	drpc.Stream
+	SendAndClose(*ListPiecesResponse) error
+}
+
+type drpcNodeAdminListPiecesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeAdminListPiecesStream) SendAndClose(m *ListPiecesResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCNodeAdmin_ListRetainRequestsStream interface {
+	drpc.Stream
+	SendAndClose(*ListRetainRequestsResponse) error
+}
+
+type drpcNodeAdminListRetainRequestsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeAdminListRetainRequestsStream) SendAndClose(m *ListRetainRequestsResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCNodeAdmin_ListPendingSatellitesStream interface {
+	drpc.Stream
+	SendAndClose(*ListPendingSatellitesResponse) error
+}
+
+type drpcNodeAdminListPendingSatellitesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeAdminListPendingSatellitesStream) SendAndClose(m *ListPendingSatellitesResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCNodeAdmin_AcceptSatelliteStream interface {
+	drpc.Stream
+	SendAndClose(*AcceptSatelliteResponse) error
+}
+
+type drpcNodeAdminAcceptSatelliteStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeAdminAcceptSatelliteStream) SendAndClose(m *AcceptSatelliteResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCNodeAdmin_ListUntrustedSatellitesStream interface {
+	drpc.Stream
+	SendAndClose(*ListUntrustedSatellitesResponse) error
+}
+
+type drpcNodeAdminListUntrustedSatellitesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeAdminListUntrustedSatellitesStream) SendAndClose(m *ListUntrustedSatellitesResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCNodeAdmin_PurgeSatelliteStream interface {
+	drpc.Stream
+	SendAndClose(*PurgeSatelliteResponse) error
+}
+
+type drpcNodeAdminPurgeSatelliteStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeAdminPurgeSatelliteStream) SendAndClose(m *PurgeSatelliteResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCNodeAdmin_NotifyStream interface {
+	drpc.Stream
+	SendAndClose(*NotifyResponse) error
+}
+
+type drpcNodeAdminNotifyStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeAdminNotifyStream) SendAndClose(m *NotifyResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCNodeAdmin_GetVersionStream interface {
+	drpc.Stream
+	SendAndClose(*GetVersionResponse) error
+}
+
+type drpcNodeAdminGetVersionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeAdminGetVersionStream) SendAndClose(m *GetVersionResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
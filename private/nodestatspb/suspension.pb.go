@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: suspension.proto
+
+package nodestatspb
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+
+	drpc "storj.io/drpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type GetSuspensionRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSuspensionRequest) Reset()         { *m = GetSuspensionRequest{} }
+func (m *GetSuspensionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSuspensionRequest) ProtoMessage()    {}
+func (*GetSuspensionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_937800fbbb5fd214, []int{0}
+}
+func (m *GetSuspensionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSuspensionRequest.Unmarshal(m, b)
+}
+func (m *GetSuspensionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSuspensionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSuspensionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSuspensionRequest.Merge(m, src)
+}
+func (m *GetSuspensionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSuspensionRequest.Size(m)
+}
+func (m *GetSuspensionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSuspensionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSuspensionRequest proto.InternalMessageInfo
+
+type GetSuspensionResponse struct {
+	UnknownAuditReputationAlpha float64 `protobuf:"fixed64,1,opt,name=unknown_audit_reputation_alpha,json=unknownAuditReputationAlpha,proto3" json:"unknown_audit_reputation_alpha,omitempty"`
+	UnknownAuditReputationBeta  float64 `protobuf:"fixed64,2,opt,name=unknown_audit_reputation_beta,json=unknownAuditReputationBeta,proto3" json:"unknown_audit_reputation_beta,omitempty"`
+	UnknownAuditReputationScore float64 `protobuf:"fixed64,3,opt,name=unknown_audit_reputation_score,json=unknownAuditReputationScore,proto3" json:"unknown_audit_reputation_score,omitempty"`
+	// suspended is the time the node was suspended at, unset when it is not suspended.
+	Suspended            *time.Time `protobuf:"bytes,4,opt,name=suspended,proto3,stdtime" json:"suspended,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetSuspensionResponse) Reset()         { *m = GetSuspensionResponse{} }
+func (m *GetSuspensionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSuspensionResponse) ProtoMessage()    {}
+func (*GetSuspensionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_937800fbbb5fd214, []int{1}
+}
+func (m *GetSuspensionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSuspensionResponse.Unmarshal(m, b)
+}
+func (m *GetSuspensionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSuspensionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSuspensionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSuspensionResponse.Merge(m, src)
+}
+func (m *GetSuspensionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSuspensionResponse.Size(m)
+}
+func (m *GetSuspensionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSuspensionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSuspensionResponse proto.InternalMessageInfo
+
+func (m *GetSuspensionResponse) GetUnknownAuditReputationAlpha() float64 {
+	if m != nil {
+		return m.UnknownAuditReputationAlpha
+	}
+	return 0
+}
+
+func (m *GetSuspensionResponse) GetUnknownAuditReputationBeta() float64 {
+	if m != nil {
+		return m.UnknownAuditReputationBeta
+	}
+	return 0
+}
+
+func (m *GetSuspensionResponse) GetUnknownAuditReputationScore() float64 {
+	if m != nil {
+		return m.UnknownAuditReputationScore
+	}
+	return 0
+}
+
+func (m *GetSuspensionResponse) GetSuspended() *time.Time {
+	if m != nil {
+		return m.Suspended
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetSuspensionRequest)(nil), "nodestats.GetSuspensionRequest")
+	proto.RegisterType((*GetSuspensionResponse)(nil), "nodestats.GetSuspensionResponse")
+}
+
+func init() { proto.RegisterFile("suspension.proto", fileDescriptor_937800fbbb5fd214) }
+
+var fileDescriptor_937800fbbb5fd214 = []byte{
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x41, 0x4e, 0xc3, 0x30,
+	0x10, 0x45, 0xe5, 0x82, 0x10, 0x75, 0x55, 0x09, 0x59, 0x80, 0xa2, 0x20, 0x48, 0xd4, 0x55, 0x57,
+	0xae, 0x54, 0x4e, 0x90, 0xb0, 0x60, 0x9f, 0xb2, 0x62, 0x13, 0x1c, 0x32, 0x84, 0x88, 0xd6, 0x63,
+	0xe2, 0xb1, 0xb8, 0x06, 0xc7, 0xe0, 0x28, 0x9c, 0x02, 0xae, 0x82, 0x12, 0xd3, 0x54, 0xa0, 0xb6,
+	0x4b, 0x7b, 0xde, 0x7c, 0x7d, 0xbd, 0xe1, 0x27, 0xd6, 0x59, 0x03, 0xda, 0xd6, 0xa8, 0xa5, 0x69,
+	0x90, 0x50, 0x0c, 0x35, 0x96, 0x60, 0x49, 0x91, 0x0d, 0x79, 0x85, 0x15, 0xfa, 0xef, 0x30, 0xaa,
+	0x10, 0xab, 0x25, 0xcc, 0xba, 0x57, 0xe1, 0x9e, 0x66, 0x54, 0xaf, 0x5a, 0x6c, 0x65, 0x3c, 0x30,
+	0x39, 0xe7, 0xa7, 0xb7, 0x40, 0x8b, 0x3e, 0x2e, 0x83, 0x57, 0x07, 0x96, 0x26, 0x1f, 0x03, 0x7e,
+	0xf6, 0x6f, 0x60, 0x0d, 0x6a, 0x0b, 0xe2, 0x86, 0x5f, 0x39, 0xfd, 0xa2, 0xf1, 0x4d, 0xe7, 0xca,
+	0x95, 0x35, 0xe5, 0x0d, 0x18, 0x47, 0x8a, 0x6a, 0xd4, 0xb9, 0x5a, 0x9a, 0x67, 0x15, 0xb0, 0x98,
+	0x4d, 0x59, 0x76, 0xf1, 0x4b, 0x25, 0x2d, 0x94, 0xf5, 0x4c, 0xd2, 0x22, 0x22, 0xe1, 0x97, 0x3b,
+	0x43, 0x0a, 0x20, 0x15, 0x0c, 0xba, 0x8c, 0x70, 0x7b, 0x46, 0x0a, 0xa4, 0xf6, 0xf6, 0xb0, 0x8f,
+	0xd8, 0x40, 0x70, 0xb0, 0xaf, 0xc7, 0xa2, 0x45, 0x44, 0xca, 0x87, 0x5e, 0x65, 0x09, 0x65, 0x70,
+	0x18, 0xb3, 0xe9, 0x68, 0x1e, 0x4a, 0xef, 0x4c, 0xae, 0x9d, 0xc9, 0xbb, 0xb5, 0xb3, 0xf4, 0xf8,
+	0xf3, 0x2b, 0x62, 0xef, 0xdf, 0x11, 0xcb, 0x36, 0x6b, 0xf3, 0x07, 0xce, 0x37, 0x9a, 0x44, 0xc6,
+	0xc7, 0x7f, 0xbc, 0x89, 0x48, 0xf6, 0xa7, 0x91, 0xdb, 0x54, 0x87, 0xf1, 0x6e, 0xc0, 0x2b, 0x4f,
+	0xc7, 0xf7, 0xa3, 0x1e, 0x31, 0x45, 0x71, 0xd4, 0x35, 0xbb, 0xfe, 0x19, 0x00, 0xda, 0xd0, 0x9a,
+	0x6b, 0x06, 0x02, 0x00, 0x00,
+}
+
+type DRPCSuspensionClient interface {
+	DRPCConn() drpc.Conn
+
+	GetSuspension(ctx context.Context, in *GetSuspensionRequest) (*GetSuspensionResponse, error)
+}
+
+type drpcSuspensionClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCSuspensionClient(cc drpc.Conn) DRPCSuspensionClient {
+	return &drpcSuspensionClient{cc}
+}
+
+func (c *drpcSuspensionClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcSuspensionClient) GetSuspension(ctx context.Context, in *GetSuspensionRequest) (*GetSuspensionResponse, error) {
+	out := new(GetSuspensionResponse)
+	err := c.cc.Invoke(ctx, "/nodestats.Suspension/GetSuspension", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCSuspensionServer interface {
+	GetSuspension(context.Context, *GetSuspensionRequest) (*GetSuspensionResponse, error)
+}
+
+type DRPCSuspensionDescription struct{}
+
+func (DRPCSuspensionDescription) NumMethods() int { return 1 }
+
+func (DRPCSuspensionDescription) Method(n int) (string, drpc.Handler, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/nodestats.Suspension/GetSuspension",
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCSuspensionServer).
+					GetSuspension(
+						ctx,
+						in1.(*GetSuspensionRequest),
+					)
+			}, DRPCSuspensionServer.GetSuspension, true
+	default:
+		return "", nil, nil, false
+	}
+}
+
+func DRPCRegisterSuspension(srv drpc.Server, impl DRPCSuspensionServer) {
+	srv.Register(impl, DRPCSuspensionDescription{})
+}
+
+type DRPCSuspension_GetSuspensionStream interface {
+	drpc.Stream
+	SendAndClose(*GetSuspensionResponse) error
+}
+
+type drpcSuspensionGetSuspensionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcSuspensionGetSuspensionStream) SendAndClose(m *GetSuspensionResponse) error {
+	if err := x.MsgSend(m); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -0,0 +1,173 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package payout contains the payout math of storage nodes, which is shared
+// by satellites calculating payouts and storage nodes estimating them.
+package payout
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/zeebo/errs"
+)
+
+// Error is the default error class for payout package.
+var Error = errs.Class("payout error")
+
+// hoursPerMonth is the number of hours in a billed month, matching the customer pricing.
+const hoursPerMonth = 30 * 24
+
+// Prices are payouts in dollars for each TB of traffic, or for storing a TB
+// for a month.
+type Prices struct {
+	AtRestTBMonth string
+	Put           string
+	Get           string
+	PutRepair     string
+	GetRepair     string
+	GetAudit      string
+}
+
+// Rates are payouts in micro dollars per byte, or per byte hour for data at rest.
+type Rates struct {
+	AtRest    decimal.Decimal
+	Put       decimal.Decimal
+	Get       decimal.Decimal
+	PutRepair decimal.Decimal
+	GetRepair decimal.Decimal
+	GetAudit  decimal.Decimal
+}
+
+// ParseRates converts dollar prices per TB to micro dollars per byte.
+func ParseRates(prices Prices) (rates Rates, err error) {
+	perByte := func(price string) (decimal.Decimal, error) {
+		dollars, err := decimal.NewFromString(price)
+		if err != nil {
+			return decimal.Decimal{}, Error.Wrap(err)
+		}
+		// dollars to micro dollars and TB to bytes
+		return dollars.Shift(6).Shift(-12), nil
+	}
+
+	if rates.AtRest, err = perByte(prices.AtRestTBMonth); err != nil {
+		return Rates{}, err
+	}
+	rates.AtRest = rates.AtRest.Div(decimal.New(hoursPerMonth, 0))
+
+	if rates.Put, err = perByte(prices.Put); err != nil {
+		return Rates{}, err
+	}
+	if rates.Get, err = perByte(prices.Get); err != nil {
+		return Rates{}, err
+	}
+	if rates.PutRepair, err = perByte(prices.PutRepair); err != nil {
+		return Rates{}, err
+	}
+	if rates.GetRepair, err = perByte(prices.GetRepair); err != nil {
+		return Rates{}, err
+	}
+	if rates.GetAudit, err = perByte(prices.GetAudit); err != nil {
+		return Rates{}, err
+	}
+	return rates, nil
+}
+
+// Usage is the usage of a node in a period.
+type Usage struct {
+	// AtRest is in byte hours, other usages are in bytes.
+	AtRest    float64
+	Put       int64
+	Get       int64
+	PutRepair int64
+	GetRepair int64
+	GetAudit  int64
+}
+
+// Compensation is the payout for each kind of usage in micro dollars.
+type Compensation struct {
+	AtRest    int64
+	Put       int64
+	Get       int64
+	PutRepair int64
+	GetRepair int64
+	GetAudit  int64
+}
+
+// Total returns the total compensation.
+func (compensation Compensation) Total() int64 {
+	return compensation.AtRest + compensation.Put + compensation.Get +
+		compensation.PutRepair + compensation.GetRepair + compensation.GetAudit
+}
+
+// Compensation returns the compensation of usage, rounded to micro dollars.
+func (rates Rates) Compensation(usage Usage) Compensation {
+	return Compensation{
+		AtRest:    compensate(decimal.NewFromFloat(usage.AtRest), rates.AtRest),
+		Put:       compensate(decimal.New(usage.Put, 0), rates.Put),
+		Get:       compensate(decimal.New(usage.Get, 0), rates.Get),
+		PutRepair: compensate(decimal.New(usage.PutRepair, 0), rates.PutRepair),
+		GetRepair: compensate(decimal.New(usage.GetRepair, 0), rates.GetRepair),
+		GetAudit:  compensate(decimal.New(usage.GetAudit, 0), rates.GetAudit),
+	}
+}
+
+// compensate returns usage multiplied by rate rounded to micro dollars.
+func compensate(usage, rate decimal.Decimal) int64 {
+	return usage.Mul(rate).Round(0).IntPart()
+}
+
+// HeldPercents are the percentages of the payout held back in each month of
+// node age, nothing is held back after the last month.
+type HeldPercents []int
+
+// ParseHeldPercents parses comma separated held percentages.
+func ParseHeldPercents(value string) (HeldPercents, error) {
+	var percents HeldPercents
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		percent, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if percent < 0 || percent > 100 {
+			return nil, Error.New("held percent %d out of range", percent)
+		}
+		percents = append(percents, percent)
+	}
+	return percents, nil
+}
+
+// Percent returns the percentage held back for a node of the given age.
+func (percents HeldPercents) Percent(ageMonths int) int {
+	if ageMonths < 1 {
+		ageMonths = 1
+	}
+	if ageMonths > len(percents) {
+		return 0
+	}
+	return percents[ageMonths-1]
+}
+
+// Held returns the amount held back of a total payout.
+func Held(total int64, percent int) int64 {
+	return total * int64(percent) / 100
+}
+
+// NodeAgeMonths returns the age of a node in period, the month when the node was created is the first month.
+func NodeAgeMonths(createdAt, period time.Time) int {
+	createdAt = createdAt.UTC()
+	period = period.UTC()
+	return (period.Year()-createdAt.Year())*12 + int(period.Month()-createdAt.Month()) + 1
+}
+
+// PeriodStart returns the beginning of the month containing t.
+func PeriodStart(t time.Time) time.Time {
+	year, month, _ := t.UTC().Date()
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
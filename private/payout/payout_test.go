@@ -0,0 +1,69 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payout_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/private/payout"
+)
+
+func TestCompensation(t *testing.T) {
+	rates, err := payout.ParseRates(payout.Prices{
+		AtRestTBMonth: "1.44",
+		Get:           "20",
+		Put:           "0",
+		GetRepair:     "10",
+		PutRepair:     "0",
+		GetAudit:      "10",
+	})
+	require.NoError(t, err)
+
+	compensation := rates.Compensation(payout.Usage{
+		AtRest:    720 * 1e12,
+		Get:       1e12,
+		GetRepair: 1e11,
+		GetAudit:  1e10,
+		Put:       1e12,
+	})
+	assert.Equal(t, payout.Compensation{
+		AtRest:    1440000,
+		Get:       20000000,
+		GetRepair: 1000000,
+		GetAudit:  100000,
+	}, compensation)
+	assert.Equal(t, int64(22540000), compensation.Total())
+
+	_, err = payout.ParseRates(payout.Prices{AtRestTBMonth: "x"})
+	require.Error(t, err)
+}
+
+func TestHeldPercents(t *testing.T) {
+	percents, err := payout.ParseHeldPercents("75,50, 25,0")
+	require.NoError(t, err)
+	assert.Equal(t, payout.HeldPercents{75, 50, 25, 0}, percents)
+
+	assert.Equal(t, 75, percents.Percent(0))
+	assert.Equal(t, 50, percents.Percent(2))
+	assert.Equal(t, 0, percents.Percent(5))
+	assert.Equal(t, int64(500), payout.Held(1000, 50))
+
+	_, err = payout.ParseHeldPercents("75,150")
+	require.Error(t, err)
+
+	_, err = payout.ParseHeldPercents("75,x")
+	require.Error(t, err)
+}
+
+func TestNodeAgeMonths(t *testing.T) {
+	created := time.Date(2019, time.November, 30, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 1, payout.NodeAgeMonths(created, payout.PeriodStart(created)))
+	assert.Equal(t, 2, payout.NodeAgeMonths(created, time.Date(2019, time.December, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 4, payout.NodeAgeMonths(created, time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,6 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package storagemigrationpb contains protobuf messages and drpc services for
+// migrating the pieces of a storage node to another directory or backend.
+package storagemigrationpb
@@ -158,6 +158,16 @@ func (bad *BadBlobs) WalkNamespace(ctx context.Context, namespace []byte, walkFu
 	return bad.blobs.WalkNamespace(ctx, namespace, walkFunc)
 }
 
+// WalkTrash executes walkFunc for each trashed blob in the given namespace.
+// If walkFunc returns a non-nil error, WalkTrash will stop iterating and return the
+// error immediately.
+func (bad *BadBlobs) WalkTrash(ctx context.Context, namespace []byte, walkFunc func(storage.TrashInfo) error) error {
+	if bad.err != nil {
+		return bad.err
+	}
+	return bad.blobs.WalkTrash(ctx, namespace, walkFunc)
+}
+
 // ListNamespaces returns all namespaces that might be storing data.
 func (bad *BadBlobs) ListNamespaces(ctx context.Context) ([][]byte, error) {
 	if bad.err != nil {
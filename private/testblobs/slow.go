@@ -135,6 +135,14 @@ func (slow *SlowBlobs) WalkNamespace(ctx context.Context, namespace []byte, walk
 	return slow.blobs.WalkNamespace(ctx, namespace, walkFunc)
 }
 
+// WalkTrash executes walkFunc for each trashed blob in the given namespace.
+// If walkFunc returns a non-nil error, WalkTrash will stop iterating and return the
+// error immediately.
+func (slow *SlowBlobs) WalkTrash(ctx context.Context, namespace []byte, walkFunc func(storage.TrashInfo) error) error {
+	slow.sleep()
+	return slow.blobs.WalkTrash(ctx, namespace, walkFunc)
+}
+
 // ListNamespaces returns all namespaces that might be storing data.
 func (slow *SlowBlobs) ListNamespaces(ctx context.Context) ([][]byte, error) {
 	return slow.blobs.ListNamespaces(ctx)
@@ -50,7 +50,9 @@ import (
 	"storj.io/storj/satellite/nodestats"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/payouts"
 	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/corruption"
 	"storj.io/storj/satellite/repair/irreparable"
 	"storj.io/storj/satellite/repair/repairer"
 	"storj.io/storj/satellite/vouchers"
@@ -104,9 +106,10 @@ type SatelliteSystem struct {
 	}
 
 	Repair struct {
-		Checker   *checker.Checker
-		Repairer  *repairer.Service
-		Inspector *irreparable.Inspector
+		Checker    *checker.Checker
+		Corruption *corruption.Chore
+		Repairer   *repairer.Service
+		Inspector  *irreparable.Inspector
 	}
 	Audit struct {
 		Queue    *audit.Queue
@@ -167,6 +170,11 @@ type SatelliteSystem struct {
 		Chore *metrics.Chore
 	}
 
+	Payouts struct {
+		Service *payouts.Service
+		Chore   *payouts.Chore
+	}
+
 	DowntimeTracking struct {
 		DetectionChore  *downtime.DetectionChore
 		EstimationChore *downtime.EstimationChore
@@ -310,6 +318,7 @@ func (planet *Planet) newSatellites(count int) ([]*SatelliteSystem, error) {
 					AuditReputationLambda:       0.95,
 					AuditReputationWeight:       1,
 					AuditReputationDQ:           0.6,
+					AuditReputationSuspension:   0.6,
 				},
 				UpdateStatsBatchSize: 100,
 			},
@@ -362,6 +371,13 @@ func (planet *Planet) newSatellites(count int) ([]*SatelliteSystem, error) {
 				MaxBufferMem:                  4 * memory.MiB,
 				MaxExcessRateOptimalThreshold: 0.05,
 			},
+			Corruption: corruption.Config{
+				Interval:         defaultInterval,
+				MaxReports:       1000,
+				MaxNodeReports:   1000,
+				NodeReportPeriod: 24 * time.Hour,
+				ReportTTL:        30 * 24 * time.Hour,
+			},
 			Audit: audit.Config{
 				MaxRetriesStatDB:   0,
 				MinBytesPerSecond:  1 * memory.KB,
@@ -422,10 +438,13 @@ func (planet *Planet) newSatellites(count int) ([]*SatelliteSystem, error) {
 				EndpointBatchSize:            100,
 				MaxFailuresPerPiece:          5,
 				MaxInactiveTimeFrame:         time.Second * 10,
+				MaxPauseDuration:             time.Second * 10,
 				OverallMaxFailuresPercentage: 10,
 				RecvTimeout:                  time.Minute * 1,
 				MaxOrderLimitSendCount:       3,
 				NodeMinAgeInMonths:           0,
+				PartialExitInterval:          time.Hour,
+				PartialExitMaxPercentage:     100,
 			},
 			Metrics: metrics.Config{
 				ChoreInterval: defaultInterval,
@@ -435,6 +454,18 @@ func (planet *Planet) newSatellites(count int) ([]*SatelliteSystem, error) {
 				EstimationInterval:  defaultInterval,
 				EstimationBatchSize: 0,
 			},
+			Payouts: payouts.Config{
+				Interval:           defaultInterval,
+				Delay:              time.Hour,
+				AtRestTBMonthPrice: "1.50",
+				GetTBPrice:         "20",
+				PutTBPrice:         "0",
+				GetRepairTBPrice:   "10",
+				PutRepairTBPrice:   "0",
+				GetAuditTBPrice:    "10",
+				HeldPercents:       "75,75,75,50,50,50,25,25,25",
+				GracefulExitMinAge: 6,
+			},
 		}
 
 		if planet.ReferralManager != nil {
@@ -535,6 +566,7 @@ func createNewSystem(log *zap.Logger, peer *satellite.Core, api *satellite.API,
 	system.Orders.Chore = api.Orders.Chore
 
 	system.Repair.Checker = peer.Repair.Checker
+	system.Repair.Corruption = peer.Repair.Corruption
 	system.Repair.Repairer = repairerPeer.Repairer
 	system.Repair.Inspector = api.Repair.Inspector
 
@@ -563,6 +595,9 @@ func createNewSystem(log *zap.Logger, peer *satellite.Core, api *satellite.API,
 
 	system.Metrics.Chore = peer.Metrics.Chore
 
+	system.Payouts.Service = peer.Payouts.Service
+	system.Payouts.Chore = peer.Payouts.Chore
+
 	system.DowntimeTracking.DetectionChore = peer.DowntimeTracking.DetectionChore
 	system.DowntimeTracking.EstimationChore = peer.DowntimeTracking.EstimationChore
 	system.DowntimeTracking.Service = peer.DowntimeTracking.Service
@@ -30,11 +30,14 @@ import (
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/nodestats"
 	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/payouts"
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/preflight"
 	"storj.io/storj/storagenode/retain"
+	"storj.io/storj/storagenode/scrubber"
 	"storj.io/storj/storagenode/storagenodedb"
 	"storj.io/storj/storagenode/trust"
+	"storj.io/storj/storagenode/untrusted"
 )
 
 // newStorageNodes initializes storage nodes
@@ -102,12 +105,20 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatellites storj.Nod
 				KBucketRefreshInterval: defaultInterval,
 			},
 			Collector: collector.Config{
-				Interval: defaultInterval,
+				Interval:          defaultInterval,
+				DeleteConcurrency: 4,
+			},
+			Scrubber: scrubber.Config{
+				// tests which corrupt pieces on purpose would race with the scrubber,
+				// so passes are only run explicitly
+				Interval:        0,
+				ReportCorrupted: true,
 			},
 			Nodestats: nodestats.Config{
 				MaxSleep:       0,
 				ReputationSync: defaultInterval,
 				StorageSync:    defaultInterval,
+				PayoutSync:     defaultInterval,
 			},
 			Console: consoleserver.Config{
 				Address:   "127.0.0.1:0",
@@ -115,6 +126,8 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatellites storj.Nod
 			},
 			Storage2: piecestore.Config{
 				CacheSyncInterval:      defaultInterval,
+				CompactionInterval:     defaultInterval,
+				IndexReconcileInterval: defaultInterval,
 				ExpirationGracePeriod:  0,
 				MaxConcurrentRequests:  100,
 				OrderLimitGracePeriod:  time.Hour,
@@ -155,6 +168,20 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatellites storj.Nod
 				MinBytesPerSecond:      128 * memory.B,
 				MinDownloadTimeout:     2 * time.Minute,
 			},
+			Untrusted: untrusted.Config{
+				Interval:    defaultInterval,
+				GracePeriod: 720 * time.Hour,
+				TrashPeriod: 168 * time.Hour,
+			},
+			Payouts: payouts.Config{
+				AtRestTBMonthPrice: "1.50",
+				GetTBPrice:         "20",
+				PutTBPrice:         "0",
+				GetRepairTBPrice:   "10",
+				PutRepairTBPrice:   "0",
+				GetAuditTBPrice:    "10",
+				HeldPercents:       "75,75,75,50,50,50,25,25,25",
+			},
 		}
 		if planet.config.Reconfigure.StorageNode != nil {
 			planet.config.Reconfigure.StorageNode(i, &config)
@@ -171,10 +198,11 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatellites storj.Nod
 		verisonInfo := planet.NewVersionInfo()
 
 		storageConfig := storagenodedb.Config{
-			Storage: config.Storage.Path,
-			Info:    filepath.Join(config.Storage.Path, "piecestore.db"),
-			Info2:   filepath.Join(config.Storage.Path, "info.db"),
-			Pieces:  config.Storage.Path,
+			Storage:      config.Storage.Path,
+			Info:         filepath.Join(config.Storage.Path, "piecestore.db"),
+			Info2:        filepath.Join(config.Storage.Path, "info.db"),
+			Pieces:       config.Storage.Path,
+			PieceBackend: config.Storage.Backend,
 		}
 
 		var db storagenode.DB
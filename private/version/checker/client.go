@@ -123,6 +123,39 @@ func (client *Client) Process(ctx context.Context, processName string) (process
 	return process, nil
 }
 
+// ReportFailure reports a failed update to the version control server.
+func (client *Client) ReportFailure(ctx context.Context, failure version.UpdateFailure) (err error) {
+	defer mon.Task()(&ctx, failure.Process)(&err)
+
+	httpClient := http.Client{
+		Timeout: client.config.RequestTimeout,
+	}
+
+	body, err := json.Marshal(failure)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	url := strings.TrimSuffix(client.config.ServerAddress, "/") + "/failures"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := ioutil.ReadAll(resp.Body)
+		return Error.New("non-success http status code: %d; body: %s\n", resp.StatusCode, respBody)
+	}
+	return nil
+}
+
 func kebabToPascal(str string) string {
 	return strings.ReplaceAll(strings.Title(str), "-", "")
 }
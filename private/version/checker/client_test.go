@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/storj/private/version"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/versioncontrol"
@@ -86,6 +87,32 @@ func TestClient_Process(t *testing.T) {
 	}
 }
 
+func TestClient_ReportFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	peer := newTestPeer(t, ctx)
+	defer ctx.Check(peer.Close)
+
+	client := checker.New(checker.ClientConfig{
+		ServerAddress: "http://" + peer.Addr(),
+	})
+	err := client.ReportFailure(ctx, version.UpdateFailure{
+		Process: "storagenode",
+		NodeID:  testrand.NodeID(),
+		From:    "v1.2.3",
+		To:      "v1.3.0",
+		Reason:  "private endpoint not responding",
+	})
+	require.NoError(t, err)
+
+	// a failure can only be reported to the failures path
+	invalid := checker.New(checker.ClientConfig{
+		ServerAddress: "http://" + peer.Addr() + "/unknown",
+	})
+	require.Error(t, invalid.ReportFailure(ctx, version.UpdateFailure{}))
+}
+
 func newTestPeer(t *testing.T, ctx *testcontext.Context) *versioncontrol.Peer {
 	t.Helper()
 
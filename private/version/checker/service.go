@@ -85,6 +85,12 @@ func (srv *Service) Run(ctx context.Context) (err error) {
 	})
 }
 
+// Checked returns whether the version was checked at least once, so that
+// IsAllowed doesn't block.
+func (srv *Service) Checked() bool {
+	return srv.checked.Released()
+}
+
 // IsAllowed returns whether if the Service is allowed to operate or not
 func (srv *Service) IsAllowed(ctx context.Context) (version.SemVer, bool) {
 	if !srv.checked.Wait(ctx) {
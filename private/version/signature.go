@@ -0,0 +1,116 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package version
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrSignature is the error class for release signature errors.
+var ErrSignature = errs.Class("release signature")
+
+// Platform returns the key of the signatures of a binary for an operating
+// system and architecture, e.g. windows_amd64.
+func Platform(goos, goarch string) string {
+	return goos + "_" + goarch
+}
+
+// ParseSignatures parses a comma separated list of <platform>:<signature>
+// entries, where the signatures are base64 encoded.
+func ParseSignatures(s string) (map[string]string, error) {
+	signatures := map[string]string{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, ErrSignature.New("invalid signature entry %q: expected <platform>:<signature>", entry)
+		}
+		sig, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil {
+			return nil, ErrSignature.New("invalid signature for %q: %v", parts[0], err)
+		}
+		if len(sig) != ed25519.SignatureSize {
+			return nil, ErrSignature.New("invalid signature for %q: expected %d bytes, got %d", parts[0], ed25519.SignatureSize, len(sig))
+		}
+		signatures[parts[0]] = parts[1]
+	}
+	return signatures, nil
+}
+
+// ReleaseKeys are the ed25519 public keys releases are signed with. More than
+// one key is listed while the release key is rotated.
+type ReleaseKeys []ed25519.PublicKey
+
+// ParseReleaseKeys parses a comma separated list of base64 encoded ed25519
+// public keys.
+func ParseReleaseKeys(s string) (ReleaseKeys, error) {
+	var keys ReleaseKeys
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		key, err := base64.StdEncoding.DecodeString(entry)
+		if err != nil {
+			return nil, ErrSignature.New("invalid release key %q: %v", entry, err)
+		}
+		if len(key) != ed25519.PublicKeySize {
+			return nil, ErrSignature.New("invalid release key %q: expected %d bytes, got %d", entry, ed25519.PublicKeySize, len(key))
+		}
+		keys = append(keys, ed25519.PublicKey(key))
+	}
+	return keys, nil
+}
+
+// ReleaseManifest describes a release archive. Releases are signed over the
+// manifest rather than the archive alone, so that a signed archive can't be
+// passed off as another process, version or platform.
+type ReleaseManifest struct {
+	Process  string
+	Version  SemVer
+	Platform string
+	SHA256   [sha256.Size]byte
+}
+
+// Bytes returns the canonical encoding of the manifest, which is signed.
+func (manifest ReleaseManifest) Bytes() []byte {
+	return []byte(fmt.Sprintf("process:%s\nversion:%s\nplatform:%s\nsha256:%x\n",
+		manifest.Process, manifest.Version.String(), manifest.Platform, manifest.SHA256))
+}
+
+// Verify checks that the base64 encoded signature of the manifest was made by
+// one of the keys.
+func (keys ReleaseKeys) Verify(manifest ReleaseManifest, signature string) error {
+	if len(keys) == 0 {
+		return ErrSignature.New("no release keys")
+	}
+	if signature == "" {
+		return ErrSignature.New("missing signature")
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return ErrSignature.New("malformed signature: %v", err)
+	}
+	data := manifest.Bytes()
+	for _, key := range keys {
+		if ed25519.Verify(key, data, sig) {
+			return nil
+		}
+	}
+	return ErrSignature.New("not signed by a release key")
+}
+
+// SignRelease returns the base64 encoded signature of a release manifest.
+func SignRelease(key ed25519.PrivateKey, manifest ReleaseManifest) string {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(key, manifest.Bytes()))
+}
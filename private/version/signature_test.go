@@ -0,0 +1,102 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package version_test
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/storj/private/version"
+)
+
+func TestReleaseKeys_Verify(t *testing.T) {
+	oldKey := ed25519.NewKeyFromSeed(testrand.BytesInt(ed25519.SeedSize))
+	newKey := ed25519.NewKeyFromSeed(testrand.BytesInt(ed25519.SeedSize))
+	encode := func(key ed25519.PrivateKey) string {
+		return base64.StdEncoding.EncodeToString(key.Public().(ed25519.PublicKey))
+	}
+
+	_, err := version.ParseReleaseKeys("not a key")
+	require.Error(t, err)
+	_, err = version.ParseReleaseKeys(base64.StdEncoding.EncodeToString([]byte("short")))
+	require.Error(t, err)
+
+	keys, err := version.ParseReleaseKeys("")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	semVer, err := version.NewSemVer("v1.2.3")
+	require.NoError(t, err)
+	manifest := version.ReleaseManifest{
+		Process:  "storagenode",
+		Version:  semVer,
+		Platform: version.Platform("windows", "amd64"),
+		SHA256:   sha256.Sum256(testrand.BytesInt(1024)),
+	}
+	require.Error(t, keys.Verify(manifest, version.SignRelease(oldKey, manifest)))
+
+	// while rotating, archives signed with either key are accepted
+	keys, err = version.ParseReleaseKeys(encode(oldKey) + ", " + encode(newKey))
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+
+	require.NoError(t, keys.Verify(manifest, version.SignRelease(oldKey, manifest)))
+	require.NoError(t, keys.Verify(manifest, version.SignRelease(newKey, manifest)))
+
+	otherKey := ed25519.NewKeyFromSeed(testrand.BytesInt(ed25519.SeedSize))
+	require.Error(t, keys.Verify(manifest, version.SignRelease(otherKey, manifest)))
+	require.Error(t, keys.Verify(manifest, ""))
+	require.Error(t, keys.Verify(manifest, "not base64"))
+
+	// the signature doesn't verify if any field of the manifest differs
+	signature := version.SignRelease(newKey, manifest)
+
+	otherProcess := manifest
+	otherProcess.Process = "storagenode-updater"
+	require.Error(t, keys.Verify(otherProcess, signature))
+
+	otherVersion := manifest
+	otherVersion.Version.Patch++
+	require.Error(t, keys.Verify(otherVersion, signature))
+
+	otherPlatform := manifest
+	otherPlatform.Platform = version.Platform("linux", "amd64")
+	require.Error(t, keys.Verify(otherPlatform, signature))
+
+	otherArchive := manifest
+	otherArchive.SHA256 = sha256.Sum256(testrand.BytesInt(1024))
+	require.Error(t, keys.Verify(otherArchive, signature))
+}
+
+func TestParseSignatures(t *testing.T) {
+	key := ed25519.NewKeyFromSeed(testrand.BytesInt(ed25519.SeedSize))
+	signature := version.SignRelease(key, version.ReleaseManifest{Process: "storagenode"})
+
+	signatures, err := version.ParseSignatures("")
+	require.NoError(t, err)
+	assert.Empty(t, signatures)
+
+	signatures, err = version.ParseSignatures("windows_amd64:" + signature + ",linux_arm:" + signature)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		version.Platform("windows", "amd64"): signature,
+		version.Platform("linux", "arm"):     signature,
+	}, signatures)
+
+	for _, invalid := range []string{
+		signature,
+		":" + signature,
+		"windows_amd64:not base64",
+		"windows_amd64:" + base64.StdEncoding.EncodeToString([]byte("short")),
+	} {
+		_, err := version.ParseSignatures(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -99,6 +99,9 @@ type Process struct {
 type Version struct {
 	Version string `json:"version"`
 	URL     string `json:"url"`
+	// Signatures are the base64 encoded signatures of the binary archives by
+	// platform, see Platform.
+	Signatures map[string]string `json:"signatures,omitempty"`
 }
 
 // Rollout represents the state of a version rollout.
@@ -107,6 +110,16 @@ type Rollout struct {
 	Cursor RolloutBytes `json:"cursor"`
 }
 
+// UpdateFailure is reported to the version control server, when an updated
+// process failed its health verification and was rolled back.
+type UpdateFailure struct {
+	Process string       `json:"process"`
+	NodeID  storj.NodeID `json:"nodeId"`
+	From    string       `json:"from"`
+	To      string       `json:"to"`
+	Reason  string       `json:"reason"`
+}
+
 // RolloutBytes implements json un/marshalling using hex de/encoding.
 type RolloutBytes [32]byte
 
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/common/memory"
 )
 
 // BucketStorageTally holds data about a bucket tally
@@ -24,3 +26,19 @@ type BucketStorageTally struct {
 	RemoteBytes  int64
 	MetadataSize int64
 }
+
+// BucketUsageLimits holds the storage, bandwidth and object count limits of a bucket.
+// A zero limit means that the bucket is only restricted by the project limits.
+type BucketUsageLimits struct {
+	ProjectID  uuid.UUID
+	BucketName []byte
+
+	Storage   memory.Size
+	Bandwidth memory.Size
+	Objects   int64
+}
+
+// IsZero returns true when no limit is set for the bucket.
+func (limits BucketUsageLimits) IsZero() bool {
+	return limits.Storage == 0 && limits.Bandwidth == 0 && limits.Objects == 0
+}
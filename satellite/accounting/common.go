@@ -17,6 +17,8 @@ const (
 	LastBandwidthTally = "LastBandwidthTally"
 	// LastRollup represents the accounting timestamp for rollup calculations
 	LastRollup = "LastRollup"
+	// LastUsageExport represents the accounting timestamp up to which usage was pushed to the usage export webhook
+	LastUsageExport = "LastUsageExport"
 )
 
 // CSVRow represents data from QueryPaymentInfo without exposing dbx
@@ -54,11 +54,12 @@ type StorageNodeUsage struct {
 }
 
 // ProjectUsage consist of period total storage, egress
-// and objects count per hour for certain Project in bytes
+// and objects and segments count per hour for certain Project in bytes
 type ProjectUsage struct {
-	Storage     float64
-	Egress      int64
-	ObjectCount float64
+	Storage      float64
+	Egress       int64
+	ObjectCount  float64
+	SegmentCount float64
 
 	Since  time.Time
 	Before time.Time
@@ -73,6 +74,10 @@ type BucketUsage struct {
 	Egress      float64
 	ObjectCount int64
 
+	StorageLimit float64
+	EgressLimit  float64
+	ObjectLimit  int64
+
 	Since  time.Time
 	Before time.Time
 }
@@ -120,6 +125,43 @@ type BucketUsageRollup struct {
 	Before time.Time
 }
 
+// UsageExportCursor holds the period and position of usage export pagination.
+type UsageExportCursor struct {
+	Since  time.Time
+	Before time.Time
+	Limit  int
+
+	// IntervalStart, ProjectID and BucketName identify the last exported record,
+	// they are zero for the first page.
+	IntervalStart time.Time
+	ProjectID     uuid.UUID
+	BucketName    []byte
+}
+
+// UsageExportRecord holds usage of a bucket within a single hour.
+type UsageExportRecord struct {
+	IntervalStart time.Time
+	ProjectID     uuid.UUID
+	BucketName    []byte
+
+	StorageByteHours float64
+	ObjectHours      float64
+	SegmentHours     float64
+
+	GetEgress       int64
+	GetAuditEgress  int64
+	GetRepairEgress int64
+}
+
+// UsageExportPage holds a page of exported usage records
+// and the cursor of the next page.
+type UsageExportPage struct {
+	Records []UsageExportRecord
+
+	Next   bool
+	Cursor UsageExportCursor
+}
+
 // StoragenodeAccounting stores information about bandwidth and storage usage for storage nodes
 //
 // architecture: Database
@@ -152,6 +194,8 @@ type StoragenodeAccounting interface {
 type ProjectAccounting interface {
 	// SaveTallies saves the latest project info
 	SaveTallies(ctx context.Context, intervalStart time.Time, bucketTallies map[string]*BucketTally) error
+	// SaveBucketCountRollups adds object and segment counts and stored bytes of bucket tallies, held for the given hours, to hourly count rollups.
+	SaveBucketCountRollups(ctx context.Context, intervalStart time.Time, hours float64, bucketTallies map[string]*BucketTally) error
 	// GetTallies retrieves all tallies
 	GetTallies(ctx context.Context) ([]BucketTally, error)
 	// CreateStorageTally creates a record for BucketStorageTally in the accounting DB table
@@ -170,8 +214,24 @@ type ProjectAccounting interface {
 	GetProjectTotal(ctx context.Context, projectID uuid.UUID, since, before time.Time) (*ProjectUsage, error)
 	// GetBucketUsageRollups returns usage rollup per each bucket for specified period of time.
 	GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]BucketUsageRollup, error)
+	// ExportUsage returns hourly usage of all buckets ordered by hour, project and bucket, starting after cursor.
+	ExportUsage(ctx context.Context, cursor UsageExportCursor) (UsageExportPage, error)
 	// GetBucketTotals returns per bucket usage summary for specified period of time.
 	GetBucketTotals(ctx context.Context, projectID uuid.UUID, cursor BucketUsageCursor, since, before time.Time) (*BucketUsagePage, error)
+	// GetBucketAllocatedBandwidthTotal returns the sum of GET bandwidth usage allocated for a bucket in the past time frame
+	GetBucketAllocatedBandwidthTotal(ctx context.Context, projectID uuid.UUID, bucketName []byte, from time.Time) (int64, error)
+	// GetBucketStorageTotals returns the most recently tallied storage usage and object count of a bucket
+	GetBucketStorageTotals(ctx context.Context, projectID uuid.UUID, bucketName []byte) (storage int64, objects int64, err error)
+	// GetBucketUsageLimits returns the usage limits of a bucket.
+	GetBucketUsageLimits(ctx context.Context, projectID uuid.UUID, bucketName []byte) (BucketUsageLimits, error)
+	// UpdateBucketUsageLimits creates or replaces the usage limits of a bucket.
+	UpdateBucketUsageLimits(ctx context.Context, limits BucketUsageLimits) error
+	// DeleteBucketUsageLimits removes the usage limits of a bucket.
+	DeleteBucketUsageLimits(ctx context.Context, projectID uuid.UUID, bucketName []byte) error
+	// GetUsageExportedUntil returns the end of the last period pushed by the usage export webhook, it is zero if nothing was pushed yet.
+	GetUsageExportedUntil(ctx context.Context) (time.Time, error)
+	// UpdateUsageExportedUntil records the end of the last period pushed by the usage export webhook.
+	UpdateUsageExportedUntil(ctx context.Context, exportedUntil time.Time) error
 }
 
 // Cache stores live information about project storage which has not yet been synced to ProjectAccounting.
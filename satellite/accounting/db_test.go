@@ -167,6 +167,93 @@ func TestProjectLimits(t *testing.T) {
 	})
 }
 
+func TestBucketUsageLimits(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		projectID := testrand.UUID()
+		bucketName := []byte("testbucket")
+		pdb := db.ProjectAccounting()
+
+		t.Run("unset", func(t *testing.T) {
+			limits, err := pdb.GetBucketUsageLimits(ctx, projectID, bucketName)
+			require.NoError(t, err)
+			assert.True(t, limits.IsZero())
+			assert.Equal(t, projectID, limits.ProjectID)
+			assert.Equal(t, bucketName, limits.BucketName)
+		})
+
+		t.Run("update", func(t *testing.T) {
+			expected := accounting.BucketUsageLimits{
+				ProjectID:  projectID,
+				BucketName: bucketName,
+				Storage:    memory.GiB,
+				Bandwidth:  2 * memory.GiB,
+				Objects:    100,
+			}
+			require.NoError(t, pdb.UpdateBucketUsageLimits(ctx, expected))
+
+			limits, err := pdb.GetBucketUsageLimits(ctx, projectID, bucketName)
+			require.NoError(t, err)
+			assert.Equal(t, expected, limits)
+
+			expected.Objects = 0
+			require.NoError(t, pdb.UpdateBucketUsageLimits(ctx, expected))
+
+			limits, err = pdb.GetBucketUsageLimits(ctx, projectID, bucketName)
+			require.NoError(t, err)
+			assert.Equal(t, expected, limits)
+		})
+
+		t.Run("delete", func(t *testing.T) {
+			require.NoError(t, pdb.DeleteBucketUsageLimits(ctx, projectID, bucketName))
+
+			limits, err := pdb.GetBucketUsageLimits(ctx, projectID, bucketName)
+			require.NoError(t, err)
+			assert.True(t, limits.IsZero())
+		})
+	})
+}
+
+func TestBucketCountRollups(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		projectID := testrand.UUID()
+		pdb := db.ProjectAccounting()
+
+		bucketTallies, _, err := createBucketStorageTallies(projectID)
+		require.NoError(t, err)
+
+		now := time.Now().UTC()
+		intervalStart := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, time.UTC)
+
+		require.NoError(t, pdb.SaveTallies(ctx, intervalStart, bucketTallies))
+
+		// two tallies within the same hour are added up into a single rollup
+		require.NoError(t, pdb.SaveBucketCountRollups(ctx, intervalStart, 0.5, bucketTallies))
+		require.NoError(t, pdb.SaveBucketCountRollups(ctx, intervalStart.Add(30*time.Minute), 0.5, bucketTallies))
+
+		rollups, err := pdb.GetBucketUsageRollups(ctx, projectID, intervalStart, intervalStart.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, rollups, len(bucketTallies))
+
+		for _, rollup := range rollups {
+			tally := bucketTallies[projectID.String()+"/"+string(rollup.BucketName)]
+			require.NotNil(t, tally)
+
+			assert.Equal(t, float64(tally.ObjectCount), rollup.ObjectCount)
+			assert.Equal(t, float64(tally.InlineSegments), rollup.InlineSegments)
+			assert.Equal(t, float64(tally.RemoteSegments), rollup.RemoteSegments)
+		}
+
+		usage, err := pdb.GetProjectTotal(ctx, projectID, intervalStart, intervalStart.Add(time.Hour))
+		require.NoError(t, err)
+
+		var expectedSegments float64
+		for _, tally := range bucketTallies {
+			expectedSegments += float64(tally.Segments())
+		}
+		assert.Equal(t, expectedSegments, usage.SegmentCount)
+	})
+}
+
 func createBucketStorageTallies(projectID uuid.UUID) (map[string]*accounting.BucketTally, []accounting.BucketTally, error) {
 	bucketTallies := make(map[string]*accounting.BucketTally)
 	var expectedTallies []accounting.BucketTally
@@ -114,6 +114,61 @@ func (usage *Service) ExceedsStorageUsage(ctx context.Context, projectID uuid.UU
 	return false, limit, nil
 }
 
+// ExceedsBucketStorageUsage returns true if the storage usage or the object count of a bucket
+// is currently over that bucket's limits. Buckets without limits never exceed them.
+func (usage *Service) ExceedsBucketStorageUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ bool, limits BucketUsageLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err = usage.GetBucketUsageLimits(ctx, projectID, bucketName)
+	if err != nil {
+		return false, limits, err
+	}
+	if limits.Storage == 0 && limits.Objects == 0 {
+		return false, limits, nil
+	}
+
+	storage, objects, err := usage.GetBucketStorageTotals(ctx, projectID, bucketName)
+	if err != nil {
+		return false, limits, err
+	}
+
+	if limits.Storage > 0 && storage >= limits.Storage.Int64() {
+		return true, limits, nil
+	}
+	if limits.Objects > 0 && objects >= limits.Objects {
+		return true, limits, nil
+	}
+
+	return false, limits, nil
+}
+
+// ExceedsBucketBandwidthUsage returns true if the bandwidth usage limit of a bucket has been exceeded
+// in the past month (30 days). As for projects, the limit is multiplied by the redundancy expansion factor.
+// Buckets without a bandwidth limit never exceed it.
+func (usage *Service) ExceedsBucketBandwidthUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ bool, limits BucketUsageLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err = usage.GetBucketUsageLimits(ctx, projectID, bucketName)
+	if err != nil {
+		return false, limits, err
+	}
+	if limits.Bandwidth == 0 {
+		return false, limits, nil
+	}
+
+	bandwidthGetTotal, err := usage.GetBucketBandwidthTotals(ctx, projectID, bucketName)
+	if err != nil {
+		return false, limits, err
+	}
+
+	maxUsage := limits.Bandwidth.Int64() * int64(ExpansionFactor)
+	if bandwidthGetTotal >= maxUsage {
+		return true, limits, nil
+	}
+
+	return false, limits, nil
+}
+
 // GetProjectStorageTotals returns total amount of storage used by project.
 func (usage *Service) GetProjectStorageTotals(ctx context.Context, projectID uuid.UUID) (total int64, err error) {
 	defer mon.Task()(&ctx, projectID)(&err)
@@ -170,6 +225,51 @@ func (usage *Service) UpdateProjectLimits(ctx context.Context, projectID uuid.UU
 	return ErrProjectUsage.Wrap(usage.projectAccountingDB.UpdateProjectUsageLimit(ctx, projectID, limit))
 }
 
+// GetBucketStorageTotals returns the most recently tallied storage usage and object count of a bucket.
+func (usage *Service) GetBucketStorageTotals(ctx context.Context, projectID uuid.UUID, bucketName []byte) (storage int64, objects int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	storage, objects, err = usage.projectAccountingDB.GetBucketStorageTotals(ctx, projectID, bucketName)
+	return storage, objects, ErrProjectUsage.Wrap(err)
+}
+
+// GetBucketBandwidthTotals returns total amount of allocated bandwidth used by a bucket for past 30 days.
+func (usage *Service) GetBucketBandwidthTotals(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	from := time.Now().AddDate(0, 0, -AverageDaysInMonth) // past 30 days
+
+	total, err := usage.projectAccountingDB.GetBucketAllocatedBandwidthTotal(ctx, projectID, bucketName, from)
+	return total, ErrProjectUsage.Wrap(err)
+}
+
+// GetBucketUsageLimits returns the usage limits of a bucket.
+func (usage *Service) GetBucketUsageLimits(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ BucketUsageLimits, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	limits, err := usage.projectAccountingDB.GetBucketUsageLimits(ctx, projectID, bucketName)
+	return limits, ErrProjectUsage.Wrap(err)
+}
+
+// UpdateBucketUsageLimits sets new values for bucket's storage, bandwidth and object count limits.
+// Setting all limits to zero removes them.
+func (usage *Service) UpdateBucketUsageLimits(ctx context.Context, limits BucketUsageLimits) (err error) {
+	defer mon.Task()(&ctx, limits.ProjectID)(&err)
+
+	if len(limits.BucketName) == 0 {
+		return ErrProjectUsage.New("bucket name is required")
+	}
+	if limits.Storage < 0 || limits.Bandwidth < 0 || limits.Objects < 0 {
+		return ErrProjectUsage.New("bucket limits can not be negative")
+	}
+
+	if limits.IsZero() {
+		return ErrProjectUsage.Wrap(usage.projectAccountingDB.DeleteBucketUsageLimits(ctx, limits.ProjectID, limits.BucketName))
+	}
+
+	return ErrProjectUsage.Wrap(usage.projectAccountingDB.UpdateBucketUsageLimits(ctx, limits))
+}
+
 // AddProjectStorageUsage lets the live accounting know that the given
 // project has just added spaceUsed bytes of storage (from the user's
 // perspective; i.e. segment size).
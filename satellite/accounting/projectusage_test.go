@@ -278,6 +278,57 @@ func TestProjectUsageCustomLimit(t *testing.T) {
 	})
 }
 
+func TestBucketUsageCustomLimit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.ProjectID[sat.ID()]
+		projectUsage := sat.Accounting.ProjectUsage
+
+		err := uplink.Upload(ctx, sat, "limited", "test/path1", testrand.Bytes(5*memory.KiB))
+		require.NoError(t, err)
+
+		// buckets without limits never exceed them
+		exceeded, _, err := projectUsage.ExceedsBucketStorageUsage(ctx, projectID, []byte("limited"))
+		require.NoError(t, err)
+		require.False(t, exceeded)
+
+		err = projectUsage.UpdateBucketUsageLimits(ctx, accounting.BucketUsageLimits{
+			ProjectID:  projectID,
+			BucketName: []byte("limited"),
+			Objects:    1,
+		})
+		require.NoError(t, err)
+
+		sat.Accounting.Tally.Loop.TriggerWait()
+
+		exceeded, limits, err := projectUsage.ExceedsBucketStorageUsage(ctx, projectID, []byte("limited"))
+		require.NoError(t, err)
+		require.True(t, exceeded)
+		require.EqualValues(t, 1, limits.Objects)
+
+		err = uplink.Upload(ctx, sat, "limited", "test/path2", testrand.Bytes(5*memory.KiB))
+		require.Error(t, err)
+		require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+
+		// other buckets of the project are not affected
+		err = uplink.Upload(ctx, sat, "unlimited", "test/path2", testrand.Bytes(5*memory.KiB))
+		require.NoError(t, err)
+
+		// removing all limits lifts the restriction
+		err = projectUsage.UpdateBucketUsageLimits(ctx, accounting.BucketUsageLimits{
+			ProjectID:  projectID,
+			BucketName: []byte("limited"),
+		})
+		require.NoError(t, err)
+
+		err = uplink.Upload(ctx, sat, "limited", "test/path2", testrand.Bytes(5*memory.KiB))
+		require.NoError(t, err)
+	})
+}
+
 func TestUsageRollups(t *testing.T) {
 	const (
 		numBuckets     = 5
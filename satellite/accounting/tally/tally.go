@@ -122,6 +122,11 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 			errAtRest = errs.New("ProjectAccounting.SaveTallies failed: %v", err)
 		}
 
+		err = service.projectAccountingDB.SaveBucketCountRollups(ctx, finishTime, hours, observer.Bucket)
+		if err != nil {
+			errBucketInfo = errs.New("ProjectAccounting.SaveBucketCountRollups failed: %v", err)
+		}
+
 		// update live accounting totals
 		tallyProjectTotals := projectTotalsFromBuckets(observer.Bucket)
 		latestLiveTotals, err := service.liveAccounting.GetAllProjectTotals(ctx)
@@ -0,0 +1,150 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usageexport
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+const (
+	contentType = "Content-Type"
+
+	applicationJSON = "application/json"
+	textCSV         = "text/csv"
+
+	// nextCursorHeader holds the cursor of the next page for csv responses.
+	nextCursorHeader = "X-Next-Cursor"
+)
+
+// Endpoint serves usage export over http.
+//
+// Usage is requested with GET and query parameters since and before as unix
+// timestamps, optional cursor and limit for pagination and format, which is
+// either json (default) or csv.
+type Endpoint struct {
+	log       *zap.Logger
+	service   *Service
+	authToken string
+}
+
+// NewEndpoint creates a new usage export http endpoint.
+func NewEndpoint(log *zap.Logger, service *Service, authToken string) *Endpoint {
+	return &Endpoint{
+		log:       log,
+		service:   service,
+		authToken: authToken,
+	}
+}
+
+// ServeHTTP handles usage export requests.
+func (endpoint *Endpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	handleError := func(code int, err error) {
+		w.Header().Set(contentType, applicationJSON)
+		w.WriteHeader(code)
+
+		var jsonError struct {
+			Error string `json:"error"`
+		}
+
+		jsonError.Error = err.Error()
+
+		if err := json.NewEncoder(w).Encode(jsonError); err != nil {
+			endpoint.log.Error("error encoding usage export error", zap.Error(err))
+		}
+	}
+
+	if endpoint.authToken == "" {
+		handleError(http.StatusNotFound, errs.New("usage export is disabled"))
+		return
+	}
+	equality := subtle.ConstantTimeCompare(
+		[]byte(r.Header.Get("Authorization")),
+		[]byte(endpoint.authToken),
+	)
+	if equality != 1 {
+		handleError(http.StatusUnauthorized, errs.New("unauthorized"))
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		handleError(http.StatusMethodNotAllowed, errs.New("method not allowed"))
+		return
+	}
+
+	query := r.URL.Query()
+
+	since, err := parseTimestamp(query.Get("since"))
+	if err != nil {
+		handleError(http.StatusBadRequest, errs.New("invalid since: %v", err))
+		return
+	}
+	before, err := parseTimestamp(query.Get("before"))
+	if err != nil {
+		handleError(http.StatusBadRequest, errs.New("invalid before: %v", err))
+		return
+	}
+
+	var limit int
+	if limitParam := query.Get("limit"); limitParam != "" {
+		limit, err = strconv.Atoi(limitParam)
+		if err != nil {
+			handleError(http.StatusBadRequest, errs.New("invalid limit: %v", err))
+			return
+		}
+	}
+
+	format := query.Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		handleError(http.StatusBadRequest, errs.New("unsupported format %q", format))
+		return
+	}
+
+	page, err := endpoint.service.Export(ctx, since, before, query.Get("cursor"), limit)
+	if err != nil {
+		if ErrInvalidArgument.Has(err) {
+			handleError(http.StatusBadRequest, err)
+			return
+		}
+		endpoint.log.Error("usage export failed", zap.Error(err))
+		handleError(http.StatusInternalServerError, err)
+		return
+	}
+
+	if format == "csv" {
+		w.Header().Set(contentType, textCSV)
+		if page.Cursor != "" {
+			w.Header().Set(nextCursorHeader, page.Cursor)
+		}
+
+		if err = WriteCSV(w, page.Records); err != nil {
+			endpoint.log.Error("error writing usage export csv", zap.Error(err))
+		}
+		return
+	}
+
+	w.Header().Set(contentType, applicationJSON)
+	if err = json.NewEncoder(w).Encode(page); err != nil {
+		endpoint.log.Error("error encoding usage export", zap.Error(err))
+	}
+}
+
+// parseTimestamp parses unix timestamp in seconds.
+func parseTimestamp(value string) (time.Time, error) {
+	stamp, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(stamp, 0).UTC(), nil
+}
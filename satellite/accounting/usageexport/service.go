@@ -0,0 +1,212 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package usageexport exports hourly project and bucket usage to billing systems.
+package usageexport
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/accounting"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the error class for this package.
+	Error = errs.Class("usage export error")
+	// ErrInvalidArgument is returned when the export request is malformed.
+	ErrInvalidArgument = errs.Class("usage export invalid argument")
+)
+
+// Config contains configurable values for usage export.
+type Config struct {
+	AuthToken string `help:"token which has to be provided in the Authorization header of export requests, export endpoint is disabled when empty" default:""`
+	PageSize  int    `help:"maximum number of usage records returned in a single page" default:"1000"`
+
+	WebhookURL      string        `help:"url where usage records are periodically pushed to, webhook is disabled when empty" default:""`
+	WebhookSecret   string        `help:"secret used to sign webhook payloads with HMAC-SHA256, required when the webhook url is set" default:""`
+	WebhookInterval time.Duration `help:"how often usage records are pushed to the webhook" releaseDefault:"1h" devDefault:"1m"`
+	WebhookDelay    time.Duration `help:"how long to wait for late usage before an hour is pushed to the webhook, should cover the reported rollup interval" releaseDefault:"25h" devDefault:"10m"`
+	WebhookTimeout  time.Duration `help:"timeout of a single webhook request" default:"30s"`
+}
+
+// Record is a single exported usage record, the usage of a bucket within an hour.
+type Record struct {
+	IntervalStart time.Time `json:"intervalStart"`
+	ProjectID     uuid.UUID `json:"projectId"`
+	BucketName    string    `json:"bucketName"`
+
+	StorageByteHours float64 `json:"storageByteHours"`
+	ObjectHours      float64 `json:"objectHours"`
+	SegmentHours     float64 `json:"segmentHours"`
+
+	GetEgress       int64 `json:"getEgress"`
+	GetAuditEgress  int64 `json:"getAuditEgress"`
+	GetRepairEgress int64 `json:"getRepairEgress"`
+}
+
+// Page is a single page of exported usage records.
+type Page struct {
+	Records []Record `json:"records"`
+	// Cursor continues the export after the last record, it is empty when there are no more records.
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// Service exports usage from project accounting.
+//
+// architecture: Service
+type Service struct {
+	db     accounting.ProjectAccounting
+	config Config
+}
+
+// NewService creates a new usage export service.
+func NewService(db accounting.ProjectAccounting, config Config) *Service {
+	return &Service{
+		db:     db,
+		config: config,
+	}
+}
+
+// Export returns a page of usage records of hours in [since, before), continuing after cursor.
+// Empty cursor starts from the beginning of the period, limit is capped to configured page size.
+func (service *Service) Export(ctx context.Context, since, before time.Time, cursor string, limit int) (_ Page, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !since.Before(before) {
+		return Page{}, ErrInvalidArgument.New("since %s is not before %s", since, before)
+	}
+	if limit <= 0 || limit > service.config.PageSize {
+		limit = service.config.PageSize
+	}
+
+	dbCursor := accounting.UsageExportCursor{
+		Since:  since,
+		Before: before,
+		Limit:  limit,
+	}
+	if cursor != "" {
+		if err := decodeCursor(cursor, &dbCursor); err != nil {
+			return Page{}, ErrInvalidArgument.Wrap(err)
+		}
+	}
+
+	dbPage, err := service.db.ExportUsage(ctx, dbCursor)
+	if err != nil {
+		return Page{}, Error.Wrap(err)
+	}
+
+	page := Page{
+		Records: make([]Record, 0, len(dbPage.Records)),
+	}
+	for _, record := range dbPage.Records {
+		page.Records = append(page.Records, Record{
+			IntervalStart:    record.IntervalStart,
+			ProjectID:        record.ProjectID,
+			BucketName:       string(record.BucketName),
+			StorageByteHours: record.StorageByteHours,
+			ObjectHours:      record.ObjectHours,
+			SegmentHours:     record.SegmentHours,
+			GetEgress:        record.GetEgress,
+			GetAuditEgress:   record.GetAuditEgress,
+			GetRepairEgress:  record.GetRepairEgress,
+		})
+	}
+	if dbPage.Next {
+		page.Cursor, err = encodeCursor(dbPage.Cursor)
+		if err != nil {
+			return Page{}, Error.Wrap(err)
+		}
+	}
+
+	return page, nil
+}
+
+// cursorPosition is the position of the last exported record.
+type cursorPosition struct {
+	IntervalStart time.Time `json:"t"`
+	ProjectID     uuid.UUID `json:"p"`
+	BucketName    []byte    `json:"b"`
+}
+
+// encodeCursor encodes position of the cursor as an opaque string.
+func encodeCursor(cursor accounting.UsageExportCursor) (string, error) {
+	data, err := json.Marshal(cursorPosition{
+		IntervalStart: cursor.IntervalStart,
+		ProjectID:     cursor.ProjectID,
+		BucketName:    cursor.BucketName,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor decodes position encoded by encodeCursor into cursor.
+func decodeCursor(encoded string, cursor *accounting.UsageExportCursor) error {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return errs.New("invalid cursor: %v", err)
+	}
+
+	var position cursorPosition
+	if err := json.Unmarshal(data, &position); err != nil {
+		return errs.New("invalid cursor: %v", err)
+	}
+
+	cursor.IntervalStart = position.IntervalStart
+	cursor.ProjectID = position.ProjectID
+	cursor.BucketName = position.BucketName
+	return nil
+}
+
+// WriteCSV writes usage records as csv with a header row.
+func WriteCSV(output io.Writer, records []Record) error {
+	w := csv.NewWriter(output)
+
+	headers := []string{
+		"intervalStart",
+		"projectID",
+		"bucketName",
+		"byte-hours:Storage",
+		"hours:Objects",
+		"hours:Segments",
+		"bytes:GetEgress",
+		"bytes:GetAuditEgress",
+		"bytes:GetRepairEgress",
+	}
+	if err := w.Write(headers); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		row := []string{
+			record.IntervalStart.UTC().Format(time.RFC3339),
+			record.ProjectID.String(),
+			record.BucketName,
+			strconv.FormatFloat(record.StorageByteHours, 'f', 5, 64),
+			strconv.FormatFloat(record.ObjectHours, 'f', 5, 64),
+			strconv.FormatFloat(record.SegmentHours, 'f', 5, 64),
+			strconv.FormatInt(record.GetEgress, 10),
+			strconv.FormatInt(record.GetAuditEgress, 10),
+			strconv.FormatInt(record.GetRepairEgress, 10),
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
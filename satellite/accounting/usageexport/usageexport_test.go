@@ -0,0 +1,244 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usageexport_test
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/usageexport"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestExport(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		now := time.Now().UTC().Truncate(time.Hour)
+		projectID := testrand.UUID()
+
+		bucketTallies := map[string]*accounting.BucketTally{}
+		for _, bucketName := range []string{"alpha", "beta"} {
+			bucketTallies[projectID.String()+"/"+bucketName] = &accounting.BucketTally{
+				ProjectID:      projectID,
+				BucketName:     []byte(bucketName),
+				ObjectCount:    2,
+				InlineSegments: 1,
+				RemoteSegments: 3,
+				InlineBytes:    100,
+				RemoteBytes:    1000,
+			}
+		}
+		for hour := 0; hour < 2; hour++ {
+			err := db.ProjectAccounting().SaveBucketCountRollups(ctx, now.Add(time.Duration(hour)*time.Hour), 1, bucketTallies)
+			require.NoError(t, err)
+		}
+
+		// bandwidth without tallies is exported too
+		err := db.Orders().UpdateBucketBandwidthSettle(ctx, projectID, []byte("gamma"), pb.PieceAction_GET, 500, now)
+		require.NoError(t, err)
+		err = db.Orders().UpdateBucketBandwidthInline(ctx, projectID, []byte("alpha"), pb.PieceAction_GET_REPAIR, 50, now)
+		require.NoError(t, err)
+
+		service := usageexport.NewService(db.ProjectAccounting(), usageexport.Config{PageSize: 2})
+
+		var records []usageexport.Record
+		var cursor string
+		for pages := 0; ; pages++ {
+			require.True(t, pages < 10, "too many pages")
+
+			page, err := service.Export(ctx, now, now.Add(2*time.Hour), cursor, 0)
+			require.NoError(t, err)
+			require.True(t, len(page.Records) <= 2)
+
+			records = append(records, page.Records...)
+			if page.Cursor == "" {
+				break
+			}
+			cursor = page.Cursor
+		}
+
+		require.Len(t, records, 5)
+		for _, record := range records {
+			assert.Equal(t, projectID, record.ProjectID)
+
+			switch record.BucketName {
+			case "alpha", "beta":
+				assert.Equal(t, float64(1100), record.StorageByteHours)
+				assert.Equal(t, float64(2), record.ObjectHours)
+				assert.Equal(t, float64(4), record.SegmentHours)
+			case "gamma":
+				assert.Equal(t, now, record.IntervalStart)
+				assert.Equal(t, int64(500), record.GetEgress)
+			}
+		}
+		assert.Equal(t, "alpha", records[0].BucketName)
+		assert.Equal(t, int64(50), records[0].GetRepairEgress)
+
+		_, err = service.Export(ctx, now, now.Add(2*time.Hour), "invalid", 0)
+		require.True(t, usageexport.ErrInvalidArgument.Has(err))
+	})
+}
+
+func TestEndpoint(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		now := time.Now().UTC().Truncate(time.Hour)
+		projectID := testrand.UUID()
+
+		err := db.Orders().UpdateBucketBandwidthSettle(ctx, projectID, []byte("bucket"), pb.PieceAction_GET, 500, now)
+		require.NoError(t, err)
+
+		service := usageexport.NewService(db.ProjectAccounting(), usageexport.Config{PageSize: 100})
+		server := httptest.NewServer(usageexport.NewEndpoint(zaptest.NewLogger(t), service, "secret-token"))
+		defer server.Close()
+
+		query := "?since=" + strconv.FormatInt(now.Unix(), 10) + "&before=" + strconv.FormatInt(now.Add(time.Hour).Unix(), 10)
+
+		get := func(url, token string) *http.Response {
+			request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			require.NoError(t, err)
+			request.Header.Set("Authorization", token)
+
+			response, err := http.DefaultClient.Do(request)
+			require.NoError(t, err)
+			return response
+		}
+
+		t.Run("unauthorized", func(t *testing.T) {
+			response := get(server.URL+query, "wrong-token")
+			defer ctx.Check(response.Body.Close)
+			assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+		})
+
+		t.Run("json", func(t *testing.T) {
+			response := get(server.URL+query, "secret-token")
+			defer ctx.Check(response.Body.Close)
+			require.Equal(t, http.StatusOK, response.StatusCode)
+
+			var page usageexport.Page
+			require.NoError(t, json.NewDecoder(response.Body).Decode(&page))
+			require.Len(t, page.Records, 1)
+			assert.Equal(t, projectID, page.Records[0].ProjectID)
+			assert.Equal(t, int64(500), page.Records[0].GetEgress)
+			assert.Empty(t, page.Cursor)
+		})
+
+		t.Run("csv", func(t *testing.T) {
+			response := get(server.URL+query+"&format=csv", "secret-token")
+			defer ctx.Check(response.Body.Close)
+			require.Equal(t, http.StatusOK, response.StatusCode)
+
+			rows, err := csv.NewReader(response.Body).ReadAll()
+			require.NoError(t, err)
+			require.Len(t, rows, 2)
+			assert.Equal(t, projectID.String(), rows[1][1])
+			assert.Equal(t, "bucket", rows[1][2])
+		})
+
+		t.Run("bad request", func(t *testing.T) {
+			response := get(server.URL+"?since=abc", "secret-token")
+			defer ctx.Check(response.Body.Close)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+		})
+	})
+}
+
+func TestWebhook(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		now := time.Now().UTC().Truncate(time.Hour)
+		projectID := testrand.UUID()
+
+		err := db.Orders().UpdateBucketBandwidthSettle(ctx, projectID, []byte("bucket"), pb.PieceAction_GET, 500, now.Add(-time.Hour))
+		require.NoError(t, err)
+
+		var mu sync.Mutex
+		var payloads []usageexport.WebhookPayload
+		status := http.StatusOK
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, usageexport.Sign("webhook-secret", body), r.Header.Get(usageexport.SignatureHeader))
+
+			var payload usageexport.WebhookPayload
+			require.NoError(t, json.Unmarshal(body, &payload))
+
+			mu.Lock()
+			defer mu.Unlock()
+			payloads = append(payloads, payload)
+			w.WriteHeader(status)
+		}))
+		defer server.Close()
+
+		config := usageexport.Config{
+			PageSize:        100,
+			WebhookURL:      server.URL,
+			WebhookSecret:   "webhook-secret",
+			WebhookInterval: time.Hour,
+			WebhookTimeout:  time.Minute,
+		}
+		webhook := usageexport.NewWebhook(zaptest.NewLogger(t), usageexport.NewService(db.ProjectAccounting(), config), config)
+
+		// failed push is retried
+		status = http.StatusInternalServerError
+		require.Error(t, webhook.Push(ctx, now))
+		status = http.StatusOK
+		require.NoError(t, webhook.Push(ctx, now))
+
+		require.Len(t, payloads, 2)
+		payload := payloads[1]
+		assert.True(t, payload.Last)
+		assert.Equal(t, now.Add(-time.Hour), payload.Since.UTC())
+		assert.Equal(t, now, payload.Before.UTC())
+		require.Len(t, payload.Records, 1)
+		assert.Equal(t, int64(500), payload.Records[0].GetEgress)
+
+		// the same period is not pushed twice
+		require.NoError(t, webhook.Push(ctx, now))
+		require.Len(t, payloads, 2)
+
+		// next hour is pushed once it has finished
+		require.NoError(t, webhook.Push(ctx, now.Add(time.Hour)))
+		require.Len(t, payloads, 3)
+		assert.Equal(t, now, payloads[2].Since.UTC())
+		assert.Empty(t, payloads[2].Records)
+
+		// a restarted webhook continues after the last pushed period
+		restarted := usageexport.NewWebhook(zaptest.NewLogger(t), usageexport.NewService(db.ProjectAccounting(), config), config)
+		require.NoError(t, restarted.Push(ctx, now.Add(time.Hour)))
+		require.Len(t, payloads, 3)
+		require.NoError(t, restarted.Push(ctx, now.Add(3*time.Hour)))
+		require.Len(t, payloads, 4)
+		assert.Equal(t, now.Add(time.Hour), payloads[3].Since.UTC())
+		assert.Equal(t, now.Add(3*time.Hour), payloads[3].Before.UTC())
+	})
+}
+
+func TestWebhook_RequiresSecret(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		config := usageexport.Config{
+			WebhookURL:      "http://localhost:1",
+			WebhookInterval: time.Hour,
+			WebhookTimeout:  time.Minute,
+		}
+		webhook := usageexport.NewWebhook(zaptest.NewLogger(t), usageexport.NewService(db.ProjectAccounting(), config), config)
+		defer ctx.Check(webhook.Close)
+
+		require.Error(t, webhook.Run(ctx))
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usageexport
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+// SignatureHeader is the header holding hex encoded HMAC-SHA256 of the webhook payload.
+const SignatureHeader = "X-Usage-Signature"
+
+// WebhookPayload is the body of a single webhook request.
+type WebhookPayload struct {
+	Since   time.Time `json:"since"`
+	Before  time.Time `json:"before"`
+	SentAt  time.Time `json:"sentAt"`
+	Records []Record  `json:"records"`
+	// Last is true for the last request of the period.
+	Last bool `json:"last"`
+}
+
+// Webhook periodically pushes usage of finished hours to a configured url.
+// The end of the last successfully pushed period is kept in project
+// accounting, so restarts neither skip nor repeat periods.
+//
+// architecture: Chore
+type Webhook struct {
+	log     *zap.Logger
+	service *Service
+	config  Config
+	client  *http.Client
+	Loop    *sync2.Cycle
+}
+
+// NewWebhook creates a new usage export webhook chore.
+func NewWebhook(log *zap.Logger, service *Service, config Config) *Webhook {
+	return &Webhook{
+		log:     log,
+		service: service,
+		config:  config,
+		client:  &http.Client{Timeout: config.WebhookTimeout},
+		Loop:    sync2.NewCycle(config.WebhookInterval),
+	}
+}
+
+// Run starts the webhook loop.
+func (webhook *Webhook) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if webhook.config.WebhookURL == "" {
+		return nil
+	}
+	if webhook.config.WebhookSecret == "" {
+		return Error.New("webhook secret is required when the webhook url is set")
+	}
+
+	return webhook.Loop.Run(ctx, func(ctx context.Context) error {
+		err := webhook.Push(ctx, time.Now())
+		if err != nil {
+			webhook.log.Error("usage webhook push failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Push sends usage of all finished hours, older than the configured delay as of now,
+// which have not been pushed yet. Failed periods are retried on the next push.
+func (webhook *Webhook) Push(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	before := now.Add(-webhook.config.WebhookDelay).UTC().Truncate(time.Hour)
+	since, err := webhook.service.db.GetUsageExportedUntil(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if since.IsZero() {
+		since = before.Add(-time.Hour)
+	}
+	if !since.Before(before) {
+		return nil
+	}
+
+	var cursor string
+	for {
+		page, err := webhook.service.Export(ctx, since, before, cursor, 0)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		err = webhook.send(ctx, WebhookPayload{
+			Since:   since,
+			Before:  before,
+			SentAt:  time.Now().UTC(),
+			Records: page.Records,
+			Last:    page.Cursor == "",
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		if page.Cursor == "" {
+			break
+		}
+		cursor = page.Cursor
+	}
+
+	return Error.Wrap(webhook.service.db.UpdateUsageExportedUntil(ctx, before))
+}
+
+// send posts a single signed payload.
+func (webhook *Webhook) send(ctx context.Context, payload WebhookPayload) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.config.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set(contentType, applicationJSON)
+	request.Header.Set(SignatureHeader, Sign(webhook.config.WebhookSecret, body))
+
+	response, err := webhook.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, response.Body)
+		err = errs.Combine(err, response.Body.Close())
+	}()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return errs.New("unexpected webhook response status %q", response.Status)
+	}
+	return nil
+}
+
+// Close stops the webhook loop.
+func (webhook *Webhook) Close() error {
+	webhook.Loop.Close()
+	return nil
+}
+
+// Sign returns hex encoded HMAC-SHA256 of payload with secret.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -21,6 +21,7 @@ import (
 	"storj.io/storj/private/version"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/usageexport"
 	"storj.io/storj/satellite/admin"
 	"storj.io/storj/satellite/metainfo"
 )
@@ -111,7 +112,11 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB,
 			SatelliteNodeID:  &peer.Identity.ID,
 			SatelliteAddress: config.Server.Address,
 		})
-		peer.Admin.Server = admin.NewServer(log.Named("admin"), peer.Admin.Listener, config.Admin, service)
+		usageExport := usageexport.NewEndpoint(log.Named("admin:usage-export"),
+			usageexport.NewService(db.ProjectAccounting(), config.UsageExport),
+			config.UsageExport.AuthToken,
+		)
+		peer.Admin.Server = admin.NewServer(log.Named("admin"), peer.Admin.Listener, config.Admin, service, usageExport)
 		peer.Servers.Add(lifecycle.Item{
 			Name:  "admin",
 			Run:   peer.Admin.Server.Run,
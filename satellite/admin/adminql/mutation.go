@@ -25,6 +25,8 @@ const (
 	CreateProjectMutation = "createProject"
 	// UpdateUsageLimitMutation is a mutation name for usage limit updating
 	UpdateUsageLimitMutation = "updateUsageLimit"
+	// UpdateBucketUsageLimitMutation is a mutation name for bucket usage limit updating
+	UpdateBucketUsageLimitMutation = "updateBucketUsageLimit"
 )
 
 // rootMutation creates mutation for graphql populated by AccountsClient
@@ -52,6 +54,11 @@ func rootMutation(log *zap.Logger, service *service.Service, types *TypeCreator)
 				Args:    graphqlUpdateUsageLimitMutationArgs(),
 				Resolve: graphqlUpdateUsageLimitMutationResolve(service),
 			},
+			UpdateBucketUsageLimitMutation: &graphql.Field{
+				Type:    types.bucketUsageLimit,
+				Args:    graphqlUpdateBucketUsageLimitMutationArgs(),
+				Resolve: graphqlUpdateBucketUsageLimitMutationResolve(service),
+			},
 			CreateAPIKeyMutation: &graphql.Field{
 				Type:    graphql.NewNonNull(types.apiKeyCreate),
 				Args:    graphqlCreateAPIKeyMutationArgs(),
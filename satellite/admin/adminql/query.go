@@ -29,6 +29,8 @@ const (
 	GatewayAccessKeyQuery = "gatewayAccessKey"
 	// UsageLimitQuery is a query name for usage limit
 	UsageLimitQuery = "usageLimit"
+	// BucketUsageLimitQuery is a query name for bucket usage limit
+	BucketUsageLimitQuery = "bucketUsageLimit"
 	// StorageNodesByWalletQuery is a query name for nodes by wallet address
 	StorageNodesByWalletQuery = "nodesByWallet"
 	// StorageNodeUsageQuery is a query name for node usage
@@ -80,6 +82,11 @@ func rootQuery(service *service.Service, types *TypeCreator) *graphql.Object {
 				Args:    graphqlUsageLimitQueryArgs(),
 				Resolve: graphqlUsageLimitQueryResolve(service),
 			},
+			BucketUsageLimitQuery: &graphql.Field{
+				Type:    types.bucketUsageLimit,
+				Args:    graphqlBucketUsageLimitQueryArgs(),
+				Resolve: graphqlBucketUsageLimitQueryResolve(service),
+			},
 			StorageNodesByWalletQuery: &graphql.Field{
 				Type:    graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(types.storageNode))),
 				Args:    graphqlStorageNodeQueryArgs(),
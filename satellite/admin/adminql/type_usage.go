@@ -17,6 +17,8 @@ const (
 	ProjectTotalUsageType = "ProjectTotalUsage"
 	// UsageLimitType is a graphql type for usage limit
 	UsageLimitType = "UsageLimit"
+	// BucketUsageLimitType is a graphql type for bucket usage limit
+	BucketUsageLimitType = "BucketUsageLimit"
 
 	// FieldUsage is a field name for usage
 	FieldUsage = "usage"
@@ -30,6 +32,10 @@ const (
 	FieldStorage = "storage"
 	// FieldStorageLimit is a field name for storage limit
 	FieldStorageLimit = "storageLimit"
+	// FieldObjectLimit is a field name for object limit
+	FieldObjectLimit = "objectLimit"
+	// FieldBucketName is a field name for bucket name
+	FieldBucketName = "bucketName"
 )
 
 // graphqlUserTotalUsage creates *graphql.Object type representation of satellite.admin.UserTotalUsage
@@ -107,6 +113,36 @@ func graphqlUsageLimit() *graphql.Object {
 	})
 }
 
+// graphqlBucketUsageLimit creates *graphql.Object type representation of satellite.admin.BucketUsageLimit
+func graphqlBucketUsageLimit() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: BucketUsageLimitType,
+		Fields: graphql.Fields{
+			FieldBucketName: &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+			},
+			FieldEgress: &graphql.Field{
+				Type: graphql.NewNonNull(bigInt),
+			},
+			FieldEgressLimit: &graphql.Field{
+				Type: graphql.NewNonNull(bigInt),
+			},
+			FieldStorage: &graphql.Field{
+				Type: graphql.NewNonNull(bigInt),
+			},
+			FieldStorageLimit: &graphql.Field{
+				Type: graphql.NewNonNull(bigInt),
+			},
+			FieldObject: &graphql.Field{
+				Type: graphql.NewNonNull(bigInt),
+			},
+			FieldObjectLimit: &graphql.Field{
+				Type: graphql.NewNonNull(bigInt),
+			},
+		},
+	})
+}
+
 func graphqlUserTotalUsageQueryArgs() graphql.FieldConfigArgument {
 	return graphql.FieldConfigArgument{
 		FieldUserID: &graphql.ArgumentConfig{
@@ -237,3 +273,72 @@ func graphqlProjectUsageLimitResolve(s *service.Service) func(graphql.ResolvePar
 		return s.GetUsageLimit(p.Context, project.ProjectID)
 	}
 }
+
+func graphqlUpdateBucketUsageLimitMutationArgs() graphql.FieldConfigArgument {
+	return graphql.FieldConfigArgument{
+		FieldProjectID: &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		FieldBucketName: &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		FieldStorageLimit: &graphql.ArgumentConfig{
+			Type: bigInt,
+		},
+		FieldEgressLimit: &graphql.ArgumentConfig{
+			Type: bigInt,
+		},
+		FieldObjectLimit: &graphql.ArgumentConfig{
+			Type: bigInt,
+		},
+	}
+}
+
+func graphqlUpdateBucketUsageLimitMutationResolve(s *service.Service) func(graphql.ResolveParams) (interface{}, error) {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		inputID, _ := p.Args[FieldProjectID].(string)
+		bucketName, _ := p.Args[FieldBucketName].(string)
+
+		projectID, err := uuid.Parse(inputID)
+		if err != nil {
+			return nil, err
+		}
+
+		var storageLimit, egressLimit, objectLimit *int64
+
+		if value, set := p.Args[FieldStorageLimit].(int64); set {
+			storageLimit = &value
+		}
+		if value, set := p.Args[FieldEgressLimit].(int64); set {
+			egressLimit = &value
+		}
+		if value, set := p.Args[FieldObjectLimit].(int64); set {
+			objectLimit = &value
+		}
+		return s.UpdateBucketUsageLimit(p.Context, *projectID, bucketName, storageLimit, egressLimit, objectLimit)
+	}
+}
+
+func graphqlBucketUsageLimitQueryArgs() graphql.FieldConfigArgument {
+	return graphql.FieldConfigArgument{
+		FieldProjectID: &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		FieldBucketName: &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+	}
+}
+
+func graphqlBucketUsageLimitQueryResolve(s *service.Service) func(graphql.ResolveParams) (interface{}, error) {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		inputID, _ := p.Args[FieldProjectID].(string)
+		bucketName, _ := p.Args[FieldBucketName].(string)
+
+		projectID, err := uuid.Parse(inputID)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetBucketUsageLimit(p.Context, *projectID, bucketName)
+	}
+}
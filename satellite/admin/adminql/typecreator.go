@@ -23,6 +23,7 @@ type TypeCreator struct {
 	apiKey            *graphql.Object
 	apiKeyCreate      *graphql.Object
 	usageLimit        *graphql.Object
+	bucketUsageLimit  *graphql.Object
 	storageNode       *graphql.Object
 	storageNodeUsage  *graphql.Object
 
@@ -43,6 +44,11 @@ func (c *TypeCreator) Create(log *zap.Logger, s *service.Service) error {
 		return err
 	}
 
+	c.bucketUsageLimit = graphqlBucketUsageLimit()
+	if err := c.bucketUsageLimit.Error(); err != nil {
+		return err
+	}
+
 	c.apiKey = graphqlAPIKey()
 	if err := c.apiKey.Error(); err != nil {
 		return err
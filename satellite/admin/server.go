@@ -60,7 +60,7 @@ type Server struct {
 }
 
 // NewServer returns a new admin.Server.
-func NewServer(log *zap.Logger, listener net.Listener, config Config, service *service.Service) *Server {
+func NewServer(log *zap.Logger, listener net.Listener, config Config, service *service.Service, usageExport http.Handler) *Server {
 	server := &Server{
 		log:     log,
 		config:  config,
@@ -77,6 +77,7 @@ func NewServer(log *zap.Logger, listener net.Listener, config Config, service *s
 
 	//router.Handle("/api/v0/graphql", server.withAuth(http.HandlerFunc(server.grapqlHandler)))
 	router.Handle("/api/v0/graphql", http.HandlerFunc(server.grapqlHandler))
+	router.Handle("/api/v0/usage/export", usageExport).Methods(http.MethodGet)
 
 	server.listener = listener
 	server.server.Handler = router
@@ -40,6 +40,17 @@ type UsageLimit struct {
 	StorageLimit int64 `json:"storageLimit"`
 }
 
+// BucketUsageLimit is an object that describes data usage limits for a bucket of a project
+type BucketUsageLimit struct {
+	BucketName   string `json:"bucketName"`
+	Egress       int64  `json:"egress"`
+	EgressLimit  int64  `json:"egressLimit"`
+	Storage      int64  `json:"storage"`
+	StorageLimit int64  `json:"storageLimit"`
+	Object       int64  `json:"object"`
+	ObjectLimit  int64  `json:"objectLimit"`
+}
+
 // UpdateUsageLimit updates usage limit for user project
 func (s *Service) UpdateUsageLimit(ctx context.Context, projectID uuid.UUID, limit int64) (*UsageLimit, error) {
 	p, err := s.consoleDB.Projects().Get(ctx, projectID)
@@ -87,6 +98,68 @@ func (s *Service) getUsageLimit(ctx context.Context, projectID uuid.UUID) (*Usag
 	}, nil
 }
 
+// UpdateBucketUsageLimit updates usage limits for a bucket of user project.
+// Limits which are nil are left unchanged, zero removes the limit.
+func (s *Service) UpdateBucketUsageLimit(ctx context.Context, projectID uuid.UUID, bucketName string, storageLimit, egressLimit, objectLimit *int64) (*BucketUsageLimit, error) {
+	p, err := s.consoleDB.Projects().Get(ctx, projectID)
+	if err != nil {
+		return nil, errs.New(projectDoesNotExistErrMsg)
+	}
+
+	limits, err := s.projectUsage.GetBucketUsageLimits(ctx, p.ID, []byte(bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if storageLimit != nil {
+		limits.Storage = memory.Size(*storageLimit)
+	}
+	if egressLimit != nil {
+		limits.Bandwidth = memory.Size(*egressLimit)
+	}
+	if objectLimit != nil {
+		limits.Objects = *objectLimit
+	}
+
+	err = s.projectUsage.UpdateBucketUsageLimits(ctx, limits)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return s.getBucketUsageLimit(ctx, p.ID, bucketName)
+}
+
+// GetBucketUsageLimit queries usage limits for a bucket of user project
+func (s *Service) GetBucketUsageLimit(ctx context.Context, projectID uuid.UUID, bucketName string) (*BucketUsageLimit, error) {
+	p, err := s.consoleDB.Projects().Get(ctx, projectID)
+	if err != nil {
+		return nil, errs.New(projectDoesNotExistErrMsg)
+	}
+	return s.getBucketUsageLimit(ctx, p.ID, bucketName)
+}
+
+func (s *Service) getBucketUsageLimit(ctx context.Context, projectID uuid.UUID, bucketName string) (*BucketUsageLimit, error) {
+	limits, err := s.projectUsage.GetBucketUsageLimits(ctx, projectID, []byte(bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	bandwidthTotals, err := s.projectUsage.GetBucketBandwidthTotals(ctx, projectID, []byte(bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	storageTotals, objectTotals, err := s.projectUsage.GetBucketStorageTotals(ctx, projectID, []byte(bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &BucketUsageLimit{
+		BucketName:   bucketName,
+		Egress:       bandwidthTotals,
+		EgressLimit:  limits.Bandwidth.Int64(),
+		Storage:      storageTotals,
+		StorageLimit: limits.Storage.Int64(),
+		Object:       objectTotals,
+		ObjectLimit:  limits.Objects,
+	}, nil
+}
+
 // GetTotalUsageForUser aggregates data usage for all user projects
 func (s *Service) GetTotalUsageForUser(ctx context.Context, userID uuid.UUID, since time.Time, before time.Time) (*UserTotalUsage, error) {
 	projects, err := s.consoleDB.Projects().GetByUserID(ctx, userID)
@@ -25,7 +25,10 @@ import (
 	"storj.io/storj/pkg/auth/grpcauth"
 	"storj.io/storj/pkg/debug"
 	"storj.io/storj/pkg/server"
+	"storj.io/storj/private/corruptionpb"
+	"storj.io/storj/private/gracefulexitpb"
 	"storj.io/storj/private/lifecycle"
+	"storj.io/storj/private/nodestatspb"
 	"storj.io/storj/private/post"
 	"storj.io/storj/private/post/oauth2"
 	"storj.io/storj/private/version"
@@ -48,6 +51,7 @@ import (
 	"storj.io/storj/satellite/payments/mockpayments"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/referrals"
+	"storj.io/storj/satellite/repair/corruption"
 	"storj.io/storj/satellite/repair/irreparable"
 	"storj.io/storj/satellite/rewards"
 	"storj.io/storj/satellite/vouchers"
@@ -152,6 +156,10 @@ type API struct {
 		Endpoint *nodestats.Endpoint
 	}
 
+	Corruption struct {
+		Endpoint *corruption.Endpoint
+	}
+
 	GracefulExit struct {
 		Endpoint *gracefulexit.Endpoint
 	}
@@ -518,6 +526,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 				pc.StorageTBPrice,
 				pc.EgressTBPrice,
 				pc.ObjectPrice,
+				pc.SegmentPrice,
 				pc.BonusRate)
 
 			if err != nil {
@@ -599,9 +608,22 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Log.Named("nodestats:endpoint"),
 			peer.Overlay.DB,
 			peer.DB.StoragenodeAccounting(),
+			peer.DB.Payouts(),
 		)
 		pb.RegisterNodeStatsServer(peer.Server.GRPC(), peer.NodeStats.Endpoint)
 		pb.DRPCRegisterNodeStats(peer.Server.DRPC(), peer.NodeStats.Endpoint)
+		nodestatspb.DRPCRegisterPayouts(peer.Server.DRPC(), peer.NodeStats.Endpoint)
+		nodestatspb.DRPCRegisterSuspension(peer.Server.DRPC(), peer.NodeStats.Endpoint)
+	}
+
+	{ // setup corrupted pieces endpoint
+		peer.Corruption.Endpoint = corruption.NewEndpoint(
+			peer.Log.Named("corruption:endpoint"),
+			config.Corruption,
+			peer.Overlay.DB,
+			peer.DB.CorruptPieces(),
+		)
+		corruptionpb.DRPCRegisterCorruption(peer.Server.DRPC(), peer.Corruption.Endpoint)
 	}
 
 	{ // setup graceful exit
@@ -615,10 +637,13 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 				peer.Metainfo.Service,
 				peer.Orders.Service,
 				peer.DB.PeerIdentities(),
+				peer.DB.StoragenodeAccounting(),
 				config.GracefulExit)
 
 			pb.RegisterSatelliteGracefulExitServer(peer.Server.GRPC(), peer.GracefulExit.Endpoint)
 			pb.DRPCRegisterSatelliteGracefulExit(peer.Server.DRPC(), peer.GracefulExit.Endpoint.DRPC())
+			gracefulexitpb.DRPCRegisterGracefulExitPause(peer.Server.DRPC(), peer.GracefulExit.Endpoint)
+			gracefulexitpb.DRPCRegisterPartialExit(peer.Server.DRPC(), peer.GracefulExit.Endpoint)
 		} else {
 			peer.Log.Named("gracefulexit").Info("disabled")
 		}
@@ -56,12 +56,14 @@ func (reporter *Reporter) RecordAudits(ctx context.Context, req Report, path sto
 	fails := req.Fails
 	offlines := req.Offlines
 	pendingAudits := req.PendingAudits
+	unknowns := req.Unknown
 
 	reporter.log.Debug("Reporting audits",
 		zap.Int("successes", len(successes)),
 		zap.Int("failures", len(fails)),
 		zap.Int("offlines", len(offlines)),
 		zap.Int("pending", len(pendingAudits)),
+		zap.Int("unknown", len(unknowns)),
 		zap.Binary("Segment", []byte(path)),
 		zap.String("Segment Path", path),
 	)
@@ -70,7 +72,7 @@ func (reporter *Reporter) RecordAudits(ctx context.Context, req Report, path sto
 
 	tries := 0
 	for tries <= reporter.maxRetries {
-		if len(successes) == 0 && len(fails) == 0 && len(offlines) == 0 && len(pendingAudits) == 0 {
+		if len(successes) == 0 && len(fails) == 0 && len(offlines) == 0 && len(pendingAudits) == 0 && len(unknowns) == 0 {
 			return Report{}, nil
 		}
 
@@ -101,6 +103,12 @@ func (reporter *Reporter) RecordAudits(ctx context.Context, req Report, path sto
 				errlist.Add(err)
 			}
 		}
+		if len(unknowns) > 0 {
+			unknowns, err = reporter.recordAuditUnknownStatus(ctx, unknowns)
+			if err != nil {
+				errlist.Add(err)
+			}
+		}
 
 		tries++
 	}
@@ -112,6 +120,7 @@ func (reporter *Reporter) RecordAudits(ctx context.Context, req Report, path sto
 			Fails:         fails,
 			Offlines:      offlines,
 			PendingAudits: pendingAudits,
+			Unknown:       unknowns,
 		}, errs.Combine(Error.New("some nodes failed to be updated in overlay"), err)
 	}
 	return Report{}, nil
@@ -139,6 +148,28 @@ func (reporter *Reporter) recordAuditFailStatus(ctx context.Context, failedAudit
 	return nil, nil
 }
 
+// recordAuditUnknownStatus updates nodeIDs in overlay with isup=true, auditunknown=true
+func (reporter *Reporter) recordAuditUnknownStatus(ctx context.Context, unknownAuditNodeIDs storj.NodeIDList) (failed storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updateRequests := make([]*overlay.UpdateRequest, len(unknownAuditNodeIDs))
+	for i, nodeID := range unknownAuditNodeIDs {
+		updateRequests[i] = &overlay.UpdateRequest{
+			NodeID:       nodeID,
+			IsUp:         true,
+			AuditUnknown: true,
+		}
+	}
+	if len(updateRequests) > 0 {
+		failed, err = reporter.overlay.BatchUpdateStats(ctx, updateRequests)
+		if err != nil || len(failed) > 0 {
+			reporter.log.Debug("failed to record Unknown Nodes ", zap.Strings("NodeIDs", failed.Strings()))
+			return failed, errs.Combine(Error.New("failed to record some audit unknown statuses in overlay"), err)
+		}
+	}
+	return nil, nil
+}
+
 // recordOfflineStatus updates nodeIDs in overlay with isup=false. When there
 // is any error the function return the list of nodes which haven't been
 // recorded.
@@ -56,6 +56,12 @@ const (
 	FieldEgress = "egress"
 	// FieldObjectCount is a field name for objects count
 	FieldObjectCount = "objectCount"
+	// FieldStorageLimit is a field name for storage limit
+	FieldStorageLimit = "storageLimit"
+	// FieldEgressLimit is a field name for egress limit
+	FieldEgressLimit = "egressLimit"
+	// FieldObjectLimit is a field name for objects limit
+	FieldObjectLimit = "objectLimit"
 	// FieldPageCount is a field name for total page count
 	FieldPageCount = "pageCount"
 	// FieldCurrentPage is a field name for current page number
@@ -290,6 +296,15 @@ func graphqlBucketUsage() *graphql.Object {
 			FieldObjectCount: &graphql.Field{
 				Type: graphql.Float,
 			},
+			FieldStorageLimit: &graphql.Field{
+				Type: graphql.Float,
+			},
+			FieldEgressLimit: &graphql.Field{
+				Type: graphql.Float,
+			},
+			FieldObjectLimit: &graphql.Field{
+				Type: graphql.Float,
+			},
 			SinceArg: &graphql.Field{
 				Type: graphql.DateTime,
 			},
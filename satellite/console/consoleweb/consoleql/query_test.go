@@ -48,7 +48,7 @@ func TestGraphqlQuery(t *testing.T) {
 			db.StripeCoinPayments(),
 			db.Console().Projects(),
 			db.ProjectAccounting(),
-			"0", "0", "0", 10,
+			"0", "0", "0", "0", 10,
 		)
 		require.NoError(t, err)
 
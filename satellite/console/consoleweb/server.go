@@ -29,6 +29,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/storj/pkg/auth"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb/consoleapi"
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
@@ -143,6 +144,11 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, mail
 		server.withAuth(http.HandlerFunc(server.projectUsageLimitsHandler)),
 	).Methods(http.MethodGet)
 
+	router.Handle(
+		"/api/v0/projects/{id}/buckets/{bucket}/usage-limits",
+		server.withAuth(http.HandlerFunc(server.bucketUsageLimitsHandler)),
+	).Methods(http.MethodGet, http.MethodPatch)
+
 	referralsController := consoleapi.NewReferrals(logger, referralsService, service, mailService, server.config.ExternalAddress)
 	referralsRouter := router.PathPrefix("/api/v0/referrals").Subrouter()
 	referralsRouter.Handle("/tokens", server.withAuth(http.HandlerFunc(referralsController.GetTokens))).Methods(http.MethodGet)
@@ -340,6 +346,16 @@ func (server *Server) bucketUsageReportHandler(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	if r.URL.Query().Get("format") == "csv" {
+		w.Header().Set(contentType, textCSV)
+		w.Header().Set("Content-Disposition", "attachment; filename=usage-report.csv")
+
+		if err = writeUsageReportCSV(w, bucketRollups); err != nil {
+			server.log.Error("bucket usage report error", zap.Error(err))
+		}
+		return
+	}
+
 	if err = server.templates.usageReport.Execute(w, bucketRollups); err != nil {
 		server.log.Error("bucket usage report error", zap.Error(err))
 	}
@@ -574,6 +590,84 @@ func (server *Server) projectUsageLimitsHandler(w http.ResponseWriter, r *http.R
 	}
 }
 
+// bucketUsageLimitsHandler api handler for reading and updating bucket usage limits.
+func (server *Server) bucketUsageLimitsHandler(w http.ResponseWriter, r *http.Request) {
+	err := error(nil)
+	ctx := r.Context()
+
+	defer mon.Task()(&ctx)(&err)
+
+	handleError := func(code int, err error) {
+		w.WriteHeader(code)
+
+		var jsonError struct {
+			Error string `json:"error"`
+		}
+
+		jsonError.Error = err.Error()
+
+		if err := json.NewEncoder(w).Encode(jsonError); err != nil {
+			server.log.Error("error encoding bucket usage limits error", zap.Error(err))
+		}
+	}
+
+	handleServiceError := func(err error) {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			handleError(http.StatusUnauthorized, err)
+		case accounting.ErrProjectUsage.Has(err):
+			handleError(http.StatusBadRequest, err)
+		default:
+			handleError(http.StatusInternalServerError, err)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	vars := mux.Vars(r)
+
+	idParam, ok := vars["id"]
+	if !ok {
+		handleError(http.StatusBadRequest, errs.New("missing project id route param"))
+		return
+	}
+
+	bucketName, ok := vars["bucket"]
+	if !ok || bucketName == "" {
+		handleError(http.StatusBadRequest, errs.New("missing bucket name route param"))
+		return
+	}
+
+	projectID, err := uuid.Parse(idParam)
+	if err != nil {
+		handleError(http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	var limits *console.BucketUsageLimits
+	switch r.Method {
+	case http.MethodPatch:
+		var update console.BucketUsageLimitsUpdate
+		if err = json.NewDecoder(r.Body).Decode(&update); err != nil {
+			handleError(http.StatusBadRequest, errs.New("invalid bucket usage limits: %v", err))
+			return
+		}
+
+		limits, err = server.service.UpdateBucketUsageLimits(ctx, *projectID, bucketName, update)
+	default:
+		limits, err = server.service.GetBucketUsageLimits(ctx, *projectID, bucketName)
+	}
+	if err != nil {
+		handleServiceError(err)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(limits); err != nil {
+		server.log.Error("error encoding bucket usage limits", zap.Error(err))
+		return
+	}
+}
+
 // grapqlHandler is graphql endpoint http handler function
 func (server *Server) grapqlHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -0,0 +1,66 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleweb
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"storj.io/storj/satellite/accounting"
+)
+
+// textCSV is the content type of the csv usage report.
+const textCSV = "text/csv"
+
+// writeUsageReportCSV writes bucket usage rollups as csv, one row per bucket.
+func writeUsageReportCSV(output io.Writer, rollups []accounting.BucketUsageRollup) error {
+	w := csv.NewWriter(output)
+
+	headers := []string{
+		"bucketName",
+		"since",
+		"before",
+		"gb-hours:InlineStoredData",
+		"gb-hours:RemoteStoredData",
+		"gb:RepairEgress",
+		"gb:GetEgress",
+		"gb:AuditEgress",
+		"hours:InlineSegments",
+		"hours:RemoteSegments",
+		"hours:Objects",
+		"gb-hours:MetadataSize",
+	}
+	if err := w.Write(headers); err != nil {
+		return err
+	}
+
+	formatFloat := func(value float64) string {
+		return strconv.FormatFloat(value, 'f', 6, 64)
+	}
+
+	for _, rollup := range rollups {
+		record := []string{
+			string(rollup.BucketName),
+			rollup.Since.Format(time.RFC3339),
+			rollup.Before.Format(time.RFC3339),
+			formatFloat(rollup.InlineStoredData),
+			formatFloat(rollup.RemoteStoredData),
+			formatFloat(rollup.RepairEgress),
+			formatFloat(rollup.GetEgress),
+			formatFloat(rollup.AuditEgress),
+			formatFloat(rollup.InlineSegments),
+			formatFloat(rollup.RemoteSegments),
+			formatFloat(rollup.ObjectCount),
+			formatFloat(rollup.MetadataSize),
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
@@ -10,3 +10,22 @@ type ProjectUsageLimits struct {
 	StorageUsed    int64 `json:"storageUsed"`
 	BandwidthUsed  int64 `json:"bandwidthUsed"`
 }
+
+// BucketUsageLimits holds bucket usage limits and current usage.
+// A zero limit means that the bucket is only restricted by the project limits.
+type BucketUsageLimits struct {
+	StorageLimit   int64 `json:"storageLimit"`
+	BandwidthLimit int64 `json:"bandwidthLimit"`
+	ObjectLimit    int64 `json:"objectLimit"`
+	StorageUsed    int64 `json:"storageUsed"`
+	BandwidthUsed  int64 `json:"bandwidthUsed"`
+	ObjectsUsed    int64 `json:"objectsUsed"`
+}
+
+// BucketUsageLimitsUpdate holds the bucket usage limits to change. Limits which
+// are nil are left unchanged and zero removes a limit.
+type BucketUsageLimitsUpdate struct {
+	StorageLimit   *int64 `json:"storageLimit"`
+	BandwidthLimit *int64 `json:"bandwidthLimit"`
+	ObjectLimit    *int64 `json:"objectLimit"`
+}
@@ -1295,6 +1295,86 @@ func (s *Service) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID
 	}, nil
 }
 
+// GetBucketUsageLimits returns bucket usage limits and current usage.
+func (s *Service) GetBucketUsageLimits(ctx context.Context, projectID uuid.UUID, bucketName string) (_ *BucketUsageLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	return s.getBucketUsageLimits(ctx, projectID, bucketName)
+}
+
+// UpdateBucketUsageLimits changes the storage, bandwidth and object count limits
+// of a bucket, which are set in the update. Only the project owner can change them.
+func (s *Service) UpdateBucketUsageLimits(ctx context.Context, projectID uuid.UUID, bucketName string, update BucketUsageLimitsUpdate) (_ *BucketUsageLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.isProjectOwner(ctx, auth.User.ID, projectID); err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	limits, err := s.projectUsage.GetBucketUsageLimits(ctx, projectID, []byte(bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if update.StorageLimit != nil {
+		limits.Storage = memory.Size(*update.StorageLimit)
+	}
+	if update.BandwidthLimit != nil {
+		limits.Bandwidth = memory.Size(*update.BandwidthLimit)
+	}
+	if update.ObjectLimit != nil {
+		limits.Objects = *update.ObjectLimit
+	}
+
+	err = s.projectUsage.UpdateBucketUsageLimits(ctx, limits)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return s.getBucketUsageLimits(ctx, projectID, bucketName)
+}
+
+// getBucketUsageLimits returns bucket usage limits and current usage.
+func (s *Service) getBucketUsageLimits(ctx context.Context, projectID uuid.UUID, bucketName string) (_ *BucketUsageLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := s.projectUsage.GetBucketUsageLimits(ctx, projectID, []byte(bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	storageUsed, objectsUsed, err := s.projectUsage.GetBucketStorageTotals(ctx, projectID, []byte(bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	bandwidthUsed, err := s.projectUsage.GetBucketBandwidthTotals(ctx, projectID, []byte(bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &BucketUsageLimits{
+		StorageLimit:   limits.Storage.Int64(),
+		BandwidthLimit: limits.Bandwidth.Int64(),
+		ObjectLimit:    limits.Objects,
+		StorageUsed:    storageUsed,
+		BandwidthUsed:  bandwidthUsed,
+		ObjectsUsed:    objectsUsed,
+	}, nil
+}
+
 // Authorize validates token from context and returns authorized Authorization
 func (s *Service) Authorize(ctx context.Context) (a Authorization, err error) {
 	defer mon.Task()(&ctx)(&err)
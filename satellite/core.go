@@ -28,6 +28,7 @@ import (
 	"storj.io/storj/satellite/accounting/reportedrollup"
 	"storj.io/storj/satellite/accounting/rollup"
 	"storj.io/storj/satellite/accounting/tally"
+	"storj.io/storj/satellite/accounting/usageexport"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/contact"
 	"storj.io/storj/satellite/dbcleanup"
@@ -41,7 +42,9 @@ import (
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/mockpayments"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/payouts"
 	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/corruption"
 )
 
 // Core is the satellite core process that runs chores
@@ -88,7 +91,8 @@ type Core struct {
 	}
 
 	Repair struct {
-		Checker *checker.Checker
+		Checker    *checker.Checker
+		Corruption *corruption.Chore
 	}
 	Audit struct {
 		Queue    *audit.Queue
@@ -111,6 +115,7 @@ type Core struct {
 		Rollup              *rollup.Service
 		ProjectUsage        *accounting.Service
 		ReportedRollupChore *reportedrollup.Chore
+		UsageExportWebhook  *usageexport.Webhook
 	}
 
 	LiveAccounting struct {
@@ -135,6 +140,11 @@ type Core struct {
 		EstimationChore *downtime.EstimationChore
 		Service         *downtime.Service
 	}
+
+	Payouts struct {
+		Service *payouts.Service
+		Chore   *payouts.Chore
+	}
 }
 
 // New creates a new satellite
@@ -298,6 +308,22 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Repair Checker", peer.Repair.Checker.Loop))
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Repair Checker Irreparable", peer.Repair.Checker.IrreparableLoop))
+
+		peer.Repair.Corruption = corruption.NewChore(
+			peer.Log.Named("repair:corruption"),
+			config.Corruption,
+			peer.DB.CorruptPieces(),
+			peer.Overlay.Service,
+			peer.Metainfo.Service,
+			peer.Metainfo.Loop,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "repair:corruption",
+			Run:   peer.Repair.Corruption.Run,
+			Close: peer.Repair.Corruption.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Repair Corruption", peer.Repair.Corruption.Loop))
 	}
 
 	{ // setup audit
@@ -409,6 +435,18 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Accounting Reported Rollup", peer.Accounting.ReportedRollupChore.Loop))
+
+		peer.Accounting.UsageExportWebhook = usageexport.NewWebhook(peer.Log.Named("accounting:usage-export-webhook"),
+			usageexport.NewService(peer.DB.ProjectAccounting(), config.UsageExport),
+			config.UsageExport,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "accounting:usage-export-webhook",
+			Run:   peer.Accounting.UsageExportWebhook.Run,
+			Close: peer.Accounting.UsageExportWebhook.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Accounting Usage Export Webhook", peer.Accounting.UsageExportWebhook.Loop))
 	}
 
 	// TODO: remove in future, should be in API
@@ -428,6 +466,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 				pc.StorageTBPrice,
 				pc.EgressTBPrice,
 				pc.ObjectPrice,
+				pc.SegmentPrice,
 				pc.BonusRate)
 
 			if err != nil {
@@ -517,6 +556,32 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Downtime Estimation", peer.DowntimeTracking.EstimationChore.Loop))
 	}
 
+	{ // setup payouts
+		peer.Payouts.Service, err = payouts.NewService(
+			peer.Log.Named("payouts:service"),
+			peer.DB.Payouts(),
+			peer.DB.StoragenodeAccounting(),
+			peer.Overlay.DB,
+			config.Payouts,
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.Payouts.Chore = payouts.NewChore(
+			peer.Log.Named("payouts:chore"),
+			peer.Payouts.Service,
+			config.Payouts,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "payouts:chore",
+			Run:   peer.Payouts.Chore.Run,
+			Close: peer.Payouts.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Payouts Chore", peer.Payouts.Chore.Loop))
+	}
+
 	return peer, nil
 }
 
@@ -53,11 +53,17 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 			return nil
 		}
 
+		partialExits, err := chore.db.GetUnqueuedPartialExits(ctx)
+		if err != nil {
+			chore.log.Error("error retrieving partial exits", zap.Error(err))
+			return nil
+		}
+
 		nodeCount := len(exitingNodes)
-		if nodeCount == 0 {
+		if nodeCount == 0 && len(partialExits) == 0 {
 			return nil
 		}
-		chore.log.Debug("found exiting nodes", zap.Int("exitingNodes", nodeCount))
+		chore.log.Debug("found exiting nodes", zap.Int("exitingNodes", nodeCount), zap.Int("partialExits", len(partialExits)))
 
 		exitingNodesLoopIncomplete := make(storj.NodeIDList, 0, nodeCount)
 		for _, node := range exitingNodes {
@@ -77,8 +83,15 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 				lastActivityTime = progress.UpdatedAt
 			}
 
+			// the time the node paused its exit isn't inactive, up to the limit
+			suspended, err := chore.pausedDuration(ctx, node.NodeID)
+			if err != nil {
+				chore.log.Error("error retrieving pause for node", zap.Stringer("Node ID", node.NodeID), zap.Error(err))
+				continue
+			}
+
 			// check inactive timeframe
-			if lastActivityTime.Add(chore.config.MaxInactiveTimeFrame).Before(time.Now().UTC()) {
+			if lastActivityTime.Add(chore.config.MaxInactiveTimeFrame + suspended).Before(time.Now().UTC()) {
 				exitStatusRequest := &overlay.ExitStatusRequest{
 					NodeID:         node.NodeID,
 					ExitSuccess:    false,
@@ -96,11 +109,25 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 				if err != nil {
 					chore.log.Error("error deleting node from transfer queue", zap.Error(err))
 				}
+
+				err = chore.db.DeletePause(ctx, node.NodeID)
+				if err != nil {
+					chore.log.Error("error deleting graceful exit pause", zap.Error(err))
+				}
 			}
 		}
 
 		// Populate transfer queue for nodes that have not completed the exit loop yet
-		pathCollector := NewPathCollector(chore.db, exitingNodesLoopIncomplete, chore.log, chore.config.ChoreBatchSize)
+		// and for the requested bytes of the partially exiting nodes
+		collectedNodes := make(storj.NodeIDList, 0, len(exitingNodesLoopIncomplete)+len(partialExits))
+		collectedNodes = append(collectedNodes, exitingNodesLoopIncomplete...)
+		for _, partialExit := range partialExits {
+			collectedNodes = append(collectedNodes, partialExit.NodeID)
+		}
+		pathCollector := NewPathCollector(chore.db, collectedNodes, chore.log, chore.config.ChoreBatchSize)
+		for _, partialExit := range partialExits {
+			pathCollector.LimitBytes(partialExit.NodeID, partialExit.RequestedBytes)
+		}
 		err = chore.metainfoLoop.Join(ctx, pathCollector)
 		if err != nil {
 			chore.log.Error("error joining metainfo loop.", zap.Error(err))
@@ -127,10 +154,40 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 			bytesToTransfer := pathCollector.nodeIDStorage[nodeID]
 			mon.IntVal("graceful_exit_init_bytes_stored").Observe(bytesToTransfer)
 		}
+
+		for _, partialExit := range partialExits {
+			queuedBytes := pathCollector.nodeIDStorage[partialExit.NodeID]
+			err = chore.db.MarkPartialExitQueued(ctx, partialExit.NodeID, queuedBytes, now)
+			if err != nil {
+				chore.log.Error("error updating partial exit.", zap.Stringer("Node ID", partialExit.NodeID), zap.Error(err))
+			}
+
+			mon.IntVal("partial_exit_queued_bytes").Observe(queuedBytes)
+		}
 		return nil
 	})
 }
 
+// pausedDuration returns how long the node paused its graceful exit, limited to
+// MaxPauseDuration.
+func (chore *Chore) pausedDuration(ctx context.Context, nodeID storj.NodeID) (_ time.Duration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pause, err := chore.db.GetPause(ctx, nodeID)
+	if err != nil {
+		if ErrNodeNotFound.Has(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	paused := pause.Total(time.Now().UTC())
+	if paused > chore.config.MaxPauseDuration {
+		paused = chore.config.MaxPauseDuration
+	}
+	return paused, nil
+}
+
 // Close closes chore.
 func (chore *Chore) Close() error {
 	chore.Loop.Close()
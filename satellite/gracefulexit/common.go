@@ -20,6 +20,9 @@ var (
 	// ErrAboveOptimalThreshold is returned if a graceful exit entry for a node has more pieces than required.
 	ErrAboveOptimalThreshold = errs.Class("pointer has more pieces than required")
 
+	// ErrPartialExitActive is returned when a partial exit is started while the previous one isn't finished.
+	ErrPartialExitActive = errs.Class("partial exit not finished")
+
 	mon = monkit.Package()
 )
 
@@ -35,7 +38,11 @@ type Config struct {
 	MaxFailuresPerPiece          int           `help:"maximum number of transfer failures per piece." default:"5"`
 	OverallMaxFailuresPercentage int           `help:"maximum percentage of transfer failures per node." default:"10"`
 	MaxInactiveTimeFrame         time.Duration `help:"maximum inactive time frame of transfer activities per node." default:"168h"`
+	MaxPauseDuration             time.Duration `help:"maximum total time a node may pause its graceful exit without being counted as inactive." default:"72h"`
 	RecvTimeout                  time.Duration `help:"the minimum duration for receiving a stream from a storage node before timing out" default:"10m"`
 	MaxOrderLimitSendCount       int           `help:"maximum number of order limits a satellite sends to a node before marking piece transfer failed" default:"10"`
 	NodeMinAgeInMonths           int           `help:"minimum age for a node on the network in order to initiate graceful exit" default:"6"`
+
+	PartialExitInterval      time.Duration `help:"minimum time between the starts of two partial exits of a node." default:"720h"`
+	PartialExitMaxPercentage int           `help:"maximum percentage of its stored bytes a node may request to move in a partial exit." default:"50"`
 }
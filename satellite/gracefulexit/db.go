@@ -19,6 +19,38 @@ type Progress struct {
 	UpdatedAt         time.Time
 }
 
+// Pause represents the persisted graceful exit pause record.
+type Pause struct {
+	NodeID storj.NodeID
+	// PausedAt is when the current pause started, nil when the node isn't paused.
+	PausedAt *time.Time
+	// PausedDuration is the total duration of the completed pauses.
+	PausedDuration time.Duration
+}
+
+// Total returns the total duration the node paused its graceful exit, including
+// the current pause.
+func (pause *Pause) Total(now time.Time) time.Duration {
+	total := pause.PausedDuration
+	if pause.PausedAt != nil && now.After(*pause.PausedAt) {
+		total += now.Sub(*pause.PausedAt)
+	}
+	return total
+}
+
+// PartialExit represents the persisted partial exit record. A node in a partial
+// exit transfers some of its pieces to other nodes and stays on the network.
+type PartialExit struct {
+	NodeID           storj.NodeID
+	RequestedBytes   int64
+	QueuedBytes      int64
+	TransferredBytes int64
+	RequestedAt      time.Time
+	// QueuedAt is when the pieces to transfer were added to the transfer queue.
+	QueuedAt   *time.Time
+	FinishedAt *time.Time
+}
+
 // TransferQueueItem represents the persisted graceful exit queue record.
 type TransferQueueItem struct {
 	NodeID              storj.NodeID
@@ -44,6 +76,29 @@ type DB interface {
 	// GetProgress gets a graceful exit progress entry.
 	GetProgress(ctx context.Context, nodeID storj.NodeID) (*Progress, error)
 
+	// Pause marks the graceful exit of a node as paused, unless it's paused already.
+	Pause(ctx context.Context, nodeID storj.NodeID, pausedAt time.Time) error
+	// Resume adds the duration of the current pause to the total paused duration of a node.
+	Resume(ctx context.Context, nodeID storj.NodeID, resumedAt time.Time) error
+	// GetPause gets a graceful exit pause entry.
+	GetPause(ctx context.Context, nodeID storj.NodeID) (*Pause, error)
+	// DeletePause deletes a graceful exit pause entry.
+	DeletePause(ctx context.Context, nodeID storj.NodeID) error
+
+	// DeleteProgress deletes a graceful exit progress entry.
+	DeleteProgress(ctx context.Context, nodeID storj.NodeID) error
+
+	// StartPartialExit starts a partial exit of a node, replacing its finished partial exit.
+	StartPartialExit(ctx context.Context, nodeID storj.NodeID, requestedBytes int64, requestedAt time.Time) error
+	// GetPartialExit gets the partial exit of a node.
+	GetPartialExit(ctx context.Context, nodeID storj.NodeID) (*PartialExit, error)
+	// GetUnqueuedPartialExits gets the unfinished partial exits whose pieces weren't added to the transfer queue yet.
+	GetUnqueuedPartialExits(ctx context.Context) ([]*PartialExit, error)
+	// MarkPartialExitQueued records the bytes of the pieces added to the transfer queue for a partial exit.
+	MarkPartialExitQueued(ctx context.Context, nodeID storj.NodeID, queuedBytes int64, queuedAt time.Time) error
+	// FinishPartialExit finishes the partial exit of a node.
+	FinishPartialExit(ctx context.Context, nodeID storj.NodeID, transferredBytes int64, finishedAt time.Time) error
+
 	// Enqueue batch inserts graceful exit transfer queue entries it does not exist.
 	Enqueue(ctx context.Context, items []TransferQueueItem) error
 	// UpdateTransferQueueItem creates a graceful exit transfer queue entry.
@@ -187,3 +187,100 @@ func TestTransferQueueItem(t *testing.T) {
 		require.Equal(t, 1, item.OrderLimitSendCount)
 	})
 }
+
+func TestPause(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		geDB := db.GracefulExit()
+		nodeID := testrand.NodeID()
+
+		_, err := geDB.GetPause(ctx, nodeID)
+		require.True(t, gracefulexit.ErrNodeNotFound.Has(err))
+
+		// resuming a node which isn't paused does nothing
+		require.NoError(t, geDB.Resume(ctx, nodeID, time.Now()))
+
+		pausedAt := time.Now().UTC().Add(-time.Hour)
+		require.NoError(t, geDB.Pause(ctx, nodeID, pausedAt))
+		// pausing again doesn't restart the pause
+		require.NoError(t, geDB.Pause(ctx, nodeID, pausedAt.Add(30*time.Minute)))
+
+		pause, err := geDB.GetPause(ctx, nodeID)
+		require.NoError(t, err)
+		require.NotNil(t, pause.PausedAt)
+		require.WithinDuration(t, pausedAt, *pause.PausedAt, time.Second)
+		require.Zero(t, pause.PausedDuration)
+		require.InDelta(t, float64(2*time.Hour), float64(pause.Total(pausedAt.Add(2*time.Hour))), float64(time.Second))
+
+		require.NoError(t, geDB.Resume(ctx, nodeID, pausedAt.Add(time.Hour)))
+
+		pause, err = geDB.GetPause(ctx, nodeID)
+		require.NoError(t, err)
+		require.Nil(t, pause.PausedAt)
+		require.InDelta(t, float64(time.Hour), float64(pause.PausedDuration), float64(time.Second))
+		require.Equal(t, pause.PausedDuration, pause.Total(time.Now()))
+
+		// the pauses add up
+		require.NoError(t, geDB.Pause(ctx, nodeID, pausedAt.Add(2*time.Hour)))
+		require.NoError(t, geDB.Resume(ctx, nodeID, pausedAt.Add(3*time.Hour)))
+
+		pause, err = geDB.GetPause(ctx, nodeID)
+		require.NoError(t, err)
+		require.InDelta(t, float64(2*time.Hour), float64(pause.PausedDuration), float64(time.Second))
+
+		require.NoError(t, geDB.DeletePause(ctx, nodeID))
+		_, err = geDB.GetPause(ctx, nodeID)
+		require.True(t, gracefulexit.ErrNodeNotFound.Has(err))
+	})
+}
+
+func TestPartialExit(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		geDB := db.GracefulExit()
+		nodeID := testrand.NodeID()
+
+		_, err := geDB.GetPartialExit(ctx, nodeID)
+		require.True(t, gracefulexit.ErrNodeNotFound.Has(err))
+
+		requestedAt := time.Now().UTC()
+		require.NoError(t, geDB.StartPartialExit(ctx, nodeID, 1000, requestedAt))
+
+		// an unfinished partial exit can't be started again
+		err = geDB.StartPartialExit(ctx, nodeID, 2000, requestedAt)
+		require.True(t, gracefulexit.ErrPartialExitActive.Has(err))
+
+		unqueued, err := geDB.GetUnqueuedPartialExits(ctx)
+		require.NoError(t, err)
+		require.Len(t, unqueued, 1)
+		require.Equal(t, nodeID, unqueued[0].NodeID)
+		require.EqualValues(t, 1000, unqueued[0].RequestedBytes)
+
+		require.NoError(t, geDB.MarkPartialExitQueued(ctx, nodeID, 900, requestedAt.Add(time.Minute)))
+
+		unqueued, err = geDB.GetUnqueuedPartialExits(ctx)
+		require.NoError(t, err)
+		require.Len(t, unqueued, 0)
+
+		require.NoError(t, geDB.FinishPartialExit(ctx, nodeID, 800, requestedAt.Add(time.Hour)))
+
+		partialExit, err := geDB.GetPartialExit(ctx, nodeID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1000, partialExit.RequestedBytes)
+		require.EqualValues(t, 900, partialExit.QueuedBytes)
+		require.EqualValues(t, 800, partialExit.TransferredBytes)
+		require.WithinDuration(t, requestedAt, partialExit.RequestedAt, time.Second)
+		require.NotNil(t, partialExit.QueuedAt)
+		require.NotNil(t, partialExit.FinishedAt)
+		require.WithinDuration(t, requestedAt.Add(time.Hour), *partialExit.FinishedAt, time.Second)
+
+		// a finished partial exit is replaced by a new one
+		require.NoError(t, geDB.StartPartialExit(ctx, nodeID, 2000, requestedAt.Add(2*time.Hour)))
+
+		partialExit, err = geDB.GetPartialExit(ctx, nodeID)
+		require.NoError(t, err)
+		require.EqualValues(t, 2000, partialExit.RequestedBytes)
+		require.Zero(t, partialExit.QueuedBytes)
+		require.Zero(t, partialExit.TransferredBytes)
+		require.Nil(t, partialExit.QueuedAt)
+		require.Nil(t, partialExit.FinishedAt)
+	})
+}
@@ -20,6 +20,8 @@ import (
 	"storj.io/common/signing"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/gracefulexitpb"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
@@ -58,6 +60,7 @@ type Endpoint struct {
 	orders         *orders.Service
 	connections    *connectionsTracker
 	peerIdentities overlay.PeerIdentities
+	nodeAccounting accounting.StoragenodeAccounting
 	config         Config
 	recvTimeout    time.Duration
 }
@@ -103,7 +106,7 @@ func (endpoint *Endpoint) DRPC() pb.DRPCSatelliteGracefulExitServer {
 
 // NewEndpoint creates a new graceful exit endpoint.
 func NewEndpoint(log *zap.Logger, signer signing.Signer, db DB, overlaydb overlay.DB, overlay *overlay.Service, metainfo *metainfo.Service, orders *orders.Service,
-	peerIdentities overlay.PeerIdentities, config Config) *Endpoint {
+	peerIdentities overlay.PeerIdentities, nodeAccounting accounting.StoragenodeAccounting, config Config) *Endpoint {
 	return &Endpoint{
 		log:            log,
 		interval:       time.Millisecond * buildQueueMillis,
@@ -115,6 +118,7 @@ func NewEndpoint(log *zap.Logger, signer signing.Signer, db DB, overlaydb overla
 		orders:         orders,
 		connections:    newConnectionsTracker(),
 		peerIdentities: peerIdentities,
+		nodeAccounting: nodeAccounting,
 		config:         config,
 		recvTimeout:    config.RecvTimeout,
 	}
@@ -150,29 +154,55 @@ func (endpoint *Endpoint) doProcess(stream processStream) (err error) {
 		endpoint.connections.delete(nodeID)
 	}()
 
-	isDisqualified, err := endpoint.handleDisqualifiedNode(ctx, nodeID)
+	// a node in a partial exit transfers the queued pieces without exiting
+	partialExit, err := endpoint.activePartialExit(ctx, nodeID)
 	if err != nil {
 		return rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
-	if isDisqualified {
-		return rpcstatus.Error(rpcstatus.FailedPrecondition, "Disqualified nodes cannot graceful exit")
-	}
+	partial := partialExit != nil
 
-	msg, err := endpoint.checkExitStatus(ctx, nodeID)
+	// disqualified nodes don't receive transfer orders, neither while exiting
+	// nor in a partial exit
+	isDisqualified, err := endpoint.handleDisqualifiedNode(ctx, nodeID, partial)
 	if err != nil {
-		if ErrIneligibleNodeAge.Has(err) {
-			return rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
-		}
 		return rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
+	if isDisqualified {
+		return rpcstatus.Error(rpcstatus.FailedPrecondition, "Disqualified nodes cannot graceful exit")
+	}
+
+	if partial {
+		if partialExit.QueuedAt == nil {
+			err = stream.Send(&pb.SatelliteMessage{Message: &pb.SatelliteMessage_NotReady{NotReady: &pb.NotReady{}}})
+			if err != nil {
+				return rpcstatus.Error(rpcstatus.Internal, err.Error())
+			}
 
-	if msg != nil {
-		err = stream.Send(msg)
+			return nil
+		}
+	} else {
+		msg, err := endpoint.checkExitStatus(ctx, nodeID)
 		if err != nil {
+			if ErrIneligibleNodeAge.Has(err) {
+				return rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+			}
 			return rpcstatus.Error(rpcstatus.Internal, err.Error())
 		}
 
-		return nil
+		if msg != nil {
+			err = stream.Send(msg)
+			if err != nil {
+				return rpcstatus.Error(rpcstatus.Internal, err.Error())
+			}
+
+			return nil
+		}
+
+		// a node processing its graceful exit isn't paused anymore
+		err = endpoint.db.Resume(ctx, nodeID, time.Now().UTC())
+		if err != nil {
+			return rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
 	}
 
 	// maps pieceIDs to pendingTransfers to keep track of ongoing piece transfer requests
@@ -239,7 +269,16 @@ func (endpoint *Endpoint) doProcess(stream processStream) (err error) {
 
 		// if there is no more work to receive send complete
 		if finished {
-			isDisqualified, err := endpoint.handleDisqualifiedNode(ctx, nodeID)
+			if partial {
+				// the node stays on the network, so there's no exit status to send
+				err = endpoint.finishPartialExit(ctx, nodeID)
+				if err != nil {
+					return rpcstatus.Error(rpcstatus.Internal, err.Error())
+				}
+				break
+			}
+
+			isDisqualified, err := endpoint.handleDisqualifiedNode(ctx, nodeID, false)
 			if err != nil {
 				return rpcstatus.Error(rpcstatus.Internal, err.Error())
 			}
@@ -295,6 +334,14 @@ func (endpoint *Endpoint) doProcess(stream processStream) (err error) {
 
 					continue
 				}
+				if ErrInvalidArgument.Has(err) && partial {
+					// a partial exit isn't failed, it ends with what was transferred so far
+					finishErr := endpoint.finishPartialExit(ctx, nodeID)
+					if finishErr != nil {
+						return rpcstatus.Error(rpcstatus.Internal, finishErr.Error())
+					}
+					return rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+				}
 				if ErrInvalidArgument.Has(err) {
 					// immediately fail and complete graceful exit for nodes that fail satellite validation
 					err = endpoint.db.IncrementProgress(ctx, nodeID, 0, 0, 1)
@@ -331,6 +378,273 @@ func (endpoint *Endpoint) doProcess(stream processStream) (err error) {
 	return nil
 }
 
+// Pause is called by an exiting storage node when its operator paused the
+// graceful exit. The time the node is paused isn't counted as inactive, up to
+// MaxPauseDuration in total.
+func (endpoint *Endpoint) Pause(ctx context.Context, req *gracefulexitpb.PauseRequest) (_ *gracefulexitpb.PauseResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID, err := endpoint.exitingNodeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	err = endpoint.db.Pause(ctx, nodeID, now)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	pause, err := endpoint.db.GetPause(ctx, nodeID)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	remaining := endpoint.config.MaxPauseDuration - pause.Total(now)
+	if remaining < 0 {
+		remaining = 0
+	}
+	endpoint.log.Info("graceful exit paused", zap.Stringer("Node ID", nodeID), zap.Duration("remaining", remaining))
+	mon.Meter("graceful_exit_pause").Mark(1)
+
+	return &gracefulexitpb.PauseResponse{Remaining: int64(remaining)}, nil
+}
+
+// Resume is called by an exiting storage node when its operator resumed the
+// graceful exit.
+func (endpoint *Endpoint) Resume(ctx context.Context, req *gracefulexitpb.ResumeRequest) (_ *gracefulexitpb.ResumeResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID, err := endpoint.exitingNodeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.db.Resume(ctx, nodeID, time.Now().UTC())
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	endpoint.log.Info("graceful exit resumed", zap.Stringer("Node ID", nodeID))
+
+	return &gracefulexitpb.ResumeResponse{}, nil
+}
+
+// exitingNodeID returns the ID of the calling node, when it started and didn't
+// finish its graceful exit.
+func (endpoint *Endpoint) exitingNodeID(ctx context.Context) (_ storj.NodeID, err error) {
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return storj.NodeID{}, rpcstatus.Error(rpcstatus.Unauthenticated, Error.Wrap(err).Error())
+	}
+
+	exitStatus, err := endpoint.overlaydb.GetExitStatus(ctx, peer.ID)
+	if err != nil {
+		return storj.NodeID{}, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if exitStatus.ExitInitiatedAt == nil || exitStatus.ExitFinishedAt != nil {
+		return storj.NodeID{}, rpcstatus.Error(rpcstatus.FailedPrecondition, "node is not exiting")
+	}
+	return peer.ID, nil
+}
+
+// StartPartialExit is called by a storage node to move the requested amount
+// of its data to other nodes without leaving the satellite.
+func (endpoint *Endpoint) StartPartialExit(ctx context.Context, req *gracefulexitpb.StartPartialExitRequest) (_ *gracefulexitpb.StartPartialExitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, Error.Wrap(err).Error())
+	}
+	nodeID := peer.ID
+
+	if req.Bytes <= 0 {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "requested bytes must be positive")
+	}
+
+	nodeInfo, err := endpoint.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if nodeInfo.Disqualified != nil {
+		return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, "Disqualified nodes cannot partially exit")
+	}
+	if nodeInfo.ExitStatus.ExitInitiatedAt != nil {
+		return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, "node is exiting")
+	}
+
+	now := time.Now().UTC()
+	eligibilityDate := nodeInfo.CreatedAt.AddDate(0, endpoint.config.NodeMinAgeInMonths, 0)
+	if now.Before(eligibilityDate) {
+		return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, ErrIneligibleNodeAge.New("will be eligible after %s", eligibilityDate.String()).Error())
+	}
+
+	lastPartialExit, err := endpoint.db.GetPartialExit(ctx, nodeID)
+	if err != nil && !ErrNodeNotFound.Has(err) {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if lastPartialExit != nil {
+		nextAllowed := lastPartialExit.RequestedAt.Add(endpoint.config.PartialExitInterval)
+		if now.Before(nextAllowed) {
+			return nil, rpcstatus.Errorf(rpcstatus.ResourceExhausted, "next partial exit can be started after %s", nextAllowed.String())
+		}
+	}
+
+	storedBytes, err := endpoint.storedBytes(ctx, nodeID, now)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	maxBytes := storedBytes * int64(endpoint.config.PartialExitMaxPercentage) / 100
+	if req.Bytes > maxBytes {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "requested bytes exceed the allowed %d bytes", maxBytes)
+	}
+
+	err = endpoint.db.StartPartialExit(ctx, nodeID, req.Bytes, now)
+	if err != nil {
+		if ErrPartialExitActive.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+		}
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	endpoint.log.Info("partial exit started", zap.Stringer("Node ID", nodeID), zap.Int64("bytes", req.Bytes))
+	mon.Meter("partial_exit_init").Mark(1)
+
+	return &gracefulexitpb.StartPartialExitResponse{}, nil
+}
+
+// storedBytes estimates the bytes a node stores from its at rest usage of the
+// last full day.
+func (endpoint *Endpoint) storedBytes(ctx context.Context, nodeID storj.NodeID, now time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	usages, err := endpoint.nodeAccounting.QueryStorageNodeUsage(ctx, nodeID, today.AddDate(0, 0, -1), today.Add(-time.Nanosecond))
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	if len(usages) == 0 {
+		return 0, nil
+	}
+	return int64(usages[len(usages)-1].StorageUsed / 24), nil
+}
+
+// GetPartialExit returns the progress of the last partial exit of a storage node.
+func (endpoint *Endpoint) GetPartialExit(ctx context.Context, req *gracefulexitpb.GetPartialExitRequest) (_ *gracefulexitpb.GetPartialExitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, Error.Wrap(err).Error())
+	}
+
+	partialExit, err := endpoint.db.GetPartialExit(ctx, peer.ID)
+	if err != nil {
+		if ErrNodeNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, "no partial exit found")
+		}
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	resp := &gracefulexitpb.GetPartialExitResponse{
+		RequestedBytes:   partialExit.RequestedBytes,
+		QueuedBytes:      partialExit.QueuedBytes,
+		TransferredBytes: partialExit.TransferredBytes,
+		RequestedAt:      partialExit.RequestedAt.Unix(),
+	}
+	if partialExit.QueuedAt != nil {
+		resp.QueuedAt = partialExit.QueuedAt.Unix()
+	}
+	if partialExit.FinishedAt != nil {
+		resp.FinishedAt = partialExit.FinishedAt.Unix()
+	}
+	return resp, nil
+}
+
+// CancelPartialExit is called by a storage node to stop its partial exit. The
+// pieces transferred so far stay on the receiving nodes.
+func (endpoint *Endpoint) CancelPartialExit(ctx context.Context, req *gracefulexitpb.CancelPartialExitRequest) (_ *gracefulexitpb.CancelPartialExitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, Error.Wrap(err).Error())
+	}
+	nodeID := peer.ID
+
+	// don't race with a running transfer of the node
+	if !endpoint.connections.tryAdd(nodeID) {
+		return nil, rpcstatus.Error(rpcstatus.Aborted, "partial exit is being processed")
+	}
+	defer endpoint.connections.delete(nodeID)
+
+	partialExit, err := endpoint.activePartialExit(ctx, nodeID)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if partialExit == nil {
+		return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, "no active partial exit")
+	}
+
+	err = endpoint.finishPartialExit(ctx, nodeID)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &gracefulexitpb.CancelPartialExitResponse{}, nil
+}
+
+// activePartialExit returns the unfinished partial exit of a node, or nil when
+// there is none.
+func (endpoint *Endpoint) activePartialExit(ctx context.Context, nodeID storj.NodeID) (_ *PartialExit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	partialExit, err := endpoint.db.GetPartialExit(ctx, nodeID)
+	if err != nil {
+		if ErrNodeNotFound.Has(err) {
+			return nil, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+	if partialExit.FinishedAt != nil {
+		return nil, nil
+	}
+	return partialExit, nil
+}
+
+// finishPartialExit records the transferred bytes of a partial exit and
+// clears its transfer queue and progress.
+func (endpoint *Endpoint) finishPartialExit(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var transferredBytes int64
+	progress, err := endpoint.db.GetProgress(ctx, nodeID)
+	if err == nil {
+		transferredBytes = progress.BytesTransferred
+	} else if !ErrNodeNotFound.Has(err) {
+		return Error.Wrap(err)
+	}
+
+	err = endpoint.db.DeleteTransferQueueItems(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = endpoint.db.FinishPartialExit(ctx, nodeID, transferredBytes, time.Now().UTC())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = endpoint.db.DeleteProgress(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	endpoint.log.Info("partial exit finished", zap.Stringer("Node ID", nodeID), zap.Int64("transferred bytes", transferredBytes))
+	mon.Meter("partial_exit_finished").Mark(1)
+	mon.IntVal("partial_exit_transferred_bytes").Observe(transferredBytes)
+
+	return nil
+}
+
 func (endpoint *Endpoint) processIncomplete(ctx context.Context, stream processStream, pending *PendingMap, incomplete *TransferQueueItem) error {
 	nodeID := incomplete.NodeID
 
@@ -630,7 +944,7 @@ func (endpoint *Endpoint) handleFailed(ctx context.Context, pending *PendingMap,
 	return pending.Delete(pieceID)
 }
 
-func (endpoint *Endpoint) handleDisqualifiedNode(ctx context.Context, nodeID storj.NodeID) (isDisqualified bool, err error) {
+func (endpoint *Endpoint) handleDisqualifiedNode(ctx context.Context, nodeID storj.NodeID, partial bool) (isDisqualified bool, err error) {
 	// check if node is disqualified
 	nodeInfo, err := endpoint.overlay.Get(ctx, nodeID)
 	if err != nil {
@@ -638,6 +952,11 @@ func (endpoint *Endpoint) handleDisqualifiedNode(ctx context.Context, nodeID sto
 	}
 
 	if nodeInfo.Disqualified != nil {
+		if partial {
+			// the node didn't exit, so only its partial exit is ended
+			return true, endpoint.finishPartialExit(ctx, nodeID)
+		}
+
 		// update graceful exit status to be failed
 		exitStatusRequest := &overlay.ExitStatusRequest{
 			NodeID:         nodeID,
@@ -684,6 +1003,11 @@ func (endpoint *Endpoint) handleFinished(ctx context.Context, stream processStre
 		return Error.Wrap(err)
 	}
 
+	err = endpoint.db.DeletePause(ctx, exitStatusRequest.NodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
 	return nil
 }
 
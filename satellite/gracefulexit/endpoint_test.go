@@ -27,9 +27,11 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/storj/private/gracefulexitpb"
 	"storj.io/storj/private/testblobs"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/storage"
 	"storj.io/storj/storagenode"
@@ -293,7 +295,7 @@ func TestRecvTimeout(t *testing.T) {
 		// make uploads on storage node slower than the timeout for transferring bytes to another node
 		delay := 200 * time.Millisecond
 		storageNodeDB.SetLatency(delay)
-		store := pieces.NewStore(zaptest.NewLogger(t), storageNodeDB.Pieces(), nil, nil, storageNodeDB.PieceSpaceUsedDB())
+		store := pieces.NewStore(zaptest.NewLogger(t), storageNodeDB.Pieces(), nil, nil, storageNodeDB.PieceSpaceUsedDB(), nil)
 
 		// run the SN chore again to start processing transfers.
 		worker := gracefulexit.NewWorker(zaptest.NewLogger(t), store, exitingNode.DB.Satellites(), exitingNode.Dialer, satellite.ID(), satellite.Addr(),
@@ -1414,6 +1416,95 @@ func TestIneligibleNodeAge(t *testing.T) {
 	})
 }
 
+func TestStartPartialExit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 1,
+		UplinkCount:      0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(logger *zap.Logger, index int, config *satellite.Config) {
+				config.GracefulExit.PartialExitInterval = 24 * time.Hour
+				config.GracefulExit.PartialExitMaxPercentage = 50
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		// the node stored 1000 bytes during the last full day.
+		now := time.Now().UTC()
+		yesterday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+		err := satellite.DB.StoragenodeAccounting().SaveRollup(ctx, yesterday.Add(time.Hour), accounting.RollupStats{
+			yesterday: {node.ID(): {NodeID: node.ID(), StartTime: yesterday, AtRestTotal: 24 * 1000}},
+		})
+		require.NoError(t, err)
+
+		conn, err := node.Dialer.DialAddressID(ctx, satellite.Addr(), satellite.Identity.ID)
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		client := gracefulexitpb.NewDRPCPartialExitClient(conn.Raw())
+
+		// more than the allowed percentage of the stored bytes is rejected
+		_, err = client.StartPartialExit(ctx, &gracefulexitpb.StartPartialExitRequest{Bytes: 501})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument), err)
+
+		_, err = client.StartPartialExit(ctx, &gracefulexitpb.StartPartialExitRequest{Bytes: 500})
+		require.NoError(t, err)
+
+		// an unfinished partial exit can't be started again
+		_, err = client.StartPartialExit(ctx, &gracefulexitpb.StartPartialExitRequest{Bytes: 100})
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition), err)
+
+		// a finished partial exit can't be followed by another one within the interval
+		err = satellite.DB.GracefulExit().FinishPartialExit(ctx, node.ID(), 500, time.Now().UTC())
+		require.NoError(t, err)
+
+		_, err = client.StartPartialExit(ctx, &gracefulexitpb.StartPartialExitRequest{Bytes: 100})
+		require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted), err)
+	})
+}
+
+func TestPartialExitDisqualifiedNode(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 1,
+		UplinkCount:      0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		now := time.Now().UTC()
+		require.NoError(t, satellite.DB.GracefulExit().StartPartialExit(ctx, node.ID(), 1000, now))
+		require.NoError(t, satellite.DB.GracefulExit().MarkPartialExitQueued(ctx, node.ID(), 1000, now))
+
+		err := satellite.DB.OverlayCache().DisqualifyNode(ctx, node.ID())
+		require.NoError(t, err)
+
+		conn, err := node.Dialer.DialAddressID(ctx, satellite.Addr(), satellite.Identity.ID)
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		client := pb.NewDRPCSatelliteGracefulExitClient(conn.Raw())
+		processClient, err := client.Process(ctx)
+		require.NoError(t, err)
+
+		// disqualified nodes don't receive transfer orders for a partial exit
+		response, err := processClient.Recv()
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+		require.Nil(t, response)
+
+		partialExit, err := satellite.DB.GracefulExit().GetPartialExit(ctx, node.ID())
+		require.NoError(t, err)
+		require.NotNil(t, partialExit.FinishedAt)
+
+		// the node didn't start a graceful exit
+		exitStatus, err := satellite.Overlay.DB.GetExitStatus(ctx, node.ID())
+		require.NoError(t, err)
+		require.Nil(t, exitStatus.ExitFinishedAt)
+	})
+}
+
 func testTransfers(t *testing.T, objects int, verifier func(t *testing.T, ctx *testcontext.Context, nodeFullIDs map[storj.NodeID]*identity.FullIdentity, satellite *testplanet.SatelliteSystem, processClient exitProcessClient, exitingNode *storagenode.Peer, numPieces int)) {
 	const successThreshold = 4
 	testplanet.Run(t, testplanet.Config{
@@ -25,19 +25,23 @@ type PathCollector struct {
 	db            DB
 	nodeIDMutex   sync.Mutex
 	nodeIDStorage map[storj.NodeID]int64
-	buffer        []TransferQueueItem
-	log           *zap.Logger
-	batchSize     int
+	// nodeIDLimit is the number of bytes after which no more pieces of the
+	// node are queued, for nodes in a partial exit.
+	nodeIDLimit map[storj.NodeID]int64
+	buffer      []TransferQueueItem
+	log         *zap.Logger
+	batchSize   int
 }
 
 // NewPathCollector instantiates a path collector.
 func NewPathCollector(db DB, nodeIDs storj.NodeIDList, log *zap.Logger, batchSize int) *PathCollector {
 	buffer := make([]TransferQueueItem, 0, batchSize)
 	collector := &PathCollector{
-		db:        db,
-		log:       log,
-		buffer:    buffer,
-		batchSize: batchSize,
+		db:          db,
+		nodeIDLimit: make(map[storj.NodeID]int64),
+		log:         log,
+		buffer:      buffer,
+		batchSize:   batchSize,
 	}
 
 	if len(nodeIDs) > 0 {
@@ -50,6 +54,12 @@ func NewPathCollector(db DB, nodeIDs storj.NodeIDList, log *zap.Logger, batchSiz
 	return collector
 }
 
+// LimitBytes stops queueing pieces of the node, once the queued pieces hold at
+// least limit bytes. It must be called before the collector joins the loop.
+func (collector *PathCollector) LimitBytes(nodeID storj.NodeID, limit int64) {
+	collector.nodeIDLimit[nodeID] = limit
+}
+
 // Flush persists the current buffer items to the database.
 func (collector *PathCollector) Flush(ctx context.Context) (err error) {
 	return collector.flush(ctx, 1)
@@ -66,7 +76,11 @@ func (collector *PathCollector) RemoteSegment(ctx context.Context, path metainfo
 
 	numPieces := int32(len(pointer.GetRemote().GetRemotePieces()))
 	for _, piece := range pointer.GetRemote().GetRemotePieces() {
-		if _, ok := collector.nodeIDStorage[piece.NodeId]; !ok {
+		stored, ok := collector.nodeIDStorage[piece.NodeId]
+		if !ok {
+			continue
+		}
+		if limit, ok := collector.nodeIDLimit[piece.NodeId]; ok && stored >= limit {
 			continue
 		}
 		redundancy, err := eestream.NewRedundancyStrategyFromProto(pointer.GetRemote().GetRedundancy())
@@ -1473,6 +1473,10 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
 	}
 
+	if err := endpoint.checkBucketStorageLimits(ctx, keyInfo.ProjectID, streamID.Bucket); err != nil {
+		return nil, err
+	}
+
 	redundancy, err := eestream.NewRedundancyStrategyFromProto(streamID.Redundancy)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
@@ -1693,6 +1697,10 @@ func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.Segment
 		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
 	}
 
+	if err := endpoint.checkBucketStorageLimits(ctx, keyInfo.ProjectID, streamID.Bucket); err != nil {
+		return nil, err
+	}
+
 	inlineUsed := int64(len(req.EncryptedInlineData))
 
 	if err := endpoint.projectUsage.AddProjectStorageUsage(ctx, keyInfo.ProjectID, inlineUsed); err != nil {
@@ -2014,6 +2022,10 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
 	}
 
+	if err := endpoint.checkBucketBandwidthLimits(ctx, keyInfo.ProjectID, streamID.Bucket); err != nil {
+		return nil, err
+	}
+
 	pointer, _, err := endpoint.getPointer(ctx, keyInfo.ProjectID, int64(req.CursorPosition.Index), streamID.Bucket, streamID.EncryptedPath)
 	if err != nil {
 		return nil, err
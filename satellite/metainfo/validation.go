@@ -203,6 +203,53 @@ func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (e
 	return nil
 }
 
+// checkBucketStorageLimits returns an error when the storage or object count limits
+// of the bucket have been exceeded or can not be checked.
+func (endpoint *Endpoint) checkBucketStorageLimits(ctx context.Context, projectID uuid.UUID, bucket []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exceeded, limits, err := endpoint.projectUsage.ExceedsBucketStorageUsage(ctx, projectID, bucket)
+	if err != nil {
+		// the bucket may be over its limits, so the request is refused
+		endpoint.log.Error("retrieving bucket storage totals", zap.Stringer("projectID", projectID), zap.Error(err))
+		mon.Event("bucket_usage_limits_check_failed")
+		return rpcstatus.Error(rpcstatus.Unavailable, "Unable to check Bucket Usage Limit")
+	}
+	if exceeded {
+		endpoint.log.Warn("bucket storage limits exceeded",
+			zap.Stringer("projectID", projectID),
+			zap.Stringer("storageLimit", limits.Storage),
+			zap.Int64("objectLimit", limits.Objects))
+
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Bucket Usage Limit")
+	}
+
+	return nil
+}
+
+// checkBucketBandwidthLimits returns an error when the bandwidth limit of the bucket
+// has been exceeded or can not be checked.
+func (endpoint *Endpoint) checkBucketBandwidthLimits(ctx context.Context, projectID uuid.UUID, bucket []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exceeded, limits, err := endpoint.projectUsage.ExceedsBucketBandwidthUsage(ctx, projectID, bucket)
+	if err != nil {
+		// the bucket may be over its limit, so the request is refused
+		endpoint.log.Error("retrieving bucket bandwidth total", zap.Stringer("projectID", projectID), zap.Error(err))
+		mon.Event("bucket_usage_limits_check_failed")
+		return rpcstatus.Error(rpcstatus.Unavailable, "Unable to check Bucket Usage Limit")
+	}
+	if exceeded {
+		endpoint.log.Warn("bucket bandwidth limit exceeded",
+			zap.Stringer("projectID", projectID),
+			zap.Stringer("bandwidthLimit", limits.Bandwidth))
+
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Bucket Usage Limit")
+	}
+
+	return nil
+}
+
 func (endpoint *Endpoint) validateCommitSegment(ctx context.Context, req *pb.SegmentCommitRequestOld) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -12,8 +12,11 @@ import (
 	"storj.io/common/identity"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/nodestatspb"
+	"storj.io/storj/private/payout"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/payouts"
 )
 
 var (
@@ -27,14 +30,16 @@ type Endpoint struct {
 	log        *zap.Logger
 	overlay    overlay.DB
 	accounting accounting.StoragenodeAccounting
+	payouts    payouts.DB
 }
 
 // NewEndpoint creates new endpoint
-func NewEndpoint(log *zap.Logger, overlay overlay.DB, accounting accounting.StoragenodeAccounting) *Endpoint {
+func NewEndpoint(log *zap.Logger, overlay overlay.DB, accounting accounting.StoragenodeAccounting, payouts payouts.DB) *Endpoint {
 	return &Endpoint{
 		log:        log,
 		overlay:    overlay,
 		accounting: accounting,
+		payouts:    payouts,
 	}
 }
 
@@ -104,6 +109,61 @@ func (e *Endpoint) DailyStorageUsage(ctx context.Context, req *pb.DailyStorageUs
 	}, nil
 }
 
+// PayoutStatements returns payout statements of client node since the requested period sorted in ASC order by period
+func (e *Endpoint) PayoutStatements(ctx context.Context, req *nodestatspb.PayoutStatementsRequest) (_ *nodestatspb.PayoutStatementsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+	node, err := e.overlay.Get(ctx, peer.ID)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.PermissionDenied, err.Error())
+		}
+		e.log.Error("overlay.Get failed", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	statements, err := e.payouts.ListStatements(ctx, node.Id, payout.PeriodStart(req.GetSince()))
+	if err != nil {
+		e.log.Error("payouts.ListStatements failed", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &nodestatspb.PayoutStatementsResponse{
+		Statements: toProtoPayoutStatements(statements),
+	}, nil
+}
+
+// GetSuspension returns the unknown audit reputation and suspension state of client node
+func (e *Endpoint) GetSuspension(ctx context.Context, req *nodestatspb.GetSuspensionRequest) (_ *nodestatspb.GetSuspensionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+	node, err := e.overlay.Get(ctx, peer.ID)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.PermissionDenied, err.Error())
+		}
+		e.log.Error("overlay.Get failed", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &nodestatspb.GetSuspensionResponse{
+		UnknownAuditReputationAlpha: node.Reputation.UnknownAuditReputationAlpha,
+		UnknownAuditReputationBeta:  node.Reputation.UnknownAuditReputationBeta,
+		UnknownAuditReputationScore: calculateReputationScore(
+			node.Reputation.UnknownAuditReputationAlpha,
+			node.Reputation.UnknownAuditReputationBeta),
+		Suspended: node.Reputation.Suspended,
+	}, nil
+}
+
 // toProtoDailyStorageUsage converts StorageNodeUsage to PB DailyStorageUsageResponse_StorageUsage
 func toProtoDailyStorageUsage(usages []accounting.StorageNodeUsage) []*pb.DailyStorageUsageResponse_StorageUsage {
 	var pbUsages []*pb.DailyStorageUsageResponse_StorageUsage
@@ -118,6 +178,38 @@ func toProtoDailyStorageUsage(usages []accounting.StorageNodeUsage) []*pb.DailyS
 	return pbUsages
 }
 
+// toProtoPayoutStatements converts payout statements to PB PayoutStatement
+func toProtoPayoutStatements(statements []payouts.Statement) []*nodestatspb.PayoutStatement {
+	var pbStatements []*nodestatspb.PayoutStatement
+
+	for _, statement := range statements {
+		pbStatements = append(pbStatements, &nodestatspb.PayoutStatement{
+			Period:         statement.Period,
+			CreatedAt:      statement.CreatedAt,
+			NodeAgeMonths:  int32(statement.NodeAgeMonths),
+			UsageAtRest:    statement.UsageAtRest,
+			UsagePut:       statement.UsagePut,
+			UsageGet:       statement.UsageGet,
+			UsagePutRepair: statement.UsagePutRepair,
+			UsageGetRepair: statement.UsageGetRepair,
+			UsageGetAudit:  statement.UsageGetAudit,
+			CompAtRest:     statement.CompAtRest,
+			CompPut:        statement.CompPut,
+			CompGet:        statement.CompGet,
+			CompPutRepair:  statement.CompPutRepair,
+			CompGetRepair:  statement.CompGetRepair,
+			CompGetAudit:   statement.CompGetAudit,
+			HeldPercent:    int32(statement.HeldPercent),
+			Held:           statement.Held,
+			Disposed:       statement.Disposed,
+			Owed:           statement.Owed,
+			GracefulExit:   statement.GracefulExit,
+		})
+	}
+
+	return pbStatements
+}
+
 // calculateReputationScore is helper method to calculate reputation score value
 func calculateReputationScore(alpha, beta float64) float64 {
 	return alpha / (alpha + beta)
@@ -42,4 +42,5 @@ type NodeSelectionConfig struct {
 	AuditReputationLambda       float64 `help:"the forgetting factor used to calculate the audit SNs reputation" default:"0.95"`
 	AuditReputationWeight       float64 `help:"the normalization weight used to calculate the audit SNs reputation" default:"1.0"`
 	AuditReputationDQ           float64 `help:"the reputation cut-off for disqualifying SNs based on audit history" default:"0.6"`
+	AuditReputationSuspension   float64 `help:"the unknown audit reputation cut-off for suspending SNs, 0 disables suspension" default:"0"`
 }
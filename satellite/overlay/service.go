@@ -133,13 +133,18 @@ type NodeCriteria struct {
 type UpdateRequest struct {
 	NodeID       storj.NodeID
 	AuditSuccess bool
+	// AuditUnknown is set when the audit could not be verified, e.g. the
+	// node timed out or returned an unexpected error. It only affects the
+	// unknown audit reputation, which is used for suspension.
+	AuditUnknown bool
 	IsUp         bool
 	// n.b. these are set values from the satellite.
 	// They are part of the UpdateRequest struct in order to be
 	// more easily accessible in satellite/satellitedb/overlaycache.go.
-	AuditLambda float64
-	AuditWeight float64
-	AuditDQ     float64
+	AuditLambda       float64
+	AuditWeight       float64
+	AuditDQ           float64
+	AuditSuspensionDQ float64
 }
 
 // ExitStatus is used for reading graceful exit status.
@@ -187,6 +192,10 @@ type NodeStats struct {
 	AuditReputationAlpha float64
 	AuditReputationBeta  float64
 	Disqualified         *time.Time
+
+	UnknownAuditReputationAlpha float64
+	UnknownAuditReputationBeta  float64
+	Suspended                   *time.Time
 }
 
 // NodeLastContact contains the ID, address, and timestamp
@@ -399,6 +408,7 @@ func (service *Service) BatchUpdateStats(ctx context.Context, requests []*Update
 		request.AuditLambda = service.config.Node.AuditReputationLambda
 		request.AuditWeight = service.config.Node.AuditReputationWeight
 		request.AuditDQ = service.config.Node.AuditReputationDQ
+		request.AuditSuspensionDQ = service.config.Node.AuditReputationSuspension
 	}
 	return service.db.BatchUpdateStats(ctx, requests, service.config.UpdateStatsBatchSize)
 }
@@ -410,6 +420,7 @@ func (service *Service) UpdateStats(ctx context.Context, request *UpdateRequest)
 	request.AuditLambda = service.config.Node.AuditReputationLambda
 	request.AuditWeight = service.config.Node.AuditReputationWeight
 	request.AuditDQ = service.config.Node.AuditReputationDQ
+	request.AuditSuspensionDQ = service.config.Node.AuditReputationSuspension
 
 	return service.db.UpdateStats(ctx, request)
 }
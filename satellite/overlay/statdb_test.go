@@ -156,6 +156,63 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 
 	}
 
+	{ // TestUnknownAuditSuspension
+		nodeID := storj.NodeID{20}
+		err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, overlay.NodeSelectionConfig{
+			AuditReputationAlpha0: 1,
+		})
+		require.NoError(t, err)
+
+		updateReq := &overlay.UpdateRequest{
+			NodeID:       nodeID,
+			AuditUnknown: true,
+			IsUp:         true,
+			AuditLambda:  1, AuditWeight: 1,
+			AuditDQ: 0.6, AuditSuspensionDQ: 0.6,
+		}
+		stats, err := cache.UpdateStats(ctx, updateReq)
+		require.NoError(t, err)
+
+		// the unknown audit doesn't change the audit reputation or count
+		require.EqualValues(t, 1, stats.AuditReputationAlpha)
+		require.EqualValues(t, 0, stats.AuditReputationBeta)
+		require.EqualValues(t, 1, stats.UnknownAuditReputationAlpha)
+		require.EqualValues(t, 1, stats.UnknownAuditReputationBeta)
+		require.EqualValues(t, 0, stats.AuditCount)
+		require.NotNil(t, stats.Suspended)
+		require.Nil(t, stats.Disqualified)
+
+		// successful audits lift the suspension
+		updateReq.AuditUnknown = false
+		updateReq.AuditSuccess = true
+		stats, err = cache.UpdateStats(ctx, updateReq)
+		require.NoError(t, err)
+
+		require.EqualValues(t, 2, stats.UnknownAuditReputationAlpha)
+		require.EqualValues(t, 1, stats.UnknownAuditReputationBeta)
+		require.Nil(t, stats.Suspended)
+	}
+
+	{ // TestUnknownAuditSuspensionDisabled
+		nodeID := storj.NodeID{21}
+		err := cache.UpdateAddress(ctx, &pb.Node{Id: nodeID}, overlay.NodeSelectionConfig{
+			AuditReputationAlpha0: 1,
+		})
+		require.NoError(t, err)
+
+		stats, err := cache.UpdateStats(ctx, &overlay.UpdateRequest{
+			NodeID:       nodeID,
+			AuditUnknown: true,
+			IsUp:         true,
+			AuditLambda:  1, AuditWeight: 1,
+			AuditDQ: 0.6,
+		})
+		require.NoError(t, err)
+
+		require.EqualValues(t, 1, stats.UnknownAuditReputationBeta)
+		require.Nil(t, stats.Suspended)
+	}
+
 	{ // test UpdateCheckIn updates the reputation correctly when the node is offline/online
 		nodeID := storj.NodeID{1}
 
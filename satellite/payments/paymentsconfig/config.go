@@ -14,5 +14,6 @@ type Config struct {
 	StorageTBPrice     string `help:"price user should pay for storing TB per month" default:"10"`
 	EgressTBPrice      string `help:"price user should pay for each TB of egress" default:"45"`
 	ObjectPrice        string `help:"price user should pay for each object stored in network per month" default:"0.0000022"`
+	SegmentPrice       string `help:"price user should pay for each segment stored in network per month, zero disables charging for segments" default:"0"`
 	BonusRate          int64  `help:"amount of percents that user will earn as bonus credits by depositing in STORJ tokens" default:"10"`
 }
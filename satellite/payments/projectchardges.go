@@ -16,4 +16,6 @@ type ProjectCharge struct {
 	Egress int64 `json:"egress"`
 	// ObjectCount shows how many cents we should pay for objects count.
 	ObjectCount int64 `json:"objectCount"`
+	// SegmentCount shows how many cents we should pay for segments count.
+	SegmentCount int64 `json:"segmentCount"`
 }
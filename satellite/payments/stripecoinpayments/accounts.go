@@ -116,12 +116,13 @@ func (accounts *accounts) ProjectCharges(ctx context.Context, userID uuid.UUID)
 			return charges, Error.Wrap(err)
 		}
 
-		projectPrice := accounts.service.calculateProjectUsagePrice(usage.Egress, usage.Storage, usage.ObjectCount)
+		projectPrice := accounts.service.calculateProjectUsagePrice(usage.Egress, usage.Storage, usage.ObjectCount, usage.SegmentCount)
 
 		charges = append(charges, payments.ProjectCharge{
 			ProjectID:    project.ID,
 			Egress:       projectPrice.Egress.IntPart(),
 			ObjectCount:  projectPrice.Objects.IntPart(),
+			SegmentCount: projectPrice.Segments.IntPart(),
 			StorageGbHrs: projectPrice.Storage.IntPart(),
 		})
 	}
@@ -36,6 +36,7 @@ type CreateProjectRecord struct {
 	Storage   float64
 	Egress    int64
 	Objects   float64
+	Segments  float64
 }
 
 // ProjectRecord holds project usage particular for billing period.
@@ -45,6 +46,7 @@ type ProjectRecord struct {
 	Storage     float64
 	Egress      int64
 	Objects     float64
+	Segments    float64
 	PeriodStart time.Time
 	PeriodEnd   time.Time
 }
@@ -37,6 +37,7 @@ func TestProjectRecords(t *testing.T) {
 						Storage:   1,
 						Egress:    2,
 						Objects:   3,
+						Segments:  4,
 					},
 				},
 				[]stripecoinpayments.CouponUsage{},
@@ -89,6 +90,7 @@ func TestProjectRecordsList(t *testing.T) {
 					Storage:   float64(i) + 1,
 					Egress:    int64(i) + 2,
 					Objects:   float64(i) + 3,
+					Segments:  float64(i) + 4,
 				},
 			)
 		}
@@ -124,6 +126,7 @@ func TestProjectRecordsList(t *testing.T) {
 				assert.Equal(t, createRecord.Storage, record.Storage)
 				assert.Equal(t, createRecord.Egress, record.Egress)
 				assert.Equal(t, createRecord.Objects, record.Objects)
+				assert.Equal(t, createRecord.Segments, record.Segments)
 				assert.Equal(t, start, record.PeriodStart.UTC())
 				assert.Equal(t, end, record.PeriodEnd.UTC())
 			}
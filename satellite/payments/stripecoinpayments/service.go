@@ -58,6 +58,8 @@ type Service struct {
 	ByteHourCents   decimal.Decimal
 	EgressByteCents decimal.Decimal
 	ObjectHourCents decimal.Decimal
+	// SegmentHourCents is zero when segments are not charged.
+	SegmentHourCents decimal.Decimal
 	// BonusRate amount of percents
 	BonusRate int64
 
@@ -67,7 +69,7 @@ type Service struct {
 }
 
 // NewService creates a Service instance.
-func NewService(log *zap.Logger, config Config, db DB, projectsDB console.Projects, usageDB accounting.ProjectAccounting, storageTBPrice, egressTBPrice, objectPrice string, bonusRate int64) (*Service, error) {
+func NewService(log *zap.Logger, config Config, db DB, projectsDB console.Projects, usageDB accounting.ProjectAccounting, storageTBPrice, egressTBPrice, objectPrice, segmentPrice string, bonusRate int64) (*Service, error) {
 	backendConfig := &stripe.BackendConfig{
 		LeveledLogger: log.Sugar(),
 	}
@@ -99,17 +101,23 @@ func NewService(log *zap.Logger, config Config, db DB, projectsDB console.Projec
 	if err != nil {
 		return nil, err
 	}
+	segmentMonthDollars, err := decimal.NewFromString(segmentPrice)
+	if err != nil {
+		return nil, err
+	}
 
 	// change the precision from dollars to cents
 	tbMonthCents := tbMonthDollars.Shift(2)
 	egressTBCents := egressTBDollars.Shift(2)
 	objectHourCents := objectMonthDollars.Shift(2)
+	segmentHourCents := segmentMonthDollars.Shift(2)
 
 	// get per hour prices from storage and objects
 	hoursPerMonth := decimal.New(30*24, 0)
 
 	tbHourCents := tbMonthCents.Div(hoursPerMonth)
 	objectHourCents = objectHourCents.Div(hoursPerMonth)
+	segmentHourCents = segmentHourCents.Div(hoursPerMonth)
 
 	// convert tb to bytes for storage and egress
 	byteHourCents := tbHourCents.Div(decimal.New(1000000000000, 0))
@@ -122,10 +130,11 @@ func NewService(log *zap.Logger, config Config, db DB, projectsDB console.Projec
 		usageDB:         usageDB,
 		stripeClient:    stripeClient,
 		coinPayments:    coinPaymentsClient,
-		ByteHourCents:   byteHourCents,
-		EgressByteCents: egressByteCents,
-		ObjectHourCents: objectHourCents,
-		BonusRate:       bonusRate,
+		ByteHourCents:    byteHourCents,
+		EgressByteCents:  egressByteCents,
+		ObjectHourCents:  objectHourCents,
+		SegmentHourCents: segmentHourCents,
+		BonusRate:        bonusRate,
 	}, nil
 }
 
@@ -428,6 +437,7 @@ func (service *Service) createProjectRecords(ctx context.Context, projects []con
 				Storage:   usage.Storage,
 				Egress:    usage.Egress,
 				Objects:   usage.ObjectCount,
+				Segments:  usage.SegmentCount,
 			},
 		)
 
@@ -436,7 +446,7 @@ func (service *Service) createProjectRecords(ctx context.Context, projects []con
 			return err
 		}
 
-		currentUsagePrice := service.calculateProjectUsagePrice(usage.Egress, usage.Storage, usage.ObjectCount).TotalInt64()
+		currentUsagePrice := service.calculateProjectUsagePrice(usage.Egress, usage.Storage, usage.ObjectCount, usage.SegmentCount).TotalInt64()
 
 		amountToChargeFromCoupon := currentUsagePrice
 
@@ -546,7 +556,7 @@ func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName
 		return err
 	}
 
-	projectPrice := service.calculateProjectUsagePrice(record.Egress, record.Storage, record.Objects)
+	projectPrice := service.calculateProjectUsagePrice(record.Egress, record.Storage, record.Objects, record.Segments)
 
 	projectItem := &stripe.InvoiceItemParams{
 		Amount:      stripe.Int64(projectPrice.TotalInt64()),
@@ -744,26 +754,28 @@ func (service *Service) createInvoice(ctx context.Context, cusID string) (err er
 
 // projectUsagePrice represents pricing for project usage.
 type projectUsagePrice struct {
-	Storage decimal.Decimal
-	Egress  decimal.Decimal
-	Objects decimal.Decimal
+	Storage  decimal.Decimal
+	Egress   decimal.Decimal
+	Objects  decimal.Decimal
+	Segments decimal.Decimal
 }
 
 // Total returns project usage price total.
 func (price projectUsagePrice) Total() decimal.Decimal {
-	return price.Storage.Add(price.Egress).Add(price.Objects)
+	return price.Storage.Add(price.Egress).Add(price.Objects).Add(price.Segments)
 }
 
 // Total returns project usage price total.
 func (price projectUsagePrice) TotalInt64() int64 {
-	return price.Storage.Add(price.Egress).Add(price.Objects).IntPart()
+	return price.Total().IntPart()
 }
 
 // calculateProjectUsagePrice calculate project usage price.
-func (service *Service) calculateProjectUsagePrice(egress int64, storage, objects float64) projectUsagePrice {
+func (service *Service) calculateProjectUsagePrice(egress int64, storage, objects, segments float64) projectUsagePrice {
 	return projectUsagePrice{
-		Storage: service.ByteHourCents.Mul(decimal.NewFromFloat(storage)),
-		Egress:  service.EgressByteCents.Mul(decimal.New(egress, 0)),
-		Objects: service.ObjectHourCents.Mul(decimal.NewFromFloat(objects)),
+		Storage:  service.ByteHourCents.Mul(decimal.NewFromFloat(storage)),
+		Egress:   service.EgressByteCents.Mul(decimal.New(egress, 0)),
+		Objects:  service.ObjectHourCents.Mul(decimal.NewFromFloat(objects)),
+		Segments: service.SegmentHourCents.Mul(decimal.NewFromFloat(segments)),
 	}
 }
@@ -0,0 +1,73 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/private/payout"
+)
+
+// Chore generates payout statements of the previous month once its usage has been rolled up.
+//
+// architecture: Chore
+type Chore struct {
+	log     *zap.Logger
+	service *Service
+	config  Config
+	Loop    *sync2.Cycle
+
+	// generated is the last period statements were generated for.
+	generated time.Time
+}
+
+// NewChore creates a new payouts chore.
+func NewChore(log *zap.Logger, service *Service, config Config) *Chore {
+	return &Chore{
+		log:     log,
+		service: service,
+		config:  config,
+		Loop:    sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.RunOnce(ctx, time.Now())
+		if err != nil {
+			chore.log.Error("generating payout statements failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce generates statements of the last month which ended at least the configured delay before now.
+func (chore *Chore) RunOnce(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	period := payout.PeriodStart(now.Add(-chore.config.Delay)).AddDate(0, -1, 0)
+	if !period.After(chore.generated) {
+		return nil
+	}
+
+	if _, err := chore.service.GenerateStatements(ctx, period); err != nil {
+		return err
+	}
+
+	chore.generated = period
+	return nil
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/payouts"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestStatementsDB(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		payoutsDB := db.Payouts()
+
+		nodeID := testrand.NodeID()
+		january := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+		february := january.AddDate(0, 1, 0)
+
+		statement := payouts.Statement{
+			NodeID:        nodeID,
+			Period:        january,
+			CreatedAt:     february.Add(72 * time.Hour),
+			NodeCreatedAt: january.Add(-24 * time.Hour),
+			NodeAgeMonths: 2,
+			Wallet:        "0x1111111111111111111111111111111111111111",
+			UsageAtRest:   1e12,
+			UsageGet:      1000,
+			CompAtRest:    1500,
+			CompGet:       20,
+			HeldPercent:   75,
+			Held:          1140,
+			Owed:          380,
+		}
+
+		_, err := payoutsDB.GetStatement(ctx, nodeID, january)
+		require.True(t, payouts.ErrNotFound.Has(err))
+
+		require.NoError(t, payoutsDB.CreateStatements(ctx, []payouts.Statement{statement}))
+
+		stored, err := payoutsDB.GetStatement(ctx, nodeID, january)
+		require.NoError(t, err)
+		assert.Equal(t, statement.Owed, stored.Owed)
+		assert.Equal(t, statement.Wallet, stored.Wallet)
+		assert.True(t, statement.CreatedAt.Equal(stored.CreatedAt))
+
+		// statements are immutable
+		changed := statement
+		changed.Owed = 0
+		next := statement
+		next.Period = february
+		require.NoError(t, payoutsDB.CreateStatements(ctx, []payouts.Statement{changed, next}))
+
+		stored, err = payoutsDB.GetStatement(ctx, nodeID, january)
+		require.NoError(t, err)
+		assert.Equal(t, statement.Owed, stored.Owed)
+
+		statements, err := payoutsDB.ListStatements(ctx, nodeID, time.Time{})
+		require.NoError(t, err)
+		require.Len(t, statements, 2)
+		assert.True(t, january.Equal(statements[0].Period))
+		assert.True(t, february.Equal(statements[1].Period))
+
+		statements, err = payoutsDB.ListStatements(ctx, nodeID, february)
+		require.NoError(t, err)
+		require.Len(t, statements, 1)
+
+		statements, err = payoutsDB.ListPeriodStatements(ctx, january)
+		require.NoError(t, err)
+		require.Len(t, statements, 1)
+		assert.Equal(t, nodeID, statements[0].NodeID)
+
+		balances, err := payoutsDB.GetHeldBalances(ctx, february)
+		require.NoError(t, err)
+		assert.Equal(t, map[storj.NodeID]int64{nodeID: statement.Held - statement.Disposed}, balances)
+
+		balances, err = payoutsDB.GetHeldBalances(ctx, january)
+		require.NoError(t, err)
+		assert.Empty(t, balances)
+	})
+}
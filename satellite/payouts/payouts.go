@@ -0,0 +1,99 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package payouts calculates monthly payouts of storage nodes.
+package payouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for payouts package.
+	Error = errs.Class("payouts error")
+	// ErrNotFound is returned when a statement does not exist.
+	ErrNotFound = errs.Class("payout statement not found")
+)
+
+// Config contains configurable values for payout calculation.
+type Config struct {
+	Interval time.Duration `help:"how often to check whether payout statements of the previous month have to be generated" releaseDefault:"24h" devDefault:"1m"`
+	Delay    time.Duration `help:"how long after the end of a month to wait before generating its statements, should cover the storage node rollup interval" releaseDefault:"72h" devDefault:"1h"`
+
+	AtRestTBMonthPrice string `help:"payout for storing a TB for a month in dollars" default:"1.50"`
+	GetTBPrice         string `help:"payout for each TB of download egress in dollars" default:"20"`
+	PutTBPrice         string `help:"payout for each TB of upload ingress in dollars" default:"0"`
+	GetRepairTBPrice   string `help:"payout for each TB of repair egress in dollars" default:"10"`
+	PutRepairTBPrice   string `help:"payout for each TB of repair ingress in dollars" default:"0"`
+	GetAuditTBPrice    string `help:"payout for each TB of audit egress in dollars" default:"10"`
+
+	HeldPercents       string `help:"comma separated percentages of the payout held back in each month of node age, nothing is held back after the last listed month" default:"75,75,75,50,50,50,25,25,25"`
+	GracefulExitMinAge int    `help:"minimum node age in months for the held amount to be returned after a successful graceful exit" default:"6"`
+}
+
+// Statement is an immutable monthly payout statement of a storage node.
+//
+// All compensation and held amounts are in micro dollars.
+type Statement struct {
+	NodeID    storj.NodeID
+	Period    time.Time
+	CreatedAt time.Time
+
+	NodeCreatedAt time.Time
+	NodeAgeMonths int
+	Wallet        string
+
+	// UsageAtRest is in byte hours, other usages are in bytes.
+	UsageAtRest    float64
+	UsagePut       int64
+	UsageGet       int64
+	UsagePutRepair int64
+	UsageGetRepair int64
+	UsageGetAudit  int64
+
+	CompAtRest    int64
+	CompPut       int64
+	CompGet       int64
+	CompPutRepair int64
+	CompGetRepair int64
+	CompGetAudit  int64
+
+	HeldPercent int
+	Held        int64
+	// Disposed is the previously held amount returned in this period.
+	Disposed int64
+	// Owed is the amount to be paid out for this period.
+	Owed int64
+
+	GracefulExit bool
+}
+
+// CompTotal returns the total compensation of the period.
+func (statement *Statement) CompTotal() int64 {
+	return statement.CompAtRest + statement.CompPut + statement.CompGet +
+		statement.CompPutRepair + statement.CompGetRepair + statement.CompGetAudit
+}
+
+// DB stores payout statements.
+//
+// architecture: Database
+type DB interface {
+	// CreateStatements stores statements, statements which already exist are left unchanged.
+	CreateStatements(ctx context.Context, statements []Statement) error
+	// GetStatement returns the statement of the node for the period.
+	GetStatement(ctx context.Context, nodeID storj.NodeID, period time.Time) (Statement, error)
+	// ListStatements returns statements of the node since the period, ordered by period.
+	ListStatements(ctx context.Context, nodeID storj.NodeID, since time.Time) ([]Statement, error)
+	// ListPeriodStatements returns statements of all nodes for the period.
+	ListPeriodStatements(ctx context.Context, period time.Time) ([]Statement, error)
+	// GetHeldBalances returns the amounts held and not yet disposed of all nodes by statements before the period.
+	GetHeldBalances(ctx context.Context, before time.Time) (map[storj.NodeID]int64, error)
+}
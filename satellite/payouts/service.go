@@ -0,0 +1,188 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/payout"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/overlay"
+)
+
+// Service calculates and stores payout statements of storage nodes.
+//
+// architecture: Service
+type Service struct {
+	log        *zap.Logger
+	db         DB
+	accounting accounting.StoragenodeAccounting
+	overlay    overlay.DB
+
+	rates              payout.Rates
+	heldPercents       payout.HeldPercents
+	gracefulExitMinAge int
+}
+
+// NewService creates a new payouts service.
+func NewService(log *zap.Logger, db DB, accounting accounting.StoragenodeAccounting, overlay overlay.DB, config Config) (*Service, error) {
+	rates, err := payout.ParseRates(payout.Prices{
+		AtRestTBMonth: config.AtRestTBMonthPrice,
+		Put:           config.PutTBPrice,
+		Get:           config.GetTBPrice,
+		PutRepair:     config.PutRepairTBPrice,
+		GetRepair:     config.GetRepairTBPrice,
+		GetAudit:      config.GetAuditTBPrice,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	heldPercents, err := payout.ParseHeldPercents(config.HeldPercents)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &Service{
+		log:                log,
+		db:                 db,
+		accounting:         accounting,
+		overlay:            overlay,
+		rates:              rates,
+		heldPercents:       heldPercents,
+		gracefulExitMinAge: config.GracefulExitMinAge,
+	}, nil
+}
+
+// GenerateStatements calculates and stores statements of all nodes for the month containing period.
+// Statements which already exist are not recalculated.
+func (service *Service) GenerateStatements(ctx context.Context, period time.Time) (_ []Statement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	start := payout.PeriodStart(period)
+	end := start.AddDate(0, 1, 0)
+
+	rows, err := service.accounting.QueryPaymentInfo(ctx, start, end)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	exited, err := service.successfullyExited(ctx, start, end)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	heldBalances, err := service.db.GetHeldBalances(ctx, start)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	now := time.Now().UTC()
+	statements := make([]Statement, 0, len(rows))
+	for _, row := range rows {
+		if row.Disqualified != nil && row.Disqualified.Before(start) {
+			continue
+		}
+
+		statement := service.calculate(row, start, heldBalances[row.NodeID], exited[row.NodeID])
+		statement.CreatedAt = now
+		statements = append(statements, statement)
+	}
+
+	if err := service.db.CreateStatements(ctx, statements); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// statements which already existed were left unchanged, so the stored ones are returned
+	stored, err := service.db.ListPeriodStatements(ctx, start)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	service.log.Info("payout statements generated",
+		zap.Time("period", start),
+		zap.Int("statements", len(stored)))
+
+	return stored, nil
+}
+
+// GetStatements returns statements of the node since the period.
+func (service *Service) GetStatements(ctx context.Context, nodeID storj.NodeID, since time.Time) (_ []Statement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	statements, err := service.db.ListStatements(ctx, nodeID, payout.PeriodStart(since))
+	return statements, Error.Wrap(err)
+}
+
+// calculate calculates the statement of a node for the period from its usage and the amount held
+// and not yet disposed by its previous statements.
+func (service *Service) calculate(row *accounting.CSVRow, period time.Time, heldBalance int64, gracefulExit bool) Statement {
+	statement := Statement{
+		NodeID:         row.NodeID,
+		Period:         period,
+		NodeCreatedAt:  row.NodeCreationDate,
+		NodeAgeMonths:  payout.NodeAgeMonths(row.NodeCreationDate, period),
+		Wallet:         row.Wallet,
+		UsageAtRest:    row.AtRestTotal,
+		UsagePut:       row.PutTotal,
+		UsageGet:       row.GetTotal,
+		UsagePutRepair: row.PutRepairTotal,
+		UsageGetRepair: row.GetRepairTotal,
+		UsageGetAudit:  row.GetAuditTotal,
+	}
+
+	compensation := service.rates.Compensation(payout.Usage{
+		AtRest:    row.AtRestTotal,
+		Put:       row.PutTotal,
+		Get:       row.GetTotal,
+		PutRepair: row.PutRepairTotal,
+		GetRepair: row.GetRepairTotal,
+		GetAudit:  row.GetAuditTotal,
+	})
+	statement.CompAtRest = compensation.AtRest
+	statement.CompPut = compensation.Put
+	statement.CompGet = compensation.Get
+	statement.CompPutRepair = compensation.PutRepair
+	statement.CompGetRepair = compensation.GetRepair
+	statement.CompGetAudit = compensation.GetAudit
+
+	total := compensation.Total()
+
+	if gracefulExit && statement.NodeAgeMonths >= service.gracefulExitMinAge {
+		statement.GracefulExit = true
+		statement.Disposed = heldBalance
+	} else {
+		statement.HeldPercent = service.heldPercents.Percent(statement.NodeAgeMonths)
+		statement.Held = payout.Held(total, statement.HeldPercent)
+	}
+
+	statement.Owed = total - statement.Held + statement.Disposed
+	return statement
+}
+
+// successfullyExited returns nodes which successfully finished graceful exit between start and end.
+func (service *Service) successfullyExited(ctx context.Context, start, end time.Time) (_ map[storj.NodeID]bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeIDs, err := service.overlay.GetGracefulExitCompletedByTimeFrame(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	exited := make(map[storj.NodeID]bool, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		status, err := service.overlay.GetExitStatus(ctx, nodeID)
+		if err != nil {
+			return nil, err
+		}
+		if status.ExitSuccess {
+			exited[nodeID] = true
+		}
+	}
+	return exited, nil
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/payout"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/accounting"
+)
+
+func TestGenerateStatements(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Payouts.Chore.Loop.Pause()
+
+		period := payout.PeriodStart(time.Now()).AddDate(0, -1, 0)
+		day := period.AddDate(0, 0, 1)
+
+		node := planet.StorageNodes[0]
+		rollups := accounting.RollupStats{
+			day: map[storj.NodeID]*accounting.Rollup{
+				node.ID(): {
+					NodeID:         node.ID(),
+					StartTime:      day,
+					GetTotal:       1e12,
+					GetAuditTotal:  1e9,
+					GetRepairTotal: 1e10,
+					AtRestTotal:    720 * 1e12,
+				},
+			},
+		}
+		require.NoError(t, satellite.DB.StoragenodeAccounting().SaveRollup(ctx, day, rollups))
+
+		statements, err := satellite.Payouts.Service.GenerateStatements(ctx, period)
+		require.NoError(t, err)
+		require.Len(t, statements, 1)
+
+		statement := statements[0]
+		assert.Equal(t, node.ID(), statement.NodeID)
+		assert.Equal(t, int64(1500000), statement.CompAtRest)
+		assert.Equal(t, int64(20000000), statement.CompGet)
+		assert.Equal(t, int64(100000), statement.CompGetRepair)
+		assert.Equal(t, int64(10000), statement.CompGetAudit)
+		assert.Equal(t, statement.CompTotal()-statement.Held, statement.Owed)
+
+		// storage node fetches its statements
+		received, err := node.NodeStats.Service.GetPayoutStatements(ctx, satellite.ID(), time.Time{})
+		require.NoError(t, err)
+		require.Len(t, received, 1)
+		assert.Equal(t, satellite.ID(), received[0].SatelliteID)
+		assert.True(t, period.Equal(received[0].Period))
+		assert.Equal(t, statement.Owed, received[0].Owed)
+		assert.Equal(t, statement.Held, received[0].Held)
+		assert.Equal(t, statement.HeldPercent, received[0].HeldPercent)
+
+		// other nodes don't have statements
+		received, err = planet.StorageNodes[1].NodeStats.Service.GetPayoutStatements(ctx, satellite.ID(), time.Time{})
+		require.NoError(t, err)
+		require.Len(t, received, 0)
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/accounting"
+)
+
+func TestCalculate(t *testing.T) {
+	service, err := NewService(zaptest.NewLogger(t), nil, nil, nil, Config{
+		AtRestTBMonthPrice: "1.44",
+		GetTBPrice:         "20",
+		PutTBPrice:         "0",
+		GetRepairTBPrice:   "10",
+		PutRepairTBPrice:   "0",
+		GetAuditTBPrice:    "10",
+		HeldPercents:       "75, 50, 25",
+		GracefulExitMinAge: 2,
+	})
+	require.NoError(t, err)
+
+	period := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+	row := &accounting.CSVRow{
+		NodeID:           testrand.NodeID(),
+		NodeCreationDate: time.Date(2020, time.February, 20, 0, 0, 0, 0, time.UTC),
+		AtRestTotal:      720 * 1e12,
+		GetTotal:         1e12,
+		GetRepairTotal:   1e11,
+		GetAuditTotal:    1e10,
+		PutTotal:         1e12,
+	}
+
+	statement := service.calculate(row, period, 0, false)
+	assert.Equal(t, 2, statement.NodeAgeMonths)
+	assert.Equal(t, int64(1440000), statement.CompAtRest)
+	assert.Equal(t, int64(20000000), statement.CompGet)
+	assert.Equal(t, int64(1000000), statement.CompGetRepair)
+	assert.Equal(t, int64(100000), statement.CompGetAudit)
+	assert.Equal(t, int64(0), statement.CompPut)
+	assert.Equal(t, 50, statement.HeldPercent)
+	assert.Equal(t, int64(11270000), statement.Held)
+	assert.Equal(t, int64(11270000), statement.Owed)
+	assert.False(t, statement.GracefulExit)
+
+	// nothing is held after the configured months
+	statement = service.calculate(row, period.AddDate(0, 2, 0), 0, false)
+	assert.Equal(t, 4, statement.NodeAgeMonths)
+	assert.Equal(t, 0, statement.HeldPercent)
+	assert.Equal(t, statement.CompTotal(), statement.Owed)
+
+	// successful graceful exit returns everything held in previous periods
+	statement = service.calculate(row, period.AddDate(0, 1, 0), 400, true)
+	assert.True(t, statement.GracefulExit)
+	assert.Equal(t, int64(0), statement.Held)
+	assert.Equal(t, int64(400), statement.Disposed)
+	assert.Equal(t, statement.CompTotal()+400, statement.Owed)
+
+	// nodes which are too young don't get the held amount back
+	statement = service.calculate(row, period.AddDate(0, -1, 0), 0, true)
+	assert.False(t, statement.GracefulExit)
+	assert.Equal(t, int64(0), statement.Disposed)
+	assert.Equal(t, 75, statement.HeldPercent)
+}
@@ -17,6 +17,7 @@ import (
 	"storj.io/storj/satellite/accounting/reportedrollup"
 	"storj.io/storj/satellite/accounting/rollup"
 	"storj.io/storj/satellite/accounting/tally"
+	"storj.io/storj/satellite/accounting/usageexport"
 	"storj.io/storj/satellite/admin"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
@@ -35,8 +36,10 @@ import (
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/payouts"
 	"storj.io/storj/satellite/referrals"
 	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/corruption"
 	"storj.io/storj/satellite/repair/irreparable"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/repair/repairer"
@@ -91,6 +94,10 @@ type DB interface {
 	StripeCoinPayments() stripecoinpayments.DB
 	// DowntimeTracking returns database for downtime tracking
 	DowntimeTracking() downtime.DB
+	// Payouts returns database for storage node payout statements
+	Payouts() payouts.DB
+	// CorruptPieces returns database for pieces reported as corrupted by storage nodes
+	CorruptPieces() corruption.DB
 }
 
 // Config is the global config satellite
@@ -107,9 +114,10 @@ type Config struct {
 	Metainfo metainfo.Config
 	Orders   orders.Config
 
-	Checker  checker.Config
-	Repairer repairer.Config
-	Audit    audit.Config
+	Checker    checker.Config
+	Repairer   repairer.Config
+	Corruption corruption.Config
+	Audit      audit.Config
 
 	GarbageCollection gc.Config
 
@@ -119,10 +127,12 @@ type Config struct {
 	Rollup         rollup.Config
 	LiveAccounting live.Config
 	ReportedRollup reportedrollup.Config
+	UsageExport    usageexport.Config
 
 	Mail mailservice.Config
 
 	Payments paymentsconfig.Config
+	Payouts  payouts.Config
 
 	Referrals referrals.Config
 
@@ -0,0 +1,214 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package corruption
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/overlay"
+)
+
+// Chore removes the reported corrupted pieces from their segments, so that the
+// repair checker queues the segments which fall below the repair threshold.
+// The lost pieces count against the reputation of the nodes which reported them.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	db       DB
+	overlay  *overlay.Service
+	metainfo *metainfo.Service
+	loop     *metainfo.Loop
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new corrupted pieces chore.
+func NewChore(log *zap.Logger, config Config, db DB, overlay *overlay.Service, metainfo *metainfo.Service, loop *metainfo.Loop) *Chore {
+	return &Chore{
+		log:      log,
+		config:   config,
+		db:       db,
+		overlay:  overlay,
+		metainfo: metainfo,
+		loop:     loop,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.RunOnce(ctx)
+		if err != nil {
+			chore.log.Error("removing corrupted pieces failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce removes the reported corrupted pieces from their segments. Reports of
+// pieces which aren't referenced by any segment are dropped. Each node whose
+// pieces were removed is penalized like for a failed audit.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	expired, err := chore.db.DeleteExpired(ctx, time.Now().Add(-chore.config.ReportTTL))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if expired > 0 {
+		chore.log.Debug("deleted expired corrupted piece reports", zap.Int64("Count", expired))
+	}
+
+	reports, err := chore.db.List(ctx, chore.config.MaxReports)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(reports) == 0 {
+		return nil
+	}
+
+	observer := newObserver(reports)
+	if err := chore.loop.Join(ctx, observer); err != nil {
+		return Error.Wrap(err)
+	}
+
+	var removed int
+	removedByNode := map[storj.NodeID]int{}
+	for path, segment := range observer.segments {
+		_, err := chore.metainfo.UpdatePieces(ctx, path, segment.pointer, nil, segment.pieces)
+		if err != nil {
+			if storj.ErrObjectNotFound.Has(err) {
+				continue
+			}
+			// the reports are kept, so removing the pieces is retried later
+			chore.log.Warn("unable to remove corrupted pieces from segment", zap.Error(err))
+			for _, piece := range segment.pieces {
+				delete(observer.reported[piece.NodeId], segment.pieceIDs[piece.PieceNum])
+			}
+			continue
+		}
+		removed += len(segment.pieces)
+		for _, piece := range segment.pieces {
+			removedByNode[piece.NodeId]++
+		}
+	}
+
+	for _, report := range reports {
+		if _, ok := observer.reported[report.NodeID][report.PieceID]; !ok {
+			continue
+		}
+		if err := chore.db.MarkProcessed(ctx, report.NodeID, report.PieceID); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	// reporting lost pieces is penalized less than failing their audits, the
+	// node fails a single audit for each run which removed any of its pieces
+	for nodeID, count := range removedByNode {
+		_, err := chore.overlay.UpdateStats(ctx, &overlay.UpdateRequest{
+			NodeID:       nodeID,
+			AuditSuccess: false,
+			IsUp:         true,
+		})
+		if err != nil {
+			chore.log.Error("unable to record lost pieces against the node reputation",
+				zap.Stringer("Node ID", nodeID), zap.Int("Removed", count), zap.Error(err))
+		}
+	}
+
+	chore.log.Info("removed corrupted pieces from segments",
+		zap.Int("Reported", len(reports)),
+		zap.Int("Removed", removed))
+	mon.IntVal("corrupt_pieces_removed").Observe(int64(removed))
+	return nil
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+var _ metainfo.Observer = (*observer)(nil)
+
+// observer finds the segments which contain reported corrupted pieces.
+//
+// architecture: Observer
+type observer struct {
+	reported map[storj.NodeID]map[storj.PieceID]struct{}
+	segments map[string]*corruptSegment
+}
+
+// corruptSegment is a segment containing reported corrupted pieces.
+type corruptSegment struct {
+	pointer  *pb.Pointer
+	pieces   []*pb.RemotePiece
+	pieceIDs map[int32]storj.PieceID
+}
+
+func newObserver(reports []Report) *observer {
+	reported := map[storj.NodeID]map[storj.PieceID]struct{}{}
+	for _, report := range reports {
+		if reported[report.NodeID] == nil {
+			reported[report.NodeID] = map[storj.PieceID]struct{}{}
+		}
+		reported[report.NodeID][report.PieceID] = struct{}{}
+	}
+	return &observer{
+		reported: reported,
+		segments: map[string]*corruptSegment{},
+	}
+}
+
+// RemoteSegment collects the reported pieces of the segment.
+func (observer *observer) RemoteSegment(ctx context.Context, path metainfo.ScopedPath, pointer *pb.Pointer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	remote := pointer.GetRemote()
+	for _, piece := range remote.GetRemotePieces() {
+		nodeReports, ok := observer.reported[piece.NodeId]
+		if !ok {
+			continue
+		}
+		pieceID := remote.RootPieceId.Derive(piece.NodeId, piece.PieceNum)
+		if _, ok := nodeReports[pieceID]; !ok {
+			continue
+		}
+
+		segment, ok := observer.segments[path.Raw]
+		if !ok {
+			segment = &corruptSegment{
+				pointer:  pointer,
+				pieceIDs: map[int32]storj.PieceID{},
+			}
+			observer.segments[path.Raw] = segment
+		}
+		segment.pieces = append(segment.pieces, piece)
+		segment.pieceIDs[piece.PieceNum] = pieceID
+	}
+	return nil
+}
+
+// Object returns nil because objects don't contain pieces.
+func (observer *observer) Object(ctx context.Context, path metainfo.ScopedPath, pointer *pb.Pointer) (err error) {
+	return nil
+}
+
+// InlineSegment returns nil because inline segments don't contain pieces.
+func (observer *observer) InlineSegment(ctx context.Context, path metainfo.ScopedPath, pointer *pb.Pointer) (err error) {
+	return nil
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package corruption_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/corruptionpb"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/storage"
+	"storj.io/storj/storagenode"
+)
+
+func TestReportedPiecesAreRemoved(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Audit.Worker.Loop.Pause()
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+		satellite.Repair.Corruption.Loop.Pause()
+
+		err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+
+		// find a remote segment and corrupt one of its pieces
+		listResponse, _, err := satellite.Metainfo.Service.List(ctx, "", "", true, 0, 0)
+		require.NoError(t, err)
+		var path string
+		var pointer *pb.Pointer
+		for _, item := range listResponse {
+			pointer, err = satellite.Metainfo.Service.Get(ctx, item.GetPath())
+			require.NoError(t, err)
+			if pointer.GetType() == pb.Pointer_REMOTE {
+				path = item.GetPath()
+				break
+			}
+		}
+		require.NotEmpty(t, path)
+
+		corruptedPiece := pointer.GetRemote().GetRemotePieces()[0]
+		var corruptedNode *storagenode.Peer
+		for _, node := range planet.StorageNodes {
+			if node.ID() == corruptedPiece.NodeId {
+				corruptedNode = node
+			}
+		}
+		require.NotNil(t, corruptedNode)
+		pieceID := pointer.GetRemote().RootPieceId.Derive(corruptedPiece.NodeId, corruptedPiece.PieceNum)
+
+		blobInfo, err := corruptedNode.Storage2.BlobsCache.Stat(ctx, storage.BlobRef{
+			Namespace: satellite.ID().Bytes(),
+			Key:       pieceID.Bytes(),
+		})
+		require.NoError(t, err)
+		blobPath, err := blobInfo.FullPath(ctx)
+		require.NoError(t, err)
+		content, err := ioutil.ReadFile(blobPath)
+		require.NoError(t, err)
+		content[len(content)-1]++
+		require.NoError(t, ioutil.WriteFile(blobPath, content, 0600))
+
+		// the node quarantines the piece and reports it
+		require.NoError(t, corruptedNode.Scrubber.Scrub(ctx))
+		status, err := corruptedNode.Scrubber.Status(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, status.Last.Corrupted)
+		require.Zero(t, status.PendingReports)
+
+		reports, err := satellite.DB.CorruptPieces().List(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		require.Equal(t, corruptedPiece.NodeId, reports[0].NodeID)
+		require.Equal(t, pieceID, reports[0].PieceID)
+
+		before, err := satellite.Overlay.Service.Get(ctx, corruptedNode.ID())
+		require.NoError(t, err)
+
+		// the satellite removes the piece from the segment
+		satellite.Repair.Corruption.Loop.TriggerWait()
+
+		pointer, err = satellite.Metainfo.Service.Get(ctx, path)
+		require.NoError(t, err)
+		for _, piece := range pointer.GetRemote().GetRemotePieces() {
+			require.NotEqual(t, corruptedPiece.NodeId, piece.NodeId)
+		}
+
+		reports, err = satellite.DB.CorruptPieces().List(ctx, 10)
+		require.NoError(t, err)
+		require.Empty(t, reports)
+
+		// the lost piece counts as a failed audit
+		after, err := satellite.Overlay.Service.Get(ctx, corruptedNode.ID())
+		require.NoError(t, err)
+		require.Equal(t, before.Reputation.AuditCount+1, after.Reputation.AuditCount)
+		require.Equal(t, before.Reputation.AuditSuccessCount, after.Reputation.AuditSuccessCount)
+	})
+}
+
+func TestReportLimit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Corruption.MaxNodeReports = 2
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		conn, err := node.Dialer.DialAddressID(ctx, satellite.Addr(), satellite.Identity.ID)
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+		client := corruptionpb.NewDRPCCorruptionClient(conn.Raw())
+
+		report := func(count int) error {
+			request := &corruptionpb.ReportCorruptPiecesRequest{}
+			for i := 0; i < count; i++ {
+				request.PieceIds = append(request.PieceIds, testrand.PieceID().Bytes())
+			}
+			_, err := client.ReportCorruptPieces(ctx, request)
+			return err
+		}
+
+		require.NoError(t, report(2))
+		err = report(1)
+		require.Error(t, err)
+		require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+
+		// processed reports still count towards the limit
+		reports, err := satellite.DB.CorruptPieces().List(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, reports, 2)
+		satellite.Repair.Corruption.Loop.TriggerWait()
+
+		err = report(1)
+		require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+	})
+}
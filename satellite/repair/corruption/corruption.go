@@ -0,0 +1,58 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package corruption handles the pieces which storage nodes found corrupted
+// and removed, so that their segments are repaired before they're audited.
+package corruption
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+var (
+	// Error is the default error class for corrupted piece handling.
+	Error = errs.Class("corruption error")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for handling corrupted piece reports.
+type Config struct {
+	Interval         time.Duration `help:"how frequently reported corrupted pieces are removed from their segments" default:"1h0m0s"`
+	MaxReports       int           `help:"maximum number of reported pieces which are removed in one metainfo loop" default:"100000"`
+	MaxNodeReports   int           `help:"maximum number of corrupted pieces a node may report in the node report period" default:"10000"`
+	NodeReportPeriod time.Duration `help:"period over which the corrupted pieces reported by a node are limited" default:"24h0m0s"`
+	ReportTTL        time.Duration `help:"how long reports of corrupted pieces are kept" default:"720h0m0s"`
+}
+
+// Report is a piece which a storage node reported as corrupted.
+type Report struct {
+	NodeID     storj.NodeID
+	PieceID    storj.PieceID
+	ReportedAt time.Time
+}
+
+// DB stores the pieces which storage nodes reported as corrupted. Reports are
+// kept after they're processed until they expire, to limit the number of
+// reports of each node.
+//
+// architecture: Database
+type DB interface {
+	// Add adds reports of corrupted pieces of the node, ignoring pieces which
+	// were already reported.
+	Add(ctx context.Context, nodeID storj.NodeID, pieceIDs []storj.PieceID) error
+	// Count returns the number of pieces the node reported since the given time.
+	Count(ctx context.Context, nodeID storj.NodeID, since time.Time) (int, error)
+	// List returns up to limit of the oldest reports which weren't processed.
+	List(ctx context.Context, limit int) ([]Report, error)
+	// MarkProcessed marks the report of the piece as processed.
+	MarkProcessed(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) error
+	// DeleteExpired deletes the reports made before the given time.
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
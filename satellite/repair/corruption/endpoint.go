@@ -0,0 +1,90 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package corruption
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/private/corruptionpb"
+	"storj.io/storj/satellite/overlay"
+)
+
+// maxReportedPieces is the maximum number of pieces a node may report in a single request.
+const maxReportedPieces = 1000
+
+// Endpoint receives reports of corrupted pieces from storage nodes.
+//
+// architecture: Endpoint
+type Endpoint struct {
+	log     *zap.Logger
+	config  Config
+	overlay overlay.DB
+	db      DB
+}
+
+// NewEndpoint creates a new corrupted pieces endpoint.
+func NewEndpoint(log *zap.Logger, config Config, overlay overlay.DB, db DB) *Endpoint {
+	return &Endpoint{
+		log:     log,
+		config:  config,
+		overlay: overlay,
+		db:      db,
+	}
+}
+
+// ReportCorruptPieces stores the corrupted pieces reported by the node.
+func (endpoint *Endpoint) ReportCorruptPieces(ctx context.Context, req *corruptionpb.ReportCorruptPiecesRequest) (_ *corruptionpb.ReportCorruptPiecesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+	if _, err := endpoint.overlay.Get(ctx, peer.ID); err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.PermissionDenied, err.Error())
+		}
+		endpoint.log.Error("overlay.Get failed", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	if len(req.PieceIds) > maxReportedPieces {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "too many pieces: %d > %d", len(req.PieceIds), maxReportedPieces)
+	}
+
+	pieceIDs := make([]storj.PieceID, 0, len(req.PieceIds))
+	for _, id := range req.PieceIds {
+		pieceID, err := storj.PieceIDFromBytes(id)
+		if err != nil {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		pieceIDs = append(pieceIDs, pieceID)
+	}
+
+	// a node can't make the satellite search for an unlimited number of pieces
+	reported, err := endpoint.db.Count(ctx, peer.ID, time.Now().Add(-endpoint.config.NodeReportPeriod))
+	if err != nil {
+		endpoint.log.Error("counting corrupted piece reports failed", zap.Stringer("Node ID", peer.ID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if reported+len(pieceIDs) > endpoint.config.MaxNodeReports {
+		mon.Event("corrupt_pieces_report_limited")
+		return nil, rpcstatus.Errorf(rpcstatus.ResourceExhausted, "too many reported pieces: %d reported in the last %s, the limit is %d",
+			reported, endpoint.config.NodeReportPeriod, endpoint.config.MaxNodeReports)
+	}
+
+	if err := endpoint.db.Add(ctx, peer.ID, pieceIDs); err != nil {
+		endpoint.log.Error("storing corrupted piece reports failed", zap.Stringer("Node ID", peer.ID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	mon.IntVal("corrupt_pieces_reported").Observe(int64(len(pieceIDs)))
+
+	return &corruptionpb.ReportCorruptPiecesResponse{}, nil
+}
@@ -108,6 +108,13 @@ func (db *bucketsDB) DeleteBucket(ctx context.Context, bucketName []byte, projec
 	if err != nil {
 		return storj.ErrBucket.Wrap(err)
 	}
+	_, err = db.db.Delete_BucketUsageLimit_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketUsageLimit_ProjectId(projectID[:]),
+		dbx.BucketUsageLimit_BucketName(bucketName),
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
 	return nil
 }
 
@@ -0,0 +1,110 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/repair/corruption"
+)
+
+// ensures that *corruptPiecesDB implements corruption.DB.
+var _ corruption.DB = (*corruptPiecesDB)(nil)
+
+// corruptPiecesDB stores pieces which storage nodes reported as corrupted.
+type corruptPiecesDB struct {
+	db *satelliteDB
+}
+
+// Add adds reports of corrupted pieces of the node, ignoring pieces which were already reported.
+func (db *corruptPiecesDB) Add(ctx context.Context, nodeID storj.NodeID, pieceIDs []storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(pieceIDs) == 0 {
+		return nil
+	}
+
+	ids := make([][]byte, 0, len(pieceIDs))
+	for _, pieceID := range pieceIDs {
+		ids = append(ids, pieceID.Bytes())
+	}
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		INSERT INTO corrupt_pieces (node_id, piece_id, reported_at)
+			SELECT $1, unnest($2::bytea[]), $3
+		ON CONFLICT DO NOTHING
+	`), nodeID.Bytes(), pq.ByteaArray(ids), time.Now().UTC())
+	return Error.Wrap(err)
+}
+
+// Count returns the number of pieces the node reported since the given time.
+func (db *corruptPiecesDB) Count(ctx context.Context, nodeID storj.NodeID, since time.Time) (count int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`
+		SELECT COUNT(*)
+			FROM corrupt_pieces
+			WHERE node_id = ? AND reported_at >= ?
+	`), nodeID.Bytes(), since.UTC()).Scan(&count)
+	return count, Error.Wrap(err)
+}
+
+// List returns up to limit of the oldest reports which weren't processed.
+func (db *corruptPiecesDB) List(ctx context.Context, limit int) (_ []corruption.Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT node_id, piece_id, reported_at
+			FROM corrupt_pieces
+			WHERE processed_at IS NULL
+			ORDER BY reported_at
+			LIMIT ?
+	`), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var reports []corruption.Report
+	for rows.Next() {
+		var report corruption.Report
+		if err := rows.Scan(&report.NodeID, &report.PieceID, &report.ReportedAt); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		reports = append(reports, report)
+	}
+	return reports, Error.Wrap(rows.Err())
+}
+
+// MarkProcessed marks the report of the piece as processed.
+func (db *corruptPiecesDB) MarkProcessed(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		UPDATE corrupt_pieces
+			SET processed_at = ?
+			WHERE node_id = ? AND piece_id = ?
+	`), time.Now().UTC(), nodeID.Bytes(), pieceID.Bytes())
+	return Error.Wrap(err)
+}
+
+// DeleteExpired deletes the reports made before the given time.
+func (db *corruptPiecesDB) DeleteExpired(ctx context.Context, before time.Time) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, db.db.Rebind(`
+		DELETE FROM corrupt_pieces
+			WHERE reported_at < ?
+	`), before.UTC())
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	deleted, err = result.RowsAffected()
+	return deleted, Error.Wrap(err)
+}
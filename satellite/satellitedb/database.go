@@ -23,6 +23,8 @@ import (
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/payouts"
+	"storj.io/storj/satellite/repair/corruption"
 	"storj.io/storj/satellite/repair/irreparable"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/rewards"
@@ -191,3 +193,13 @@ func (db *satelliteDB) StripeCoinPayments() stripecoinpayments.DB {
 func (db *satelliteDB) DowntimeTracking() downtime.DB {
 	return &downtimeTrackingDB{db: db}
 }
+
+// Payouts returns database for storage node payout statements
+func (db *satelliteDB) Payouts() payouts.DB {
+	return &payoutsDB{db: db}
+}
+
+// CorruptPieces returns database for pieces reported as corrupted by storage nodes
+func (db *satelliteDB) CorruptPieces() corruption.DB {
+	return &corruptPiecesDB{db: db}
+}
@@ -300,6 +300,20 @@ CREATE TABLE bucket_bandwidth_rollups (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_count_rollups (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	interval_start timestamp NOT NULL,
+	object_count bigint NOT NULL,
+	inline_segments_count bigint NOT NULL,
+	remote_segments_count bigint NOT NULL,
+	object_hours double precision NOT NULL,
+	inline_segment_hours double precision NOT NULL,
+	remote_segment_hours double precision NOT NULL,
+	inline_byte_hours double precision NOT NULL,
+	remote_byte_hours double precision NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, interval_start )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -312,6 +326,16 @@ CREATE TABLE bucket_storage_tallies (
 	metadata_size bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start )
 );
+CREATE TABLE bucket_usage_limits (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	storage_limit bigint NOT NULL,
+	bandwidth_limit bigint NOT NULL,
+	object_limit bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE coinpayments_transactions (
 	id text NOT NULL,
 	user_id bytea NOT NULL,
@@ -324,6 +348,13 @@ CREATE TABLE coinpayments_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE corrupt_pieces (
+	node_id bytea NOT NULL,
+	piece_id bytea NOT NULL,
+	reported_at timestamp with time zone NOT NULL,
+	processed_at timestamp with time zone,
+	PRIMARY KEY ( node_id, piece_id )
+);
 CREATE TABLE coupons (
 	id bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -359,6 +390,12 @@ CREATE TABLE credits_spendings (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE graceful_exit_pauses (
+	node_id bytea NOT NULL,
+	paused_at timestamp with time zone,
+	paused_duration bigint NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -432,6 +469,9 @@ CREATE TABLE nodes (
 	exit_loop_completed_at timestamp,
 	exit_finished_at timestamp,
 	exit_success boolean NOT NULL,
+	unknown_audit_reputation_alpha double precision NOT NULL,
+	unknown_audit_reputation_beta double precision NOT NULL,
+	suspended timestamp with time zone,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE nodes_offline_times (
@@ -455,6 +495,42 @@ CREATE TABLE offers (
 	type integer NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE partial_exits (
+	node_id bytea NOT NULL,
+	requested_bytes bigint NOT NULL,
+	queued_bytes bigint NOT NULL,
+	transferred_bytes bigint NOT NULL,
+	requested_at timestamp with time zone NOT NULL,
+	queued_at timestamp with time zone,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE payout_statements (
+	node_id bytea NOT NULL,
+	period timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	node_created_at timestamp with time zone NOT NULL,
+	node_age_months integer NOT NULL,
+	wallet text NOT NULL,
+	usage_at_rest double precision NOT NULL,
+	usage_put bigint NOT NULL,
+	usage_get bigint NOT NULL,
+	usage_put_repair bigint NOT NULL,
+	usage_get_repair bigint NOT NULL,
+	usage_get_audit bigint NOT NULL,
+	comp_at_rest bigint NOT NULL,
+	comp_put bigint NOT NULL,
+	comp_get bigint NOT NULL,
+	comp_put_repair bigint NOT NULL,
+	comp_get_repair bigint NOT NULL,
+	comp_get_audit bigint NOT NULL,
+	held_percent integer NOT NULL,
+	held bigint NOT NULL,
+	disposed bigint NOT NULL,
+	owed bigint NOT NULL,
+	graceful_exit boolean NOT NULL,
+	PRIMARY KEY ( node_id, period )
+);
 CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -544,6 +620,7 @@ CREATE TABLE stripecoinpayments_invoice_project_records (
 	storage double precision NOT NULL,
 	egress bigint NOT NULL,
 	objects bigint NOT NULL,
+	segments bigint NOT NULL,
 	period_start timestamp with time zone NOT NULL,
 	period_end timestamp with time zone NOT NULL,
 	state integer NOT NULL,
@@ -743,6 +820,20 @@ CREATE TABLE bucket_bandwidth_rollups (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_count_rollups (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	interval_start timestamp NOT NULL,
+	object_count bigint NOT NULL,
+	inline_segments_count bigint NOT NULL,
+	remote_segments_count bigint NOT NULL,
+	object_hours double precision NOT NULL,
+	inline_segment_hours double precision NOT NULL,
+	remote_segment_hours double precision NOT NULL,
+	inline_byte_hours double precision NOT NULL,
+	remote_byte_hours double precision NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, interval_start )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -755,6 +846,16 @@ CREATE TABLE bucket_storage_tallies (
 	metadata_size bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start )
 );
+CREATE TABLE bucket_usage_limits (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	storage_limit bigint NOT NULL,
+	bandwidth_limit bigint NOT NULL,
+	object_limit bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE coinpayments_transactions (
 	id text NOT NULL,
 	user_id bytea NOT NULL,
@@ -767,6 +868,13 @@ CREATE TABLE coinpayments_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE corrupt_pieces (
+	node_id bytea NOT NULL,
+	piece_id bytea NOT NULL,
+	reported_at timestamp with time zone NOT NULL,
+	processed_at timestamp with time zone,
+	PRIMARY KEY ( node_id, piece_id )
+);
 CREATE TABLE coupons (
 	id bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -802,6 +910,12 @@ CREATE TABLE credits_spendings (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE graceful_exit_pauses (
+	node_id bytea NOT NULL,
+	paused_at timestamp with time zone,
+	paused_duration bigint NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -875,6 +989,9 @@ CREATE TABLE nodes (
 	exit_loop_completed_at timestamp,
 	exit_finished_at timestamp,
 	exit_success boolean NOT NULL,
+	unknown_audit_reputation_alpha double precision NOT NULL,
+	unknown_audit_reputation_beta double precision NOT NULL,
+	suspended timestamp with time zone,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE nodes_offline_times (
@@ -898,6 +1015,42 @@ CREATE TABLE offers (
 	type integer NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE partial_exits (
+	node_id bytea NOT NULL,
+	requested_bytes bigint NOT NULL,
+	queued_bytes bigint NOT NULL,
+	transferred_bytes bigint NOT NULL,
+	requested_at timestamp with time zone NOT NULL,
+	queued_at timestamp with time zone,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE payout_statements (
+	node_id bytea NOT NULL,
+	period timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	node_created_at timestamp with time zone NOT NULL,
+	node_age_months integer NOT NULL,
+	wallet text NOT NULL,
+	usage_at_rest double precision NOT NULL,
+	usage_put bigint NOT NULL,
+	usage_get bigint NOT NULL,
+	usage_put_repair bigint NOT NULL,
+	usage_get_repair bigint NOT NULL,
+	usage_get_audit bigint NOT NULL,
+	comp_at_rest bigint NOT NULL,
+	comp_put bigint NOT NULL,
+	comp_get bigint NOT NULL,
+	comp_put_repair bigint NOT NULL,
+	comp_get_repair bigint NOT NULL,
+	comp_get_audit bigint NOT NULL,
+	held_percent integer NOT NULL,
+	held bigint NOT NULL,
+	disposed bigint NOT NULL,
+	owed bigint NOT NULL,
+	graceful_exit boolean NOT NULL,
+	PRIMARY KEY ( node_id, period )
+);
 CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -987,6 +1140,7 @@ CREATE TABLE stripecoinpayments_invoice_project_records (
 	storage double precision NOT NULL,
 	egress bigint NOT NULL,
 	objects bigint NOT NULL,
+	segments bigint NOT NULL,
 	period_start timestamp with time zone NOT NULL,
 	period_end timestamp with time zone NOT NULL,
 	state integer NOT NULL,
@@ -1566,6 +1720,242 @@ func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
 
 func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
 
+type BucketCountRollup struct {
+	ProjectId           []byte
+	BucketName          []byte
+	IntervalStart       time.Time
+	ObjectCount         int64
+	InlineSegmentsCount int64
+	RemoteSegmentsCount int64
+	ObjectHours         float64
+	InlineSegmentHours  float64
+	RemoteSegmentHours  float64
+	InlineByteHours     float64
+	RemoteByteHours     float64
+}
+
+func (BucketCountRollup) _Table() string { return "bucket_count_rollups" }
+
+type BucketCountRollup_Update_Fields struct {
+	ObjectCount         BucketCountRollup_ObjectCount_Field
+	InlineSegmentsCount BucketCountRollup_InlineSegmentsCount_Field
+	RemoteSegmentsCount BucketCountRollup_RemoteSegmentsCount_Field
+	ObjectHours         BucketCountRollup_ObjectHours_Field
+	InlineSegmentHours  BucketCountRollup_InlineSegmentHours_Field
+	RemoteSegmentHours  BucketCountRollup_RemoteSegmentHours_Field
+	InlineByteHours     BucketCountRollup_InlineByteHours_Field
+	RemoteByteHours     BucketCountRollup_RemoteByteHours_Field
+}
+
+type BucketCountRollup_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketCountRollup_ProjectId(v []byte) BucketCountRollup_ProjectId_Field {
+	return BucketCountRollup_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketCountRollup_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketCountRollup_ProjectId_Field) _Column() string { return "project_id" }
+
+type BucketCountRollup_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketCountRollup_BucketName(v []byte) BucketCountRollup_BucketName_Field {
+	return BucketCountRollup_BucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketCountRollup_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketCountRollup_BucketName_Field) _Column() string { return "bucket_name" }
+
+type BucketCountRollup_IntervalStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketCountRollup_IntervalStart(v time.Time) BucketCountRollup_IntervalStart_Field {
+	return BucketCountRollup_IntervalStart_Field{_set: true, _value: v}
+}
+
+func (f BucketCountRollup_IntervalStart_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketCountRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+
+type BucketCountRollup_ObjectCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func BucketCountRollup_ObjectCount(v int64) BucketCountRollup_ObjectCount_Field {
+	return BucketCountRollup_ObjectCount_Field{_set: true, _value: v}
+}
+
+func (f BucketCountRollup_ObjectCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketCountRollup_ObjectCount_Field) _Column() string { return "object_count" }
+
+type BucketCountRollup_InlineSegmentsCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func BucketCountRollup_InlineSegmentsCount(v int64) BucketCountRollup_InlineSegmentsCount_Field {
+	return BucketCountRollup_InlineSegmentsCount_Field{_set: true, _value: v}
+}
+
+func (f BucketCountRollup_InlineSegmentsCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketCountRollup_InlineSegmentsCount_Field) _Column() string { return "inline_segments_count" }
+
+type BucketCountRollup_RemoteSegmentsCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func BucketCountRollup_RemoteSegmentsCount(v int64) BucketCountRollup_RemoteSegmentsCount_Field {
+	return BucketCountRollup_RemoteSegmentsCount_Field{_set: true, _value: v}
+}
+
+func (f BucketCountRollup_RemoteSegmentsCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketCountRollup_RemoteSegmentsCount_Field) _Column() string { return "remote_segments_count" }
+
+type BucketCountRollup_ObjectHours_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func BucketCountRollup_ObjectHours(v float64) BucketCountRollup_ObjectHours_Field {
+	return BucketCountRollup_ObjectHours_Field{_set: true, _value: v}
+}
+
+func (f BucketCountRollup_ObjectHours_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketCountRollup_ObjectHours_Field) _Column() string { return "object_hours" }
+
+type BucketCountRollup_InlineSegmentHours_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func BucketCountRollup_InlineSegmentHours(v float64) BucketCountRollup_InlineSegmentHours_Field {
+	return BucketCountRollup_InlineSegmentHours_Field{_set: true, _value: v}
+}
+
+func (f BucketCountRollup_InlineSegmentHours_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketCountRollup_InlineSegmentHours_Field) _Column() string { return "inline_segment_hours" }
+
+type BucketCountRollup_RemoteSegmentHours_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func BucketCountRollup_RemoteSegmentHours(v float64) BucketCountRollup_RemoteSegmentHours_Field {
+	return BucketCountRollup_RemoteSegmentHours_Field{_set: true, _value: v}
+}
+
+func (f BucketCountRollup_RemoteSegmentHours_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketCountRollup_RemoteSegmentHours_Field) _Column() string { return "remote_segment_hours" }
+
+type BucketCountRollup_InlineByteHours_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func BucketCountRollup_InlineByteHours(v float64) BucketCountRollup_InlineByteHours_Field {
+	return BucketCountRollup_InlineByteHours_Field{_set: true, _value: v}
+}
+
+func (f BucketCountRollup_InlineByteHours_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketCountRollup_InlineByteHours_Field) _Column() string { return "inline_byte_hours" }
+
+type BucketCountRollup_RemoteByteHours_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func BucketCountRollup_RemoteByteHours(v float64) BucketCountRollup_RemoteByteHours_Field {
+	return BucketCountRollup_RemoteByteHours_Field{_set: true, _value: v}
+}
+
+func (f BucketCountRollup_RemoteByteHours_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketCountRollup_RemoteByteHours_Field) _Column() string { return "remote_byte_hours" }
+
 type BucketStorageTally struct {
 	BucketName          []byte
 	ProjectId           []byte
@@ -1755,127 +2145,278 @@ func (f BucketStorageTally_MetadataSize_Field) value() interface{} {
 
 func (BucketStorageTally_MetadataSize_Field) _Column() string { return "metadata_size" }
 
-type CoinpaymentsTransaction struct {
-	Id        string
-	UserId    []byte
-	Address   string
-	Amount    []byte
-	Received  []byte
-	Status    int
-	Key       string
-	Timeout   int
-	CreatedAt time.Time
+type BucketUsageLimit struct {
+	ProjectId      []byte
+	BucketName     []byte
+	StorageLimit   int64
+	BandwidthLimit int64
+	ObjectLimit    int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
-func (CoinpaymentsTransaction) _Table() string { return "coinpayments_transactions" }
+func (BucketUsageLimit) _Table() string { return "bucket_usage_limits" }
 
-type CoinpaymentsTransaction_Update_Fields struct {
-	Received CoinpaymentsTransaction_Received_Field
-	Status   CoinpaymentsTransaction_Status_Field
+type BucketUsageLimit_Update_Fields struct {
+	StorageLimit   BucketUsageLimit_StorageLimit_Field
+	BandwidthLimit BucketUsageLimit_BandwidthLimit_Field
+	ObjectLimit    BucketUsageLimit_ObjectLimit_Field
 }
 
-type CoinpaymentsTransaction_Id_Field struct {
+type BucketUsageLimit_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func CoinpaymentsTransaction_Id(v string) CoinpaymentsTransaction_Id_Field {
-	return CoinpaymentsTransaction_Id_Field{_set: true, _value: v}
+func BucketUsageLimit_ProjectId(v []byte) BucketUsageLimit_ProjectId_Field {
+	return BucketUsageLimit_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Id_Field) value() interface{} {
+func (f BucketUsageLimit_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Id_Field) _Column() string { return "id" }
+func (BucketUsageLimit_ProjectId_Field) _Column() string { return "project_id" }
 
-type CoinpaymentsTransaction_UserId_Field struct {
+type BucketUsageLimit_BucketName_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func CoinpaymentsTransaction_UserId(v []byte) CoinpaymentsTransaction_UserId_Field {
-	return CoinpaymentsTransaction_UserId_Field{_set: true, _value: v}
+func BucketUsageLimit_BucketName(v []byte) BucketUsageLimit_BucketName_Field {
+	return BucketUsageLimit_BucketName_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_UserId_Field) value() interface{} {
+func (f BucketUsageLimit_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_UserId_Field) _Column() string { return "user_id" }
+func (BucketUsageLimit_BucketName_Field) _Column() string { return "bucket_name" }
 
-type CoinpaymentsTransaction_Address_Field struct {
+type BucketUsageLimit_StorageLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func CoinpaymentsTransaction_Address(v string) CoinpaymentsTransaction_Address_Field {
-	return CoinpaymentsTransaction_Address_Field{_set: true, _value: v}
+func BucketUsageLimit_StorageLimit(v int64) BucketUsageLimit_StorageLimit_Field {
+	return BucketUsageLimit_StorageLimit_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Address_Field) value() interface{} {
+func (f BucketUsageLimit_StorageLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Address_Field) _Column() string { return "address" }
+func (BucketUsageLimit_StorageLimit_Field) _Column() string { return "storage_limit" }
 
-type CoinpaymentsTransaction_Amount_Field struct {
+type BucketUsageLimit_BandwidthLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func CoinpaymentsTransaction_Amount(v []byte) CoinpaymentsTransaction_Amount_Field {
-	return CoinpaymentsTransaction_Amount_Field{_set: true, _value: v}
+func BucketUsageLimit_BandwidthLimit(v int64) BucketUsageLimit_BandwidthLimit_Field {
+	return BucketUsageLimit_BandwidthLimit_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Amount_Field) value() interface{} {
+func (f BucketUsageLimit_BandwidthLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Amount_Field) _Column() string { return "amount" }
+func (BucketUsageLimit_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
 
-type CoinpaymentsTransaction_Received_Field struct {
+type BucketUsageLimit_ObjectLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func CoinpaymentsTransaction_Received(v []byte) CoinpaymentsTransaction_Received_Field {
-	return CoinpaymentsTransaction_Received_Field{_set: true, _value: v}
+func BucketUsageLimit_ObjectLimit(v int64) BucketUsageLimit_ObjectLimit_Field {
+	return BucketUsageLimit_ObjectLimit_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Received_Field) value() interface{} {
+func (f BucketUsageLimit_ObjectLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Received_Field) _Column() string { return "received" }
+func (BucketUsageLimit_ObjectLimit_Field) _Column() string { return "object_limit" }
 
-type CoinpaymentsTransaction_Status_Field struct {
+type BucketUsageLimit_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func CoinpaymentsTransaction_Status(v int) CoinpaymentsTransaction_Status_Field {
+func BucketUsageLimit_CreatedAt(v time.Time) BucketUsageLimit_CreatedAt_Field {
+	return BucketUsageLimit_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketUsageLimit_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketUsageLimit_CreatedAt_Field) _Column() string { return "created_at" }
+
+type BucketUsageLimit_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketUsageLimit_UpdatedAt(v time.Time) BucketUsageLimit_UpdatedAt_Field {
+	return BucketUsageLimit_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketUsageLimit_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketUsageLimit_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type CoinpaymentsTransaction struct {
+	Id        string
+	UserId    []byte
+	Address   string
+	Amount    []byte
+	Received  []byte
+	Status    int
+	Key       string
+	Timeout   int
+	CreatedAt time.Time
+}
+
+func (CoinpaymentsTransaction) _Table() string { return "coinpayments_transactions" }
+
+type CoinpaymentsTransaction_Update_Fields struct {
+	Received CoinpaymentsTransaction_Received_Field
+	Status   CoinpaymentsTransaction_Status_Field
+}
+
+type CoinpaymentsTransaction_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func CoinpaymentsTransaction_Id(v string) CoinpaymentsTransaction_Id_Field {
+	return CoinpaymentsTransaction_Id_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CoinpaymentsTransaction_Id_Field) _Column() string { return "id" }
+
+type CoinpaymentsTransaction_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func CoinpaymentsTransaction_UserId(v []byte) CoinpaymentsTransaction_UserId_Field {
+	return CoinpaymentsTransaction_UserId_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CoinpaymentsTransaction_UserId_Field) _Column() string { return "user_id" }
+
+type CoinpaymentsTransaction_Address_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func CoinpaymentsTransaction_Address(v string) CoinpaymentsTransaction_Address_Field {
+	return CoinpaymentsTransaction_Address_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_Address_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CoinpaymentsTransaction_Address_Field) _Column() string { return "address" }
+
+type CoinpaymentsTransaction_Amount_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func CoinpaymentsTransaction_Amount(v []byte) CoinpaymentsTransaction_Amount_Field {
+	return CoinpaymentsTransaction_Amount_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_Amount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CoinpaymentsTransaction_Amount_Field) _Column() string { return "amount" }
+
+type CoinpaymentsTransaction_Received_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func CoinpaymentsTransaction_Received(v []byte) CoinpaymentsTransaction_Received_Field {
+	return CoinpaymentsTransaction_Received_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_Received_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CoinpaymentsTransaction_Received_Field) _Column() string { return "received" }
+
+type CoinpaymentsTransaction_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func CoinpaymentsTransaction_Status(v int) CoinpaymentsTransaction_Status_Field {
 	return CoinpaymentsTransaction_Status_Field{_set: true, _value: v}
 }
 
@@ -1945,6 +2486,112 @@ func (f CoinpaymentsTransaction_CreatedAt_Field) value() interface{} {
 
 func (CoinpaymentsTransaction_CreatedAt_Field) _Column() string { return "created_at" }
 
+type CorruptPiece struct {
+	NodeId      []byte
+	PieceId     []byte
+	ReportedAt  time.Time
+	ProcessedAt *time.Time
+}
+
+func (CorruptPiece) _Table() string { return "corrupt_pieces" }
+
+type CorruptPiece_Create_Fields struct {
+	ProcessedAt CorruptPiece_ProcessedAt_Field
+}
+
+type CorruptPiece_Update_Fields struct {
+	ProcessedAt CorruptPiece_ProcessedAt_Field
+}
+
+type CorruptPiece_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func CorruptPiece_NodeId(v []byte) CorruptPiece_NodeId_Field {
+	return CorruptPiece_NodeId_Field{_set: true, _value: v}
+}
+
+func (f CorruptPiece_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CorruptPiece_NodeId_Field) _Column() string { return "node_id" }
+
+type CorruptPiece_PieceId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func CorruptPiece_PieceId(v []byte) CorruptPiece_PieceId_Field {
+	return CorruptPiece_PieceId_Field{_set: true, _value: v}
+}
+
+func (f CorruptPiece_PieceId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CorruptPiece_PieceId_Field) _Column() string { return "piece_id" }
+
+type CorruptPiece_ReportedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func CorruptPiece_ReportedAt(v time.Time) CorruptPiece_ReportedAt_Field {
+	return CorruptPiece_ReportedAt_Field{_set: true, _value: v}
+}
+
+func (f CorruptPiece_ReportedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CorruptPiece_ReportedAt_Field) _Column() string { return "reported_at" }
+
+type CorruptPiece_ProcessedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func CorruptPiece_ProcessedAt(v time.Time) CorruptPiece_ProcessedAt_Field {
+	return CorruptPiece_ProcessedAt_Field{_set: true, _value: &v}
+}
+
+func CorruptPiece_ProcessedAt_Raw(v *time.Time) CorruptPiece_ProcessedAt_Field {
+	if v == nil {
+		return CorruptPiece_ProcessedAt_Null()
+	}
+	return CorruptPiece_ProcessedAt(*v)
+}
+
+func CorruptPiece_ProcessedAt_Null() CorruptPiece_ProcessedAt_Field {
+	return CorruptPiece_ProcessedAt_Field{_set: true, _null: true}
+}
+
+func (f CorruptPiece_ProcessedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f CorruptPiece_ProcessedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CorruptPiece_ProcessedAt_Field) _Column() string { return "processed_at" }
+
 type Coupon struct {
 	Id          []byte
 	ProjectId   []byte
@@ -2440,83 +3087,170 @@ func (f CreditsSpending_CreatedAt_Field) value() interface{} {
 
 func (CreditsSpending_CreatedAt_Field) _Column() string { return "created_at" }
 
-type GracefulExitProgress struct {
-	NodeId            []byte
-	BytesTransferred  int64
-	PiecesTransferred int64
-	PiecesFailed      int64
-	UpdatedAt         time.Time
+type GracefulExitPause struct {
+	NodeId         []byte
+	PausedAt       *time.Time
+	PausedDuration int64
 }
 
-func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
+func (GracefulExitPause) _Table() string { return "graceful_exit_pauses" }
 
-type GracefulExitProgress_Update_Fields struct {
-	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
-	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
-	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
+type GracefulExitPause_Create_Fields struct {
+	PausedAt GracefulExitPause_PausedAt_Field
 }
 
-type GracefulExitProgress_NodeId_Field struct {
+type GracefulExitPause_Update_Fields struct {
+	PausedAt       GracefulExitPause_PausedAt_Field
+	PausedDuration GracefulExitPause_PausedDuration_Field
+}
+
+type GracefulExitPause_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
-	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
+func GracefulExitPause_NodeId(v []byte) GracefulExitPause_NodeId_Field {
+	return GracefulExitPause_NodeId_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_NodeId_Field) value() interface{} {
+func (f GracefulExitPause_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
+func (GracefulExitPause_NodeId_Field) _Column() string { return "node_id" }
 
-type GracefulExitProgress_BytesTransferred_Field struct {
+type GracefulExitPause_PausedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *time.Time
 }
 
-func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
-	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
+func GracefulExitPause_PausedAt(v time.Time) GracefulExitPause_PausedAt_Field {
+	return GracefulExitPause_PausedAt_Field{_set: true, _value: &v}
 }
 
-func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
+func GracefulExitPause_PausedAt_Raw(v *time.Time) GracefulExitPause_PausedAt_Field {
+	if v == nil {
+		return GracefulExitPause_PausedAt_Null()
+	}
+	return GracefulExitPause_PausedAt(*v)
+}
+
+func GracefulExitPause_PausedAt_Null() GracefulExitPause_PausedAt_Field {
+	return GracefulExitPause_PausedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitPause_PausedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f GracefulExitPause_PausedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
+func (GracefulExitPause_PausedAt_Field) _Column() string { return "paused_at" }
 
-type GracefulExitProgress_PiecesTransferred_Field struct {
+type GracefulExitPause_PausedDuration_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
-	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
+func GracefulExitPause_PausedDuration(v int64) GracefulExitPause_PausedDuration_Field {
+	return GracefulExitPause_PausedDuration_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
+func (f GracefulExitPause_PausedDuration_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+func (GracefulExitPause_PausedDuration_Field) _Column() string { return "paused_duration" }
 
-type GracefulExitProgress_PiecesFailed_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+type GracefulExitProgress struct {
+	NodeId            []byte
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+	UpdatedAt         time.Time
+}
+
+func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
+
+type GracefulExitProgress_Update_Fields struct {
+	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
+	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
+	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
+}
+
+type GracefulExitProgress_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
+	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
+
+type GracefulExitProgress_BytesTransferred_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
+	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
+
+type GracefulExitProgress_PiecesTransferred_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
+	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+
+type GracefulExitProgress_PiecesFailed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
 func GracefulExitProgress_PiecesFailed(v int64) GracefulExitProgress_PiecesFailed_Field {
@@ -3112,41 +3846,44 @@ func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
 func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
 
 type Node struct {
-	Id                    []byte
-	Address               string
-	LastNet               string
-	Protocol              int
-	Type                  int
-	Email                 string
-	Wallet                string
-	FreeBandwidth         int64
-	FreeDisk              int64
-	PieceCount            int64
-	Major                 int64
-	Minor                 int64
-	Patch                 int64
-	Hash                  string
-	Timestamp             time.Time
-	Release               bool
-	Latency90             int64
-	AuditSuccessCount     int64
-	TotalAuditCount       int64
-	UptimeSuccessCount    int64
-	TotalUptimeCount      int64
-	CreatedAt             time.Time
-	UpdatedAt             time.Time
-	LastContactSuccess    time.Time
-	LastContactFailure    time.Time
-	Contained             bool
-	Disqualified          *time.Time
-	AuditReputationAlpha  float64
-	AuditReputationBeta   float64
-	UptimeReputationAlpha float64
-	UptimeReputationBeta  float64
-	ExitInitiatedAt       *time.Time
-	ExitLoopCompletedAt   *time.Time
-	ExitFinishedAt        *time.Time
-	ExitSuccess           bool
+	Id                          []byte
+	Address                     string
+	LastNet                     string
+	Protocol                    int
+	Type                        int
+	Email                       string
+	Wallet                      string
+	FreeBandwidth               int64
+	FreeDisk                    int64
+	PieceCount                  int64
+	Major                       int64
+	Minor                       int64
+	Patch                       int64
+	Hash                        string
+	Timestamp                   time.Time
+	Release                     bool
+	Latency90                   int64
+	AuditSuccessCount           int64
+	TotalAuditCount             int64
+	UptimeSuccessCount          int64
+	TotalUptimeCount            int64
+	CreatedAt                   time.Time
+	UpdatedAt                   time.Time
+	LastContactSuccess          time.Time
+	LastContactFailure          time.Time
+	Contained                   bool
+	Disqualified                *time.Time
+	AuditReputationAlpha        float64
+	AuditReputationBeta         float64
+	UptimeReputationAlpha       float64
+	UptimeReputationBeta        float64
+	ExitInitiatedAt             *time.Time
+	ExitLoopCompletedAt         *time.Time
+	ExitFinishedAt              *time.Time
+	ExitSuccess                 bool
+	UnknownAuditReputationAlpha float64
+	UnknownAuditReputationBeta  float64
+	Suspended                   *time.Time
 }
 
 func (Node) _Table() string { return "nodes" }
@@ -3156,41 +3893,45 @@ type Node_Create_Fields struct {
 	ExitInitiatedAt     Node_ExitInitiatedAt_Field
 	ExitLoopCompletedAt Node_ExitLoopCompletedAt_Field
 	ExitFinishedAt      Node_ExitFinishedAt_Field
+	Suspended           Node_Suspended_Field
 }
 
 type Node_Update_Fields struct {
-	Address               Node_Address_Field
-	LastNet               Node_LastNet_Field
-	Protocol              Node_Protocol_Field
-	Type                  Node_Type_Field
-	Email                 Node_Email_Field
-	Wallet                Node_Wallet_Field
-	FreeBandwidth         Node_FreeBandwidth_Field
-	FreeDisk              Node_FreeDisk_Field
-	PieceCount            Node_PieceCount_Field
-	Major                 Node_Major_Field
-	Minor                 Node_Minor_Field
-	Patch                 Node_Patch_Field
-	Hash                  Node_Hash_Field
-	Timestamp             Node_Timestamp_Field
-	Release               Node_Release_Field
-	Latency90             Node_Latency90_Field
-	AuditSuccessCount     Node_AuditSuccessCount_Field
-	TotalAuditCount       Node_TotalAuditCount_Field
-	UptimeSuccessCount    Node_UptimeSuccessCount_Field
-	TotalUptimeCount      Node_TotalUptimeCount_Field
-	LastContactSuccess    Node_LastContactSuccess_Field
-	LastContactFailure    Node_LastContactFailure_Field
-	Contained             Node_Contained_Field
-	Disqualified          Node_Disqualified_Field
-	AuditReputationAlpha  Node_AuditReputationAlpha_Field
-	AuditReputationBeta   Node_AuditReputationBeta_Field
-	UptimeReputationAlpha Node_UptimeReputationAlpha_Field
-	UptimeReputationBeta  Node_UptimeReputationBeta_Field
-	ExitInitiatedAt       Node_ExitInitiatedAt_Field
-	ExitLoopCompletedAt   Node_ExitLoopCompletedAt_Field
-	ExitFinishedAt        Node_ExitFinishedAt_Field
-	ExitSuccess           Node_ExitSuccess_Field
+	Address                     Node_Address_Field
+	LastNet                     Node_LastNet_Field
+	Protocol                    Node_Protocol_Field
+	Type                        Node_Type_Field
+	Email                       Node_Email_Field
+	Wallet                      Node_Wallet_Field
+	FreeBandwidth               Node_FreeBandwidth_Field
+	FreeDisk                    Node_FreeDisk_Field
+	PieceCount                  Node_PieceCount_Field
+	Major                       Node_Major_Field
+	Minor                       Node_Minor_Field
+	Patch                       Node_Patch_Field
+	Hash                        Node_Hash_Field
+	Timestamp                   Node_Timestamp_Field
+	Release                     Node_Release_Field
+	Latency90                   Node_Latency90_Field
+	AuditSuccessCount           Node_AuditSuccessCount_Field
+	TotalAuditCount             Node_TotalAuditCount_Field
+	UptimeSuccessCount          Node_UptimeSuccessCount_Field
+	TotalUptimeCount            Node_TotalUptimeCount_Field
+	LastContactSuccess          Node_LastContactSuccess_Field
+	LastContactFailure          Node_LastContactFailure_Field
+	Contained                   Node_Contained_Field
+	Disqualified                Node_Disqualified_Field
+	AuditReputationAlpha        Node_AuditReputationAlpha_Field
+	AuditReputationBeta         Node_AuditReputationBeta_Field
+	UptimeReputationAlpha       Node_UptimeReputationAlpha_Field
+	UptimeReputationBeta        Node_UptimeReputationBeta_Field
+	ExitInitiatedAt             Node_ExitInitiatedAt_Field
+	ExitLoopCompletedAt         Node_ExitLoopCompletedAt_Field
+	ExitFinishedAt              Node_ExitFinishedAt_Field
+	ExitSuccess                 Node_ExitSuccess_Field
+	UnknownAuditReputationAlpha Node_UnknownAuditReputationAlpha_Field
+	UnknownAuditReputationBeta  Node_UnknownAuditReputationBeta_Field
+	Suspended                   Node_Suspended_Field
 }
 
 type Node_Id_Field struct {
@@ -3913,6 +4654,78 @@ func (f Node_ExitSuccess_Field) value() interface{} {
 
 func (Node_ExitSuccess_Field) _Column() string { return "exit_success" }
 
+type Node_UnknownAuditReputationAlpha_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_UnknownAuditReputationAlpha(v float64) Node_UnknownAuditReputationAlpha_Field {
+	return Node_UnknownAuditReputationAlpha_Field{_set: true, _value: v}
+}
+
+func (f Node_UnknownAuditReputationAlpha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UnknownAuditReputationAlpha_Field) _Column() string {
+	return "unknown_audit_reputation_alpha"
+}
+
+type Node_UnknownAuditReputationBeta_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_UnknownAuditReputationBeta(v float64) Node_UnknownAuditReputationBeta_Field {
+	return Node_UnknownAuditReputationBeta_Field{_set: true, _value: v}
+}
+
+func (f Node_UnknownAuditReputationBeta_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UnknownAuditReputationBeta_Field) _Column() string { return "unknown_audit_reputation_beta" }
+
+type Node_Suspended_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_Suspended(v time.Time) Node_Suspended_Field {
+	return Node_Suspended_Field{_set: true, _value: &v}
+}
+
+func Node_Suspended_Raw(v *time.Time) Node_Suspended_Field {
+	if v == nil {
+		return Node_Suspended_Null()
+	}
+	return Node_Suspended(*v)
+}
+
+func Node_Suspended_Null() Node_Suspended_Field {
+	return Node_Suspended_Field{_set: true, _null: true}
+}
+
+func (f Node_Suspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_Suspended_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Suspended_Field) _Column() string { return "suspended" }
+
 type NodesOfflineTime struct {
 	NodeId    []byte
 	TrackedAt time.Time
@@ -4218,75 +5031,725 @@ type Offer_ExpiresAt_Field struct {
 	_value time.Time
 }
 
-func Offer_ExpiresAt(v time.Time) Offer_ExpiresAt_Field {
-	return Offer_ExpiresAt_Field{_set: true, _value: v}
+func Offer_ExpiresAt(v time.Time) Offer_ExpiresAt_Field {
+	return Offer_ExpiresAt_Field{_set: true, _value: v}
+}
+
+func (f Offer_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_ExpiresAt_Field) _Column() string { return "expires_at" }
+
+type Offer_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Offer_CreatedAt(v time.Time) Offer_CreatedAt_Field {
+	return Offer_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Offer_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Offer_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_Status(v int) Offer_Status_Field {
+	return Offer_Status_Field{_set: true, _value: v}
+}
+
+func (f Offer_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_Status_Field) _Column() string { return "status" }
+
+type Offer_Type_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_Type(v int) Offer_Type_Field {
+	return Offer_Type_Field{_set: true, _value: v}
+}
+
+func (f Offer_Type_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_Type_Field) _Column() string { return "type" }
+
+type PartialExit struct {
+	NodeId           []byte
+	RequestedBytes   int64
+	QueuedBytes      int64
+	TransferredBytes int64
+	RequestedAt      time.Time
+	QueuedAt         *time.Time
+	FinishedAt       *time.Time
+}
+
+func (PartialExit) _Table() string { return "partial_exits" }
+
+type PartialExit_Create_Fields struct {
+	QueuedAt   PartialExit_QueuedAt_Field
+	FinishedAt PartialExit_FinishedAt_Field
+}
+
+type PartialExit_Update_Fields struct {
+	RequestedBytes   PartialExit_RequestedBytes_Field
+	QueuedBytes      PartialExit_QueuedBytes_Field
+	TransferredBytes PartialExit_TransferredBytes_Field
+	RequestedAt      PartialExit_RequestedAt_Field
+	QueuedAt         PartialExit_QueuedAt_Field
+	FinishedAt       PartialExit_FinishedAt_Field
+}
+
+type PartialExit_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PartialExit_NodeId(v []byte) PartialExit_NodeId_Field {
+	return PartialExit_NodeId_Field{_set: true, _value: v}
+}
+
+func (f PartialExit_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PartialExit_NodeId_Field) _Column() string { return "node_id" }
+
+type PartialExit_RequestedBytes_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PartialExit_RequestedBytes(v int64) PartialExit_RequestedBytes_Field {
+	return PartialExit_RequestedBytes_Field{_set: true, _value: v}
+}
+
+func (f PartialExit_RequestedBytes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PartialExit_RequestedBytes_Field) _Column() string { return "requested_bytes" }
+
+type PartialExit_QueuedBytes_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PartialExit_QueuedBytes(v int64) PartialExit_QueuedBytes_Field {
+	return PartialExit_QueuedBytes_Field{_set: true, _value: v}
+}
+
+func (f PartialExit_QueuedBytes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PartialExit_QueuedBytes_Field) _Column() string { return "queued_bytes" }
+
+type PartialExit_TransferredBytes_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PartialExit_TransferredBytes(v int64) PartialExit_TransferredBytes_Field {
+	return PartialExit_TransferredBytes_Field{_set: true, _value: v}
+}
+
+func (f PartialExit_TransferredBytes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PartialExit_TransferredBytes_Field) _Column() string { return "transferred_bytes" }
+
+type PartialExit_RequestedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PartialExit_RequestedAt(v time.Time) PartialExit_RequestedAt_Field {
+	return PartialExit_RequestedAt_Field{_set: true, _value: v}
+}
+
+func (f PartialExit_RequestedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PartialExit_RequestedAt_Field) _Column() string { return "requested_at" }
+
+type PartialExit_QueuedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func PartialExit_QueuedAt(v time.Time) PartialExit_QueuedAt_Field {
+	return PartialExit_QueuedAt_Field{_set: true, _value: &v}
+}
+
+func PartialExit_QueuedAt_Raw(v *time.Time) PartialExit_QueuedAt_Field {
+	if v == nil {
+		return PartialExit_QueuedAt_Null()
+	}
+	return PartialExit_QueuedAt(*v)
+}
+
+func PartialExit_QueuedAt_Null() PartialExit_QueuedAt_Field {
+	return PartialExit_QueuedAt_Field{_set: true, _null: true}
+}
+
+func (f PartialExit_QueuedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f PartialExit_QueuedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PartialExit_QueuedAt_Field) _Column() string { return "queued_at" }
+
+type PartialExit_FinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func PartialExit_FinishedAt(v time.Time) PartialExit_FinishedAt_Field {
+	return PartialExit_FinishedAt_Field{_set: true, _value: &v}
+}
+
+func PartialExit_FinishedAt_Raw(v *time.Time) PartialExit_FinishedAt_Field {
+	if v == nil {
+		return PartialExit_FinishedAt_Null()
+	}
+	return PartialExit_FinishedAt(*v)
+}
+
+func PartialExit_FinishedAt_Null() PartialExit_FinishedAt_Field {
+	return PartialExit_FinishedAt_Field{_set: true, _null: true}
+}
+
+func (f PartialExit_FinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f PartialExit_FinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PartialExit_FinishedAt_Field) _Column() string { return "finished_at" }
+
+type PayoutStatement struct {
+	NodeId         []byte
+	Period         time.Time
+	CreatedAt      time.Time
+	NodeCreatedAt  time.Time
+	NodeAgeMonths  int
+	Wallet         string
+	UsageAtRest    float64
+	UsagePut       int64
+	UsageGet       int64
+	UsagePutRepair int64
+	UsageGetRepair int64
+	UsageGetAudit  int64
+	CompAtRest     int64
+	CompPut        int64
+	CompGet        int64
+	CompPutRepair  int64
+	CompGetRepair  int64
+	CompGetAudit   int64
+	HeldPercent    int
+	Held           int64
+	Disposed       int64
+	Owed           int64
+	GracefulExit   bool
+}
+
+func (PayoutStatement) _Table() string { return "payout_statements" }
+
+type PayoutStatement_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PayoutStatement_NodeId(v []byte) PayoutStatement_NodeId_Field {
+	return PayoutStatement_NodeId_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_NodeId_Field) _Column() string { return "node_id" }
+
+type PayoutStatement_Period_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PayoutStatement_Period(v time.Time) PayoutStatement_Period_Field {
+	return PayoutStatement_Period_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_Period_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_Period_Field) _Column() string { return "period" }
+
+type PayoutStatement_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PayoutStatement_CreatedAt(v time.Time) PayoutStatement_CreatedAt_Field {
+	return PayoutStatement_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_CreatedAt_Field) _Column() string { return "created_at" }
+
+type PayoutStatement_NodeCreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PayoutStatement_NodeCreatedAt(v time.Time) PayoutStatement_NodeCreatedAt_Field {
+	return PayoutStatement_NodeCreatedAt_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_NodeCreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_NodeCreatedAt_Field) _Column() string { return "node_created_at" }
+
+type PayoutStatement_NodeAgeMonths_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func PayoutStatement_NodeAgeMonths(v int) PayoutStatement_NodeAgeMonths_Field {
+	return PayoutStatement_NodeAgeMonths_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_NodeAgeMonths_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_NodeAgeMonths_Field) _Column() string { return "node_age_months" }
+
+type PayoutStatement_Wallet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func PayoutStatement_Wallet(v string) PayoutStatement_Wallet_Field {
+	return PayoutStatement_Wallet_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_Wallet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_Wallet_Field) _Column() string { return "wallet" }
+
+type PayoutStatement_UsageAtRest_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func PayoutStatement_UsageAtRest(v float64) PayoutStatement_UsageAtRest_Field {
+	return PayoutStatement_UsageAtRest_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_UsageAtRest_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_UsageAtRest_Field) _Column() string { return "usage_at_rest" }
+
+type PayoutStatement_UsagePut_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_UsagePut(v int64) PayoutStatement_UsagePut_Field {
+	return PayoutStatement_UsagePut_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_UsagePut_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_UsagePut_Field) _Column() string { return "usage_put" }
+
+type PayoutStatement_UsageGet_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_UsageGet(v int64) PayoutStatement_UsageGet_Field {
+	return PayoutStatement_UsageGet_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_UsageGet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_UsageGet_Field) _Column() string { return "usage_get" }
+
+type PayoutStatement_UsagePutRepair_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_UsagePutRepair(v int64) PayoutStatement_UsagePutRepair_Field {
+	return PayoutStatement_UsagePutRepair_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_UsagePutRepair_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_UsagePutRepair_Field) _Column() string { return "usage_put_repair" }
+
+type PayoutStatement_UsageGetRepair_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_UsageGetRepair(v int64) PayoutStatement_UsageGetRepair_Field {
+	return PayoutStatement_UsageGetRepair_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_UsageGetRepair_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_UsageGetRepair_Field) _Column() string { return "usage_get_repair" }
+
+type PayoutStatement_UsageGetAudit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_UsageGetAudit(v int64) PayoutStatement_UsageGetAudit_Field {
+	return PayoutStatement_UsageGetAudit_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_UsageGetAudit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_UsageGetAudit_Field) _Column() string { return "usage_get_audit" }
+
+type PayoutStatement_CompAtRest_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_CompAtRest(v int64) PayoutStatement_CompAtRest_Field {
+	return PayoutStatement_CompAtRest_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_CompAtRest_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_CompAtRest_Field) _Column() string { return "comp_at_rest" }
+
+type PayoutStatement_CompPut_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_CompPut(v int64) PayoutStatement_CompPut_Field {
+	return PayoutStatement_CompPut_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_CompPut_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_CompPut_Field) _Column() string { return "comp_put" }
+
+type PayoutStatement_CompGet_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_CompGet(v int64) PayoutStatement_CompGet_Field {
+	return PayoutStatement_CompGet_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_CompGet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_CompGet_Field) _Column() string { return "comp_get" }
+
+type PayoutStatement_CompPutRepair_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_CompPutRepair(v int64) PayoutStatement_CompPutRepair_Field {
+	return PayoutStatement_CompPutRepair_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_CompPutRepair_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_CompPutRepair_Field) _Column() string { return "comp_put_repair" }
+
+type PayoutStatement_CompGetRepair_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_CompGetRepair(v int64) PayoutStatement_CompGetRepair_Field {
+	return PayoutStatement_CompGetRepair_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_CompGetRepair_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_CompGetRepair_Field) _Column() string { return "comp_get_repair" }
+
+type PayoutStatement_CompGetAudit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_CompGetAudit(v int64) PayoutStatement_CompGetAudit_Field {
+	return PayoutStatement_CompGetAudit_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_CompGetAudit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_CompGetAudit_Field) _Column() string { return "comp_get_audit" }
+
+type PayoutStatement_HeldPercent_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func PayoutStatement_HeldPercent(v int) PayoutStatement_HeldPercent_Field {
+	return PayoutStatement_HeldPercent_Field{_set: true, _value: v}
+}
+
+func (f PayoutStatement_HeldPercent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutStatement_HeldPercent_Field) _Column() string { return "held_percent" }
+
+type PayoutStatement_Held_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PayoutStatement_Held(v int64) PayoutStatement_Held_Field {
+	return PayoutStatement_Held_Field{_set: true, _value: v}
 }
 
-func (f Offer_ExpiresAt_Field) value() interface{} {
+func (f PayoutStatement_Held_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (PayoutStatement_Held_Field) _Column() string { return "held" }
 
-type Offer_CreatedAt_Field struct {
+type PayoutStatement_Disposed_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func Offer_CreatedAt(v time.Time) Offer_CreatedAt_Field {
-	return Offer_CreatedAt_Field{_set: true, _value: v}
+func PayoutStatement_Disposed(v int64) PayoutStatement_Disposed_Field {
+	return PayoutStatement_Disposed_Field{_set: true, _value: v}
 }
 
-func (f Offer_CreatedAt_Field) value() interface{} {
+func (f PayoutStatement_Disposed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_CreatedAt_Field) _Column() string { return "created_at" }
+func (PayoutStatement_Disposed_Field) _Column() string { return "disposed" }
 
-type Offer_Status_Field struct {
+type PayoutStatement_Owed_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int64
 }
 
-func Offer_Status(v int) Offer_Status_Field {
-	return Offer_Status_Field{_set: true, _value: v}
+func PayoutStatement_Owed(v int64) PayoutStatement_Owed_Field {
+	return PayoutStatement_Owed_Field{_set: true, _value: v}
 }
 
-func (f Offer_Status_Field) value() interface{} {
+func (f PayoutStatement_Owed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Status_Field) _Column() string { return "status" }
+func (PayoutStatement_Owed_Field) _Column() string { return "owed" }
 
-type Offer_Type_Field struct {
+type PayoutStatement_GracefulExit_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value bool
 }
 
-func Offer_Type(v int) Offer_Type_Field {
-	return Offer_Type_Field{_set: true, _value: v}
+func PayoutStatement_GracefulExit(v bool) PayoutStatement_GracefulExit_Field {
+	return PayoutStatement_GracefulExit_Field{_set: true, _value: v}
 }
 
-func (f Offer_Type_Field) value() interface{} {
+func (f PayoutStatement_GracefulExit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Type_Field) _Column() string { return "type" }
+func (PayoutStatement_GracefulExit_Field) _Column() string { return "graceful_exit" }
 
 type PeerIdentity struct {
 	NodeId           []byte
@@ -5453,6 +6916,7 @@ type StripecoinpaymentsInvoiceProjectRecord struct {
 	Storage     float64
 	Egress      int64
 	Objects     int64
+	Segments    int64
 	PeriodStart time.Time
 	PeriodEnd   time.Time
 	State       int
@@ -5562,6 +7026,25 @@ func (f StripecoinpaymentsInvoiceProjectRecord_Objects_Field) value() interface{
 
 func (StripecoinpaymentsInvoiceProjectRecord_Objects_Field) _Column() string { return "objects" }
 
+type StripecoinpaymentsInvoiceProjectRecord_Segments_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_Segments(v int64) StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Segments_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_Segments_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsInvoiceProjectRecord_Segments_Field) _Column() string { return "segments" }
+
 type StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field struct {
 	_set   bool
 	_null  bool
@@ -7583,6 +9066,8 @@ func (obj *postgresImpl) CreateNoReturn_Node(ctx context.Context,
 	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
 	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
 	node_exit_success Node_ExitSuccess_Field,
+	node_unknown_audit_reputation_alpha Node_UnknownAuditReputationAlpha_Field,
+	node_unknown_audit_reputation_beta Node_UnknownAuditReputationBeta_Field,
 	optional Node_Create_Fields) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -7623,11 +9108,14 @@ func (obj *postgresImpl) CreateNoReturn_Node(ctx context.Context,
 	__exit_loop_completed_at_val := optional.ExitLoopCompletedAt.value()
 	__exit_finished_at_val := optional.ExitFinishedAt.value()
 	__exit_success_val := node_exit_success.value()
+	__unknown_audit_reputation_alpha_val := node_unknown_audit_reputation_alpha.value()
+	__unknown_audit_reputation_beta_val := node_unknown_audit_reputation_beta.value()
+	__suspended_val := optional.Suspended.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, last_net, protocol, type, email, wallet, free_bandwidth, free_disk, piece_count, major, minor, patch, hash, timestamp, release, latency_90, audit_success_count, total_audit_count, uptime_success_count, total_uptime_count, created_at, updated_at, last_contact_success, last_contact_failure, contained, disqualified, audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta, exit_initiated_at, exit_loop_completed_at, exit_finished_at, exit_success ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, last_net, protocol, type, email, wallet, free_bandwidth, free_disk, piece_count, major, minor, patch, hash, timestamp, release, latency_90, audit_success_count, total_audit_count, uptime_success_count, total_uptime_count, created_at, updated_at, last_contact_success, last_contact_failure, contained, disqualified, audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta, exit_initiated_at, exit_loop_completed_at, exit_finished_at, exit_success, unknown_audit_reputation_alpha, unknown_audit_reputation_beta, suspended ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __address_val, __last_net_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __piece_count_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __uptime_success_count_val, __total_uptime_count_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __exit_initiated_at_val, __exit_loop_completed_at_val, __exit_finished_at_val, __exit_success_val)
+	__values = append(__values, __id_val, __address_val, __last_net_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __piece_count_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __uptime_success_count_val, __total_uptime_count_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __exit_initiated_at_val, __exit_loop_completed_at_val, __exit_finished_at_val, __exit_success_val, __unknown_audit_reputation_alpha_val, __unknown_audit_reputation_beta_val, __suspended_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -8374,6 +9862,7 @@ func (obj *postgresImpl) Create_StripecoinpaymentsInvoiceProjectRecord(ctx conte
 	stripecoinpayments_invoice_project_record_storage StripecoinpaymentsInvoiceProjectRecord_Storage_Field,
 	stripecoinpayments_invoice_project_record_egress StripecoinpaymentsInvoiceProjectRecord_Egress_Field,
 	stripecoinpayments_invoice_project_record_objects StripecoinpaymentsInvoiceProjectRecord_Objects_Field,
+	stripecoinpayments_invoice_project_record_segments StripecoinpaymentsInvoiceProjectRecord_Segments_Field,
 	stripecoinpayments_invoice_project_record_period_start StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field,
 	stripecoinpayments_invoice_project_record_period_end StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field,
 	stripecoinpayments_invoice_project_record_state StripecoinpaymentsInvoiceProjectRecord_State_Field) (
@@ -8386,21 +9875,22 @@ func (obj *postgresImpl) Create_StripecoinpaymentsInvoiceProjectRecord(ctx conte
 	__storage_val := stripecoinpayments_invoice_project_record_storage.value()
 	__egress_val := stripecoinpayments_invoice_project_record_egress.value()
 	__objects_val := stripecoinpayments_invoice_project_record_objects.value()
+	__segments_val := stripecoinpayments_invoice_project_record_segments.value()
 	__period_start_val := stripecoinpayments_invoice_project_record_period_start.value()
 	__period_end_val := stripecoinpayments_invoice_project_record_period_end.value()
 	__state_val := stripecoinpayments_invoice_project_record_state.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO stripecoinpayments_invoice_project_records ( id, project_id, storage, egress, objects, period_start, period_end, state, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO stripecoinpayments_invoice_project_records ( id, project_id, storage, egress, objects, segments, period_start, period_end, state, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.segments, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __storage_val, __egress_val, __objects_val, __period_start_val, __period_end_val, __state_val, __created_at_val)
+	__values = append(__values, __id_val, __project_id_val, __storage_val, __egress_val, __objects_val, __segments_val, __period_start_val, __period_end_val, __state_val, __created_at_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	stripecoinpayments_invoice_project_record = &StripecoinpaymentsInvoiceProjectRecord{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.Segments, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -8795,7 +10285,7 @@ func (obj *postgresImpl) Get_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.suspended FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -8804,7 +10294,7 @@ func (obj *postgresImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.Suspended)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -8850,7 +10340,7 @@ func (obj *postgresImpl) All_Node_By_Wallet_And_Type_OrderBy_Asc_Id(ctx context.
 	rows []*Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.wallet = ? AND nodes.type = ? ORDER BY nodes.id")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.suspended FROM nodes WHERE nodes.wallet = ? AND nodes.type = ? ORDER BY nodes.id")
 
 	var __values []interface{}
 	__values = append(__values, node_wallet.value(), node_type.value())
@@ -8866,7 +10356,7 @@ func (obj *postgresImpl) All_Node_By_Wallet_And_Type_OrderBy_Asc_Id(ctx context.
 
 	for __rows.Next() {
 		node := &Node{}
-		err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+		err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.Suspended)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -8885,7 +10375,7 @@ func (obj *postgresImpl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx co
 	rows []*Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.suspended FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id_greater_or_equal.value())
@@ -8903,7 +10393,7 @@ func (obj *postgresImpl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx co
 
 	for __rows.Next() {
 		node := &Node{}
-		err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+		err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.Suspended)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -10335,6 +11825,28 @@ func (obj *postgresImpl) Get_GracefulExitProgress_By_NodeId(ctx context.Context,
 
 }
 
+func (obj *postgresImpl) Get_PartialExit_By_NodeId(ctx context.Context,
+	partial_exit_node_id PartialExit_NodeId_Field) (
+	partial_exit *PartialExit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT partial_exits.node_id, partial_exits.requested_bytes, partial_exits.queued_bytes, partial_exits.transferred_bytes, partial_exits.requested_at, partial_exits.queued_at, partial_exits.finished_at FROM partial_exits WHERE partial_exits.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, partial_exit_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	partial_exit = &PartialExit{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&partial_exit.NodeId, &partial_exit.RequestedBytes, &partial_exit.QueuedBytes, &partial_exit.TransferredBytes, &partial_exit.RequestedAt, &partial_exit.QueuedAt, &partial_exit.FinishedAt)
+	if err != nil {
+		return (*PartialExit)(nil), obj.makeErr(err)
+	}
+	return partial_exit, nil
+
+}
+
 func (obj *postgresImpl) Get_GracefulExitTransferQueue_By_NodeId_And_Path_And_PieceNum(ctx context.Context,
 	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
 	graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field,
@@ -10533,7 +12045,7 @@ func (obj *postgresImpl) Get_StripecoinpaymentsInvoiceProjectRecord_By_ProjectId
 	stripecoinpayments_invoice_project_record *StripecoinpaymentsInvoiceProjectRecord, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at FROM stripecoinpayments_invoice_project_records WHERE stripecoinpayments_invoice_project_records.project_id = ? AND stripecoinpayments_invoice_project_records.period_start = ? AND stripecoinpayments_invoice_project_records.period_end = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.segments, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at FROM stripecoinpayments_invoice_project_records WHERE stripecoinpayments_invoice_project_records.project_id = ? AND stripecoinpayments_invoice_project_records.period_start = ? AND stripecoinpayments_invoice_project_records.period_end = ?")
 
 	var __values []interface{}
 	__values = append(__values, stripecoinpayments_invoice_project_record_project_id.value(), stripecoinpayments_invoice_project_record_period_start.value(), stripecoinpayments_invoice_project_record_period_end.value())
@@ -10542,7 +12054,7 @@ func (obj *postgresImpl) Get_StripecoinpaymentsInvoiceProjectRecord_By_ProjectId
 	obj.logStmt(__stmt, __values...)
 
 	stripecoinpayments_invoice_project_record = &StripecoinpaymentsInvoiceProjectRecord{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.Segments, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
 	if err != nil {
 		return (*StripecoinpaymentsInvoiceProjectRecord)(nil), obj.makeErr(err)
 	}
@@ -10557,7 +12069,7 @@ func (obj *postgresImpl) Limited_StripecoinpaymentsInvoiceProjectRecord_By_Creat
 	rows []*StripecoinpaymentsInvoiceProjectRecord, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at FROM stripecoinpayments_invoice_project_records WHERE stripecoinpayments_invoice_project_records.created_at <= ? AND stripecoinpayments_invoice_project_records.state = ? ORDER BY stripecoinpayments_invoice_project_records.created_at DESC LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.segments, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at FROM stripecoinpayments_invoice_project_records WHERE stripecoinpayments_invoice_project_records.created_at <= ? AND stripecoinpayments_invoice_project_records.state = ? ORDER BY stripecoinpayments_invoice_project_records.created_at DESC LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, stripecoinpayments_invoice_project_record_created_at_less_or_equal.value(), stripecoinpayments_invoice_project_record_state.value())
@@ -10575,7 +12087,7 @@ func (obj *postgresImpl) Limited_StripecoinpaymentsInvoiceProjectRecord_By_Creat
 
 	for __rows.Next() {
 		stripecoinpayments_invoice_project_record := &StripecoinpaymentsInvoiceProjectRecord{}
-		err = __rows.Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
+		err = __rows.Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.Segments, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -11147,7 +12659,7 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.suspended")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -11313,6 +12825,21 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
 	}
 
+	if update.UnknownAuditReputationAlpha._set {
+		__values = append(__values, update.UnknownAuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
+	}
+
+	if update.UnknownAuditReputationBeta._set {
+		__values = append(__values, update.UnknownAuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
+	}
+
+	if update.Suspended._set {
+		__values = append(__values, update.Suspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("suspended = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -11327,7 +12854,7 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.Suspended)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -11510,6 +13037,21 @@ func (obj *postgresImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
 	}
 
+	if update.UnknownAuditReputationAlpha._set {
+		__values = append(__values, update.UnknownAuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
+	}
+
+	if update.UnknownAuditReputationBeta._set {
+		__values = append(__values, update.UnknownAuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
+	}
+
+	if update.Suspended._set {
+		__values = append(__values, update.Suspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("suspended = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -12148,7 +13690,7 @@ func (obj *postgresImpl) Update_StripecoinpaymentsInvoiceProjectRecord_By_Id(ctx
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE stripecoinpayments_invoice_project_records SET "), __sets, __sqlbundle_Literal(" WHERE stripecoinpayments_invoice_project_records.id = ? RETURNING stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE stripecoinpayments_invoice_project_records SET "), __sets, __sqlbundle_Literal(" WHERE stripecoinpayments_invoice_project_records.id = ? RETURNING stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.segments, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -12172,7 +13714,7 @@ func (obj *postgresImpl) Update_StripecoinpaymentsInvoiceProjectRecord_By_Id(ctx
 	obj.logStmt(__stmt, __values...)
 
 	stripecoinpayments_invoice_project_record = &StripecoinpaymentsInvoiceProjectRecord{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.Segments, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -12583,10 +14125,202 @@ func (obj *postgresImpl) Delete_ReportedSerial_By_ExpiresAt_LessOrEqual(ctx cont
 	count int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM reported_serials WHERE reported_serials.expires_at <= ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM reported_serials WHERE reported_serials.expires_at <= ?")
+
+	var __values []interface{}
+	__values = append(__values, reported_serial_expires_at_less_or_equal.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *postgresImpl) Delete_StoragenodeStorageTally_By_Id(ctx context.Context,
+	storagenode_storage_tally_id StoragenodeStorageTally_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM storagenode_storage_tallies WHERE storagenode_storage_tallies.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, storagenode_storage_tally_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_ResetPasswordToken_By_Secret(ctx context.Context,
+	reset_password_token_secret ResetPasswordToken_Secret_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM reset_password_tokens WHERE reset_password_tokens.secret = ?")
+
+	var __values []interface{}
+	__values = append(__values, reset_password_token_secret.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_GracefulExitProgress_By_NodeId(ctx context.Context,
+	graceful_exit_progress_node_id GracefulExitProgress_NodeId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_progress WHERE graceful_exit_progress.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, graceful_exit_progress_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_GracefulExitTransferQueue_By_NodeId(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, graceful_exit_transfer_queue_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *postgresImpl) Delete_GracefulExitTransferQueue_By_NodeId_And_Path_And_PieceNum(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+	graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field,
+	graceful_exit_transfer_queue_piece_num GracefulExitTransferQueue_PieceNum_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.path = ? AND graceful_exit_transfer_queue.piece_num = ?")
+
+	var __values []interface{}
+	__values = append(__values, graceful_exit_transfer_queue_node_id.value(), graceful_exit_transfer_queue_path.value(), graceful_exit_transfer_queue_piece_num.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_GracefulExitTransferQueue_By_NodeId_And_FinishedAt_IsNot_Null(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.finished_at is not NULL")
 
 	var __values []interface{}
-	__values = append(__values, reported_serial_expires_at_less_or_equal.value())
+	__values = append(__values, graceful_exit_transfer_queue_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -12605,15 +14339,15 @@ func (obj *postgresImpl) Delete_ReportedSerial_By_ExpiresAt_LessOrEqual(ctx cont
 
 }
 
-func (obj *postgresImpl) Delete_StoragenodeStorageTally_By_Id(ctx context.Context,
-	storagenode_storage_tally_id StoragenodeStorageTally_Id_Field) (
+func (obj *postgresImpl) Delete_StripecoinpaymentsApplyBalanceIntent_By_TxId(ctx context.Context,
+	stripecoinpayments_apply_balance_intent_tx_id StripecoinpaymentsApplyBalanceIntent_TxId_Field) (
 	deleted bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM storagenode_storage_tallies WHERE storagenode_storage_tallies.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM stripecoinpayments_apply_balance_intents WHERE stripecoinpayments_apply_balance_intents.tx_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, storagenode_storage_tally_id.value())
+	__values = append(__values, stripecoinpayments_apply_balance_intent_tx_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -12632,15 +14366,15 @@ func (obj *postgresImpl) Delete_StoragenodeStorageTally_By_Id(ctx context.Contex
 
 }
 
-func (obj *postgresImpl) Delete_ResetPasswordToken_By_Secret(ctx context.Context,
-	reset_password_token_secret ResetPasswordToken_Secret_Field) (
+func (obj *postgresImpl) Delete_StripecoinpaymentsInvoiceProjectRecord_By_Id(ctx context.Context,
+	stripecoinpayments_invoice_project_record_id StripecoinpaymentsInvoiceProjectRecord_Id_Field) (
 	deleted bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM reset_password_tokens WHERE reset_password_tokens.secret = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM stripecoinpayments_invoice_project_records WHERE stripecoinpayments_invoice_project_records.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, reset_password_token_secret.value())
+	__values = append(__values, stripecoinpayments_invoice_project_record_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -12659,16 +14393,15 @@ func (obj *postgresImpl) Delete_ResetPasswordToken_By_Secret(ctx context.Context
 
 }
 
-func (obj *postgresImpl) Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
-	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
-	bucket_metainfo_name BucketMetainfo_Name_Field) (
+func (obj *postgresImpl) Delete_Coupon_By_Id(ctx context.Context,
+	coupon_id Coupon_Id_Field) (
 	deleted bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM coupons WHERE coupons.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+	__values = append(__values, coupon_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -12687,71 +14420,83 @@ func (obj *postgresImpl) Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context
 
 }
 
-func (obj *postgresImpl) Delete_GracefulExitProgress_By_NodeId(ctx context.Context,
-	graceful_exit_progress_node_id GracefulExitProgress_NodeId_Field) (
-	deleted bool, err error) {
+func (impl postgresImpl) isConstraintError(err error) (
+	constraint string, ok bool) {
+	if e, ok := err.(*pq.Error); ok {
+		if e.Code.Class() == "23" {
+			return e.Constraint, true
+		}
+	}
+	return "", false
+}
+
+func (obj *postgresImpl) Get_BucketUsageLimit_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_usage_limit_project_id BucketUsageLimit_ProjectId_Field,
+	bucket_usage_limit_bucket_name BucketUsageLimit_BucketName_Field) (
+	bucket_usage_limit *BucketUsageLimit, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_progress WHERE graceful_exit_progress.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_usage_limits.project_id, bucket_usage_limits.bucket_name, bucket_usage_limits.storage_limit, bucket_usage_limits.bandwidth_limit, bucket_usage_limits.object_limit, bucket_usage_limits.created_at, bucket_usage_limits.updated_at FROM bucket_usage_limits WHERE bucket_usage_limits.project_id = ? AND bucket_usage_limits.bucket_name = ?")
 
 	var __values []interface{}
-	__values = append(__values, graceful_exit_progress_node_id.value())
+	__values = append(__values, bucket_usage_limit_project_id.value(), bucket_usage_limit_bucket_name.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
-
-	__count, err := __res.RowsAffected()
+	bucket_usage_limit = &BucketUsageLimit{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&bucket_usage_limit.ProjectId, &bucket_usage_limit.BucketName, &bucket_usage_limit.StorageLimit, &bucket_usage_limit.BandwidthLimit, &bucket_usage_limit.ObjectLimit, &bucket_usage_limit.CreatedAt, &bucket_usage_limit.UpdatedAt)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return (*BucketUsageLimit)(nil), obj.makeErr(err)
 	}
-
-	return __count > 0, nil
+	return bucket_usage_limit, nil
 
 }
 
-func (obj *postgresImpl) Delete_GracefulExitTransferQueue_By_NodeId(ctx context.Context,
-	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field) (
-	count int64, err error) {
+func (obj *postgresImpl) All_BucketUsageLimit_By_ProjectId_OrderBy_Asc_BucketName(ctx context.Context,
+	bucket_usage_limit_project_id BucketUsageLimit_ProjectId_Field) (
+	rows []*BucketUsageLimit, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_usage_limits.project_id, bucket_usage_limits.bucket_name, bucket_usage_limits.storage_limit, bucket_usage_limits.bandwidth_limit, bucket_usage_limits.object_limit, bucket_usage_limits.created_at, bucket_usage_limits.updated_at FROM bucket_usage_limits WHERE bucket_usage_limits.project_id = ? ORDER BY bucket_usage_limits.bucket_name")
 
 	var __values []interface{}
-	__values = append(__values, graceful_exit_transfer_queue_node_id.value())
+	__values = append(__values, bucket_usage_limit_project_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 	if err != nil {
-		return 0, obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
+	defer __rows.Close()
 
-	count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
+	for __rows.Next() {
+		bucket_usage_limit := &BucketUsageLimit{}
+		err = __rows.Scan(&bucket_usage_limit.ProjectId, &bucket_usage_limit.BucketName, &bucket_usage_limit.StorageLimit, &bucket_usage_limit.BandwidthLimit, &bucket_usage_limit.ObjectLimit, &bucket_usage_limit.CreatedAt, &bucket_usage_limit.UpdatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_usage_limit)
 	}
-
-	return count, nil
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *postgresImpl) Delete_GracefulExitTransferQueue_By_NodeId_And_Path_And_PieceNum(ctx context.Context,
-	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
-	graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field,
-	graceful_exit_transfer_queue_piece_num GracefulExitTransferQueue_PieceNum_Field) (
+func (obj *postgresImpl) Delete_BucketUsageLimit_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_usage_limit_project_id BucketUsageLimit_ProjectId_Field,
+	bucket_usage_limit_bucket_name BucketUsageLimit_BucketName_Field) (
 	deleted bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.path = ? AND graceful_exit_transfer_queue.piece_num = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_usage_limits WHERE bucket_usage_limits.project_id = ? AND bucket_usage_limits.bucket_name = ?")
 
 	var __values []interface{}
-	__values = append(__values, graceful_exit_transfer_queue_node_id.value(), graceful_exit_transfer_queue_path.value(), graceful_exit_transfer_queue_piece_num.value())
+	__values = append(__values, bucket_usage_limit_project_id.value(), bucket_usage_limit_bucket_name.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -12770,96 +14515,166 @@ func (obj *postgresImpl) Delete_GracefulExitTransferQueue_By_NodeId_And_Path_And
 
 }
 
-func (obj *postgresImpl) Delete_GracefulExitTransferQueue_By_NodeId_And_FinishedAt_IsNot_Null(ctx context.Context,
-	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field) (
-	count int64, err error) {
+func (obj *postgresImpl) All_BucketCountRollup_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Asc_IntervalStart(ctx context.Context,
+	bucket_count_rollup_project_id BucketCountRollup_ProjectId_Field,
+	bucket_count_rollup_bucket_name BucketCountRollup_BucketName_Field,
+	bucket_count_rollup_interval_start_greater_or_equal BucketCountRollup_IntervalStart_Field,
+	bucket_count_rollup_interval_start_less_or_equal BucketCountRollup_IntervalStart_Field) (
+	rows []*BucketCountRollup, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.finished_at is not NULL")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_count_rollups.project_id, bucket_count_rollups.bucket_name, bucket_count_rollups.interval_start, bucket_count_rollups.object_count, bucket_count_rollups.inline_segments_count, bucket_count_rollups.remote_segments_count, bucket_count_rollups.object_hours, bucket_count_rollups.inline_segment_hours, bucket_count_rollups.remote_segment_hours, bucket_count_rollups.inline_byte_hours, bucket_count_rollups.remote_byte_hours FROM bucket_count_rollups WHERE bucket_count_rollups.project_id = ? AND bucket_count_rollups.bucket_name = ? AND bucket_count_rollups.interval_start >= ? AND bucket_count_rollups.interval_start <= ? ORDER BY bucket_count_rollups.interval_start")
 
 	var __values []interface{}
-	__values = append(__values, graceful_exit_transfer_queue_node_id.value())
+	__values = append(__values, bucket_count_rollup_project_id.value(), bucket_count_rollup_bucket_name.value(), bucket_count_rollup_interval_start_greater_or_equal.value(), bucket_count_rollup_interval_start_less_or_equal.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 	if err != nil {
-		return 0, obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
+	defer __rows.Close()
 
-	count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
+	for __rows.Next() {
+		bucket_count_rollup := &BucketCountRollup{}
+		err = __rows.Scan(&bucket_count_rollup.ProjectId, &bucket_count_rollup.BucketName, &bucket_count_rollup.IntervalStart, &bucket_count_rollup.ObjectCount, &bucket_count_rollup.InlineSegmentsCount, &bucket_count_rollup.RemoteSegmentsCount, &bucket_count_rollup.ObjectHours, &bucket_count_rollup.InlineSegmentHours, &bucket_count_rollup.RemoteSegmentHours, &bucket_count_rollup.InlineByteHours, &bucket_count_rollup.RemoteByteHours)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_count_rollup)
 	}
-
-	return count, nil
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *postgresImpl) Delete_StripecoinpaymentsApplyBalanceIntent_By_TxId(ctx context.Context,
-	stripecoinpayments_apply_balance_intent_tx_id StripecoinpaymentsApplyBalanceIntent_TxId_Field) (
-	deleted bool, err error) {
+func (obj *postgresImpl) Get_PayoutStatement_By_NodeId_And_Period(ctx context.Context,
+	payout_statement_node_id PayoutStatement_NodeId_Field,
+	payout_statement_period PayoutStatement_Period_Field) (
+	payout_statement *PayoutStatement, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM stripecoinpayments_apply_balance_intents WHERE stripecoinpayments_apply_balance_intents.tx_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_statements.node_id, payout_statements.period, payout_statements.created_at, payout_statements.node_created_at, payout_statements.node_age_months, payout_statements.wallet, payout_statements.usage_at_rest, payout_statements.usage_put, payout_statements.usage_get, payout_statements.usage_put_repair, payout_statements.usage_get_repair, payout_statements.usage_get_audit, payout_statements.comp_at_rest, payout_statements.comp_put, payout_statements.comp_get, payout_statements.comp_put_repair, payout_statements.comp_get_repair, payout_statements.comp_get_audit, payout_statements.held_percent, payout_statements.held, payout_statements.disposed, payout_statements.owed, payout_statements.graceful_exit FROM payout_statements WHERE payout_statements.node_id = ? AND payout_statements.period = ?")
 
 	var __values []interface{}
-	__values = append(__values, stripecoinpayments_apply_balance_intent_tx_id.value())
+	__values = append(__values, payout_statement_node_id.value(), payout_statement_period.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	payout_statement = &PayoutStatement{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&payout_statement.NodeId, &payout_statement.Period, &payout_statement.CreatedAt, &payout_statement.NodeCreatedAt, &payout_statement.NodeAgeMonths, &payout_statement.Wallet, &payout_statement.UsageAtRest, &payout_statement.UsagePut, &payout_statement.UsageGet, &payout_statement.UsagePutRepair, &payout_statement.UsageGetRepair, &payout_statement.UsageGetAudit, &payout_statement.CompAtRest, &payout_statement.CompPut, &payout_statement.CompGet, &payout_statement.CompPutRepair, &payout_statement.CompGetRepair, &payout_statement.CompGetAudit, &payout_statement.HeldPercent, &payout_statement.Held, &payout_statement.Disposed, &payout_statement.Owed, &payout_statement.GracefulExit)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return (*PayoutStatement)(nil), obj.makeErr(err)
 	}
+	return payout_statement, nil
 
-	__count, err := __res.RowsAffected()
+}
+
+func (obj *postgresImpl) All_PayoutStatement_By_NodeId_And_Period_GreaterOrEqual_OrderBy_Asc_Period(ctx context.Context,
+	payout_statement_node_id PayoutStatement_NodeId_Field,
+	payout_statement_period_greater_or_equal PayoutStatement_Period_Field) (
+	rows []*PayoutStatement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_statements.node_id, payout_statements.period, payout_statements.created_at, payout_statements.node_created_at, payout_statements.node_age_months, payout_statements.wallet, payout_statements.usage_at_rest, payout_statements.usage_put, payout_statements.usage_get, payout_statements.usage_put_repair, payout_statements.usage_get_repair, payout_statements.usage_get_audit, payout_statements.comp_at_rest, payout_statements.comp_put, payout_statements.comp_get, payout_statements.comp_put_repair, payout_statements.comp_get_repair, payout_statements.comp_get_audit, payout_statements.held_percent, payout_statements.held, payout_statements.disposed, payout_statements.owed, payout_statements.graceful_exit FROM payout_statements WHERE payout_statements.node_id = ? AND payout_statements.period >= ? ORDER BY payout_statements.period")
+
+	var __values []interface{}
+	__values = append(__values, payout_statement_node_id.value(), payout_statement_period_greater_or_equal.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
+	defer __rows.Close()
 
-	return __count > 0, nil
+	for __rows.Next() {
+		payout_statement := &PayoutStatement{}
+		err = __rows.Scan(&payout_statement.NodeId, &payout_statement.Period, &payout_statement.CreatedAt, &payout_statement.NodeCreatedAt, &payout_statement.NodeAgeMonths, &payout_statement.Wallet, &payout_statement.UsageAtRest, &payout_statement.UsagePut, &payout_statement.UsageGet, &payout_statement.UsagePutRepair, &payout_statement.UsageGetRepair, &payout_statement.UsageGetAudit, &payout_statement.CompAtRest, &payout_statement.CompPut, &payout_statement.CompGet, &payout_statement.CompPutRepair, &payout_statement.CompGetRepair, &payout_statement.CompGetAudit, &payout_statement.HeldPercent, &payout_statement.Held, &payout_statement.Disposed, &payout_statement.Owed, &payout_statement.GracefulExit)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, payout_statement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *postgresImpl) Delete_StripecoinpaymentsInvoiceProjectRecord_By_Id(ctx context.Context,
-	stripecoinpayments_invoice_project_record_id StripecoinpaymentsInvoiceProjectRecord_Id_Field) (
-	deleted bool, err error) {
+func (obj *postgresImpl) All_PayoutStatement_By_Period_OrderBy_Asc_NodeId(ctx context.Context,
+	payout_statement_period PayoutStatement_Period_Field) (
+	rows []*PayoutStatement, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM stripecoinpayments_invoice_project_records WHERE stripecoinpayments_invoice_project_records.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_statements.node_id, payout_statements.period, payout_statements.created_at, payout_statements.node_created_at, payout_statements.node_age_months, payout_statements.wallet, payout_statements.usage_at_rest, payout_statements.usage_put, payout_statements.usage_get, payout_statements.usage_put_repair, payout_statements.usage_get_repair, payout_statements.usage_get_audit, payout_statements.comp_at_rest, payout_statements.comp_put, payout_statements.comp_get, payout_statements.comp_put_repair, payout_statements.comp_get_repair, payout_statements.comp_get_audit, payout_statements.held_percent, payout_statements.held, payout_statements.disposed, payout_statements.owed, payout_statements.graceful_exit FROM payout_statements WHERE payout_statements.period = ? ORDER BY payout_statements.node_id")
 
 	var __values []interface{}
-	__values = append(__values, stripecoinpayments_invoice_project_record_id.value())
+	__values = append(__values, payout_statement_period.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
+	defer __rows.Close()
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
+	for __rows.Next() {
+		payout_statement := &PayoutStatement{}
+		err = __rows.Scan(&payout_statement.NodeId, &payout_statement.Period, &payout_statement.CreatedAt, &payout_statement.NodeCreatedAt, &payout_statement.NodeAgeMonths, &payout_statement.Wallet, &payout_statement.UsageAtRest, &payout_statement.UsagePut, &payout_statement.UsageGet, &payout_statement.UsagePutRepair, &payout_statement.UsageGetRepair, &payout_statement.UsageGetAudit, &payout_statement.CompAtRest, &payout_statement.CompPut, &payout_statement.CompGet, &payout_statement.CompPutRepair, &payout_statement.CompGetRepair, &payout_statement.CompGetAudit, &payout_statement.HeldPercent, &payout_statement.Held, &payout_statement.Disposed, &payout_statement.Owed, &payout_statement.GracefulExit)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, payout_statement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return rows, nil
 
-	return __count > 0, nil
+}
+
+func (obj *postgresImpl) Get_GracefulExitPause_By_NodeId(ctx context.Context,
+	graceful_exit_pause_node_id GracefulExitPause_NodeId_Field) (
+	graceful_exit_pause *GracefulExitPause, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT graceful_exit_pauses.node_id, graceful_exit_pauses.paused_at, graceful_exit_pauses.paused_duration FROM graceful_exit_pauses WHERE graceful_exit_pauses.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, graceful_exit_pause_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	graceful_exit_pause = &GracefulExitPause{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&graceful_exit_pause.NodeId, &graceful_exit_pause.PausedAt, &graceful_exit_pause.PausedDuration)
+	if err != nil {
+		return (*GracefulExitPause)(nil), obj.makeErr(err)
+	}
+	return graceful_exit_pause, nil
 
 }
 
-func (obj *postgresImpl) Delete_Coupon_By_Id(ctx context.Context,
-	coupon_id Coupon_Id_Field) (
+func (obj *postgresImpl) Delete_GracefulExitPause_By_NodeId(ctx context.Context,
+	graceful_exit_pause_node_id GracefulExitPause_NodeId_Field) (
 	deleted bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM coupons WHERE coupons.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_pauses WHERE graceful_exit_pauses.node_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, coupon_id.value())
+	__values = append(__values, graceful_exit_pause_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -12878,20 +14693,60 @@ func (obj *postgresImpl) Delete_Coupon_By_Id(ctx context.Context,
 
 }
 
-func (impl postgresImpl) isConstraintError(err error) (
-	constraint string, ok bool) {
-	if e, ok := err.(*pq.Error); ok {
-		if e.Code.Class() == "23" {
-			return e.Constraint, true
-		}
-	}
-	return "", false
-}
-
 func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_pauses;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM corrupt_pieces;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM payout_statements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_count_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_usage_limits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_credits;")
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -13097,6 +14952,16 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM partial_exits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -13451,6 +15316,8 @@ func (obj *cockroachImpl) CreateNoReturn_Node(ctx context.Context,
 	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
 	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
 	node_exit_success Node_ExitSuccess_Field,
+	node_unknown_audit_reputation_alpha Node_UnknownAuditReputationAlpha_Field,
+	node_unknown_audit_reputation_beta Node_UnknownAuditReputationBeta_Field,
 	optional Node_Create_Fields) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -13491,11 +15358,14 @@ func (obj *cockroachImpl) CreateNoReturn_Node(ctx context.Context,
 	__exit_loop_completed_at_val := optional.ExitLoopCompletedAt.value()
 	__exit_finished_at_val := optional.ExitFinishedAt.value()
 	__exit_success_val := node_exit_success.value()
+	__unknown_audit_reputation_alpha_val := node_unknown_audit_reputation_alpha.value()
+	__unknown_audit_reputation_beta_val := node_unknown_audit_reputation_beta.value()
+	__suspended_val := optional.Suspended.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, last_net, protocol, type, email, wallet, free_bandwidth, free_disk, piece_count, major, minor, patch, hash, timestamp, release, latency_90, audit_success_count, total_audit_count, uptime_success_count, total_uptime_count, created_at, updated_at, last_contact_success, last_contact_failure, contained, disqualified, audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta, exit_initiated_at, exit_loop_completed_at, exit_finished_at, exit_success ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, last_net, protocol, type, email, wallet, free_bandwidth, free_disk, piece_count, major, minor, patch, hash, timestamp, release, latency_90, audit_success_count, total_audit_count, uptime_success_count, total_uptime_count, created_at, updated_at, last_contact_success, last_contact_failure, contained, disqualified, audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta, exit_initiated_at, exit_loop_completed_at, exit_finished_at, exit_success, unknown_audit_reputation_alpha, unknown_audit_reputation_beta, suspended ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __address_val, __last_net_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __piece_count_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __uptime_success_count_val, __total_uptime_count_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __exit_initiated_at_val, __exit_loop_completed_at_val, __exit_finished_at_val, __exit_success_val)
+	__values = append(__values, __id_val, __address_val, __last_net_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __piece_count_val, __major_val, __minor_val, __patch_val, __hash_val, __timestamp_val, __release_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __uptime_success_count_val, __total_uptime_count_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __contained_val, __disqualified_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __exit_initiated_at_val, __exit_loop_completed_at_val, __exit_finished_at_val, __exit_success_val, __unknown_audit_reputation_alpha_val, __unknown_audit_reputation_beta_val, __suspended_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -14242,6 +16112,7 @@ func (obj *cockroachImpl) Create_StripecoinpaymentsInvoiceProjectRecord(ctx cont
 	stripecoinpayments_invoice_project_record_storage StripecoinpaymentsInvoiceProjectRecord_Storage_Field,
 	stripecoinpayments_invoice_project_record_egress StripecoinpaymentsInvoiceProjectRecord_Egress_Field,
 	stripecoinpayments_invoice_project_record_objects StripecoinpaymentsInvoiceProjectRecord_Objects_Field,
+	stripecoinpayments_invoice_project_record_segments StripecoinpaymentsInvoiceProjectRecord_Segments_Field,
 	stripecoinpayments_invoice_project_record_period_start StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field,
 	stripecoinpayments_invoice_project_record_period_end StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field,
 	stripecoinpayments_invoice_project_record_state StripecoinpaymentsInvoiceProjectRecord_State_Field) (
@@ -14254,21 +16125,22 @@ func (obj *cockroachImpl) Create_StripecoinpaymentsInvoiceProjectRecord(ctx cont
 	__storage_val := stripecoinpayments_invoice_project_record_storage.value()
 	__egress_val := stripecoinpayments_invoice_project_record_egress.value()
 	__objects_val := stripecoinpayments_invoice_project_record_objects.value()
+	__segments_val := stripecoinpayments_invoice_project_record_segments.value()
 	__period_start_val := stripecoinpayments_invoice_project_record_period_start.value()
 	__period_end_val := stripecoinpayments_invoice_project_record_period_end.value()
 	__state_val := stripecoinpayments_invoice_project_record_state.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO stripecoinpayments_invoice_project_records ( id, project_id, storage, egress, objects, period_start, period_end, state, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO stripecoinpayments_invoice_project_records ( id, project_id, storage, egress, objects, segments, period_start, period_end, state, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.segments, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __storage_val, __egress_val, __objects_val, __period_start_val, __period_end_val, __state_val, __created_at_val)
+	__values = append(__values, __id_val, __project_id_val, __storage_val, __egress_val, __objects_val, __segments_val, __period_start_val, __period_end_val, __state_val, __created_at_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	stripecoinpayments_invoice_project_record = &StripecoinpaymentsInvoiceProjectRecord{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.Segments, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -14663,7 +16535,7 @@ func (obj *cockroachImpl) Get_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.suspended FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -14672,7 +16544,7 @@ func (obj *cockroachImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.Suspended)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -14718,7 +16590,7 @@ func (obj *cockroachImpl) All_Node_By_Wallet_And_Type_OrderBy_Asc_Id(ctx context
 	rows []*Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.wallet = ? AND nodes.type = ? ORDER BY nodes.id")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.suspended FROM nodes WHERE nodes.wallet = ? AND nodes.type = ? ORDER BY nodes.id")
 
 	var __values []interface{}
 	__values = append(__values, node_wallet.value(), node_type.value())
@@ -14734,7 +16606,7 @@ func (obj *cockroachImpl) All_Node_By_Wallet_And_Type_OrderBy_Asc_Id(ctx context
 
 	for __rows.Next() {
 		node := &Node{}
-		err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+		err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.Suspended)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -14753,7 +16625,7 @@ func (obj *cockroachImpl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx c
 	rows []*Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.suspended FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id_greater_or_equal.value())
@@ -14771,7 +16643,7 @@ func (obj *cockroachImpl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx c
 
 	for __rows.Next() {
 		node := &Node{}
-		err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+		err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.Suspended)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -16186,20 +18058,42 @@ func (obj *cockroachImpl) Get_GracefulExitProgress_By_NodeId(ctx context.Context
 	graceful_exit_progress *GracefulExitProgress, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT graceful_exit_progress.node_id, graceful_exit_progress.bytes_transferred, graceful_exit_progress.pieces_transferred, graceful_exit_progress.pieces_failed, graceful_exit_progress.updated_at FROM graceful_exit_progress WHERE graceful_exit_progress.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT graceful_exit_progress.node_id, graceful_exit_progress.bytes_transferred, graceful_exit_progress.pieces_transferred, graceful_exit_progress.pieces_failed, graceful_exit_progress.updated_at FROM graceful_exit_progress WHERE graceful_exit_progress.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, graceful_exit_progress_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	graceful_exit_progress = &GracefulExitProgress{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&graceful_exit_progress.NodeId, &graceful_exit_progress.BytesTransferred, &graceful_exit_progress.PiecesTransferred, &graceful_exit_progress.PiecesFailed, &graceful_exit_progress.UpdatedAt)
+	if err != nil {
+		return (*GracefulExitProgress)(nil), obj.makeErr(err)
+	}
+	return graceful_exit_progress, nil
+
+}
+
+func (obj *cockroachImpl) Get_PartialExit_By_NodeId(ctx context.Context,
+	partial_exit_node_id PartialExit_NodeId_Field) (
+	partial_exit *PartialExit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT partial_exits.node_id, partial_exits.requested_bytes, partial_exits.queued_bytes, partial_exits.transferred_bytes, partial_exits.requested_at, partial_exits.queued_at, partial_exits.finished_at FROM partial_exits WHERE partial_exits.node_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, graceful_exit_progress_node_id.value())
+	__values = append(__values, partial_exit_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	graceful_exit_progress = &GracefulExitProgress{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&graceful_exit_progress.NodeId, &graceful_exit_progress.BytesTransferred, &graceful_exit_progress.PiecesTransferred, &graceful_exit_progress.PiecesFailed, &graceful_exit_progress.UpdatedAt)
+	partial_exit = &PartialExit{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&partial_exit.NodeId, &partial_exit.RequestedBytes, &partial_exit.QueuedBytes, &partial_exit.TransferredBytes, &partial_exit.RequestedAt, &partial_exit.QueuedAt, &partial_exit.FinishedAt)
 	if err != nil {
-		return (*GracefulExitProgress)(nil), obj.makeErr(err)
+		return (*PartialExit)(nil), obj.makeErr(err)
 	}
-	return graceful_exit_progress, nil
+	return partial_exit, nil
 
 }
 
@@ -16401,7 +18295,7 @@ func (obj *cockroachImpl) Get_StripecoinpaymentsInvoiceProjectRecord_By_ProjectI
 	stripecoinpayments_invoice_project_record *StripecoinpaymentsInvoiceProjectRecord, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at FROM stripecoinpayments_invoice_project_records WHERE stripecoinpayments_invoice_project_records.project_id = ? AND stripecoinpayments_invoice_project_records.period_start = ? AND stripecoinpayments_invoice_project_records.period_end = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.segments, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at FROM stripecoinpayments_invoice_project_records WHERE stripecoinpayments_invoice_project_records.project_id = ? AND stripecoinpayments_invoice_project_records.period_start = ? AND stripecoinpayments_invoice_project_records.period_end = ?")
 
 	var __values []interface{}
 	__values = append(__values, stripecoinpayments_invoice_project_record_project_id.value(), stripecoinpayments_invoice_project_record_period_start.value(), stripecoinpayments_invoice_project_record_period_end.value())
@@ -16410,7 +18304,7 @@ func (obj *cockroachImpl) Get_StripecoinpaymentsInvoiceProjectRecord_By_ProjectI
 	obj.logStmt(__stmt, __values...)
 
 	stripecoinpayments_invoice_project_record = &StripecoinpaymentsInvoiceProjectRecord{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.Segments, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
 	if err != nil {
 		return (*StripecoinpaymentsInvoiceProjectRecord)(nil), obj.makeErr(err)
 	}
@@ -16425,7 +18319,7 @@ func (obj *cockroachImpl) Limited_StripecoinpaymentsInvoiceProjectRecord_By_Crea
 	rows []*StripecoinpaymentsInvoiceProjectRecord, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at FROM stripecoinpayments_invoice_project_records WHERE stripecoinpayments_invoice_project_records.created_at <= ? AND stripecoinpayments_invoice_project_records.state = ? ORDER BY stripecoinpayments_invoice_project_records.created_at DESC LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.segments, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at FROM stripecoinpayments_invoice_project_records WHERE stripecoinpayments_invoice_project_records.created_at <= ? AND stripecoinpayments_invoice_project_records.state = ? ORDER BY stripecoinpayments_invoice_project_records.created_at DESC LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, stripecoinpayments_invoice_project_record_created_at_less_or_equal.value(), stripecoinpayments_invoice_project_record_state.value())
@@ -16443,7 +18337,7 @@ func (obj *cockroachImpl) Limited_StripecoinpaymentsInvoiceProjectRecord_By_Crea
 
 	for __rows.Next() {
 		stripecoinpayments_invoice_project_record := &StripecoinpaymentsInvoiceProjectRecord{}
-		err = __rows.Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
+		err = __rows.Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.Segments, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -17015,7 +18909,7 @@ func (obj *cockroachImpl) Update_Node_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.uptime_success_count, nodes.total_uptime_count, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.suspended")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -17181,6 +19075,21 @@ func (obj *cockroachImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
 	}
 
+	if update.UnknownAuditReputationAlpha._set {
+		__values = append(__values, update.UnknownAuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
+	}
+
+	if update.UnknownAuditReputationBeta._set {
+		__values = append(__values, update.UnknownAuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
+	}
+
+	if update.Suspended._set {
+		__values = append(__values, update.Suspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("suspended = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -17195,7 +19104,7 @@ func (obj *cockroachImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.Suspended)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -17378,6 +19287,21 @@ func (obj *cockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
 	}
 
+	if update.UnknownAuditReputationAlpha._set {
+		__values = append(__values, update.UnknownAuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
+	}
+
+	if update.UnknownAuditReputationBeta._set {
+		__values = append(__values, update.UnknownAuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
+	}
+
+	if update.Suspended._set {
+		__values = append(__values, update.Suspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("suspended = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -18016,7 +19940,7 @@ func (obj *cockroachImpl) Update_StripecoinpaymentsInvoiceProjectRecord_By_Id(ct
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE stripecoinpayments_invoice_project_records SET "), __sets, __sqlbundle_Literal(" WHERE stripecoinpayments_invoice_project_records.id = ? RETURNING stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE stripecoinpayments_invoice_project_records SET "), __sets, __sqlbundle_Literal(" WHERE stripecoinpayments_invoice_project_records.id = ? RETURNING stripecoinpayments_invoice_project_records.id, stripecoinpayments_invoice_project_records.project_id, stripecoinpayments_invoice_project_records.storage, stripecoinpayments_invoice_project_records.egress, stripecoinpayments_invoice_project_records.objects, stripecoinpayments_invoice_project_records.segments, stripecoinpayments_invoice_project_records.period_start, stripecoinpayments_invoice_project_records.period_end, stripecoinpayments_invoice_project_records.state, stripecoinpayments_invoice_project_records.created_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -18040,7 +19964,7 @@ func (obj *cockroachImpl) Update_StripecoinpaymentsInvoiceProjectRecord_By_Id(ct
 	obj.logStmt(__stmt, __values...)
 
 	stripecoinpayments_invoice_project_record = &StripecoinpaymentsInvoiceProjectRecord{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&stripecoinpayments_invoice_project_record.Id, &stripecoinpayments_invoice_project_record.ProjectId, &stripecoinpayments_invoice_project_record.Storage, &stripecoinpayments_invoice_project_record.Egress, &stripecoinpayments_invoice_project_record.Objects, &stripecoinpayments_invoice_project_record.Segments, &stripecoinpayments_invoice_project_record.PeriodStart, &stripecoinpayments_invoice_project_record.PeriodEnd, &stripecoinpayments_invoice_project_record.State, &stripecoinpayments_invoice_project_record.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -18541,29 +20465,288 @@ func (obj *cockroachImpl) Delete_BucketMetainfo_By_ProjectId_And_Name(ctx contex
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *cockroachImpl) Delete_GracefulExitProgress_By_NodeId(ctx context.Context,
+	graceful_exit_progress_node_id GracefulExitProgress_NodeId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_progress WHERE graceful_exit_progress.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, graceful_exit_progress_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *cockroachImpl) Delete_GracefulExitTransferQueue_By_NodeId(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, graceful_exit_transfer_queue_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *cockroachImpl) Delete_GracefulExitTransferQueue_By_NodeId_And_Path_And_PieceNum(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
+	graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field,
+	graceful_exit_transfer_queue_piece_num GracefulExitTransferQueue_PieceNum_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.path = ? AND graceful_exit_transfer_queue.piece_num = ?")
+
+	var __values []interface{}
+	__values = append(__values, graceful_exit_transfer_queue_node_id.value(), graceful_exit_transfer_queue_path.value(), graceful_exit_transfer_queue_piece_num.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *cockroachImpl) Delete_GracefulExitTransferQueue_By_NodeId_And_FinishedAt_IsNot_Null(ctx context.Context,
+	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.finished_at is not NULL")
+
+	var __values []interface{}
+	__values = append(__values, graceful_exit_transfer_queue_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *cockroachImpl) Delete_StripecoinpaymentsApplyBalanceIntent_By_TxId(ctx context.Context,
+	stripecoinpayments_apply_balance_intent_tx_id StripecoinpaymentsApplyBalanceIntent_TxId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM stripecoinpayments_apply_balance_intents WHERE stripecoinpayments_apply_balance_intents.tx_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, stripecoinpayments_apply_balance_intent_tx_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *cockroachImpl) Delete_StripecoinpaymentsInvoiceProjectRecord_By_Id(ctx context.Context,
+	stripecoinpayments_invoice_project_record_id StripecoinpaymentsInvoiceProjectRecord_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM stripecoinpayments_invoice_project_records WHERE stripecoinpayments_invoice_project_records.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, stripecoinpayments_invoice_project_record_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *cockroachImpl) Delete_Coupon_By_Id(ctx context.Context,
+	coupon_id Coupon_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM coupons WHERE coupons.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, coupon_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (impl cockroachImpl) isConstraintError(err error) (
+	constraint string, ok bool) {
+	if e, ok := err.(*pq.Error); ok {
+		if e.Code.Class() == "23" {
+			return e.Constraint, true
+		}
+	}
+	return "", false
+}
+
+func (obj *cockroachImpl) Get_BucketUsageLimit_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_usage_limit_project_id BucketUsageLimit_ProjectId_Field,
+	bucket_usage_limit_bucket_name BucketUsageLimit_BucketName_Field) (
+	bucket_usage_limit *BucketUsageLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_usage_limits.project_id, bucket_usage_limits.bucket_name, bucket_usage_limits.storage_limit, bucket_usage_limits.bandwidth_limit, bucket_usage_limits.object_limit, bucket_usage_limits.created_at, bucket_usage_limits.updated_at FROM bucket_usage_limits WHERE bucket_usage_limits.project_id = ? AND bucket_usage_limits.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_usage_limit_project_id.value(), bucket_usage_limit_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_usage_limit = &BucketUsageLimit{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&bucket_usage_limit.ProjectId, &bucket_usage_limit.BucketName, &bucket_usage_limit.StorageLimit, &bucket_usage_limit.BandwidthLimit, &bucket_usage_limit.ObjectLimit, &bucket_usage_limit.CreatedAt, &bucket_usage_limit.UpdatedAt)
+	if err != nil {
+		return (*BucketUsageLimit)(nil), obj.makeErr(err)
+	}
+	return bucket_usage_limit, nil
+
+}
+
+func (obj *cockroachImpl) All_BucketUsageLimit_By_ProjectId_OrderBy_Asc_BucketName(ctx context.Context,
+	bucket_usage_limit_project_id BucketUsageLimit_ProjectId_Field) (
+	rows []*BucketUsageLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_usage_limits.project_id, bucket_usage_limits.bucket_name, bucket_usage_limits.storage_limit, bucket_usage_limits.bandwidth_limit, bucket_usage_limits.object_limit, bucket_usage_limits.created_at, bucket_usage_limits.updated_at FROM bucket_usage_limits WHERE bucket_usage_limits.project_id = ? ORDER BY bucket_usage_limits.bucket_name")
+
+	var __values []interface{}
+	__values = append(__values, bucket_usage_limit_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
+	defer __rows.Close()
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
+	for __rows.Next() {
+		bucket_usage_limit := &BucketUsageLimit{}
+		err = __rows.Scan(&bucket_usage_limit.ProjectId, &bucket_usage_limit.BucketName, &bucket_usage_limit.StorageLimit, &bucket_usage_limit.BandwidthLimit, &bucket_usage_limit.ObjectLimit, &bucket_usage_limit.CreatedAt, &bucket_usage_limit.UpdatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_usage_limit)
 	}
-
-	return __count > 0, nil
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *cockroachImpl) Delete_GracefulExitProgress_By_NodeId(ctx context.Context,
-	graceful_exit_progress_node_id GracefulExitProgress_NodeId_Field) (
+func (obj *cockroachImpl) Delete_BucketUsageLimit_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_usage_limit_project_id BucketUsageLimit_ProjectId_Field,
+	bucket_usage_limit_bucket_name BucketUsageLimit_BucketName_Field) (
 	deleted bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_progress WHERE graceful_exit_progress.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_usage_limits WHERE bucket_usage_limits.project_id = ? AND bucket_usage_limits.bucket_name = ?")
 
 	var __values []interface{}
-	__values = append(__values, graceful_exit_progress_node_id.value())
+	__values = append(__values, bucket_usage_limit_project_id.value(), bucket_usage_limit_bucket_name.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -18582,152 +20765,166 @@ func (obj *cockroachImpl) Delete_GracefulExitProgress_By_NodeId(ctx context.Cont
 
 }
 
-func (obj *cockroachImpl) Delete_GracefulExitTransferQueue_By_NodeId(ctx context.Context,
-	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field) (
-	count int64, err error) {
+func (obj *cockroachImpl) All_BucketCountRollup_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Asc_IntervalStart(ctx context.Context,
+	bucket_count_rollup_project_id BucketCountRollup_ProjectId_Field,
+	bucket_count_rollup_bucket_name BucketCountRollup_BucketName_Field,
+	bucket_count_rollup_interval_start_greater_or_equal BucketCountRollup_IntervalStart_Field,
+	bucket_count_rollup_interval_start_less_or_equal BucketCountRollup_IntervalStart_Field) (
+	rows []*BucketCountRollup, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_count_rollups.project_id, bucket_count_rollups.bucket_name, bucket_count_rollups.interval_start, bucket_count_rollups.object_count, bucket_count_rollups.inline_segments_count, bucket_count_rollups.remote_segments_count, bucket_count_rollups.object_hours, bucket_count_rollups.inline_segment_hours, bucket_count_rollups.remote_segment_hours, bucket_count_rollups.inline_byte_hours, bucket_count_rollups.remote_byte_hours FROM bucket_count_rollups WHERE bucket_count_rollups.project_id = ? AND bucket_count_rollups.bucket_name = ? AND bucket_count_rollups.interval_start >= ? AND bucket_count_rollups.interval_start <= ? ORDER BY bucket_count_rollups.interval_start")
 
 	var __values []interface{}
-	__values = append(__values, graceful_exit_transfer_queue_node_id.value())
+	__values = append(__values, bucket_count_rollup_project_id.value(), bucket_count_rollup_bucket_name.value(), bucket_count_rollup_interval_start_greater_or_equal.value(), bucket_count_rollup_interval_start_less_or_equal.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 	if err != nil {
-		return 0, obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
+	defer __rows.Close()
 
-	count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
+	for __rows.Next() {
+		bucket_count_rollup := &BucketCountRollup{}
+		err = __rows.Scan(&bucket_count_rollup.ProjectId, &bucket_count_rollup.BucketName, &bucket_count_rollup.IntervalStart, &bucket_count_rollup.ObjectCount, &bucket_count_rollup.InlineSegmentsCount, &bucket_count_rollup.RemoteSegmentsCount, &bucket_count_rollup.ObjectHours, &bucket_count_rollup.InlineSegmentHours, &bucket_count_rollup.RemoteSegmentHours, &bucket_count_rollup.InlineByteHours, &bucket_count_rollup.RemoteByteHours)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_count_rollup)
 	}
-
-	return count, nil
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *cockroachImpl) Delete_GracefulExitTransferQueue_By_NodeId_And_Path_And_PieceNum(ctx context.Context,
-	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field,
-	graceful_exit_transfer_queue_path GracefulExitTransferQueue_Path_Field,
-	graceful_exit_transfer_queue_piece_num GracefulExitTransferQueue_PieceNum_Field) (
-	deleted bool, err error) {
+func (obj *cockroachImpl) Get_PayoutStatement_By_NodeId_And_Period(ctx context.Context,
+	payout_statement_node_id PayoutStatement_NodeId_Field,
+	payout_statement_period PayoutStatement_Period_Field) (
+	payout_statement *PayoutStatement, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.path = ? AND graceful_exit_transfer_queue.piece_num = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_statements.node_id, payout_statements.period, payout_statements.created_at, payout_statements.node_created_at, payout_statements.node_age_months, payout_statements.wallet, payout_statements.usage_at_rest, payout_statements.usage_put, payout_statements.usage_get, payout_statements.usage_put_repair, payout_statements.usage_get_repair, payout_statements.usage_get_audit, payout_statements.comp_at_rest, payout_statements.comp_put, payout_statements.comp_get, payout_statements.comp_put_repair, payout_statements.comp_get_repair, payout_statements.comp_get_audit, payout_statements.held_percent, payout_statements.held, payout_statements.disposed, payout_statements.owed, payout_statements.graceful_exit FROM payout_statements WHERE payout_statements.node_id = ? AND payout_statements.period = ?")
 
 	var __values []interface{}
-	__values = append(__values, graceful_exit_transfer_queue_node_id.value(), graceful_exit_transfer_queue_path.value(), graceful_exit_transfer_queue_piece_num.value())
+	__values = append(__values, payout_statement_node_id.value(), payout_statement_period.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
-
-	__count, err := __res.RowsAffected()
+	payout_statement = &PayoutStatement{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&payout_statement.NodeId, &payout_statement.Period, &payout_statement.CreatedAt, &payout_statement.NodeCreatedAt, &payout_statement.NodeAgeMonths, &payout_statement.Wallet, &payout_statement.UsageAtRest, &payout_statement.UsagePut, &payout_statement.UsageGet, &payout_statement.UsagePutRepair, &payout_statement.UsageGetRepair, &payout_statement.UsageGetAudit, &payout_statement.CompAtRest, &payout_statement.CompPut, &payout_statement.CompGet, &payout_statement.CompPutRepair, &payout_statement.CompGetRepair, &payout_statement.CompGetAudit, &payout_statement.HeldPercent, &payout_statement.Held, &payout_statement.Disposed, &payout_statement.Owed, &payout_statement.GracefulExit)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return (*PayoutStatement)(nil), obj.makeErr(err)
 	}
-
-	return __count > 0, nil
+	return payout_statement, nil
 
 }
 
-func (obj *cockroachImpl) Delete_GracefulExitTransferQueue_By_NodeId_And_FinishedAt_IsNot_Null(ctx context.Context,
-	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field) (
-	count int64, err error) {
+func (obj *cockroachImpl) All_PayoutStatement_By_NodeId_And_Period_GreaterOrEqual_OrderBy_Asc_Period(ctx context.Context,
+	payout_statement_node_id PayoutStatement_NodeId_Field,
+	payout_statement_period_greater_or_equal PayoutStatement_Period_Field) (
+	rows []*PayoutStatement, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_transfer_queue WHERE graceful_exit_transfer_queue.node_id = ? AND graceful_exit_transfer_queue.finished_at is not NULL")
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_statements.node_id, payout_statements.period, payout_statements.created_at, payout_statements.node_created_at, payout_statements.node_age_months, payout_statements.wallet, payout_statements.usage_at_rest, payout_statements.usage_put, payout_statements.usage_get, payout_statements.usage_put_repair, payout_statements.usage_get_repair, payout_statements.usage_get_audit, payout_statements.comp_at_rest, payout_statements.comp_put, payout_statements.comp_get, payout_statements.comp_put_repair, payout_statements.comp_get_repair, payout_statements.comp_get_audit, payout_statements.held_percent, payout_statements.held, payout_statements.disposed, payout_statements.owed, payout_statements.graceful_exit FROM payout_statements WHERE payout_statements.node_id = ? AND payout_statements.period >= ? ORDER BY payout_statements.period")
 
 	var __values []interface{}
-	__values = append(__values, graceful_exit_transfer_queue_node_id.value())
+	__values = append(__values, payout_statement_node_id.value(), payout_statement_period_greater_or_equal.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 	if err != nil {
-		return 0, obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
+	defer __rows.Close()
 
-	count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
+	for __rows.Next() {
+		payout_statement := &PayoutStatement{}
+		err = __rows.Scan(&payout_statement.NodeId, &payout_statement.Period, &payout_statement.CreatedAt, &payout_statement.NodeCreatedAt, &payout_statement.NodeAgeMonths, &payout_statement.Wallet, &payout_statement.UsageAtRest, &payout_statement.UsagePut, &payout_statement.UsageGet, &payout_statement.UsagePutRepair, &payout_statement.UsageGetRepair, &payout_statement.UsageGetAudit, &payout_statement.CompAtRest, &payout_statement.CompPut, &payout_statement.CompGet, &payout_statement.CompPutRepair, &payout_statement.CompGetRepair, &payout_statement.CompGetAudit, &payout_statement.HeldPercent, &payout_statement.Held, &payout_statement.Disposed, &payout_statement.Owed, &payout_statement.GracefulExit)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, payout_statement)
 	}
-
-	return count, nil
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *cockroachImpl) Delete_StripecoinpaymentsApplyBalanceIntent_By_TxId(ctx context.Context,
-	stripecoinpayments_apply_balance_intent_tx_id StripecoinpaymentsApplyBalanceIntent_TxId_Field) (
-	deleted bool, err error) {
+func (obj *cockroachImpl) All_PayoutStatement_By_Period_OrderBy_Asc_NodeId(ctx context.Context,
+	payout_statement_period PayoutStatement_Period_Field) (
+	rows []*PayoutStatement, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM stripecoinpayments_apply_balance_intents WHERE stripecoinpayments_apply_balance_intents.tx_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_statements.node_id, payout_statements.period, payout_statements.created_at, payout_statements.node_created_at, payout_statements.node_age_months, payout_statements.wallet, payout_statements.usage_at_rest, payout_statements.usage_put, payout_statements.usage_get, payout_statements.usage_put_repair, payout_statements.usage_get_repair, payout_statements.usage_get_audit, payout_statements.comp_at_rest, payout_statements.comp_put, payout_statements.comp_get, payout_statements.comp_put_repair, payout_statements.comp_get_repair, payout_statements.comp_get_audit, payout_statements.held_percent, payout_statements.held, payout_statements.disposed, payout_statements.owed, payout_statements.graceful_exit FROM payout_statements WHERE payout_statements.period = ? ORDER BY payout_statements.node_id")
 
 	var __values []interface{}
-	__values = append(__values, stripecoinpayments_apply_balance_intent_tx_id.value())
+	__values = append(__values, payout_statement_period.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
+	defer __rows.Close()
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
+	for __rows.Next() {
+		payout_statement := &PayoutStatement{}
+		err = __rows.Scan(&payout_statement.NodeId, &payout_statement.Period, &payout_statement.CreatedAt, &payout_statement.NodeCreatedAt, &payout_statement.NodeAgeMonths, &payout_statement.Wallet, &payout_statement.UsageAtRest, &payout_statement.UsagePut, &payout_statement.UsageGet, &payout_statement.UsagePutRepair, &payout_statement.UsageGetRepair, &payout_statement.UsageGetAudit, &payout_statement.CompAtRest, &payout_statement.CompPut, &payout_statement.CompGet, &payout_statement.CompPutRepair, &payout_statement.CompGetRepair, &payout_statement.CompGetAudit, &payout_statement.HeldPercent, &payout_statement.Held, &payout_statement.Disposed, &payout_statement.Owed, &payout_statement.GracefulExit)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, payout_statement)
 	}
-
-	return __count > 0, nil
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *cockroachImpl) Delete_StripecoinpaymentsInvoiceProjectRecord_By_Id(ctx context.Context,
-	stripecoinpayments_invoice_project_record_id StripecoinpaymentsInvoiceProjectRecord_Id_Field) (
-	deleted bool, err error) {
+func (obj *cockroachImpl) Get_GracefulExitPause_By_NodeId(ctx context.Context,
+	graceful_exit_pause_node_id GracefulExitPause_NodeId_Field) (
+	graceful_exit_pause *GracefulExitPause, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM stripecoinpayments_invoice_project_records WHERE stripecoinpayments_invoice_project_records.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT graceful_exit_pauses.node_id, graceful_exit_pauses.paused_at, graceful_exit_pauses.paused_duration FROM graceful_exit_pauses WHERE graceful_exit_pauses.node_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, stripecoinpayments_invoice_project_record_id.value())
+	__values = append(__values, graceful_exit_pause_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
-
-	__count, err := __res.RowsAffected()
+	graceful_exit_pause = &GracefulExitPause{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&graceful_exit_pause.NodeId, &graceful_exit_pause.PausedAt, &graceful_exit_pause.PausedDuration)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return (*GracefulExitPause)(nil), obj.makeErr(err)
 	}
-
-	return __count > 0, nil
+	return graceful_exit_pause, nil
 
 }
 
-func (obj *cockroachImpl) Delete_Coupon_By_Id(ctx context.Context,
-	coupon_id Coupon_Id_Field) (
+func (obj *cockroachImpl) Delete_GracefulExitPause_By_NodeId(ctx context.Context,
+	graceful_exit_pause_node_id GracefulExitPause_NodeId_Field) (
 	deleted bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM coupons WHERE coupons.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM graceful_exit_pauses WHERE graceful_exit_pauses.node_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, coupon_id.value())
+	__values = append(__values, graceful_exit_pause_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -18746,20 +20943,60 @@ func (obj *cockroachImpl) Delete_Coupon_By_Id(ctx context.Context,
 
 }
 
-func (impl cockroachImpl) isConstraintError(err error) (
-	constraint string, ok bool) {
-	if e, ok := err.(*pq.Error); ok {
-		if e.Code.Class() == "23" {
-			return e.Constraint, true
-		}
-	}
-	return "", false
-}
-
 func (obj *cockroachImpl) deleteAll(ctx context.Context) (count int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_pauses;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM corrupt_pieces;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM payout_statements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_count_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_usage_limits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_credits;")
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -18965,6 +21202,16 @@ func (obj *cockroachImpl) deleteAll(ctx context.Context) (count int64, err error
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM partial_exits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19169,6 +21416,103 @@ func (rx *Rx) Commit() (err error) {
 	return err
 }
 
+func (rx *Rx) All_BucketUsageLimit_By_ProjectId_OrderBy_Asc_BucketName(ctx context.Context,
+	bucket_usage_limit_project_id BucketUsageLimit_ProjectId_Field) (
+	rows []*BucketUsageLimit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_BucketUsageLimit_By_ProjectId_OrderBy_Asc_BucketName(ctx, bucket_usage_limit_project_id)
+}
+
+func (rx *Rx) Delete_BucketUsageLimit_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_usage_limit_project_id BucketUsageLimit_ProjectId_Field,
+	bucket_usage_limit_bucket_name BucketUsageLimit_BucketName_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_BucketUsageLimit_By_ProjectId_And_BucketName(ctx, bucket_usage_limit_project_id, bucket_usage_limit_bucket_name)
+}
+
+func (rx *Rx) Get_BucketUsageLimit_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_usage_limit_project_id BucketUsageLimit_ProjectId_Field,
+	bucket_usage_limit_bucket_name BucketUsageLimit_BucketName_Field) (
+	bucket_usage_limit *BucketUsageLimit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketUsageLimit_By_ProjectId_And_BucketName(ctx, bucket_usage_limit_project_id, bucket_usage_limit_bucket_name)
+}
+
+func (rx *Rx) All_BucketCountRollup_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Asc_IntervalStart(ctx context.Context,
+	bucket_count_rollup_project_id BucketCountRollup_ProjectId_Field,
+	bucket_count_rollup_bucket_name BucketCountRollup_BucketName_Field,
+	bucket_count_rollup_interval_start_greater_or_equal BucketCountRollup_IntervalStart_Field,
+	bucket_count_rollup_interval_start_less_or_equal BucketCountRollup_IntervalStart_Field) (
+	rows []*BucketCountRollup, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_BucketCountRollup_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Asc_IntervalStart(ctx, bucket_count_rollup_project_id, bucket_count_rollup_bucket_name, bucket_count_rollup_interval_start_greater_or_equal, bucket_count_rollup_interval_start_less_or_equal)
+}
+
+func (rx *Rx) All_PayoutStatement_By_NodeId_And_Period_GreaterOrEqual_OrderBy_Asc_Period(ctx context.Context,
+	payout_statement_node_id PayoutStatement_NodeId_Field,
+	payout_statement_period_greater_or_equal PayoutStatement_Period_Field) (
+	rows []*PayoutStatement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_PayoutStatement_By_NodeId_And_Period_GreaterOrEqual_OrderBy_Asc_Period(ctx, payout_statement_node_id, payout_statement_period_greater_or_equal)
+}
+
+func (rx *Rx) All_PayoutStatement_By_Period_OrderBy_Asc_NodeId(ctx context.Context,
+	payout_statement_period PayoutStatement_Period_Field) (
+	rows []*PayoutStatement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_PayoutStatement_By_Period_OrderBy_Asc_NodeId(ctx, payout_statement_period)
+}
+
+func (rx *Rx) Get_PayoutStatement_By_NodeId_And_Period(ctx context.Context,
+	payout_statement_node_id PayoutStatement_NodeId_Field,
+	payout_statement_period PayoutStatement_Period_Field) (
+	payout_statement *PayoutStatement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_PayoutStatement_By_NodeId_And_Period(ctx, payout_statement_node_id, payout_statement_period)
+}
+
+func (rx *Rx) Delete_GracefulExitPause_By_NodeId(ctx context.Context,
+	graceful_exit_pause_node_id GracefulExitPause_NodeId_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_GracefulExitPause_By_NodeId(ctx, graceful_exit_pause_node_id)
+}
+
+func (rx *Rx) Get_GracefulExitPause_By_NodeId(ctx context.Context,
+	graceful_exit_pause_node_id GracefulExitPause_NodeId_Field) (
+	graceful_exit_pause *GracefulExitPause, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_GracefulExitPause_By_NodeId(ctx, graceful_exit_pause_node_id)
+}
+
 func (rx *Rx) Rollback() (err error) {
 	if rx.tx != nil {
 		err = rx.tx.Rollback()
@@ -19592,13 +21936,15 @@ func (rx *Rx) CreateNoReturn_Node(ctx context.Context,
 	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
 	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
 	node_exit_success Node_ExitSuccess_Field,
+	node_unknown_audit_reputation_alpha Node_UnknownAuditReputationAlpha_Field,
+	node_unknown_audit_reputation_beta Node_UnknownAuditReputationBeta_Field,
 	optional Node_Create_Fields) (
 	err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.CreateNoReturn_Node(ctx, node_id, node_address, node_last_net, node_protocol, node_type, node_email, node_wallet, node_free_bandwidth, node_free_disk, node_major, node_minor, node_patch, node_hash, node_timestamp, node_release, node_latency_90, node_audit_success_count, node_total_audit_count, node_uptime_success_count, node_total_uptime_count, node_last_contact_success, node_last_contact_failure, node_contained, node_audit_reputation_alpha, node_audit_reputation_beta, node_uptime_reputation_alpha, node_uptime_reputation_beta, node_exit_success, optional)
+	return tx.CreateNoReturn_Node(ctx, node_id, node_address, node_last_net, node_protocol, node_type, node_email, node_wallet, node_free_bandwidth, node_free_disk, node_major, node_minor, node_patch, node_hash, node_timestamp, node_release, node_latency_90, node_audit_success_count, node_total_audit_count, node_uptime_success_count, node_total_uptime_count, node_last_contact_success, node_last_contact_failure, node_contained, node_audit_reputation_alpha, node_audit_reputation_beta, node_uptime_reputation_alpha, node_uptime_reputation_beta, node_exit_success, node_unknown_audit_reputation_alpha, node_unknown_audit_reputation_beta, optional)
 
 }
 
@@ -19917,6 +22263,7 @@ func (rx *Rx) Create_StripecoinpaymentsInvoiceProjectRecord(ctx context.Context,
 	stripecoinpayments_invoice_project_record_storage StripecoinpaymentsInvoiceProjectRecord_Storage_Field,
 	stripecoinpayments_invoice_project_record_egress StripecoinpaymentsInvoiceProjectRecord_Egress_Field,
 	stripecoinpayments_invoice_project_record_objects StripecoinpaymentsInvoiceProjectRecord_Objects_Field,
+	stripecoinpayments_invoice_project_record_segments StripecoinpaymentsInvoiceProjectRecord_Segments_Field,
 	stripecoinpayments_invoice_project_record_period_start StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field,
 	stripecoinpayments_invoice_project_record_period_end StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field,
 	stripecoinpayments_invoice_project_record_state StripecoinpaymentsInvoiceProjectRecord_State_Field) (
@@ -19925,7 +22272,7 @@ func (rx *Rx) Create_StripecoinpaymentsInvoiceProjectRecord(ctx context.Context,
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_StripecoinpaymentsInvoiceProjectRecord(ctx, stripecoinpayments_invoice_project_record_id, stripecoinpayments_invoice_project_record_project_id, stripecoinpayments_invoice_project_record_storage, stripecoinpayments_invoice_project_record_egress, stripecoinpayments_invoice_project_record_objects, stripecoinpayments_invoice_project_record_period_start, stripecoinpayments_invoice_project_record_period_end, stripecoinpayments_invoice_project_record_state)
+	return tx.Create_StripecoinpaymentsInvoiceProjectRecord(ctx, stripecoinpayments_invoice_project_record_id, stripecoinpayments_invoice_project_record_project_id, stripecoinpayments_invoice_project_record_storage, stripecoinpayments_invoice_project_record_egress, stripecoinpayments_invoice_project_record_objects, stripecoinpayments_invoice_project_record_segments, stripecoinpayments_invoice_project_record_period_start, stripecoinpayments_invoice_project_record_period_end, stripecoinpayments_invoice_project_record_state)
 
 }
 
@@ -20384,6 +22731,16 @@ func (rx *Rx) Get_Offer_By_Id(ctx context.Context,
 	return tx.Get_Offer_By_Id(ctx, offer_id)
 }
 
+func (rx *Rx) Get_PartialExit_By_NodeId(ctx context.Context,
+	partial_exit_node_id PartialExit_NodeId_Field) (
+	partial_exit *PartialExit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_PartialExit_By_NodeId(ctx, partial_exit_node_id)
+}
+
 func (rx *Rx) Get_PeerIdentity_By_NodeId(ctx context.Context,
 	peer_identity_node_id PeerIdentity_NodeId_Field) (
 	peer_identity *PeerIdentity, err error) {
@@ -20995,6 +23352,12 @@ type Methods interface {
 	All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
 		api_key_project_id ApiKey_ProjectId_Field) (
 		rows []*ApiKey, err error)
+	All_BucketCountRollup_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Asc_IntervalStart(ctx context.Context,
+		bucket_count_rollup_project_id BucketCountRollup_ProjectId_Field,
+		bucket_count_rollup_bucket_name BucketCountRollup_BucketName_Field,
+		bucket_count_rollup_interval_start_greater_or_equal BucketCountRollup_IntervalStart_Field,
+		bucket_count_rollup_interval_start_less_or_equal BucketCountRollup_IntervalStart_Field) (
+		rows []*BucketCountRollup, err error)
 
 	All_BucketStorageTally(ctx context.Context) (
 		rows []*BucketStorageTally, err error)
@@ -21005,6 +23368,9 @@ type Methods interface {
 		bucket_storage_tally_interval_start_greater_or_equal BucketStorageTally_IntervalStart_Field,
 		bucket_storage_tally_interval_start_less_or_equal BucketStorageTally_IntervalStart_Field) (
 		rows []*BucketStorageTally, err error)
+	All_BucketUsageLimit_By_ProjectId_OrderBy_Asc_BucketName(ctx context.Context,
+		bucket_usage_limit_project_id BucketUsageLimit_ProjectId_Field) (
+		rows []*BucketUsageLimit, err error)
 
 	All_CoinpaymentsTransaction_By_UserId_OrderBy_Desc_CreatedAt(ctx context.Context,
 		coinpayments_transaction_user_id CoinpaymentsTransaction_UserId_Field) (
@@ -21058,6 +23424,14 @@ type Methods interface {
 
 	All_Offer_OrderBy_Asc_Id(ctx context.Context) (
 		rows []*Offer, err error)
+	All_PayoutStatement_By_NodeId_And_Period_GreaterOrEqual_OrderBy_Asc_Period(ctx context.Context,
+		payout_statement_node_id PayoutStatement_NodeId_Field,
+		payout_statement_period_greater_or_equal PayoutStatement_Period_Field) (
+		rows []*PayoutStatement, err error)
+
+	All_PayoutStatement_By_Period_OrderBy_Asc_NodeId(ctx context.Context,
+		payout_statement_period PayoutStatement_Period_Field) (
+		rows []*PayoutStatement, err error)
 
 	All_Project(ctx context.Context) (
 		rows []*Project, err error)
@@ -21181,6 +23555,8 @@ type Methods interface {
 		node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
 		node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
 		node_exit_success Node_ExitSuccess_Field,
+		node_unknown_audit_reputation_alpha Node_UnknownAuditReputationAlpha_Field,
+		node_unknown_audit_reputation_beta Node_UnknownAuditReputationBeta_Field,
 		optional Node_Create_Fields) (
 		err error)
 
@@ -21352,6 +23728,7 @@ type Methods interface {
 		stripecoinpayments_invoice_project_record_storage StripecoinpaymentsInvoiceProjectRecord_Storage_Field,
 		stripecoinpayments_invoice_project_record_egress StripecoinpaymentsInvoiceProjectRecord_Egress_Field,
 		stripecoinpayments_invoice_project_record_objects StripecoinpaymentsInvoiceProjectRecord_Objects_Field,
+		stripecoinpayments_invoice_project_record_segments StripecoinpaymentsInvoiceProjectRecord_Segments_Field,
 		stripecoinpayments_invoice_project_record_period_start StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field,
 		stripecoinpayments_invoice_project_record_period_end StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field,
 		stripecoinpayments_invoice_project_record_state StripecoinpaymentsInvoiceProjectRecord_State_Field) (
@@ -21398,10 +23775,17 @@ type Methods interface {
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
 		deleted bool, err error)
+	Delete_BucketUsageLimit_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_usage_limit_project_id BucketUsageLimit_ProjectId_Field,
+		bucket_usage_limit_bucket_name BucketUsageLimit_BucketName_Field) (
+		deleted bool, err error)
 
 	Delete_Coupon_By_Id(ctx context.Context,
 		coupon_id Coupon_Id_Field) (
 		deleted bool, err error)
+	Delete_GracefulExitPause_By_NodeId(ctx context.Context,
+		graceful_exit_pause_node_id GracefulExitPause_NodeId_Field) (
+		deleted bool, err error)
 
 	Delete_GracefulExitProgress_By_NodeId(ctx context.Context,
 		graceful_exit_progress_node_id GracefulExitProgress_NodeId_Field) (
@@ -21521,6 +23905,10 @@ type Methods interface {
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
 		bucket_metainfo *BucketMetainfo, err error)
+	Get_BucketUsageLimit_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_usage_limit_project_id BucketUsageLimit_ProjectId_Field,
+		bucket_usage_limit_bucket_name BucketUsageLimit_BucketName_Field) (
+		bucket_usage_limit *BucketUsageLimit, err error)
 
 	Get_Coupon_By_Id(ctx context.Context,
 		coupon_id Coupon_Id_Field) (
@@ -21529,6 +23917,9 @@ type Methods interface {
 	Get_Credit_By_TransactionId(ctx context.Context,
 		credit_transaction_id Credit_TransactionId_Field) (
 		credit *Credit, err error)
+	Get_GracefulExitPause_By_NodeId(ctx context.Context,
+		graceful_exit_pause_node_id GracefulExitPause_NodeId_Field) (
+		graceful_exit_pause *GracefulExitPause, err error)
 
 	Get_GracefulExitProgress_By_NodeId(ctx context.Context,
 		graceful_exit_progress_node_id GracefulExitProgress_NodeId_Field) (
@@ -21552,6 +23943,15 @@ type Methods interface {
 		offer_id Offer_Id_Field) (
 		offer *Offer, err error)
 
+	Get_PartialExit_By_NodeId(ctx context.Context,
+		partial_exit_node_id PartialExit_NodeId_Field) (
+		partial_exit *PartialExit, err error)
+
+	Get_PayoutStatement_By_NodeId_And_Period(ctx context.Context,
+		payout_statement_node_id PayoutStatement_NodeId_Field,
+		payout_statement_period PayoutStatement_Period_Field) (
+		payout_statement *PayoutStatement, err error)
+
 	Get_PeerIdentity_By_NodeId(ctx context.Context,
 		peer_identity_node_id PeerIdentity_NodeId_Field) (
 		peer_identity *PeerIdentity, err error)
@@ -28,6 +28,20 @@ CREATE TABLE bucket_bandwidth_rollups (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_count_rollups (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	interval_start timestamp NOT NULL,
+	object_count bigint NOT NULL,
+	inline_segments_count bigint NOT NULL,
+	remote_segments_count bigint NOT NULL,
+	object_hours double precision NOT NULL,
+	inline_segment_hours double precision NOT NULL,
+	remote_segment_hours double precision NOT NULL,
+	inline_byte_hours double precision NOT NULL,
+	remote_byte_hours double precision NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, interval_start )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -40,6 +54,16 @@ CREATE TABLE bucket_storage_tallies (
 	metadata_size bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start )
 );
+CREATE TABLE bucket_usage_limits (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	storage_limit bigint NOT NULL,
+	bandwidth_limit bigint NOT NULL,
+	object_limit bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE coinpayments_transactions (
 	id text NOT NULL,
 	user_id bytea NOT NULL,
@@ -52,6 +76,13 @@ CREATE TABLE coinpayments_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE corrupt_pieces (
+	node_id bytea NOT NULL,
+	piece_id bytea NOT NULL,
+	reported_at timestamp with time zone NOT NULL,
+	processed_at timestamp with time zone,
+	PRIMARY KEY ( node_id, piece_id )
+);
 CREATE TABLE coupons (
 	id bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -87,6 +118,12 @@ CREATE TABLE credits_spendings (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE graceful_exit_pauses (
+	node_id bytea NOT NULL,
+	paused_at timestamp with time zone,
+	paused_duration bigint NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -160,6 +197,9 @@ CREATE TABLE nodes (
 	exit_loop_completed_at timestamp,
 	exit_finished_at timestamp,
 	exit_success boolean NOT NULL,
+	unknown_audit_reputation_alpha double precision NOT NULL,
+	unknown_audit_reputation_beta double precision NOT NULL,
+	suspended timestamp with time zone,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE nodes_offline_times (
@@ -183,6 +223,42 @@ CREATE TABLE offers (
 	type integer NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE partial_exits (
+	node_id bytea NOT NULL,
+	requested_bytes bigint NOT NULL,
+	queued_bytes bigint NOT NULL,
+	transferred_bytes bigint NOT NULL,
+	requested_at timestamp with time zone NOT NULL,
+	queued_at timestamp with time zone,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE payout_statements (
+	node_id bytea NOT NULL,
+	period timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	node_created_at timestamp with time zone NOT NULL,
+	node_age_months integer NOT NULL,
+	wallet text NOT NULL,
+	usage_at_rest double precision NOT NULL,
+	usage_put bigint NOT NULL,
+	usage_get bigint NOT NULL,
+	usage_put_repair bigint NOT NULL,
+	usage_get_repair bigint NOT NULL,
+	usage_get_audit bigint NOT NULL,
+	comp_at_rest bigint NOT NULL,
+	comp_put bigint NOT NULL,
+	comp_get bigint NOT NULL,
+	comp_put_repair bigint NOT NULL,
+	comp_get_repair bigint NOT NULL,
+	comp_get_audit bigint NOT NULL,
+	held_percent integer NOT NULL,
+	held bigint NOT NULL,
+	disposed bigint NOT NULL,
+	owed bigint NOT NULL,
+	graceful_exit boolean NOT NULL,
+	PRIMARY KEY ( node_id, period )
+);
 CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -272,6 +348,7 @@ CREATE TABLE stripecoinpayments_invoice_project_records (
 	storage double precision NOT NULL,
 	egress bigint NOT NULL,
 	objects bigint NOT NULL,
+	segments bigint NOT NULL,
 	period_start timestamp with time zone NOT NULL,
 	period_end timestamp with time zone NOT NULL,
 	state integer NOT NULL,
@@ -69,6 +69,170 @@ func (db *gracefulexitDB) GetProgress(ctx context.Context, nodeID storj.NodeID)
 	return progress, Error.Wrap(err)
 }
 
+// Pause marks the graceful exit of a node as paused, unless it's paused already.
+func (db *gracefulexitDB) Pause(ctx context.Context, nodeID storj.NodeID, pausedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	statement := db.db.Rebind(
+		`INSERT INTO graceful_exit_pauses (node_id, paused_at, paused_duration) VALUES (?, ?, 0)
+		 ON CONFLICT(node_id)
+		 DO UPDATE SET paused_at = COALESCE(graceful_exit_pauses.paused_at, excluded.paused_at);`,
+	)
+	_, err = db.db.ExecContext(ctx, statement, nodeID, pausedAt.UTC())
+	return Error.Wrap(err)
+}
+
+// Resume adds the duration of the current pause to the total paused duration of a node.
+func (db *gracefulexitDB) Resume(ctx context.Context, nodeID storj.NodeID, resumedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		pause, err := tx.Get_GracefulExitPause_By_NodeId(ctx, dbx.GracefulExitPause_NodeId(nodeID.Bytes()))
+		if errs.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if pause.PausedAt == nil {
+			return nil
+		}
+
+		duration := pause.PausedDuration
+		if resumedAt.After(*pause.PausedAt) {
+			duration += int64(resumedAt.Sub(*pause.PausedAt))
+		}
+		_, err = tx.Tx.ExecContext(ctx, db.db.Rebind(
+			`UPDATE graceful_exit_pauses SET paused_at = NULL, paused_duration = ? WHERE node_id = ?`),
+			duration, nodeID)
+		return err
+	}))
+}
+
+// GetPause gets a graceful exit pause entry.
+func (db *gracefulexitDB) GetPause(ctx context.Context, nodeID storj.NodeID) (_ *gracefulexit.Pause, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxPause, err := db.db.Get_GracefulExitPause_By_NodeId(ctx, dbx.GracefulExitPause_NodeId(nodeID.Bytes()))
+	if errs.Is(err, sql.ErrNoRows) {
+		return nil, gracefulexit.ErrNodeNotFound.Wrap(err)
+	} else if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &gracefulexit.Pause{
+		NodeID:         nodeID,
+		PausedAt:       dbxPause.PausedAt,
+		PausedDuration: time.Duration(dbxPause.PausedDuration),
+	}, nil
+}
+
+// DeletePause deletes a graceful exit pause entry.
+func (db *gracefulexitDB) DeletePause(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	_, err = db.db.Delete_GracefulExitPause_By_NodeId(ctx, dbx.GracefulExitPause_NodeId(nodeID.Bytes()))
+	return Error.Wrap(err)
+}
+
+// DeleteProgress deletes a graceful exit progress entry.
+func (db *gracefulexitDB) DeleteProgress(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	_, err = db.db.Delete_GracefulExitProgress_By_NodeId(ctx, dbx.GracefulExitProgress_NodeId(nodeID.Bytes()))
+	return Error.Wrap(err)
+}
+
+// StartPartialExit starts a partial exit of a node, replacing its finished partial exit.
+func (db *gracefulexitDB) StartPartialExit(ctx context.Context, nodeID storj.NodeID, requestedBytes int64, requestedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	statement := db.db.Rebind(
+		`INSERT INTO partial_exits (node_id, requested_bytes, queued_bytes, transferred_bytes, requested_at) VALUES (?, ?, 0, 0, ?)
+		 ON CONFLICT(node_id)
+		 DO UPDATE SET requested_bytes = excluded.requested_bytes,
+		 	queued_bytes = 0,
+		 	transferred_bytes = 0,
+		 	requested_at = excluded.requested_at,
+		 	queued_at = NULL,
+		 	finished_at = NULL
+		 WHERE partial_exits.finished_at IS NOT NULL;`,
+	)
+	result, err := db.db.ExecContext(ctx, statement, nodeID, requestedBytes, requestedAt.UTC())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return gracefulexit.ErrPartialExitActive.New("%s", nodeID)
+	}
+	return nil
+}
+
+// GetPartialExit gets the partial exit of a node.
+func (db *gracefulexitDB) GetPartialExit(ctx context.Context, nodeID storj.NodeID) (_ *gracefulexit.PartialExit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxPartialExit, err := db.db.Get_PartialExit_By_NodeId(ctx, dbx.PartialExit_NodeId(nodeID.Bytes()))
+	if errs.Is(err, sql.ErrNoRows) {
+		return nil, gracefulexit.ErrNodeNotFound.Wrap(err)
+	} else if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return dbxToPartialExit(dbxPartialExit)
+}
+
+// GetUnqueuedPartialExits gets the unfinished partial exits whose pieces weren't added to the transfer queue yet.
+func (db *gracefulexitDB) GetUnqueuedPartialExits(ctx context.Context) (_ []*gracefulexit.PartialExit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.Query(ctx, `
+		SELECT node_id, requested_bytes, queued_bytes, transferred_bytes, requested_at, queued_at, finished_at
+		FROM partial_exits
+		WHERE queued_at IS NULL AND finished_at IS NULL`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var partialExits []*gracefulexit.PartialExit
+	for rows.Next() {
+		dbxPartialExit := &dbx.PartialExit{}
+		err := rows.Scan(&dbxPartialExit.NodeId, &dbxPartialExit.RequestedBytes, &dbxPartialExit.QueuedBytes, &dbxPartialExit.TransferredBytes,
+			&dbxPartialExit.RequestedAt, &dbxPartialExit.QueuedAt, &dbxPartialExit.FinishedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		partialExit, err := dbxToPartialExit(dbxPartialExit)
+		if err != nil {
+			return nil, err
+		}
+		partialExits = append(partialExits, partialExit)
+	}
+	return partialExits, Error.Wrap(rows.Err())
+}
+
+// MarkPartialExitQueued records the bytes of the pieces added to the transfer queue for a partial exit.
+func (db *gracefulexitDB) MarkPartialExitQueued(ctx context.Context, nodeID storj.NodeID, queuedBytes int64, queuedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(
+		`UPDATE partial_exits SET queued_bytes = ?, queued_at = ? WHERE node_id = ? AND finished_at IS NULL`),
+		queuedBytes, queuedAt.UTC(), nodeID)
+	return Error.Wrap(err)
+}
+
+// FinishPartialExit finishes the partial exit of a node.
+func (db *gracefulexitDB) FinishPartialExit(ctx context.Context, nodeID storj.NodeID, transferredBytes int64, finishedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(
+		`UPDATE partial_exits SET transferred_bytes = ?, finished_at = ? WHERE node_id = ? AND finished_at IS NULL`),
+		transferredBytes, finishedAt.UTC(), nodeID)
+	return Error.Wrap(err)
+}
+
 // Enqueue batch inserts graceful exit transfer queue entries it does not exist.
 func (db *gracefulexitDB) Enqueue(ctx context.Context, items []gracefulexit.TransferQueueItem) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -313,3 +477,19 @@ func dbxToTransferQueueItem(dbxTransferQueue *dbx.GracefulExitTransferQueue) (it
 
 	return item, nil
 }
+
+func dbxToPartialExit(dbxPartialExit *dbx.PartialExit) (*gracefulexit.PartialExit, error) {
+	nodeID, err := storj.NodeIDFromBytes(dbxPartialExit.NodeId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &gracefulexit.PartialExit{
+		NodeID:           nodeID,
+		RequestedBytes:   dbxPartialExit.RequestedBytes,
+		QueuedBytes:      dbxPartialExit.QueuedBytes,
+		TransferredBytes: dbxPartialExit.TransferredBytes,
+		RequestedAt:      dbxPartialExit.RequestedAt,
+		QueuedAt:         dbxPartialExit.QueuedAt,
+		FinishedAt:       dbxPartialExit.FinishedAt,
+	}, nil
+}
@@ -58,6 +58,7 @@ func (db *invoiceProjectRecords) Create(ctx context.Context, records []stripecoi
 				dbx.StripecoinpaymentsInvoiceProjectRecord_Storage(record.Storage),
 				dbx.StripecoinpaymentsInvoiceProjectRecord_Egress(record.Egress),
 				dbx.StripecoinpaymentsInvoiceProjectRecord_Objects(int64(record.Objects)),
+				dbx.StripecoinpaymentsInvoiceProjectRecord_Segments(int64(record.Segments)),
 				dbx.StripecoinpaymentsInvoiceProjectRecord_PeriodStart(start),
 				dbx.StripecoinpaymentsInvoiceProjectRecord_PeriodEnd(end),
 				dbx.StripecoinpaymentsInvoiceProjectRecord_State(invoiceProjectRecordStateUnapplied.Int()),
@@ -187,6 +188,7 @@ func fromDBXInvoiceProjectRecord(dbxRecord *dbx.StripecoinpaymentsInvoiceProject
 		Storage:     dbxRecord.Storage,
 		Egress:      dbxRecord.Egress,
 		Objects:     float64(dbxRecord.Objects),
+		Segments:    float64(dbxRecord.Segments),
 		PeriodStart: dbxRecord.PeriodStart,
 		PeriodEnd:   dbxRecord.PeriodEnd,
 	}, nil
@@ -759,6 +759,146 @@ func (db *satelliteDB) PostgresMigration() *migrate.Migration {
 					);`,
 				},
 			},
+			{
+				DB:          db.DB,
+				Description: "Create bucket_usage_limits table",
+				Version:     82,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_usage_limits (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						storage_limit bigint NOT NULL,
+						bandwidth_limit bigint NOT NULL,
+						object_limit bigint NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				DB:          db.DB,
+				Description: "Create bucket_count_rollups table and add segments to invoice project records",
+				Version:     83,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_count_rollups (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						interval_start timestamp NOT NULL,
+						object_count bigint NOT NULL,
+						inline_segments_count bigint NOT NULL,
+						remote_segments_count bigint NOT NULL,
+						object_hours double precision NOT NULL,
+						inline_segment_hours double precision NOT NULL,
+						remote_segment_hours double precision NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name, interval_start )
+					);`,
+					`ALTER TABLE stripecoinpayments_invoice_project_records ADD COLUMN segments bigint NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				DB:          db.DB,
+				Description: "Add storage byte hours to bucket_count_rollups",
+				Version:     84,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_count_rollups ADD COLUMN inline_byte_hours double precision NOT NULL DEFAULT 0;`,
+					`ALTER TABLE bucket_count_rollups ADD COLUMN remote_byte_hours double precision NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				DB:          db.DB,
+				Description: "Add payout_statements table",
+				Version:     85,
+				Action: migrate.SQL{
+					`CREATE TABLE payout_statements (
+						node_id bytea NOT NULL,
+						period timestamp with time zone NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						node_created_at timestamp with time zone NOT NULL,
+						node_age_months integer NOT NULL,
+						wallet text NOT NULL,
+						usage_at_rest double precision NOT NULL,
+						usage_put bigint NOT NULL,
+						usage_get bigint NOT NULL,
+						usage_put_repair bigint NOT NULL,
+						usage_get_repair bigint NOT NULL,
+						usage_get_audit bigint NOT NULL,
+						comp_at_rest bigint NOT NULL,
+						comp_put bigint NOT NULL,
+						comp_get bigint NOT NULL,
+						comp_put_repair bigint NOT NULL,
+						comp_get_repair bigint NOT NULL,
+						comp_get_audit bigint NOT NULL,
+						held_percent integer NOT NULL,
+						held bigint NOT NULL,
+						disposed bigint NOT NULL,
+						owed bigint NOT NULL,
+						graceful_exit boolean NOT NULL,
+						PRIMARY KEY ( node_id, period )
+					);`,
+				},
+			},
+			{
+				DB:          db.DB,
+				Description: "Add corrupt_pieces table",
+				Version:     86,
+				Action: migrate.SQL{
+					`CREATE TABLE corrupt_pieces (
+						node_id bytea NOT NULL,
+						piece_id bytea NOT NULL,
+						reported_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id, piece_id )
+					);`,
+				},
+			},
+			{
+				DB:          db.DB,
+				Description: "Add graceful_exit_pauses table",
+				Version:     87,
+				Action: migrate.SQL{
+					`CREATE TABLE graceful_exit_pauses (
+						node_id bytea NOT NULL,
+						paused_at timestamp with time zone,
+						paused_duration bigint NOT NULL,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				DB:          db.DB,
+				Description: "Add partial_exits table",
+				Version:     88,
+				Action: migrate.SQL{
+					`CREATE TABLE partial_exits (
+						node_id bytea NOT NULL,
+						requested_bytes bigint NOT NULL,
+						queued_bytes bigint NOT NULL,
+						transferred_bytes bigint NOT NULL,
+						requested_at timestamp with time zone NOT NULL,
+						queued_at timestamp with time zone,
+						finished_at timestamp with time zone,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				DB:          db.DB,
+				Description: "Add processed_at to corrupt_pieces",
+				Version:     89,
+				Action: migrate.SQL{
+					`ALTER TABLE corrupt_pieces ADD COLUMN processed_at timestamp with time zone;`,
+				},
+			},
+			{
+				DB:          db.DB,
+				Description: "Add unknown audit reputation and suspended to nodes",
+				Version:     90,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN unknown_audit_reputation_alpha double precision NOT NULL DEFAULT 1;`,
+					`ALTER TABLE nodes ADD COLUMN unknown_audit_reputation_beta double precision NOT NULL DEFAULT 0;`,
+					`ALTER TABLE nodes ADD COLUMN suspended timestamp with time zone;`,
+				},
+			},
 		},
 	}
 }
@@ -562,6 +562,8 @@ func (cache *overlaycache) UpdateAddress(ctx context.Context, info *pb.Node, def
 				dbx.Node_UptimeReputationAlpha(0),
 				dbx.Node_UptimeReputationBeta(0),
 				dbx.Node_ExitSuccess(false),
+				dbx.Node_UnknownAuditReputationAlpha(1),
+				dbx.Node_UnknownAuditReputationBeta(0),
 				dbx.Node_Create_Fields{
 					Disqualified: dbx.Node_Disqualified_Null(),
 				},
@@ -1184,6 +1186,10 @@ func getNodeStats(dbNode *dbx.Node) *overlay.NodeStats {
 		AuditReputationAlpha: dbNode.AuditReputationAlpha,
 		AuditReputationBeta:  dbNode.AuditReputationBeta,
 		Disqualified:         dbNode.Disqualified,
+
+		UnknownAuditReputationAlpha: dbNode.UnknownAuditReputationAlpha,
+		UnknownAuditReputationBeta:  dbNode.UnknownAuditReputationBeta,
+		Suspended:                   dbNode.Suspended,
 	}
 	return nodeStats
 }
@@ -1240,6 +1246,31 @@ func buildUpdateStatement(update updateNodeStats) string {
 		atLeastOne = true
 		sql += fmt.Sprintf("disqualified = '%v'", update.Disqualified.value.Format(time.RFC3339Nano))
 	}
+	if update.UnknownAuditReputationAlpha.set {
+		if atLeastOne {
+			sql += ","
+		}
+		atLeastOne = true
+		sql += fmt.Sprintf("unknown_audit_reputation_alpha = %v", update.UnknownAuditReputationAlpha.value)
+	}
+	if update.UnknownAuditReputationBeta.set {
+		if atLeastOne {
+			sql += ","
+		}
+		atLeastOne = true
+		sql += fmt.Sprintf("unknown_audit_reputation_beta = %v", update.UnknownAuditReputationBeta.value)
+	}
+	if update.Suspended.set {
+		if atLeastOne {
+			sql += ","
+		}
+		atLeastOne = true
+		if update.Suspended.null {
+			sql += "suspended = NULL"
+		} else {
+			sql += fmt.Sprintf("suspended = '%v'", update.Suspended.value.Format(time.RFC3339Nano))
+		}
+	}
 	if update.UptimeSuccessCount.set {
 		if atLeastOne {
 			sql += ","
@@ -1307,48 +1338,103 @@ type timeField struct {
 	value time.Time
 }
 
+type nullTimeField struct {
+	set   bool
+	null  bool
+	value time.Time
+}
+
 type updateNodeStats struct {
-	NodeID               storj.NodeID
-	TotalAuditCount      int64Field
-	TotalUptimeCount     int64Field
-	AuditReputationAlpha float64Field
-	AuditReputationBeta  float64Field
-	Disqualified         timeField
-	UptimeSuccessCount   int64Field
-	LastContactSuccess   timeField
-	LastContactFailure   timeField
-	AuditSuccessCount    int64Field
-	Contained            boolField
+	NodeID                      storj.NodeID
+	TotalAuditCount             int64Field
+	TotalUptimeCount            int64Field
+	AuditReputationAlpha        float64Field
+	AuditReputationBeta         float64Field
+	Disqualified                timeField
+	UptimeSuccessCount          int64Field
+	UnknownAuditReputationAlpha float64Field
+	UnknownAuditReputationBeta  float64Field
+	Suspended                   nullTimeField
+	LastContactSuccess          timeField
+	LastContactFailure          timeField
+	AuditSuccessCount           int64Field
+	Contained                   boolField
 }
 
 func populateUpdateNodeStats(dbNode *dbx.Node, updateReq *overlay.UpdateRequest) updateNodeStats {
-	auditAlpha, auditBeta, totalAuditCount := updateReputation(
-		updateReq.AuditSuccess,
-		dbNode.AuditReputationAlpha,
-		dbNode.AuditReputationBeta,
-		updateReq.AuditLambda,
-		updateReq.AuditWeight,
-		dbNode.TotalAuditCount,
-	)
-	mon.FloatVal("audit_reputation_alpha").Observe(auditAlpha) //locked
-	mon.FloatVal("audit_reputation_beta").Observe(auditBeta)   //locked
-
 	totalUptimeCount := dbNode.TotalUptimeCount
 	if updateReq.IsUp {
 		totalUptimeCount++
 	}
 
 	updateFields := updateNodeStats{
-		NodeID:               updateReq.NodeID,
-		TotalAuditCount:      int64Field{set: true, value: totalAuditCount},
-		TotalUptimeCount:     int64Field{set: true, value: totalUptimeCount},
-		AuditReputationAlpha: float64Field{set: true, value: auditAlpha},
-		AuditReputationBeta:  float64Field{set: true, value: auditBeta},
+		NodeID:           updateReq.NodeID,
+		TotalUptimeCount: int64Field{set: true, value: totalUptimeCount},
+	}
+
+	// an unknown audit result only counts against the unknown audit
+	// reputation, which decides suspension rather than disqualification.
+	// It isn't a completed audit, so the audit count stays the same.
+	if updateReq.AuditUnknown {
+		unknownAlpha, unknownBeta, _ := updateReputation(
+			false,
+			dbNode.UnknownAuditReputationAlpha,
+			dbNode.UnknownAuditReputationBeta,
+			updateReq.AuditLambda,
+			updateReq.AuditWeight,
+			0,
+		)
+		updateFields.UnknownAuditReputationAlpha = float64Field{set: true, value: unknownAlpha}
+		updateFields.UnknownAuditReputationBeta = float64Field{set: true, value: unknownBeta}
+	} else {
+		auditAlpha, auditBeta, totalAuditCount := updateReputation(
+			updateReq.AuditSuccess,
+			dbNode.AuditReputationAlpha,
+			dbNode.AuditReputationBeta,
+			updateReq.AuditLambda,
+			updateReq.AuditWeight,
+			dbNode.TotalAuditCount,
+		)
+		mon.FloatVal("audit_reputation_alpha").Observe(auditAlpha) //locked
+		mon.FloatVal("audit_reputation_beta").Observe(auditBeta)   //locked
+
+		updateFields.TotalAuditCount = int64Field{set: true, value: totalAuditCount}
+		updateFields.AuditReputationAlpha = float64Field{set: true, value: auditAlpha}
+		updateFields.AuditReputationBeta = float64Field{set: true, value: auditBeta}
+
+		auditRep := auditAlpha / (auditAlpha + auditBeta)
+		if auditRep <= updateReq.AuditDQ {
+			updateFields.Disqualified = timeField{set: true, value: time.Now().UTC()}
+		}
+
+		if updateReq.AuditSuccess {
+			unknownAlpha, unknownBeta, _ := updateReputation(
+				true,
+				dbNode.UnknownAuditReputationAlpha,
+				dbNode.UnknownAuditReputationBeta,
+				updateReq.AuditLambda,
+				updateReq.AuditWeight,
+				0,
+			)
+			updateFields.UnknownAuditReputationAlpha = float64Field{set: true, value: unknownAlpha}
+			updateFields.UnknownAuditReputationBeta = float64Field{set: true, value: unknownBeta}
+		}
 	}
 
-	auditRep := auditAlpha / (auditAlpha + auditBeta)
-	if auditRep <= updateReq.AuditDQ {
-		updateFields.Disqualified = timeField{set: true, value: time.Now().UTC()}
+	if updateFields.UnknownAuditReputationAlpha.set {
+		unknownAlpha := updateFields.UnknownAuditReputationAlpha.value
+		unknownBeta := updateFields.UnknownAuditReputationBeta.value
+		mon.FloatVal("unknown_audit_reputation_alpha").Observe(unknownAlpha)
+		mon.FloatVal("unknown_audit_reputation_beta").Observe(unknownBeta)
+
+		unknownRep := unknownAlpha / (unknownAlpha + unknownBeta)
+		suspend := updateReq.AuditSuspensionDQ > 0 && unknownRep <= updateReq.AuditSuspensionDQ
+		switch {
+		case suspend && dbNode.Suspended == nil:
+			updateFields.Suspended = nullTimeField{set: true, value: time.Now().UTC()}
+		case !suspend && dbNode.Suspended != nil:
+			updateFields.Suspended = nullTimeField{set: true, null: true}
+		}
 	}
 
 	if updateReq.IsUp {
@@ -1387,6 +1473,19 @@ func populateUpdateFields(dbNode *dbx.Node, updateReq *overlay.UpdateRequest) db
 	if update.Disqualified.set {
 		updateFields.Disqualified = dbx.Node_Disqualified(update.Disqualified.value)
 	}
+	if update.UnknownAuditReputationAlpha.set {
+		updateFields.UnknownAuditReputationAlpha = dbx.Node_UnknownAuditReputationAlpha(update.UnknownAuditReputationAlpha.value)
+	}
+	if update.UnknownAuditReputationBeta.set {
+		updateFields.UnknownAuditReputationBeta = dbx.Node_UnknownAuditReputationBeta(update.UnknownAuditReputationBeta.value)
+	}
+	if update.Suspended.set {
+		if update.Suspended.null {
+			updateFields.Suspended = dbx.Node_Suspended_Null()
+		} else {
+			updateFields.Suspended = dbx.Node_Suspended(update.Suspended.value)
+		}
+	}
 	if update.UptimeSuccessCount.set {
 		updateFields.UptimeSuccessCount = dbx.Node_UptimeSuccessCount(update.UptimeSuccessCount.value)
 	}
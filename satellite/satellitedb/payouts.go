@@ -0,0 +1,188 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/payouts"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that *payoutsDB implements payouts.DB.
+var _ payouts.DB = (*payoutsDB)(nil)
+
+// payoutsDB stores payout statements.
+type payoutsDB struct {
+	db *satelliteDB
+}
+
+// CreateStatements stores statements, statements which already exist are left unchanged.
+func (db *payoutsDB) CreateStatements(ctx context.Context, statements []payouts.Statement) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		for _, statement := range statements {
+			_, err := tx.Tx.ExecContext(ctx, db.db.Rebind(`
+				INSERT INTO payout_statements (
+					node_id, period, created_at,
+					node_created_at, node_age_months, wallet,
+					usage_at_rest, usage_put, usage_get, usage_put_repair, usage_get_repair, usage_get_audit,
+					comp_at_rest, comp_put, comp_get, comp_put_repair, comp_get_repair, comp_get_audit,
+					held_percent, held, disposed, owed, graceful_exit
+				) VALUES (
+					?, ?, ?,
+					?, ?, ?,
+					?, ?, ?, ?, ?, ?,
+					?, ?, ?, ?, ?, ?,
+					?, ?, ?, ?, ?
+				)
+				ON CONFLICT (node_id, period) DO NOTHING
+			`),
+				statement.NodeID.Bytes(), statement.Period.UTC(), statement.CreatedAt.UTC(),
+				statement.NodeCreatedAt.UTC(), statement.NodeAgeMonths, statement.Wallet,
+				statement.UsageAtRest, statement.UsagePut, statement.UsageGet,
+				statement.UsagePutRepair, statement.UsageGetRepair, statement.UsageGetAudit,
+				statement.CompAtRest, statement.CompPut, statement.CompGet,
+				statement.CompPutRepair, statement.CompGetRepair, statement.CompGetAudit,
+				statement.HeldPercent, statement.Held, statement.Disposed, statement.Owed, statement.GracefulExit,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetStatement returns the statement of the node for the period.
+func (db *payoutsDB) GetStatement(ctx context.Context, nodeID storj.NodeID, period time.Time) (_ payouts.Statement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxStatement, err := db.db.Get_PayoutStatement_By_NodeId_And_Period(ctx,
+		dbx.PayoutStatement_NodeId(nodeID.Bytes()),
+		dbx.PayoutStatement_Period(period.UTC()),
+	)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return payouts.Statement{}, payouts.ErrNotFound.New("%s %s", nodeID, period.Format("2006-01"))
+		}
+		return payouts.Statement{}, Error.Wrap(err)
+	}
+
+	statement, err := fromDBXPayoutStatement(dbxStatement)
+	return statement, Error.Wrap(err)
+}
+
+// ListStatements returns statements of the node since the period, ordered by period.
+func (db *payoutsDB) ListStatements(ctx context.Context, nodeID storj.NodeID, since time.Time) (_ []payouts.Statement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxStatements, err := db.db.All_PayoutStatement_By_NodeId_And_Period_GreaterOrEqual_OrderBy_Asc_Period(ctx,
+		dbx.PayoutStatement_NodeId(nodeID.Bytes()),
+		dbx.PayoutStatement_Period(since.UTC()),
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return fromDBXPayoutStatements(dbxStatements)
+}
+
+// ListPeriodStatements returns statements of all nodes for the period.
+func (db *payoutsDB) ListPeriodStatements(ctx context.Context, period time.Time) (_ []payouts.Statement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxStatements, err := db.db.All_PayoutStatement_By_Period_OrderBy_Asc_NodeId(ctx,
+		dbx.PayoutStatement_Period(period.UTC()),
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return fromDBXPayoutStatements(dbxStatements)
+}
+
+// GetHeldBalances returns the amounts held and not yet disposed of all nodes by statements before the period.
+func (db *payoutsDB) GetHeldBalances(ctx context.Context, before time.Time) (_ map[storj.NodeID]int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT node_id, SUM(held - disposed)
+		FROM payout_statements
+		WHERE period < ?
+		GROUP BY node_id
+	`), before.UTC())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(rows.Close())) }()
+
+	balances := map[storj.NodeID]int64{}
+	for rows.Next() {
+		var nodeIDBytes []byte
+		var balance int64
+		if err := rows.Scan(&nodeIDBytes, &balance); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		nodeID, err := storj.NodeIDFromBytes(nodeIDBytes)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		balances[nodeID] = balance
+	}
+	return balances, Error.Wrap(rows.Err())
+}
+
+// fromDBXPayoutStatements converts dbx payout statements to payouts.Statement.
+func fromDBXPayoutStatements(dbxStatements []*dbx.PayoutStatement) ([]payouts.Statement, error) {
+	statements := make([]payouts.Statement, 0, len(dbxStatements))
+	for _, dbxStatement := range dbxStatements {
+		statement, err := fromDBXPayoutStatement(dbxStatement)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		statements = append(statements, statement)
+	}
+	return statements, nil
+}
+
+// fromDBXPayoutStatement converts dbx payout statement to payouts.Statement.
+func fromDBXPayoutStatement(dbxStatement *dbx.PayoutStatement) (payouts.Statement, error) {
+	nodeID, err := storj.NodeIDFromBytes(dbxStatement.NodeId)
+	if err != nil {
+		return payouts.Statement{}, err
+	}
+
+	return payouts.Statement{
+		NodeID:         nodeID,
+		Period:         dbxStatement.Period.UTC(),
+		CreatedAt:      dbxStatement.CreatedAt.UTC(),
+		NodeCreatedAt:  dbxStatement.NodeCreatedAt.UTC(),
+		NodeAgeMonths:  dbxStatement.NodeAgeMonths,
+		Wallet:         dbxStatement.Wallet,
+		UsageAtRest:    dbxStatement.UsageAtRest,
+		UsagePut:       dbxStatement.UsagePut,
+		UsageGet:       dbxStatement.UsageGet,
+		UsagePutRepair: dbxStatement.UsagePutRepair,
+		UsageGetRepair: dbxStatement.UsageGetRepair,
+		UsageGetAudit:  dbxStatement.UsageGetAudit,
+		CompAtRest:     dbxStatement.CompAtRest,
+		CompPut:        dbxStatement.CompPut,
+		CompGet:        dbxStatement.CompGet,
+		CompPutRepair:  dbxStatement.CompPutRepair,
+		CompGetRepair:  dbxStatement.CompGetRepair,
+		CompGetAudit:   dbxStatement.CompGetAudit,
+		HeldPercent:    dbxStatement.HeldPercent,
+		Held:           dbxStatement.Held,
+		Disposed:       dbxStatement.Disposed,
+		Owed:           dbxStatement.Owed,
+		GracefulExit:   dbxStatement.GracefulExit,
+	}, nil
+}
@@ -69,6 +69,63 @@ func (db *ProjectAccounting) SaveTallies(ctx context.Context, intervalStart time
 	return Error.Wrap(err)
 }
 
+// SaveBucketCountRollups adds object and segment counts and stored bytes of bucket tallies,
+// held for the given hours, to the hourly bucket count rollups.
+func (db *ProjectAccounting) SaveBucketCountRollups(ctx context.Context, intervalStart time.Time, hours float64, bucketTallies map[string]*accounting.BucketTally) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(bucketTallies) == 0 {
+		return nil
+	}
+	intervalStart = intervalStart.UTC().Truncate(time.Hour)
+
+	var bucketNames, projectIDs [][]byte
+	var objectCounts, inlineSegments, remoteSegments []int64
+	var objectHours, inlineSegmentHours, remoteSegmentHours []float64
+	var inlineByteHours, remoteByteHours []float64
+	for _, info := range bucketTallies {
+		bucketNames = append(bucketNames, info.BucketName)
+		projectIDs = append(projectIDs, info.ProjectID[:])
+		objectCounts = append(objectCounts, info.ObjectCount)
+		inlineSegments = append(inlineSegments, info.InlineSegments)
+		remoteSegments = append(remoteSegments, info.RemoteSegments)
+		objectHours = append(objectHours, float64(info.ObjectCount)*hours)
+		inlineSegmentHours = append(inlineSegmentHours, float64(info.InlineSegments)*hours)
+		remoteSegmentHours = append(remoteSegmentHours, float64(info.RemoteSegments)*hours)
+		inlineByteHours = append(inlineByteHours, float64(info.InlineBytes)*hours)
+		remoteByteHours = append(remoteByteHours, float64(info.RemoteBytes)*hours)
+	}
+	_, err = db.db.DB.ExecContext(ctx, db.db.Rebind(`
+		INSERT INTO bucket_count_rollups (
+			interval_start,
+			project_id, bucket_name,
+			object_count, inline_segments_count, remote_segments_count,
+			object_hours, inline_segment_hours, remote_segment_hours,
+			inline_byte_hours, remote_byte_hours)
+		SELECT
+			$1,
+			unnest($2::bytea[]), unnest($3::bytea[]),
+			unnest($4::int8[]), unnest($5::int8[]), unnest($6::int8[]),
+			unnest($7::float8[]), unnest($8::float8[]), unnest($9::float8[]),
+			unnest($10::float8[]), unnest($11::float8[])
+		ON CONFLICT (project_id, bucket_name, interval_start)
+		DO UPDATE SET
+			object_count = EXCLUDED.object_count,
+			inline_segments_count = EXCLUDED.inline_segments_count,
+			remote_segments_count = EXCLUDED.remote_segments_count,
+			object_hours = bucket_count_rollups.object_hours + EXCLUDED.object_hours,
+			inline_segment_hours = bucket_count_rollups.inline_segment_hours + EXCLUDED.inline_segment_hours,
+			remote_segment_hours = bucket_count_rollups.remote_segment_hours + EXCLUDED.remote_segment_hours,
+			inline_byte_hours = bucket_count_rollups.inline_byte_hours + EXCLUDED.inline_byte_hours,
+			remote_byte_hours = bucket_count_rollups.remote_byte_hours + EXCLUDED.remote_byte_hours`),
+		intervalStart,
+		pq.ByteaArray(projectIDs), pq.ByteaArray(bucketNames),
+		pq.Array(objectCounts), pq.Array(inlineSegments), pq.Array(remoteSegments),
+		pq.Array(objectHours), pq.Array(inlineSegmentHours), pq.Array(remoteSegmentHours),
+		pq.Array(inlineByteHours), pq.Array(remoteByteHours))
+
+	return Error.Wrap(err)
+}
+
 // GetTallies saves the latest bucket info
 func (db *ProjectAccounting) GetTallies(ctx context.Context) (tallies []accounting.BucketTally, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -152,6 +209,106 @@ func (db *ProjectAccounting) GetStorageTotals(ctx context.Context, projectID uui
 	return inlineSum.Int64, remoteSum.Int64, err
 }
 
+// GetBucketAllocatedBandwidthTotal returns the sum of GET bandwidth usage allocated for a bucket for a time frame
+func (db *ProjectAccounting) GetBucketAllocatedBandwidthTotal(ctx context.Context, projectID uuid.UUID, bucketName []byte, from time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var sum *int64
+	query := `SELECT SUM(allocated) FROM bucket_bandwidth_rollups WHERE project_id = ? AND bucket_name = ? AND action = ? AND interval_start > ?;`
+	err = db.db.QueryRow(ctx, db.db.Rebind(query), projectID[:], bucketName, pb.PieceAction_GET, from).Scan(&sum)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	// the sum is NULL when there are no rollups in the time frame
+	if sum == nil {
+		return 0, nil
+	}
+	return *sum, nil
+}
+
+// GetBucketStorageTotals returns the most recently tallied storage usage and object count of a bucket
+func (db *ProjectAccounting) GetBucketStorageTotals(ctx context.Context, projectID uuid.UUID, bucketName []byte) (storage int64, objects int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var inline, remote int64
+	query := `SELECT inline, remote, object_count
+		FROM bucket_storage_tallies
+		WHERE project_id = ? AND bucket_name = ?
+		ORDER BY interval_start DESC LIMIT 1;`
+
+	err = db.db.QueryRow(ctx, db.db.Rebind(query), projectID[:], bucketName).Scan(&inline, &remote, &objects)
+	if err == sql.ErrNoRows {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, Error.Wrap(err)
+	}
+
+	return inline + remote, objects, nil
+}
+
+// GetBucketUsageLimits returns the usage limits of a bucket.
+func (db *ProjectAccounting) GetBucketUsageLimits(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ accounting.BucketUsageLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits := accounting.BucketUsageLimits{
+		ProjectID:  projectID,
+		BucketName: bucketName,
+	}
+
+	row, err := db.db.Get_BucketUsageLimit_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketUsageLimit_ProjectId(projectID[:]),
+		dbx.BucketUsageLimit_BucketName(bucketName),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return limits, nil
+		}
+		return limits, Error.Wrap(err)
+	}
+
+	limits.Storage = memory.Size(row.StorageLimit)
+	limits.Bandwidth = memory.Size(row.BandwidthLimit)
+	limits.Objects = row.ObjectLimit
+	return limits, nil
+}
+
+// UpdateBucketUsageLimits creates or replaces the usage limits of a bucket.
+func (db *ProjectAccounting) UpdateBucketUsageLimits(ctx context.Context, limits accounting.BucketUsageLimits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		INSERT INTO bucket_usage_limits (
+			project_id, bucket_name,
+			storage_limit, bandwidth_limit, object_limit,
+			created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, now(), now())
+		ON CONFLICT (project_id, bucket_name) DO UPDATE SET
+			storage_limit = EXCLUDED.storage_limit,
+			bandwidth_limit = EXCLUDED.bandwidth_limit,
+			object_limit = EXCLUDED.object_limit,
+			updated_at = EXCLUDED.updated_at`),
+		limits.ProjectID[:], limits.BucketName,
+		limits.Storage.Int64(), limits.Bandwidth.Int64(), limits.Objects,
+	)
+
+	return Error.Wrap(err)
+}
+
+// DeleteBucketUsageLimits removes the usage limits of a bucket.
+func (db *ProjectAccounting) DeleteBucketUsageLimits(ctx context.Context, projectID uuid.UUID, bucketName []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Delete_BucketUsageLimit_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketUsageLimit_ProjectId(projectID[:]),
+		dbx.BucketUsageLimit_BucketName(bucketName),
+	)
+
+	return Error.Wrap(err)
+}
+
 // UpdateProjectUsageLimit updates project usage limit.
 func (db *ProjectAccounting) UpdateProjectUsageLimit(ctx context.Context, projectID uuid.UUID, limit memory.Size) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -253,8 +410,14 @@ func (db *ProjectAccounting) GetProjectTotal(ctx context.Context, projectID uuid
 		return nil, err
 	}
 
+	totalSegments, err := db.getTotalSegmentHours(ctx, projectID, since, before)
+	if err != nil {
+		return nil, err
+	}
+
 	usage = new(accounting.ProjectUsage)
 	usage.Egress = memory.Size(totalEgress).Int64()
+	usage.SegmentCount = totalSegments
 
 	// sum up storage and objects
 	for _, tallies := range bucketsTallies {
@@ -297,6 +460,25 @@ func (db *ProjectAccounting) getTotalEgress(ctx context.Context, projectID uuid.
 	return totalEgress, err
 }
 
+// getTotalSegmentHours returns the sum of inline and remote segment hours of
+// each bucket_count_rollup in selected time period, project id.
+func (db *ProjectAccounting) getTotalSegmentHours(ctx context.Context, projectID uuid.UUID, since, before time.Time) (totalSegments float64, err error) {
+	totalSegmentsQuery := db.db.Rebind(`
+		SELECT
+			COALESCE(SUM(inline_segment_hours) + SUM(remote_segment_hours), 0)
+		FROM
+			bucket_count_rollups
+		WHERE
+			project_id = ? AND
+			interval_start >= ? AND
+			interval_start <= ?;
+	`)
+
+	err = db.db.QueryRowContext(ctx, totalSegmentsQuery, projectID[:], since, before).Scan(&totalSegments)
+
+	return totalSegments, err
+}
+
 // GetBucketUsageRollups retrieves summed usage rollups for every bucket of particular project for a given period
 func (db *ProjectAccounting) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.BucketUsageRollup, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -315,6 +497,7 @@ func (db *ProjectAccounting) GetBucketUsageRollups(ctx context.Context, projectI
 
 	// TODO: should be optimized
 	storageQuery := db.db.All_BucketStorageTally_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Desc_IntervalStart
+	countQuery := db.db.All_BucketCountRollup_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Asc_IntervalStart
 
 	var bucketUsageRollups []accounting.BucketUsageRollup
 	for _, bucket := range buckets {
@@ -367,20 +550,51 @@ func (db *ProjectAccounting) GetBucketUsageRollups(ctx context.Context, projectI
 			return nil, err
 		}
 
+		bucketCountRollups, err := countQuery(ctx,
+			dbx.BucketCountRollup_ProjectId(projectID[:]),
+			dbx.BucketCountRollup_BucketName([]byte(bucket)),
+			dbx.BucketCountRollup_IntervalStart(since),
+			dbx.BucketCountRollup_IntervalStart(before))
+		if err != nil {
+			return nil, err
+		}
+
+		// prefer persisted count rollups over the estimate from tallies,
+		// the estimate is only used for the hours the rollups don't cover,
+		// i.e. periods before count rollups were recorded and the hours
+		// after the last rollup
+		var coveredFrom, coveredUntil time.Time
+		if len(bucketCountRollups) > 0 {
+			coveredFrom = bucketCountRollups[0].IntervalStart
+			coveredUntil = bucketCountRollups[len(bucketCountRollups)-1].IntervalStart.Add(time.Hour)
+
+			for _, rollup := range bucketCountRollups {
+				bucketRollup.ObjectCount += rollup.ObjectHours
+				bucketRollup.InlineSegments += rollup.InlineSegmentHours
+				bucketRollup.RemoteSegments += rollup.RemoteSegmentHours
+			}
+		}
+
 		// fill metadata, objects and stored data
 		// hours calculated from previous tallies,
 		// so we skip the most recent one
 		for i := len(bucketStorageTallies) - 1; i > 0; i-- {
 			current := bucketStorageTallies[i]
+			next := bucketStorageTallies[i-1]
 
-			hours := bucketStorageTallies[i-1].IntervalStart.Sub(current.IntervalStart).Hours()
+			hours := next.IntervalStart.Sub(current.IntervalStart).Hours()
 
 			bucketRollup.RemoteStoredData += memory.Size(current.Remote).GB() * hours
 			bucketRollup.InlineStoredData += memory.Size(current.Inline).GB() * hours
 			bucketRollup.MetadataSize += memory.Size(current.MetadataSize).GB() * hours
-			bucketRollup.RemoteSegments += float64(current.RemoteSegmentsCount) * hours
-			bucketRollup.InlineSegments += float64(current.InlineSegmentsCount) * hours
-			bucketRollup.ObjectCount += float64(current.ObjectCount) * hours
+
+			uncovered := hours
+			if len(bucketCountRollups) > 0 {
+				uncovered = uncoveredHours(current.IntervalStart, next.IntervalStart, coveredFrom, coveredUntil)
+			}
+			bucketRollup.RemoteSegments += float64(current.RemoteSegmentsCount) * uncovered
+			bucketRollup.InlineSegments += float64(current.InlineSegmentsCount) * uncovered
+			bucketRollup.ObjectCount += float64(current.ObjectCount) * uncovered
 		}
 
 		bucketUsageRollups = append(bucketUsageRollups, bucketRollup)
@@ -389,6 +603,165 @@ func (db *ProjectAccounting) GetBucketUsageRollups(ctx context.Context, projectI
 	return bucketUsageRollups, nil
 }
 
+// GetUsageExportedUntil returns the end of the last period pushed by the usage export webhook, it is zero if nothing was pushed yet.
+func (db *ProjectAccounting) GetUsageExportedUntil(ctx context.Context) (_ time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row, err := db.db.Find_AccountingTimestamps_Value_By_Name(ctx, dbx.AccountingTimestamps_Name(accounting.LastUsageExport))
+	if err != nil {
+		return time.Time{}, Error.Wrap(err)
+	}
+	if row == nil {
+		return time.Time{}, nil
+	}
+	return row.Value.UTC(), nil
+}
+
+// UpdateUsageExportedUntil records the end of the last period pushed by the usage export webhook.
+func (db *ProjectAccounting) UpdateUsageExportedUntil(ctx context.Context, exportedUntil time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		INSERT INTO accounting_timestamps (name, value) VALUES (?, ?)
+		ON CONFLICT (name) DO UPDATE SET value = EXCLUDED.value`),
+		accounting.LastUsageExport, exportedUntil.UTC())
+	return Error.Wrap(err)
+}
+
+// uncoveredHours returns the hours of the interval from start to end, which
+// are outside of the interval from coveredFrom to coveredUntil.
+func uncoveredHours(start, end, coveredFrom, coveredUntil time.Time) float64 {
+	var uncovered time.Duration
+	if start.Before(coveredFrom) {
+		until := coveredFrom
+		if end.Before(until) {
+			until = end
+		}
+		uncovered += until.Sub(start)
+	}
+	if end.After(coveredUntil) {
+		from := coveredUntil
+		if start.After(from) {
+			from = start
+		}
+		uncovered += end.Sub(from)
+	}
+	return uncovered.Hours()
+}
+
+// ExportUsage returns hourly usage of all buckets ordered by hour, project and bucket, starting after cursor.
+func (db *ProjectAccounting) ExportUsage(ctx context.Context, cursor accounting.UsageExportCursor) (_ accounting.UsageExportPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	since := cursor.Since.UTC()
+	before := cursor.Before.UTC()
+
+	// comparing with NULL would exclude every row from the first page
+	lastBucketName := cursor.BucketName
+	if lastBucketName == nil {
+		lastBucketName = []byte{}
+	}
+
+	query := db.db.Rebind(`
+		SELECT
+			usage_keys.interval_start, usage_keys.project_id, usage_keys.bucket_name,
+			COALESCE(counts.byte_hours, 0), COALESCE(counts.object_hours, 0), COALESCE(counts.segment_hours, 0),
+			COALESCE(bandwidth.get_egress, 0), COALESCE(bandwidth.get_audit_egress, 0), COALESCE(bandwidth.get_repair_egress, 0)
+		FROM (
+			SELECT interval_start, project_id, bucket_name
+			FROM bucket_count_rollups
+			WHERE interval_start >= ? AND interval_start < ?
+			UNION
+			SELECT interval_start, project_id, bucket_name
+			FROM bucket_bandwidth_rollups
+			WHERE interval_start >= ? AND interval_start < ?
+		) AS usage_keys
+		LEFT JOIN (
+			SELECT
+				interval_start, project_id, bucket_name,
+				inline_byte_hours + remote_byte_hours AS byte_hours,
+				object_hours,
+				inline_segment_hours + remote_segment_hours AS segment_hours
+			FROM bucket_count_rollups
+			WHERE interval_start >= ? AND interval_start < ?
+		) AS counts ON
+			counts.interval_start = usage_keys.interval_start AND
+			counts.project_id = usage_keys.project_id AND
+			counts.bucket_name = usage_keys.bucket_name
+		LEFT JOIN (
+			SELECT
+				interval_start, project_id, bucket_name,
+				SUM(CASE WHEN action = ? THEN settled + inline ELSE 0 END) AS get_egress,
+				SUM(CASE WHEN action = ? THEN settled + inline ELSE 0 END) AS get_audit_egress,
+				SUM(CASE WHEN action = ? THEN settled + inline ELSE 0 END) AS get_repair_egress
+			FROM bucket_bandwidth_rollups
+			WHERE interval_start >= ? AND interval_start < ?
+			GROUP BY interval_start, project_id, bucket_name
+		) AS bandwidth ON
+			bandwidth.interval_start = usage_keys.interval_start AND
+			bandwidth.project_id = usage_keys.project_id AND
+			bandwidth.bucket_name = usage_keys.bucket_name
+		WHERE (usage_keys.interval_start, usage_keys.project_id, usage_keys.bucket_name) > (?, ?, ?)
+		ORDER BY usage_keys.interval_start, usage_keys.project_id, usage_keys.bucket_name
+		LIMIT ?
+	`)
+
+	rows, err := db.db.QueryContext(ctx, query,
+		since, before,
+		since, before,
+		since, before,
+		pb.PieceAction_GET, pb.PieceAction_GET_AUDIT, pb.PieceAction_GET_REPAIR,
+		since, before,
+		cursor.IntervalStart.UTC(), cursor.ProjectID[:], lastBucketName,
+		cursor.Limit+1,
+	)
+	if err != nil {
+		return accounting.UsageExportPage{}, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	page := accounting.UsageExportPage{
+		Cursor: cursor,
+	}
+	for rows.Next() {
+		var record accounting.UsageExportRecord
+		var projectID []byte
+
+		err = rows.Scan(
+			&record.IntervalStart, &projectID, &record.BucketName,
+			&record.StorageByteHours, &record.ObjectHours, &record.SegmentHours,
+			&record.GetEgress, &record.GetAuditEgress, &record.GetRepairEgress,
+		)
+		if err != nil {
+			return accounting.UsageExportPage{}, Error.Wrap(err)
+		}
+
+		id, err := dbutil.BytesToUUID(projectID)
+		if err != nil {
+			return accounting.UsageExportPage{}, Error.Wrap(err)
+		}
+		record.ProjectID = id
+
+		page.Records = append(page.Records, record)
+	}
+	if err = rows.Err(); err != nil {
+		return accounting.UsageExportPage{}, Error.Wrap(err)
+	}
+
+	if len(page.Records) > cursor.Limit {
+		page.Next = true
+		page.Records = page.Records[:cursor.Limit]
+	}
+	if len(page.Records) > 0 {
+		last := page.Records[len(page.Records)-1]
+		page.Cursor.IntervalStart = last.IntervalStart
+		page.Cursor.ProjectID = last.ProjectID
+		page.Cursor.BucketName = last.BucketName
+	}
+
+	return page, nil
+}
+
 // prefixIncrement returns the lexicographically lowest byte string which is
 // greater than origPrefix and does not have origPrefix as a prefix. If no such
 // byte string exists (origPrefix is empty, or origPrefix contains only 0xff
@@ -498,12 +871,34 @@ func (db *ProjectAccounting) GetBucketTotals(ctx context.Context, projectID uuid
 		return nil, errs.New("page is out of range")
 	}
 
-	var buckets []string
-	bucketsQuery := db.db.Rebind(`SELECT DISTINCT bucket_name
-	FROM bucket_bandwidth_rollups
-	WHERE project_id = ? AND interval_start >= ? AND interval_start <= ?
-	AND ` + bucketNameRange + ` ORDER BY bucket_name ASC
-	LIMIT ? OFFSET ?`)
+	// the egress, the latest storage tally and the limits of all buckets of
+	// the page are aggregated in a single query
+	usageQuery := db.db.Rebind(`SELECT buckets.bucket_name,
+		COALESCE(egress.amount, 0),
+		COALESCE(storage.inline, 0), COALESCE(storage.remote, 0), COALESCE(storage.object_count, 0),
+		COALESCE(limits.storage_limit, 0), COALESCE(limits.bandwidth_limit, 0), COALESCE(limits.object_limit, 0)
+	FROM (
+		SELECT DISTINCT bucket_name
+		FROM bucket_bandwidth_rollups
+		WHERE project_id = ? AND interval_start >= ? AND interval_start <= ?
+		AND ` + bucketNameRange + ` ORDER BY bucket_name ASC
+		LIMIT ? OFFSET ?
+	) AS buckets
+	LEFT JOIN (
+		SELECT bucket_name, SUM(settled) + SUM(inline) AS amount
+		FROM bucket_bandwidth_rollups
+		WHERE project_id = ? AND interval_start >= ? AND interval_start <= ? AND action = ?
+		GROUP BY bucket_name
+	) AS egress ON egress.bucket_name = buckets.bucket_name
+	LEFT JOIN (
+		SELECT DISTINCT ON (bucket_name) bucket_name, inline, remote, object_count
+		FROM bucket_storage_tallies
+		WHERE project_id = ? AND interval_start >= ? AND interval_start <= ?
+		ORDER BY bucket_name, interval_start DESC
+	) AS storage ON storage.bucket_name = buckets.bucket_name
+	LEFT JOIN bucket_usage_limits AS limits
+		ON limits.project_id = ? AND limits.bucket_name = buckets.bucket_name
+	ORDER BY buckets.bucket_name ASC`)
 
 	args = []interface{}{
 		projectID[:],
@@ -515,75 +910,44 @@ func (db *ProjectAccounting) GetBucketTotals(ctx context.Context, projectID uuid
 		args = append(args, incrPrefix)
 	}
 	args = append(args, page.Limit, page.Offset)
+	args = append(args, projectID[:], since, before, pb.PieceAction_GET)
+	args = append(args, projectID[:], since, before)
+	args = append(args, projectID[:])
 
-	bucketRows, err := db.db.QueryContext(ctx, bucketsQuery, args...)
+	usageRows, err := db.db.QueryContext(ctx, usageQuery, args...)
 	if err != nil {
 		return nil, err
 	}
-	defer func() { err = errs.Combine(err, bucketRows.Close()) }()
+	defer func() { err = errs.Combine(err, usageRows.Close()) }()
 
-	for bucketRows.Next() {
-		var bucket string
-		err = bucketRows.Scan(&bucket)
+	var bucketUsages []accounting.BucketUsage
+	for usageRows.Next() {
+		var bucket []byte
+		var egress, inline, remote, objectCount int64
+		var storageLimit, bandwidthLimit, objectLimit int64
+		err = usageRows.Scan(&bucket, &egress, &inline, &remote, &objectCount, &storageLimit, &bandwidthLimit, &objectLimit)
 		if err != nil {
 			return nil, err
 		}
 
-		buckets = append(buckets, bucket)
+		bucketUsages = append(bucketUsages, accounting.BucketUsage{
+			ProjectID:   projectID,
+			BucketName:  string(bucket),
+			Since:       since,
+			Before:      before,
+			Egress:      memory.Size(egress).GB(),
+			Storage:     memory.Size(inline + remote).GB(),
+			ObjectCount: objectCount,
+
+			StorageLimit: memory.Size(storageLimit).GB(),
+			EgressLimit:  memory.Size(bandwidthLimit).GB(),
+			ObjectLimit:  objectLimit,
+		})
 	}
-	if err := bucketRows.Err(); err != nil {
+	if err := usageRows.Err(); err != nil {
 		return nil, err
 	}
 
-	rollupsQuery := db.db.Rebind(`SELECT COALESCE(SUM(settled) + SUM(inline), 0)
-		FROM bucket_bandwidth_rollups
-		WHERE project_id = ? AND bucket_name = ? AND interval_start >= ? AND interval_start <= ? AND action = ?`)
-
-	storageQuery := db.db.Rebind(`SELECT inline, remote, object_count
-		FROM bucket_storage_tallies
-		WHERE project_id = ? AND bucket_name = ? AND interval_start >= ? AND interval_start <= ?
-		ORDER BY interval_start DESC
-		LIMIT 1`)
-
-	var bucketUsages []accounting.BucketUsage
-	for _, bucket := range buckets {
-		bucketUsage := accounting.BucketUsage{
-			ProjectID:  projectID,
-			BucketName: bucket,
-			Since:      since,
-			Before:     before,
-		}
-
-		// get bucket_bandwidth_rollups
-		rollupRow := db.db.QueryRowContext(ctx, rollupsQuery, projectID[:], []byte(bucket), since, before, pb.PieceAction_GET)
-
-		var egress int64
-		err = rollupRow.Scan(&egress)
-		if err != nil {
-			if err != sql.ErrNoRows {
-				return nil, err
-			}
-		}
-
-		bucketUsage.Egress = memory.Size(egress).GB()
-
-		storageRow := db.db.QueryRowContext(ctx, storageQuery, projectID[:], []byte(bucket), since, before)
-
-		var inline, remote, objectCount int64
-		err = storageRow.Scan(&inline, &remote, &objectCount)
-		if err != nil {
-			if err != sql.ErrNoRows {
-				return nil, err
-			}
-		}
-
-		// fill storage and object count
-		bucketUsage.Storage = memory.Size(inline + remote).GB()
-		bucketUsage.ObjectCount = objectCount
-
-		bucketUsages = append(bucketUsages, bucketUsage)
-	}
-
 	page.PageCount = uint(page.TotalCount / uint64(cursor.Limit))
 	if page.TotalCount%uint64(cursor.Limit) != 0 {
 		page.PageCount++
@@ -26,9 +26,16 @@ else
   RELEASE=false
 fi
 
+RELEASE_KEYS=${RELEASE_KEYS:-}
+if [[ "${@: -1}" == */storagenode-updater ]] && [[ $RELEASE == true ]] && [[ -z "$RELEASE_KEYS" ]]; then
+  echo "RELEASE_KEYS must be set to build a release of storagenode-updater" >&2
+  exit 1
+fi
+
 echo Running "go $@"
 exec go "$1" -ldflags \
 	"-s -w -X storj.io/storj/private/version.buildTimestamp=$TIMESTAMP
          -X storj.io/storj/private/version.buildCommitHash=$COMMIT
          -X storj.io/storj/private/version.buildVersion=$VERSION
-         -X storj.io/storj/private/version.buildRelease=$RELEASE" "${@:2}"
+         -X storj.io/storj/private/version.buildRelease=$RELEASE
+         -X main.releaseKeys=$RELEASE_KEYS" "${@:2}"
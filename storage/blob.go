@@ -106,6 +106,10 @@ type Blobs interface {
 	// error, WalkNamespace will stop iterating and return the error immediately. The ctx
 	// parameter is intended to allow canceling iteration early.
 	WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(BlobInfo) error) error
+	// WalkTrash executes walkFunc for each trashed blob in the given namespace, without
+	// restoring it. If walkFunc returns a non-nil error, WalkTrash will stop iterating and
+	// return the error immediately.
+	WalkTrash(ctx context.Context, namespace []byte, walkFunc func(TrashInfo) error) error
 	// Close closes the blob store and any resources associated with it.
 	Close() error
 }
@@ -122,3 +126,12 @@ type BlobInfo interface {
 	// Stat does a stat on the on-disk blob file
 	Stat(ctx context.Context) (os.FileInfo, error)
 }
+
+// TrashInfo allows inspecting and reading a trashed blob during iteration with WalkTrash
+type TrashInfo interface {
+	BlobInfo
+	// TrashedAt returns the time the blob was moved to the trash
+	TrashedAt() time.Time
+	// Open opens a reader for the trashed blob
+	Open(ctx context.Context) (BlobReader, error)
+}
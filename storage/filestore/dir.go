@@ -158,6 +158,18 @@ func (dir *Dir) blobToGarbagePath(ref storage.BlobRef) string {
 // Commit commits the temporary file to permanent storage.
 func (dir *Dir) Commit(ctx context.Context, file *os.File, ref storage.BlobRef, formatVersion storage.FormatVersion) (err error) {
 	defer mon.Task()(&ctx)(&err)
+	path, err := dir.blobToBasePath(ref)
+	if err != nil {
+		closeErr := file.Close()
+		removeErr := os.Remove(file.Name())
+		return errs.Combine(err, closeErr, removeErr)
+	}
+	return commitToPath(file, blobPathForFormatVersion(path, formatVersion), time.Time{})
+}
+
+// commitToPath moves the temporary file to path. When mtime isn't zero, it's set
+// as the modification time of the file before it's moved.
+func commitToPath(file *os.File, path string, mtime time.Time) error {
 	position, seekErr := file.Seek(0, io.SeekCurrent)
 	truncErr := file.Truncate(position)
 	syncErr := file.Sync()
@@ -169,12 +181,12 @@ func (dir *Dir) Commit(ctx context.Context, file *os.File, ref storage.BlobRef,
 		return errs.Combine(seekErr, truncErr, syncErr, chmodErr, closeErr, removeErr)
 	}
 
-	path, err := dir.blobToBasePath(ref)
-	if err != nil {
-		removeErr := os.Remove(file.Name())
-		return errs.Combine(err, removeErr)
+	if !mtime.IsZero() {
+		if err := os.Chtimes(file.Name(), mtime, mtime); err != nil {
+			removeErr := os.Remove(file.Name())
+			return errs.Combine(err, removeErr)
+		}
 	}
-	path = blobPathForFormatVersion(path, formatVersion)
 
 	mkdirErr := os.MkdirAll(filepath.Dir(path), dirPermission)
 	if os.IsExist(mkdirErr) {
@@ -399,6 +411,39 @@ func (dir *Dir) RestoreTrash(ctx context.Context, namespace []byte) (keysRestore
 	return keysRestored, err
 }
 
+// WalkTrash executes walkFunc for each file in the trash folder of the namespace,
+// without restoring it. The mtime of the file is the time it was trashed.
+func (dir *Dir) WalkTrash(ctx context.Context, namespace []byte, walkFunc func(storage.TrashInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return dir.walkNamespaceInPath(ctx, namespace, dir.trashdir(), func(info storage.BlobInfo) error {
+		fileInfo, err := info.Stat(ctx)
+		if err != nil {
+			return err
+		}
+		return walkFunc(&trashInfo{BlobInfo: info, trashedAt: fileInfo.ModTime()})
+	})
+}
+
+// ImportTrash writes the blob directly to the trash folder, with its mtime set to
+// trashedAt, as if it was trashed at that time.
+func (dir *Dir) ImportTrash(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion, trashedAt time.Time, data io.Reader, size int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	trashBasePath, err := dir.refToDirPath(ref, dir.trashdir())
+	if err != nil {
+		return err
+	}
+
+	file, err := dir.CreateTemporaryFile(ctx, size)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, data); err != nil {
+		return errs.Combine(err, dir.DeleteTemporary(ctx, file))
+	}
+	return commitToPath(file, blobPathForFormatVersion(trashBasePath, formatVer), trashedAt)
+}
+
 // EmptyTrash walks the trash files for the given namespace and deletes any
 // file whose mtime is older than trashedBefore. The mtime is modified when
 // Trash is called.
@@ -753,6 +798,15 @@ func (dir *Dir) Info() (DiskInfo, error) {
 	return diskInfoFromPath(path)
 }
 
+// DiskInfoFromPath returns information about the disk containing path.
+func DiskInfoFromPath(path string) (DiskInfo, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return DiskInfo{}, err
+	}
+	return diskInfoFromPath(path)
+}
+
 type blobInfo struct {
 	ref           storage.BlobRef
 	path          string
@@ -784,3 +838,25 @@ func (info *blobInfo) Stat(ctx context.Context) (os.FileInfo, error) {
 func (info *blobInfo) FullPath(ctx context.Context) (string, error) {
 	return info.path, nil
 }
+
+// trashInfo is a blobInfo of a file in the trash folder.
+type trashInfo struct {
+	storage.BlobInfo
+	trashedAt time.Time
+}
+
+func (info *trashInfo) TrashedAt() time.Time {
+	return info.trashedAt
+}
+
+func (info *trashInfo) Open(ctx context.Context) (storage.BlobReader, error) {
+	path, err := info.FullPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+	file, err := openFileReadOnly(path, blobPermission)
+	if err != nil {
+		return nil, err
+	}
+	return newBlobReader(file, info.StorageFormatVersion()), nil
+}
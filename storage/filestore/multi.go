@@ -0,0 +1,675 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package filestore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/storj/storage"
+)
+
+var (
+	monLocationFailed = mon.Meter("location_failed") //locked
+
+	_ storage.Blobs = (*MultiStore)(nil)
+)
+
+// Location is a directory used for storing blobs together with the disk space allocated to it.
+type Location struct {
+	Path      string
+	Allocated int64
+}
+
+// ParseLocations parses a comma separated list of path=size pairs, e.g. "/mnt/disk2=2TB,/mnt/disk3=500GB".
+func ParseLocations(value string) ([]Location, error) {
+	var locations []Location
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		separator := strings.LastIndex(part, "=")
+		if separator <= 0 {
+			return nil, Error.New("invalid location %q, expected path=size", part)
+		}
+
+		size := strings.TrimSpace(part[separator+1:])
+		// memory.ParseString doesn't handle sizes without any digits
+		if size == "" || size[0] < '0' || size[0] > '9' {
+			return nil, Error.New("invalid size for location %q", part)
+		}
+
+		allocated, err := memory.ParseString(size)
+		if err != nil {
+			return nil, Error.New("invalid size for location %q: %v", part, err)
+		}
+		if allocated <= 0 {
+			return nil, Error.New("allocated space for location %q must be positive", part)
+		}
+
+		locations = append(locations, Location{
+			Path:      strings.TrimSpace(part[:separator]),
+			Allocated: allocated,
+		})
+	}
+	return locations, nil
+}
+
+// LocationStatus describes the current state of a location.
+type LocationStatus struct {
+	Path      string
+	Allocated int64
+	// Used is the space used by blobs in the location, as known to the store.
+	Used int64
+	// Available is the space which can still be used for new blobs.
+	Available int64
+	// Err is set when the location has failed and is not used.
+	Err error
+}
+
+// location is a single directory of a MultiStore.
+type location struct {
+	Location
+	store *blobStore
+
+	mu     sync.Mutex
+	used   int64
+	failed error
+}
+
+// MultiStore implements a blob store which spans multiple directories.
+//
+// New blobs are placed in the location with the most available space. A location
+// which fails is reported and skipped instead of failing the whole store.
+//
+// architecture: Database
+type MultiStore struct {
+	log       *zap.Logger
+	locations []*location
+}
+
+// NewMulti creates a blob store spanning the specified locations.
+//
+// Locations which cannot be initialized are marked as failed. An error is returned only
+// when none of the locations can be used.
+func NewMulti(log *zap.Logger, locations []Location) (*MultiStore, error) {
+	if len(locations) == 0 {
+		return nil, Error.New("no locations specified")
+	}
+
+	multi := &MultiStore{log: log}
+
+	var group errs.Group
+	for _, config := range locations {
+		loc := &location{Location: config}
+
+		dir, err := NewDir(config.Path)
+		if err != nil {
+			group.Add(err)
+			loc.failed = err
+			log.Error("unable to initialize storage location", zap.String("path", config.Path), zap.Error(err))
+			monLocationFailed.Mark(1)
+		}
+		loc.store = &blobStore{dir: dir, log: log.With(zap.String("path", config.Path))}
+
+		multi.locations = append(multi.locations, loc)
+	}
+
+	if len(multi.healthy()) == 0 {
+		return nil, Error.Wrap(group.Err())
+	}
+	return multi, nil
+}
+
+// Close closes the store.
+func (multi *MultiStore) Close() error { return nil }
+
+// healthy returns the locations which have not failed.
+func (multi *MultiStore) healthy() []*location {
+	locations := make([]*location, 0, len(multi.locations))
+	for _, loc := range multi.locations {
+		loc.mu.Lock()
+		failed := loc.failed
+		loc.mu.Unlock()
+		if failed == nil {
+			locations = append(locations, loc)
+		}
+	}
+	return locations
+}
+
+// check marks the location as failed when err indicates a problem with the
+// location itself rather than with the requested blob.
+func (multi *MultiStore) check(loc *location, err error) {
+	if err == nil || errors.Is(err, os.ErrNotExist) || storage.ErrInvalidBlobRef.Has(err) || errors.Is(err, context.Canceled) {
+		return
+	}
+
+	// the directory may still be fine when a single blob is unreadable
+	if _, statErr := os.Stat(loc.store.dir.blobsdir()); statErr == nil {
+		if _, infoErr := loc.store.dir.Info(); infoErr == nil {
+			return
+		}
+	}
+
+	loc.mu.Lock()
+	alreadyFailed := loc.failed != nil
+	if !alreadyFailed {
+		loc.failed = err
+	}
+	loc.mu.Unlock()
+
+	if !alreadyFailed {
+		multi.log.Error("storage location failed", zap.String("path", loc.Path), zap.Error(err))
+		monLocationFailed.Mark(1)
+	}
+}
+
+// CheckHealth probes failed locations and starts using them again when they have recovered.
+func (multi *MultiStore) CheckHealth(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, loc := range multi.locations {
+		loc.mu.Lock()
+		failed := loc.failed
+		loc.mu.Unlock()
+		if failed == nil {
+			continue
+		}
+
+		// recreate the directory structure, in case the disk was replaced
+		_, err := NewDir(loc.Path)
+		if err == nil {
+			_, err = loc.store.dir.Info()
+		}
+		if err != nil {
+			group.Add(Error.New("%s: %v", loc.Path, err))
+			continue
+		}
+
+		loc.mu.Lock()
+		loc.failed = nil
+		loc.mu.Unlock()
+		multi.log.Info("storage location recovered", zap.String("path", loc.Path))
+	}
+	return group.Err()
+}
+
+// available returns the space which can be used for new blobs in the location.
+func (loc *location) available() (int64, DiskInfo, error) {
+	info, err := loc.store.dir.Info()
+	if err != nil {
+		return 0, DiskInfo{}, err
+	}
+
+	loc.mu.Lock()
+	remaining := loc.Allocated - loc.used
+	loc.mu.Unlock()
+
+	if remaining > info.AvailableSpace {
+		remaining = info.AvailableSpace
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining, info, nil
+}
+
+// addUsed adjusts the known space used in the location.
+func (loc *location) addUsed(delta int64) {
+	loc.mu.Lock()
+	loc.used += delta
+	if loc.used < 0 {
+		loc.used = 0
+	}
+	loc.mu.Unlock()
+}
+
+// Locations returns the status of all locations.
+func (multi *MultiStore) Locations(ctx context.Context) []LocationStatus {
+	statuses := make([]LocationStatus, 0, len(multi.locations))
+	for _, loc := range multi.locations {
+		loc.mu.Lock()
+		status := LocationStatus{
+			Path:      loc.Path,
+			Allocated: loc.Allocated,
+			Used:      loc.used,
+			Err:       loc.failed,
+		}
+		loc.mu.Unlock()
+
+		if status.Err == nil {
+			available, _, err := loc.available()
+			if err != nil {
+				multi.check(loc, err)
+				status.Err = err
+			}
+			status.Available = available
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// place returns the location with the most available space.
+func (multi *MultiStore) place(ctx context.Context) (_ *location, err error) {
+	var best *location
+	var bestAvailable int64
+	for _, loc := range multi.healthy() {
+		available, _, err := loc.available()
+		if err != nil {
+			multi.check(loc, err)
+			continue
+		}
+		if best == nil || available > bestAvailable {
+			best, bestAvailable = loc, available
+		}
+	}
+	if best == nil {
+		return nil, Error.New("no storage locations available")
+	}
+	return best, nil
+}
+
+// Create creates a new blob in the location with the most available space.
+func (multi *MultiStore) Create(ctx context.Context, ref storage.BlobRef, size int64) (_ storage.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	loc, err := multi.place(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := loc.store.Create(ctx, ref, size)
+	if err != nil {
+		multi.check(loc, err)
+		return nil, err
+	}
+	return &multiBlobWriter{BlobWriter: writer, multi: multi, location: loc}, nil
+}
+
+// TestCreateV0 creates a new V0 blob that can be written. This is ONLY appropriate in test situations.
+func (multi *MultiStore) TestCreateV0(ctx context.Context, ref storage.BlobRef) (_ storage.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	loc, err := multi.place(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := loc.store.TestCreateV0(ctx, ref)
+	if err != nil {
+		multi.check(loc, err)
+		return nil, err
+	}
+	return &multiBlobWriter{BlobWriter: writer, multi: multi, location: loc}, nil
+}
+
+// Open opens the blob from whichever location contains it.
+func (multi *MultiStore) Open(ctx context.Context, ref storage.BlobRef) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, loc := range multi.healthy() {
+		reader, err := loc.store.Open(ctx, ref)
+		if err == nil {
+			return reader, nil
+		}
+		if !os.IsNotExist(err) {
+			multi.check(loc, err)
+			group.Add(err)
+		}
+	}
+	if err := group.Err(); err != nil {
+		return nil, err
+	}
+	return nil, os.ErrNotExist
+}
+
+// OpenWithStorageFormat opens the blob with a known storage format from whichever location contains it.
+func (multi *MultiStore) OpenWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, loc := range multi.healthy() {
+		reader, err := loc.store.OpenWithStorageFormat(ctx, ref, formatVer)
+		if err == nil {
+			return reader, nil
+		}
+		if !os.IsNotExist(err) {
+			multi.check(loc, err)
+			group.Add(err)
+		}
+	}
+	if err := group.Err(); err != nil {
+		return nil, err
+	}
+	return nil, os.ErrNotExist
+}
+
+// Stat looks up disk metadata on the blob file in whichever location contains it.
+func (multi *MultiStore) Stat(ctx context.Context, ref storage.BlobRef) (_ storage.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := multi.find(ctx, func(store *blobStore) (storage.BlobInfo, error) {
+		return store.dir.Stat(ctx, ref)
+	})
+	return info, Error.Wrap(err)
+}
+
+// StatWithStorageFormat looks up disk metadata on the blob file with the given storage format version.
+func (multi *MultiStore) StatWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (_ storage.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := multi.find(ctx, func(store *blobStore) (storage.BlobInfo, error) {
+		return store.dir.StatWithStorageFormat(ctx, ref, formatVer)
+	})
+	return info, Error.Wrap(err)
+}
+
+// find returns the blob info from the first location where stat succeeds. When no location contains the blob
+// os.ErrNotExist or the errors from failing locations are returned.
+func (multi *MultiStore) find(ctx context.Context, stat func(*blobStore) (storage.BlobInfo, error)) (storage.BlobInfo, error) {
+	var group errs.Group
+	for _, loc := range multi.healthy() {
+		info, err := stat(loc.store)
+		if err == nil {
+			return info, nil
+		}
+		if !os.IsNotExist(err) {
+			multi.check(loc, err)
+			group.Add(err)
+		}
+	}
+	if err := group.Err(); err != nil {
+		return nil, err
+	}
+	return nil, os.ErrNotExist
+}
+
+// Delete deletes the blob from all locations.
+func (multi *MultiStore) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, loc := range multi.healthy() {
+		multi.forgetUsed(ctx, loc, ref)
+		err := loc.store.Delete(ctx, ref)
+		multi.check(loc, err)
+		group.Add(err)
+	}
+	return group.Err()
+}
+
+// DeleteWithStorageFormat deletes the blob with the given storage format from all locations.
+func (multi *MultiStore) DeleteWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, loc := range multi.healthy() {
+		multi.forgetUsed(ctx, loc, ref)
+		err := loc.store.DeleteWithStorageFormat(ctx, ref, formatVer)
+		multi.check(loc, err)
+		group.Add(err)
+	}
+	return group.Err()
+}
+
+// Trash moves the blob to the trash of the location containing it.
+func (multi *MultiStore) Trash(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, loc := range multi.healthy() {
+		multi.forgetUsed(ctx, loc, ref)
+		err := loc.store.Trash(ctx, ref)
+		multi.check(loc, err)
+		group.Add(err)
+	}
+	return group.Err()
+}
+
+// forgetUsed subtracts the size of the blob from the known space used in the location.
+func (multi *MultiStore) forgetUsed(ctx context.Context, loc *location, ref storage.BlobRef) {
+	info, err := loc.store.dir.Stat(ctx, ref)
+	if err != nil {
+		return
+	}
+	stat, err := info.Stat(ctx)
+	if err != nil {
+		return
+	}
+	loc.addUsed(-stat.Size())
+}
+
+// RestoreTrash restores the trash of all locations.
+func (multi *MultiStore) RestoreTrash(ctx context.Context, namespace []byte) (keysRestored [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, loc := range multi.healthy() {
+		keys, err := loc.store.RestoreTrash(ctx, namespace)
+		multi.check(loc, err)
+		group.Add(err)
+		keysRestored = append(keysRestored, keys...)
+	}
+	return keysRestored, group.Err()
+}
+
+// EmptyTrash empties the trash of all locations.
+func (multi *MultiStore) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (bytesEmptied int64, keys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, loc := range multi.healthy() {
+		emptied, deleted, err := loc.store.EmptyTrash(ctx, namespace, trashedBefore)
+		multi.check(loc, err)
+		group.Add(err)
+		bytesEmptied += emptied
+		keys = append(keys, deleted...)
+	}
+	return bytesEmptied, keys, group.Err()
+}
+
+// GarbageCollect tries to delete any files that haven't yet been deleted in all locations.
+func (multi *MultiStore) GarbageCollect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, loc := range multi.healthy() {
+		err := loc.store.GarbageCollect(ctx)
+		multi.check(loc, err)
+		group.Add(err)
+	}
+	return group.Err()
+}
+
+// FreeSpace returns the space available for new blobs in all locations. Locations
+// sharing a disk are not counted more than once.
+func (multi *MultiStore) FreeSpace() (int64, error) {
+	var total int64
+	diskAvailable := map[string]int64{}
+	for _, loc := range multi.healthy() {
+		available, info, err := loc.available()
+		if err != nil {
+			multi.check(loc, err)
+			continue
+		}
+
+		remaining, ok := diskAvailable[info.ID]
+		if !ok {
+			remaining = info.AvailableSpace
+		}
+		if available > remaining {
+			available = remaining
+		}
+		diskAvailable[info.ID] = remaining - available
+		total += available
+	}
+	return total, nil
+}
+
+// SpaceUsedForTrash returns the total space used by the trash of all locations.
+func (multi *MultiStore) SpaceUsedForTrash(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, loc := range multi.healthy() {
+		used, err := loc.store.SpaceUsedForTrash(ctx)
+		multi.check(loc, err)
+		group.Add(err)
+		total += used
+	}
+	return total, group.Err()
+}
+
+// SpaceUsedForBlobs adds up the space used in all namespaces of all locations
+// and updates the known space used of each location.
+func (multi *MultiStore) SpaceUsedForBlobs(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, loc := range multi.healthy() {
+		used, err := loc.store.SpaceUsedForBlobs(ctx)
+		if err != nil {
+			multi.check(loc, err)
+			return 0, err
+		}
+
+		loc.mu.Lock()
+		loc.used = used
+		loc.mu.Unlock()
+
+		total += used
+	}
+	return total, nil
+}
+
+// SpaceUsedForBlobsInNamespace adds up how much is used in the given namespace in all locations.
+func (multi *MultiStore) SpaceUsedForBlobsInNamespace(ctx context.Context, namespace []byte) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, loc := range multi.healthy() {
+		used, err := loc.store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+		if err != nil {
+			multi.check(loc, err)
+			return 0, err
+		}
+		total += used
+	}
+	return total, nil
+}
+
+// RefreshUsage recalculates the space used in each location and retries failed locations.
+func (multi *MultiStore) RefreshUsage(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	healthErr := multi.CheckHealth(ctx)
+	_, err = multi.SpaceUsedForBlobs(ctx)
+	return errs.Combine(healthErr, err)
+}
+
+// ListNamespaces finds all namespaces in use in any location.
+func (multi *MultiStore) ListNamespaces(ctx context.Context) (ids [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	seen := map[string]bool{}
+	for _, loc := range multi.healthy() {
+		namespaces, err := loc.store.ListNamespaces(ctx)
+		if err != nil {
+			multi.check(loc, err)
+			return nil, err
+		}
+		for _, namespace := range namespaces {
+			if seen[string(namespace)] {
+				continue
+			}
+			seen[string(namespace)] = true
+			ids = append(ids, namespace)
+		}
+	}
+
+	sort.Slice(ids, func(i, k int) bool { return string(ids[i]) < string(ids[k]) })
+	return ids, nil
+}
+
+// WalkNamespace executes walkFunc for each blob in the given namespace in all locations.
+// A location which fails during the walk is reported and skipped.
+func (multi *MultiStore) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(storage.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, loc := range multi.healthy() {
+		var walkErr error
+		err := loc.store.WalkNamespace(ctx, namespace, func(info storage.BlobInfo) error {
+			walkErr = walkFunc(info)
+			return walkErr
+		})
+		if err != nil {
+			if walkErr != nil || ctx.Err() != nil {
+				return err
+			}
+			multi.check(loc, err)
+			multi.log.Error("unable to walk storage location", zap.String("path", loc.Path), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// WalkTrash executes walkFunc for each trashed blob in the given namespace in all locations.
+// A location which fails during the walk is reported and skipped.
+func (multi *MultiStore) WalkTrash(ctx context.Context, namespace []byte, walkFunc func(storage.TrashInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, loc := range multi.healthy() {
+		var walkErr error
+		err := loc.store.WalkTrash(ctx, namespace, func(info storage.TrashInfo) error {
+			walkErr = walkFunc(info)
+			return walkErr
+		})
+		if err != nil {
+			if walkErr != nil || ctx.Err() != nil {
+				return err
+			}
+			multi.check(loc, err)
+			multi.log.Error("unable to walk trash of storage location", zap.String("path", loc.Path), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// multiBlobWriter tracks the space used by committed blobs.
+type multiBlobWriter struct {
+	storage.BlobWriter
+	multi    *MultiStore
+	location *location
+}
+
+// Commit ensures that the blob is readable by others.
+func (writer *multiBlobWriter) Commit(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	size, sizeErr := writer.BlobWriter.Size()
+
+	err = writer.BlobWriter.Commit(ctx)
+	if err != nil {
+		writer.multi.check(writer.location, err)
+		return err
+	}
+
+	if sizeErr == nil {
+		writer.location.addUsed(size)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package filestore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+)
+
+func TestParseLocations(t *testing.T) {
+	locations, err := filestore.ParseLocations("")
+	require.NoError(t, err)
+	require.Empty(t, locations)
+
+	locations, err = filestore.ParseLocations("/mnt/disk2=2TB, C:\\storage=500GB")
+	require.NoError(t, err)
+	require.Equal(t, []filestore.Location{
+		{Path: "/mnt/disk2", Allocated: 2 * memory.TB.Int64()},
+		{Path: "C:\\storage", Allocated: 500 * memory.GB.Int64()},
+	}, locations)
+
+	for _, invalid := range []string{"/mnt/disk2", "=1TB", "/mnt/disk2=", "/mnt/disk2=0B", "/mnt/disk2=lots"} {
+		_, err := filestore.ParseLocations(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestMultiStore(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	const blobSize = 4 << 10
+
+	small, large := ctx.Dir("small"), ctx.Dir("large")
+	store, err := filestore.NewMulti(zaptest.NewLogger(t), []filestore.Location{
+		{Path: small, Allocated: 3 * blobSize},
+		{Path: large, Allocated: 6 * blobSize},
+	})
+	require.NoError(t, err)
+	ctx.Check(store.Close)
+
+	namespace := testrand.Bytes(32)
+	var refs []storage.BlobRef
+	for i := 0; i < 6; i++ {
+		ref := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		refs = append(refs, ref)
+
+		writer, err := store.Create(ctx, ref, blobSize)
+		require.NoError(t, err)
+		_, err = writer.Write(testrand.Bytes(blobSize))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+	}
+
+	// blobs are placed by available space, so the large location fills up to the small one
+	statuses := store.Locations(ctx)
+	require.Len(t, statuses, 2)
+	assert.NoError(t, statuses[0].Err)
+	assert.NoError(t, statuses[1].Err)
+	assert.Equal(t, int64(2*blobSize), statuses[0].Used)
+	assert.Equal(t, int64(4*blobSize), statuses[1].Used)
+	assert.Equal(t, int64(1*blobSize), statuses[0].Available)
+	assert.Equal(t, int64(2*blobSize), statuses[1].Available)
+
+	free, err := store.FreeSpace()
+	require.NoError(t, err)
+	assert.Equal(t, int64(3*blobSize), free)
+
+	// all blobs are locatable
+	for _, ref := range refs {
+		reader, err := store.Open(ctx, ref)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		_, err = store.Stat(ctx, ref)
+		require.NoError(t, err)
+	}
+
+	var walked int
+	require.NoError(t, store.WalkNamespace(ctx, namespace, func(storage.BlobInfo) error {
+		walked++
+		return nil
+	}))
+	assert.Equal(t, len(refs), walked)
+
+	used, err := store.SpaceUsedForBlobs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(refs)*blobSize), used)
+
+	// trash and restore across locations
+	for _, ref := range refs {
+		require.NoError(t, store.Trash(ctx, ref))
+		_, err = store.Open(ctx, ref)
+		require.True(t, os.IsNotExist(err))
+	}
+
+	restored, err := store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	assert.Len(t, restored, len(refs))
+
+	// missing blobs are reported as not existing
+	_, err = store.Open(ctx, storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)})
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestMultiStoreFailedLocation(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	// a file in place of the directory makes the location unusable
+	broken := filepath.Join(ctx.Dir("broken"), "disk")
+	require.NoError(t, ioutil.WriteFile(broken, nil, 0600))
+
+	store, err := filestore.NewMulti(zaptest.NewLogger(t), []filestore.Location{
+		{Path: broken, Allocated: memory.GB.Int64()},
+		{Path: ctx.Dir("working"), Allocated: memory.MB.Int64()},
+	})
+	require.NoError(t, err)
+	ctx.Check(store.Close)
+
+	statuses := store.Locations(ctx)
+	require.Len(t, statuses, 2)
+	assert.Error(t, statuses[0].Err)
+	assert.NoError(t, statuses[1].Err)
+
+	ref := storage.BlobRef{Namespace: testrand.Bytes(32), Key: testrand.Bytes(32)}
+	writer, err := store.Create(ctx, ref, -1)
+	require.NoError(t, err)
+	_, err = writer.Write(testrand.Bytes(memory.KiB))
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+
+	_, err = store.Stat(ctx, ref)
+	require.NoError(t, err)
+
+	// the location recovers once the directory can be created
+	require.Error(t, store.CheckHealth(ctx))
+	require.NoError(t, os.Remove(broken))
+	require.NoError(t, store.CheckHealth(ctx))
+	for _, status := range store.Locations(ctx) {
+		assert.NoError(t, status.Err)
+	}
+
+	// no usable location at all is an error
+	brokenAgain := filepath.Join(ctx.Dir("broken"), "other")
+	require.NoError(t, ioutil.WriteFile(brokenAgain, nil, 0600))
+	_, err = filestore.NewMulti(zaptest.NewLogger(t), []filestore.Location{
+		{Path: brokenAgain, Allocated: memory.GB.Int64()},
+	})
+	require.Error(t, err)
+}
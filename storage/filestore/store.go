@@ -5,6 +5,7 @@ package filestore
 
 import (
 	"context"
+	"io"
 	"os"
 	"path/filepath"
 	"time"
@@ -219,6 +220,19 @@ func (store *blobStore) WalkNamespace(ctx context.Context, namespace []byte, wal
 	return store.dir.WalkNamespace(ctx, namespace, walkFunc)
 }
 
+// WalkTrash executes walkFunc for each trashed blob in the given namespace, without restoring
+// it. If walkFunc returns a non-nil error, WalkTrash will stop iterating and return the error
+// immediately.
+func (store *blobStore) WalkTrash(ctx context.Context, namespace []byte, walkFunc func(storage.TrashInfo) error) (err error) {
+	return store.dir.WalkTrash(ctx, namespace, walkFunc)
+}
+
+// ImportTrash stores the blob directly in the trash, as if it was trashed at trashedAt
+func (store *blobStore) ImportTrash(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion, trashedAt time.Time, data io.Reader, size int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return Error.Wrap(store.dir.ImportTrash(ctx, ref, formatVer, trashedAt, data, size))
+}
+
 // TestCreateV0 creates a new V0 blob that can be written. This is ONLY appropriate in test situations.
 func (store *blobStore) TestCreateV0(ctx context.Context, ref storage.BlobRef) (_ storage.BlobWriter, err error) {
 	defer mon.Task()(&ctx)(&err)
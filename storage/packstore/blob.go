@@ -0,0 +1,189 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"context"
+	"encoding/base32"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/storage"
+)
+
+var pathEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// blobReader implements reading blobs from a pack
+type blobReader struct {
+	*io.SectionReader
+	file          *os.File
+	formatVersion storage.FormatVersion
+}
+
+func newBlobReader(file *os.File, offset, size int64, formatVersion storage.FormatVersion) *blobReader {
+	return &blobReader{
+		SectionReader: io.NewSectionReader(file, offset, size),
+		file:          file,
+		formatVersion: formatVersion,
+	}
+}
+
+// Close closes the underlying pack.
+func (blob *blobReader) Close() error {
+	return blob.file.Close()
+}
+
+// Size returns how large is the blob.
+func (blob *blobReader) Size() (int64, error) {
+	return blob.SectionReader.Size(), nil
+}
+
+// StorageFormatVersion gets the storage format version being used by the blob.
+func (blob *blobReader) StorageFormatVersion() storage.FormatVersion {
+	return blob.formatVersion
+}
+
+// blobWriter implements writing blobs
+//
+// The blob is written to a temporary file and appended to a pack on commit.
+type blobWriter struct {
+	ref           storage.BlobRef
+	store         *Store
+	closed        bool
+	formatVersion storage.FormatVersion
+
+	*os.File
+}
+
+func newBlobWriter(ref storage.BlobRef, store *Store, formatVersion storage.FormatVersion, file *os.File) *blobWriter {
+	return &blobWriter{
+		ref:           ref,
+		store:         store,
+		closed:        false,
+		formatVersion: formatVersion,
+		File:          file,
+	}
+}
+
+// Cancel discards the blob.
+func (blob *blobWriter) Cancel(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if blob.closed {
+		return nil
+	}
+	blob.closed = true
+	err = blob.File.Close()
+	removeErr := os.Remove(blob.File.Name())
+	return Error.Wrap(errs.Combine(err, removeErr))
+}
+
+// Commit appends the blob to the active pack.
+func (blob *blobWriter) Commit(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if blob.closed {
+		return Error.New("already closed")
+	}
+	blob.closed = true
+	err = blob.store.commit(ctx, blob.ref, blob.formatVersion, blob.File)
+	closeErr := blob.File.Close()
+	removeErr := os.Remove(blob.File.Name())
+	return Error.Wrap(errs.Combine(err, closeErr, removeErr))
+}
+
+// Size returns how much has been written so far.
+func (blob *blobWriter) Size() (int64, error) {
+	pos, err := blob.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	return pos, err
+}
+
+// StorageFormatVersion indicates what storage format version the blob is using.
+func (blob *blobWriter) StorageFormatVersion() storage.FormatVersion {
+	return blob.formatVersion
+}
+
+// blobInfo is the metadata of a blob in a pack
+type blobInfo struct {
+	ref           storage.BlobRef
+	formatVersion storage.FormatVersion
+	path          string
+	size          int64
+	modTime       time.Time
+}
+
+func (store *Store) newBlobInfo(ref storage.BlobRef, formatVer storage.FormatVersion, e *entry) storage.BlobInfo {
+	return &blobInfo{
+		ref:           ref,
+		formatVersion: formatVer,
+		path:          store.virtualPath(e.loc, ref, formatVer),
+		size:          e.size,
+		modTime:       time.Unix(0, e.modTime),
+	}
+}
+
+// trashInfo is the metadata of a trashed blob in a pack
+type trashInfo struct {
+	blobInfo
+	store     *Store
+	trashedAt time.Time
+}
+
+func (store *Store) newTrashInfo(ref storage.BlobRef, formatVer storage.FormatVersion, e *entry) storage.TrashInfo {
+	return &trashInfo{
+		blobInfo: blobInfo{
+			ref:           ref,
+			formatVersion: formatVer,
+			path:          store.virtualPath(e.loc, ref, formatVer),
+			size:          e.size,
+			modTime:       time.Unix(0, e.modTime),
+		},
+		store:     store,
+		trashedAt: time.Unix(0, e.trashedAt),
+	}
+}
+
+func (info *trashInfo) TrashedAt() time.Time {
+	return info.trashedAt
+}
+
+// Open opens a reader for the trashed blob, as long as it's still in the trash.
+func (info *trashInfo) Open(ctx context.Context) (storage.BlobReader, error) {
+	return info.store.openTrash(ctx, info.ref, info.formatVersion)
+}
+
+func (info *blobInfo) BlobRef() storage.BlobRef {
+	return info.ref
+}
+
+func (info *blobInfo) StorageFormatVersion() storage.FormatVersion {
+	return info.formatVersion
+}
+
+// Stat returns the metadata of the blob in the form of a file.
+func (info *blobInfo) Stat(ctx context.Context) (os.FileInfo, error) {
+	return &fileInfo{info: info}, nil
+}
+
+// FullPath returns a virtual path of the blob inside its pack.
+func (info *blobInfo) FullPath(ctx context.Context) (string, error) {
+	return info.path, nil
+}
+
+// fileInfo implements os.FileInfo for a blob in a pack
+type fileInfo struct {
+	info *blobInfo
+}
+
+func (fi *fileInfo) Name() string       { return filepath.Base(fi.info.path) }
+func (fi *fileInfo) Size() int64        { return fi.info.size }
+func (fi *fileInfo) Mode() os.FileMode  { return packPermission }
+func (fi *fileInfo) ModTime() time.Time { return fi.info.modTime }
+func (fi *fileInfo) IsDir() bool        { return false }
+func (fi *fileInfo) Sys() interface{}   { return nil }
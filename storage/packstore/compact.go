@@ -0,0 +1,261 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"context"
+	"io"
+	"os"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/storage"
+)
+
+// relocation is a blob record which has to be moved out of the compacted packs.
+type relocation struct {
+	key blobKey
+	loc location
+	// forward is set for blob records in other packs referred to by the compacted packs.
+	forward bool
+}
+
+// Compact rewrites the packs which contain mostly dead records.
+//
+// The live blobs of a compacted pack are appended to the active pack. Records
+// of a compacted pack refer to blob records in other packs, which must not be
+// revived when replaying the remaining packs. Such blob records are either
+// moved to the active pack, when they are still live, or a delete record for
+// them is appended to the active pack. Afterwards the compacted packs are
+// removed in ascending order.
+func (store *Store) Compact(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.compactMu.Lock()
+	defer store.compactMu.Unlock()
+
+	compacting, relocations, err := store.planCompaction()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(compacting) == 0 {
+		return nil
+	}
+
+	for _, relocation := range relocations {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := store.relocate(relocation); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	store.writeMu.Lock()
+	defer store.writeMu.Unlock()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if err := store.syncActive(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	ids := make([]uint64, 0, len(compacting))
+	for id := range compacting {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, k int) bool { return ids[i] < ids[k] })
+
+	// packs are removed in ascending order, so records in a remaining pack are
+	// never revived by a missing record of a removed pack
+	for _, id := range ids {
+		if p := store.packs[id]; p.live != 0 {
+			return Error.New("pack %016x still contains %d live bytes", id, p.live)
+		}
+		delete(store.packs, id)
+	}
+	store.removals = append(store.removals, ids...)
+	store.retryRemovals()
+	return nil
+}
+
+// planCompaction selects the packs to compact and the blob records which
+// need to be moved out of them.
+func (store *Store) planCompaction() (compacting map[uint64]struct{}, relocations []relocation, err error) {
+	store.writeMu.Lock()
+	defer store.writeMu.Unlock()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.retryRemovals()
+
+	compacting = make(map[uint64]struct{})
+	for id, p := range store.packs {
+		if p == store.activePack {
+			// the active pack is compacted only once it's full, unless it's
+			// entirely dead and can be removed without moving anything
+			if p.live == 0 && !store.refersToOtherPacks(p) && p.size > 0 {
+				compacting[id] = struct{}{}
+			}
+			continue
+		}
+		if p.size == 0 || float64(p.size-p.live)/float64(p.size) >= store.config.CompactDeadRatio {
+			compacting[id] = struct{}{}
+		}
+	}
+	if len(compacting) == 0 {
+		return nil, nil, nil
+	}
+
+	if store.activePack != nil {
+		if _, ok := compacting[store.activePack.id]; ok {
+			if err := store.closeActive(); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	for namespace, ns := range store.namespaces {
+		for _, entries := range []map[entryKey]*entry{ns.live, ns.trash} {
+			for key, e := range entries {
+				if _, ok := compacting[e.loc.pack]; ok {
+					relocations = append(relocations, relocation{
+						key: blobKey{namespace: namespace, key: key},
+						loc: e.loc,
+					})
+				}
+			}
+		}
+	}
+
+	for id := range compacting {
+		for loc, key := range store.packs[id].refs {
+			if _, ok := compacting[loc.pack]; ok {
+				continue
+			}
+			if !store.packExists(loc.pack) {
+				continue
+			}
+			relocations = append(relocations, relocation{key: key, loc: loc, forward: true})
+		}
+	}
+
+	// copy blobs in the order they are stored
+	sort.Slice(relocations, func(i, k int) bool {
+		a, b := relocations[i].loc, relocations[k].loc
+		if a.pack != b.pack {
+			return a.pack < b.pack
+		}
+		return a.offset < b.offset
+	})
+	return compacting, relocations, nil
+}
+
+// relocate moves the blob record of relocation to the active pack.
+func (store *Store) relocate(relocation relocation) (err error) {
+	store.writeMu.Lock()
+	defer store.writeMu.Unlock()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	e := store.current(relocation.key, relocation.loc)
+	if e == nil {
+		if !relocation.forward {
+			// the blob was removed in the meantime
+			return nil
+		}
+		if !store.packExists(relocation.loc.pack) {
+			return nil
+		}
+		// keep the dead blob record from being revived
+		return store.appendReference(kindDelete, relocation.key, relocation.loc)
+	}
+
+	file, err := os.Open(store.packPath(e.loc.pack))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := file.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	ref := storage.BlobRef{
+		Namespace: []byte(relocation.key.namespace),
+		Key:       []byte(relocation.key.key.key),
+	}
+	_, err = store.appendRecord(&record{
+		kind:      kindBlob,
+		ref:       ref,
+		formatVer: relocation.key.key.formatVer,
+		size:      e.size,
+		modTime:   e.modTime,
+		trashedAt: e.trashedAt,
+	}, io.NewSectionReader(file, e.dataOffset, e.size))
+	if err != nil {
+		return err
+	}
+
+	// the previous blob record may outlive this one, when it's not compacted
+	return store.appendReference(kindDelete, relocation.key, e.loc)
+}
+
+// current returns the entry of key when it's still stored at loc.
+// store.mu must be held.
+func (store *Store) current(key blobKey, loc location) *entry {
+	ns, ok := store.namespaces[key.namespace]
+	if !ok {
+		return nil
+	}
+	if e := ns.live[key.key]; e != nil && e.loc == loc {
+		return e
+	}
+	if e := ns.trash[key.key]; e != nil && e.loc == loc {
+		return e
+	}
+	return nil
+}
+
+// refersToOtherPacks returns whether records of p refer to other existing packs.
+// store.mu must be held.
+func (store *Store) refersToOtherPacks(p *pack) bool {
+	for loc := range p.refs {
+		if store.packExists(loc.pack) {
+			return true
+		}
+	}
+	return false
+}
+
+// packExists returns whether the pack is still on disk.
+// store.mu must be held.
+func (store *Store) packExists(id uint64) bool {
+	if _, ok := store.packs[id]; ok {
+		return true
+	}
+	for _, removal := range store.removals {
+		if removal == id {
+			return true
+		}
+	}
+	return false
+}
+
+// retryRemovals removes compacted packs in ascending order. It stops at the
+// first failure, since a remaining pack could otherwise revive records of a
+// removed one. store.mu must be held.
+func (store *Store) retryRemovals() {
+	sort.Slice(store.removals, func(i, k int) bool { return store.removals[i] < store.removals[k] })
+	for len(store.removals) > 0 {
+		id := store.removals[0]
+		err := os.Remove(store.packPath(id))
+		if err != nil && !os.IsNotExist(err) {
+			store.log.Warn("failed to remove compacted pack, retrying later", zap.Uint64("pack", id), zap.Error(err))
+			return
+		}
+		store.removals = store.removals[1:]
+	}
+}
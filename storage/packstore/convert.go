@@ -0,0 +1,109 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storage"
+)
+
+// Convert moves all blobs of source into target and returns the number of blobs moved.
+//
+// Blobs are deleted from source once they are stored in target, so an
+// interrupted conversion can be continued. Trashed blobs stay trashed and keep
+// the time they were trashed.
+func Convert(ctx context.Context, log *zap.Logger, source storage.Blobs, target *Store) (converted int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaces, err := source.ListNamespaces(ctx)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	for _, namespace := range namespaces {
+		err := source.WalkNamespace(ctx, namespace, func(info storage.BlobInfo) error {
+			if err := convertBlob(ctx, source, target, info, time.Time{}); err != nil {
+				return err
+			}
+			converted++
+			return nil
+		})
+		if err != nil {
+			return converted, Error.Wrap(err)
+		}
+
+		// trashed blobs are removed from the source only after all of them are
+		// stored in target, so they stay trashed when the conversion is interrupted
+		walkStarted := time.Now()
+		err = source.WalkTrash(ctx, namespace, func(info storage.TrashInfo) error {
+			if err := convertTrash(ctx, target, info); err != nil {
+				return err
+			}
+			converted++
+			return nil
+		})
+		if err != nil {
+			return converted, Error.Wrap(err)
+		}
+		if _, _, err := source.EmptyTrash(ctx, namespace, walkStarted); err != nil {
+			return converted, Error.Wrap(err)
+		}
+
+		log.Info("converted namespace to packs", zap.Binary("namespace", namespace), zap.Int("total blobs", converted))
+	}
+	return converted, nil
+}
+
+// convertTrash stores a single trashed blob of the source in the trash of target.
+func convertTrash(ctx context.Context, target *Store, info storage.TrashInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stat, err := info.Stat(ctx)
+	if err != nil {
+		return err
+	}
+
+	reader, err := info.Open(ctx)
+	if err != nil {
+		return err
+	}
+	size, err := reader.Size()
+	if err != nil {
+		return errs.Combine(err, reader.Close())
+	}
+
+	err = target.importBlob(ctx, info.BlobRef(), info.StorageFormatVersion(), stat.ModTime(), info.TrashedAt(), reader, size)
+	return errs.Combine(err, reader.Close())
+}
+
+// convertBlob moves a single blob from source into target.
+func convertBlob(ctx context.Context, source storage.Blobs, target *Store, info storage.BlobInfo, trashedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stat, err := info.Stat(ctx)
+	if err != nil {
+		return err
+	}
+
+	reader, err := source.OpenWithStorageFormat(ctx, info.BlobRef(), info.StorageFormatVersion())
+	if err != nil {
+		return err
+	}
+	size, err := reader.Size()
+	if err != nil {
+		return errs.Combine(err, reader.Close())
+	}
+
+	err = target.importBlob(ctx, info.BlobRef(), info.StorageFormatVersion(), stat.ModTime(), trashedAt, reader, size)
+	if err := errs.Combine(err, reader.Close()); err != nil {
+		return err
+	}
+
+	return source.DeleteWithStorageFormat(ctx, info.BlobRef(), info.StorageFormatVersion())
+}
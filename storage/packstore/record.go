@@ -0,0 +1,184 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"math"
+
+	"storj.io/storj/storage"
+)
+
+// recordMagic marks the start of every record in a pack.
+const recordMagic = 0x4b504a53 // "SJPK"
+
+// recordHeaderSize is the size of the fixed record header.
+//
+// The header layout is:
+//
+//	magic     uint32
+//	checksum  uint32 // crc32 of the rest of the header, the namespace and the key
+//	kind      uint8
+//	format    uint8
+//	nsLen     uint16
+//	keyLen    uint16
+//	reserved  uint16
+//	fields    [3]uint64
+//
+// The header is followed by the namespace, the key and, for blob records, the blob data.
+const recordHeaderSize = 40
+
+// recordKind is the kind of record stored in a pack.
+type recordKind byte
+
+const (
+	// kindBlob records contain the blob data.
+	kindBlob recordKind = 1
+	// kindTrash records move the target blob record to the trash.
+	kindTrash recordKind = 2
+	// kindRestore records move the target blob record out of the trash.
+	kindRestore recordKind = 3
+	// kindDelete records remove the target blob record.
+	kindDelete recordKind = 4
+)
+
+// location is the position of a record.
+type location struct {
+	pack   uint64
+	offset int64
+}
+
+// record is a decoded record header.
+//
+// Blob records use size, modTime and trashedAt, the other records refer to a
+// blob record with target and use at as the time of the operation.
+type record struct {
+	kind      recordKind
+	ref       storage.BlobRef
+	formatVer storage.FormatVersion
+
+	size      int64
+	modTime   int64
+	trashedAt int64
+
+	target location
+	at     int64
+}
+
+// headerLength returns the length of the record without blob data.
+func (rec *record) headerLength() int64 {
+	return recordHeaderSize + int64(len(rec.ref.Namespace)) + int64(len(rec.ref.Key))
+}
+
+// length returns the total length of the record.
+func (rec *record) length() int64 {
+	if rec.kind == kindBlob {
+		return rec.headerLength() + rec.size
+	}
+	return rec.headerLength()
+}
+
+// marshal encodes the record without blob data.
+func (rec *record) marshal() ([]byte, error) {
+	if len(rec.ref.Namespace) > math.MaxUint16 || len(rec.ref.Key) > math.MaxUint16 {
+		return nil, Error.New("blob ref too long")
+	}
+	if rec.formatVer < 0 || rec.formatVer > math.MaxUint8 {
+		return nil, Error.New("unsupported storage format version %d", rec.formatVer)
+	}
+
+	buf := make([]byte, rec.headerLength())
+	binary.BigEndian.PutUint32(buf[0:], recordMagic)
+	buf[8] = byte(rec.kind)
+	buf[9] = byte(rec.formatVer)
+	binary.BigEndian.PutUint16(buf[10:], uint16(len(rec.ref.Namespace)))
+	binary.BigEndian.PutUint16(buf[12:], uint16(len(rec.ref.Key)))
+
+	fields := rec.fields()
+	for i, field := range fields {
+		binary.BigEndian.PutUint64(buf[16+8*i:], field)
+	}
+
+	copy(buf[recordHeaderSize:], rec.ref.Namespace)
+	copy(buf[recordHeaderSize+len(rec.ref.Namespace):], rec.ref.Key)
+
+	binary.BigEndian.PutUint32(buf[4:], crc32.ChecksumIEEE(buf[8:]))
+	return buf, nil
+}
+
+// fields returns the kind specific header fields.
+func (rec *record) fields() [3]uint64 {
+	if rec.kind == kindBlob {
+		return [3]uint64{uint64(rec.size), uint64(rec.modTime), uint64(rec.trashedAt)}
+	}
+	return [3]uint64{rec.target.pack, uint64(rec.target.offset), uint64(rec.at)}
+}
+
+// readRecord reads a record header from r. Blob data is not read.
+//
+// io.EOF is returned only when r has no more data, a truncated or invalid
+// header returns errInvalidRecord.
+func readRecord(r io.Reader) (rec record, err error) {
+	var header [recordHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if err == io.EOF {
+			return rec, io.EOF
+		}
+		if err == io.ErrUnexpectedEOF {
+			return rec, errInvalidRecord.New("truncated header")
+		}
+		return rec, err
+	}
+
+	if binary.BigEndian.Uint32(header[0:]) != recordMagic {
+		return rec, errInvalidRecord.New("invalid magic")
+	}
+
+	rec.kind = recordKind(header[8])
+	rec.formatVer = storage.FormatVersion(header[9])
+	nsLen := binary.BigEndian.Uint16(header[10:])
+	keyLen := binary.BigEndian.Uint16(header[12:])
+
+	refBytes := make([]byte, int(nsLen)+int(keyLen))
+	if _, err := io.ReadFull(r, refBytes); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return rec, errInvalidRecord.New("truncated blob ref")
+		}
+		return rec, err
+	}
+
+	checksum := crc32.NewIEEE()
+	_, _ = checksum.Write(header[8:])
+	_, _ = checksum.Write(refBytes)
+	if checksum.Sum32() != binary.BigEndian.Uint32(header[4:]) {
+		return rec, errInvalidRecord.New("checksum mismatch")
+	}
+
+	rec.ref = storage.BlobRef{
+		Namespace: refBytes[:nsLen:nsLen],
+		Key:       refBytes[nsLen:],
+	}
+
+	var fields [3]uint64
+	for i := range fields {
+		fields[i] = binary.BigEndian.Uint64(header[16+8*i:])
+	}
+
+	switch rec.kind {
+	case kindBlob:
+		rec.size, rec.modTime, rec.trashedAt = int64(fields[0]), int64(fields[1]), int64(fields[2])
+		if rec.size < 0 {
+			return rec, errInvalidRecord.New("invalid blob size")
+		}
+	case kindTrash, kindRestore, kindDelete:
+		rec.target = location{pack: fields[0], offset: int64(fields[1])}
+		rec.at = int64(fields[2])
+	default:
+		return rec, errInvalidRecord.New("unknown record kind %d", rec.kind)
+	}
+
+	return rec, nil
+}
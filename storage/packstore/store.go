@@ -0,0 +1,991 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+)
+
+var (
+	// Error is the default packstore error class
+	Error = errs.Class("packstore error")
+
+	// errInvalidRecord is returned when a pack contains a torn or corrupted record
+	errInvalidRecord = errs.Class("invalid record")
+
+	mon = monkit.Package()
+
+	_ storage.Blobs = (*Store)(nil)
+)
+
+const (
+	packPermission = 0600
+	dirPermission  = 0700
+
+	packSuffix = ".pack"
+)
+
+// Config contains configurable values for the pack store.
+type Config struct {
+	// MaxPackSize is the size after which a new pack is started.
+	MaxPackSize int64
+	// CompactDeadRatio is the ratio of dead bytes after which a pack is compacted.
+	CompactDeadRatio float64
+}
+
+// DefaultConfig is the default configuration of the pack store.
+var DefaultConfig = Config{
+	MaxPackSize:      64 << 20,
+	CompactDeadRatio: 0.5,
+}
+
+// Store implements storage.Blobs by appending blobs into large pack files.
+//
+// Every pack is an append-only log of records. Blob records contain the blob
+// data, while trash, restore and delete records refer to an earlier blob
+// record. The index of the blobs is kept in memory and rebuilt from the packs
+// when the store is opened. Packs with mostly dead records are rewritten by
+// Compact.
+type Store struct {
+	log    *zap.Logger
+	path   string
+	config Config
+
+	// compactMu serializes compactions.
+	compactMu sync.Mutex
+
+	// writeMu serializes writes to the packs. It's acquired before mu, which
+	// protects the index and the set of packs, so blob data can be written
+	// without blocking readers.
+	writeMu sync.Mutex
+
+	mu         sync.RWMutex
+	packs      map[uint64]*pack
+	nextPack   uint64
+	active     *os.File
+	activePack *pack
+	namespaces map[string]*namespaceIndex
+	// removals contains packs which failed to be removed.
+	removals []uint64
+
+	now func() time.Time
+}
+
+// pack contains information about a single pack file.
+type pack struct {
+	id   uint64
+	size int64
+	// live is the number of bytes used by blob records referenced by the index.
+	live int64
+	// refs are the blob records in other packs referred to by records in this pack.
+	refs map[location]blobKey
+}
+
+// blobKey identifies a blob in the index.
+type blobKey struct {
+	namespace string
+	key       entryKey
+}
+
+// entryKey identifies a blob within a namespace.
+type entryKey struct {
+	key       string
+	formatVer storage.FormatVersion
+}
+
+// entry is the indexed state of a blob.
+type entry struct {
+	loc        location
+	length     int64
+	dataOffset int64
+	size       int64
+	modTime    int64
+	// trashedAt is zero for blobs which are not in the trash.
+	trashedAt int64
+}
+
+// namespaceIndex contains the blobs of a namespace.
+type namespaceIndex struct {
+	live  map[entryKey]*entry
+	trash map[entryKey]*entry
+}
+
+// NewAt creates a new pack blob store in the specified directory with the default configuration.
+func NewAt(log *zap.Logger, path string) (*Store, error) {
+	return New(log, path, DefaultConfig)
+}
+
+// New opens a pack blob store in the specified directory.
+func New(log *zap.Logger, path string, config Config) (*Store, error) {
+	store := &Store{
+		log:        log,
+		path:       path,
+		config:     config,
+		packs:      make(map[uint64]*pack),
+		namespaces: make(map[string]*namespaceIndex),
+		now:        time.Now,
+	}
+
+	if err := os.MkdirAll(store.tempdir(), dirPermission); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	// temporary files left behind can't be committed anymore
+	if err := removeAllContent(store.tempdir()); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if err := store.load(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return store, nil
+}
+
+// packsdir is the directory containing the packs
+func (store *Store) packsdir() string { return filepath.Join(store.path, "packs") }
+
+// tempdir is used for blobs prior to being appended to a pack
+func (store *Store) tempdir() string { return filepath.Join(store.packsdir(), "temp") }
+
+// packPath returns the path of the pack with the specified id.
+func (store *Store) packPath(id uint64) string {
+	return filepath.Join(store.packsdir(), fmt.Sprintf("%016x%s", id, packSuffix))
+}
+
+// load rebuilds the index from the existing packs.
+func (store *Store) load() error {
+	infos, err := ioutil.ReadDir(store.packsdir())
+	if err != nil {
+		return err
+	}
+
+	var ids []uint64
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || !strings.HasSuffix(name, packSuffix) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, packSuffix), 16, 64)
+		if err != nil {
+			store.log.Warn("ignoring unknown file in packs directory", zap.String("name", name))
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, k int) bool { return ids[i] < ids[k] })
+
+	for i, id := range ids {
+		if err := store.replay(id, i == len(ids)-1); err != nil {
+			return errs.New("failed to load pack %016x: %v", id, err)
+		}
+		store.nextPack = id + 1
+	}
+
+	// continue appending to the last pack when it has room left
+	if len(ids) > 0 {
+		last := store.packs[ids[len(ids)-1]]
+		if last.size < store.config.MaxPackSize {
+			file, err := os.OpenFile(store.packPath(last.id), os.O_RDWR, packPermission)
+			if err != nil {
+				return err
+			}
+			store.active, store.activePack = file, last
+		}
+	}
+	return nil
+}
+
+// replay applies the records of a pack to the index. A torn record at the end
+// of the last pack is truncated, since it was never committed.
+func (store *Store) replay(id uint64, last bool) (err error) {
+	path := store.packPath(id)
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	p := &pack{id: id, refs: make(map[location]blobKey)}
+	store.packs[id] = p
+
+	reader := bufio.NewReaderSize(file, 256<<10)
+	var offset int64
+	for {
+		rec, err := readRecord(reader)
+		if err == io.EOF {
+			break
+		}
+		if err == nil && offset+rec.length() > info.Size() {
+			err = errInvalidRecord.New("truncated blob data")
+		}
+		if err != nil {
+			if !errInvalidRecord.Has(err) {
+				return err
+			}
+			if !last {
+				store.log.Error("ignoring invalid records in pack", zap.String("path", path), zap.Int64("offset", offset), zap.Error(err))
+				break
+			}
+			store.log.Warn("truncating incomplete record", zap.String("path", path), zap.Int64("offset", offset), zap.Error(err))
+			if err := os.Truncate(path, offset); err != nil {
+				return err
+			}
+			p.size = offset
+			return nil
+		}
+
+		if rec.kind == kindBlob {
+			if err := skip(reader, file, offset+rec.length(), rec.size); err != nil {
+				return err
+			}
+		}
+
+		store.apply(p, location{pack: id, offset: offset}, &rec)
+		offset += rec.length()
+	}
+
+	p.size = info.Size()
+	return nil
+}
+
+// skip discards n bytes of blob data from reader, seeking the file when the
+// data isn't buffered. next is the offset after the data.
+func skip(reader *bufio.Reader, file *os.File, next, n int64) error {
+	if n <= int64(reader.Buffered()) {
+		_, err := reader.Discard(int(n))
+		return err
+	}
+	if _, err := file.Seek(next, io.SeekStart); err != nil {
+		return err
+	}
+	reader.Reset(file)
+	return nil
+}
+
+// apply applies the record at loc in pack p to the index.
+func (store *Store) apply(p *pack, loc location, rec *record) {
+	key := entryKey{key: string(rec.ref.Key), formatVer: rec.formatVer}
+
+	if rec.kind == kindBlob {
+		ns := store.namespace(rec.ref.Namespace)
+		e := &entry{
+			loc:        loc,
+			length:     rec.length(),
+			dataOffset: loc.offset + rec.headerLength(),
+			size:       rec.size,
+			modTime:    rec.modTime,
+			trashedAt:  rec.trashedAt,
+		}
+		if e.trashedAt != 0 {
+			store.replace(ns.trash, key, e)
+		} else {
+			store.replace(ns.live, key, e)
+		}
+		return
+	}
+
+	if rec.target.pack != p.id {
+		p.refs[rec.target] = blobKey{namespace: string(rec.ref.Namespace), key: key}
+	}
+
+	ns, ok := store.namespaces[string(rec.ref.Namespace)]
+	if !ok {
+		return
+	}
+
+	switch rec.kind {
+	case kindTrash:
+		if e := ns.live[key]; e != nil && e.loc == rec.target {
+			delete(ns.live, key)
+			store.release(e)
+			e.trashedAt = rec.at
+			store.replace(ns.trash, key, e)
+		}
+	case kindRestore:
+		if e := ns.trash[key]; e != nil && e.loc == rec.target {
+			delete(ns.trash, key)
+			store.release(e)
+			e.trashedAt = 0
+			store.replace(ns.live, key, e)
+		}
+	case kindDelete:
+		if e := ns.live[key]; e != nil && e.loc == rec.target {
+			delete(ns.live, key)
+			store.release(e)
+		}
+		if e := ns.trash[key]; e != nil && e.loc == rec.target {
+			delete(ns.trash, key)
+			store.release(e)
+		}
+		if len(ns.live) == 0 && len(ns.trash) == 0 {
+			delete(store.namespaces, string(rec.ref.Namespace))
+		}
+	}
+}
+
+// namespace returns the index of the namespace, creating it when necessary.
+func (store *Store) namespace(namespace []byte) *namespaceIndex {
+	ns, ok := store.namespaces[string(namespace)]
+	if !ok {
+		ns = &namespaceIndex{
+			live:  make(map[entryKey]*entry),
+			trash: make(map[entryKey]*entry),
+		}
+		store.namespaces[string(namespace)] = ns
+	}
+	return ns
+}
+
+// replace sets the entry of key, releasing the previous entry.
+func (store *Store) replace(entries map[entryKey]*entry, key entryKey, e *entry) {
+	if previous := entries[key]; previous != nil {
+		store.release(previous)
+	}
+	entries[key] = e
+	if p := store.packs[e.loc.pack]; p != nil {
+		p.live += e.length
+	}
+}
+
+// release removes the entry from the live bytes of its pack.
+func (store *Store) release(e *entry) {
+	if p := store.packs[e.loc.pack]; p != nil {
+		p.live -= e.length
+	}
+}
+
+// lookup returns the entry of the blob which is not in the trash.
+func (store *Store) lookup(ref storage.BlobRef, formatVer storage.FormatVersion) *entry {
+	ns, ok := store.namespaces[string(ref.Namespace)]
+	if !ok {
+		return nil
+	}
+	return ns.live[entryKey{key: string(ref.Key), formatVer: formatVer}]
+}
+
+// appendRecord appends rec followed by size bytes of data to the active pack
+// and applies it to the index. store.writeMu and store.mu must be held.
+func (store *Store) appendRecord(rec *record, data io.Reader) (_ location, err error) {
+	if err := store.rotate(rec.length()); err != nil {
+		return location{}, err
+	}
+
+	p := store.activePack
+	loc, err := store.writeRecord(store.active, p, rec, data)
+	if err != nil {
+		return location{}, err
+	}
+	store.apply(p, loc, rec)
+	return loc, nil
+}
+
+// writeRecord writes rec followed by size bytes of data at the end of pack p,
+// which is stored in file, without applying it to the index. store.writeMu
+// must be held.
+func (store *Store) writeRecord(file *os.File, p *pack, rec *record, data io.Reader) (_ location, err error) {
+	header, err := rec.marshal()
+	if err != nil {
+		return location{}, err
+	}
+
+	loc := location{pack: p.id, offset: p.size}
+	defer func() {
+		if err != nil {
+			// drop the partially written record, so it won't be replayed
+			err = errs.Combine(err, file.Truncate(loc.offset))
+		}
+	}()
+
+	if _, err := file.Seek(loc.offset, io.SeekStart); err != nil {
+		return location{}, err
+	}
+	if _, err := file.Write(header); err != nil {
+		return location{}, err
+	}
+	if rec.kind == kindBlob {
+		n, err := io.Copy(file, io.LimitReader(data, rec.size))
+		if err != nil {
+			return location{}, err
+		}
+		if n != rec.size {
+			return location{}, errs.New("blob data too short: expected %d bytes, got %d", rec.size, n)
+		}
+	}
+
+	p.size += rec.length()
+	return loc, nil
+}
+
+// appendReference appends a record of kind referring to the blob record at target.
+// store.writeMu and store.mu must be held.
+func (store *Store) appendReference(kind recordKind, key blobKey, target location) error {
+	_, err := store.appendRecord(store.reference(kind, key, target), nil)
+	return err
+}
+
+// reference returns a record of kind referring to the blob record at target.
+func (store *Store) reference(kind recordKind, key blobKey, target location) *record {
+	return &record{
+		kind:      kind,
+		ref:       storage.BlobRef{Namespace: []byte(key.namespace), Key: []byte(key.key.key)},
+		formatVer: key.key.formatVer,
+		target:    target,
+		at:        store.now().UnixNano(),
+	}
+}
+
+// rotate ensures there is an active pack with room for length bytes.
+// store.writeMu and store.mu must be held.
+func (store *Store) rotate(length int64) error {
+	if store.active != nil && store.activePack.size > 0 && store.activePack.size+length > store.config.MaxPackSize {
+		if err := store.closeActive(); err != nil {
+			return err
+		}
+	}
+	if store.active != nil {
+		return nil
+	}
+
+	id := store.nextPack
+	file, err := os.OpenFile(store.packPath(id), os.O_RDWR|os.O_CREATE|os.O_EXCL, packPermission)
+	if err != nil {
+		return err
+	}
+	store.nextPack++
+
+	p := &pack{id: id, refs: make(map[location]blobKey)}
+	store.packs[id] = p
+	store.active, store.activePack = file, p
+	return nil
+}
+
+// syncActive flushes the active pack to disk. store.writeMu and store.mu must be held.
+func (store *Store) syncActive() error {
+	if store.active == nil {
+		return nil
+	}
+	return store.active.Sync()
+}
+
+// closeActive syncs and closes the active pack. store.writeMu and store.mu must be held.
+func (store *Store) closeActive() error {
+	if store.active == nil {
+		return nil
+	}
+	err := errs.Combine(store.active.Sync(), store.active.Close())
+	store.active, store.activePack = nil, nil
+	return err
+}
+
+// Close closes the store.
+func (store *Store) Close() error {
+	store.writeMu.Lock()
+	defer store.writeMu.Unlock()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return Error.Wrap(store.closeActive())
+}
+
+// commit appends the blob in file to the active pack.
+func (store *Store) commit(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion, file *os.File) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	return store.importBlob(ctx, ref, formatVer, store.now(), time.Time{}, file, size)
+}
+
+// importBlob appends a blob with the specified modification time and trash
+// time, when trashedAt is not zero, to the active pack.
+func (store *Store) importBlob(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion, modTime, trashedAt time.Time, data io.Reader, size int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rec := &record{
+		kind:      kindBlob,
+		ref:       ref,
+		formatVer: formatVer,
+		size:      size,
+		modTime:   modTime.UnixNano(),
+	}
+	if !trashedAt.IsZero() {
+		rec.trashedAt = trashedAt.UnixNano()
+	}
+
+	store.writeMu.Lock()
+	defer store.writeMu.Unlock()
+
+	key := blobKey{namespace: string(ref.Namespace), key: entryKey{key: string(ref.Key), formatVer: formatVer}}
+
+	store.mu.Lock()
+	var previous *entry
+	if ns, ok := store.namespaces[key.namespace]; ok {
+		if rec.trashedAt != 0 {
+			previous = ns.trash[key.key]
+		} else {
+			previous = ns.live[key.key]
+		}
+	}
+	// the replaced blob record must stay dead when the new one is compacted
+	var del *record
+	length := rec.length()
+	if previous != nil {
+		del = store.reference(kindDelete, key, previous.loc)
+		length += del.length()
+	}
+	err = store.rotate(length)
+	file, p := store.active, store.activePack
+	store.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	// the records are written and synced without holding store.mu, other
+	// writers are excluded by store.writeMu, so the index doesn't change
+	start := p.size
+	var delLoc location
+	loc, err := store.writeRecord(file, p, rec, data)
+	if err == nil && del != nil {
+		delLoc, err = store.writeRecord(file, p, del, nil)
+	}
+	if err == nil {
+		err = file.Sync()
+	}
+	if err != nil {
+		// drop the records, which were not published to the index
+		p.size = start
+		return errs.Combine(err, file.Truncate(start))
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.apply(p, loc, rec)
+	if del != nil {
+		store.apply(p, delLoc, del)
+	}
+	return nil
+}
+
+// notExist returns the error for a missing blob.
+func (store *Store) notExist(ref storage.BlobRef, formatVer storage.FormatVersion) error {
+	return &os.PathError{Op: "open", Path: store.virtualPath(location{}, ref, formatVer), Err: os.ErrNotExist}
+}
+
+// virtualPath returns a path for the blob inside the pack containing it.
+func (store *Store) virtualPath(loc location, ref storage.BlobRef, formatVer storage.FormatVersion) string {
+	name := pathEncoding.EncodeToString(ref.Key)
+	if formatVer == filestore.FormatV1 {
+		name += ".sj1"
+	}
+	return filepath.Join(store.packPath(loc.pack), pathEncoding.EncodeToString(ref.Namespace), name)
+}
+
+// Open loads blob with the specified hash
+func (store *Store) Open(ctx context.Context, ref storage.BlobRef) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	for formatVer := filestore.MaxFormatVersionSupported; formatVer >= filestore.MinFormatVersionSupported; formatVer-- {
+		if e := store.lookup(ref, formatVer); e != nil {
+			return store.openEntry(e, formatVer)
+		}
+	}
+	return nil, store.notExist(ref, filestore.MaxFormatVersionSupported)
+}
+
+// OpenWithStorageFormat loads the already-located blob, avoiding the potential need to check multiple
+// storage formats to find the blob.
+func (store *Store) OpenWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	e := store.lookup(ref, formatVer)
+	if e == nil {
+		return nil, store.notExist(ref, formatVer)
+	}
+	return store.openEntry(e, formatVer)
+}
+
+// openEntry opens a reader for the blob data of the entry. The pack is opened
+// while holding store.mu, so compaction can't remove it in the meantime.
+func (store *Store) openEntry(e *entry, formatVer storage.FormatVersion) (_ storage.BlobReader, err error) {
+	file, err := os.Open(store.packPath(e.loc.pack))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return newBlobReader(file, e.dataOffset, e.size, formatVer), nil
+}
+
+// Stat looks up metadata of the blob
+func (store *Store) Stat(ctx context.Context, ref storage.BlobRef) (_ storage.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	for formatVer := filestore.MaxFormatVersionSupported; formatVer >= filestore.MinFormatVersionSupported; formatVer-- {
+		if e := store.lookup(ref, formatVer); e != nil {
+			return store.newBlobInfo(ref, formatVer, e), nil
+		}
+	}
+	return nil, Error.Wrap(store.notExist(ref, filestore.MaxFormatVersionSupported))
+}
+
+// StatWithStorageFormat looks up metadata of the blob with the given storage format version
+func (store *Store) StatWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (_ storage.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	e := store.lookup(ref, formatVer)
+	if e == nil {
+		return nil, Error.Wrap(store.notExist(ref, formatVer))
+	}
+	return store.newBlobInfo(ref, formatVer, e), nil
+}
+
+// Delete deletes blobs with the specified ref.
+//
+// It doesn't return an error if the blob isn't found.
+func (store *Store) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for formatVer := filestore.MinFormatVersionSupported; formatVer <= filestore.MaxFormatVersionSupported; formatVer++ {
+		group.Add(store.DeleteWithStorageFormat(ctx, ref, formatVer))
+	}
+	return group.Err()
+}
+
+// DeleteWithStorageFormat deletes blobs with the specified ref and storage format version
+func (store *Store) DeleteWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.writeMu.Lock()
+	defer store.writeMu.Unlock()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	e := store.lookup(ref, formatVer)
+	if e == nil {
+		return nil
+	}
+	key := blobKey{namespace: string(ref.Namespace), key: entryKey{key: string(ref.Key), formatVer: formatVer}}
+	return Error.Wrap(store.appendReference(kindDelete, key, e.loc))
+}
+
+// Trash marks the blobs with the specified ref as trashed
+func (store *Store) Trash(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.writeMu.Lock()
+	defer store.writeMu.Unlock()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	for formatVer := filestore.MinFormatVersionSupported; formatVer <= filestore.MaxFormatVersionSupported; formatVer++ {
+		e := store.lookup(ref, formatVer)
+		if e == nil {
+			continue
+		}
+
+		key := blobKey{namespace: string(ref.Namespace), key: entryKey{key: string(ref.Key), formatVer: formatVer}}
+		previous := store.namespaces[key.namespace].trash[key.key]
+		if err := store.appendReference(kindTrash, key, e.loc); err != nil {
+			return Error.Wrap(err)
+		}
+		if previous != nil {
+			if err := store.appendReference(kindDelete, key, previous.loc); err != nil {
+				return Error.Wrap(err)
+			}
+		}
+	}
+	return nil
+}
+
+// RestoreTrash restores every blob in the trash of the namespace
+func (store *Store) RestoreTrash(ctx context.Context, namespace []byte) (keysRestored [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.writeMu.Lock()
+	defer store.writeMu.Unlock()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	ns, ok := store.namespaces[string(namespace)]
+	if !ok {
+		return nil, nil
+	}
+
+	for key, e := range ns.trash {
+		blob := blobKey{namespace: string(namespace), key: key}
+		previous := ns.live[key]
+		if err := store.appendReference(kindRestore, blob, e.loc); err != nil {
+			return keysRestored, Error.Wrap(err)
+		}
+		if previous != nil {
+			if err := store.appendReference(kindDelete, blob, previous.loc); err != nil {
+				return keysRestored, Error.Wrap(err)
+			}
+		}
+		keysRestored = append(keysRestored, []byte(key.key))
+	}
+	return keysRestored, nil
+}
+
+// EmptyTrash removes all blobs in the trash of the namespace which were trashed before trashedBefore
+func (store *Store) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (bytesEmptied int64, keys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.writeMu.Lock()
+	defer store.writeMu.Unlock()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	ns, ok := store.namespaces[string(namespace)]
+	if !ok {
+		return 0, nil, nil
+	}
+
+	for key, e := range ns.trash {
+		if !time.Unix(0, e.trashedAt).Before(trashedBefore) {
+			continue
+		}
+		if err := store.appendReference(kindDelete, blobKey{namespace: string(namespace), key: key}, e.loc); err != nil {
+			return 0, nil, Error.Wrap(err)
+		}
+		bytesEmptied += e.size
+		keys = append(keys, []byte(key.key))
+	}
+	return bytesEmptied, keys, nil
+}
+
+// GarbageCollect compacts packs with mostly dead records
+func (store *Store) GarbageCollect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.Compact(ctx)
+}
+
+// Create creates a new blob that can be written
+// optionally takes a size argument for performance improvements, -1 is unknown size
+func (store *Store) Create(ctx context.Context, ref storage.BlobRef, size int64) (_ storage.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.create(ref, filestore.MaxFormatVersionSupported)
+}
+
+// TestCreateV0 creates a new V0 blob that can be written. This is ONLY appropriate in test situations.
+func (store *Store) TestCreateV0(ctx context.Context, ref storage.BlobRef) (_ storage.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.create(ref, filestore.FormatV0)
+}
+
+// create creates a writer for a blob with the specified format version.
+func (store *Store) create(ref storage.BlobRef, formatVer storage.FormatVersion) (_ storage.BlobWriter, err error) {
+	if !ref.IsValid() {
+		return nil, storage.ErrInvalidBlobRef.New("")
+	}
+	file, err := ioutil.TempFile(store.tempdir(), "blob-*.partial")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return newBlobWriter(ref, store, formatVer, file), nil
+}
+
+// SpaceUsedForBlobs adds up the space used in all namespaces for blob storage
+func (store *Store) SpaceUsedForBlobs(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	for _, ns := range store.namespaces {
+		for _, e := range ns.live {
+			total += e.size
+		}
+	}
+	return total, nil
+}
+
+// SpaceUsedForBlobsInNamespace adds up how much is used in the given namespace for blob storage
+func (store *Store) SpaceUsedForBlobsInNamespace(ctx context.Context, namespace []byte) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	if ns, ok := store.namespaces[string(namespace)]; ok {
+		for _, e := range ns.live {
+			total += e.size
+		}
+	}
+	return total, nil
+}
+
+// SpaceUsedForTrash returns the total space used by the trash
+func (store *Store) SpaceUsedForTrash(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	for _, ns := range store.namespaces {
+		for _, e := range ns.trash {
+			total += e.size
+		}
+	}
+	return total, nil
+}
+
+// FreeSpace returns how much space left in underlying directory
+func (store *Store) FreeSpace() (int64, error) {
+	info, err := filestore.DiskInfoFromPath(store.path)
+	if err != nil {
+		return 0, err
+	}
+	return info.AvailableSpace, nil
+}
+
+// ListNamespaces finds all namespace IDs which contain blobs, including trashed blobs.
+func (store *Store) ListNamespaces(ctx context.Context) (ids [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	for namespace := range store.namespaces {
+		ids = append(ids, []byte(namespace))
+	}
+	return ids, nil
+}
+
+// WalkNamespace executes walkFunc for each stored blob in the given namespace. If walkFunc
+// returns a non-nil error, WalkNamespace will stop iterating and return the error immediately. The
+// ctx parameter is intended specifically to allow canceling iteration early.
+func (store *Store) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(storage.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// walkFunc may call back into the store, so it's called without holding the lock
+	store.mu.RLock()
+	var infos []storage.BlobInfo
+	if ns, ok := store.namespaces[string(namespace)]; ok {
+		infos = make([]storage.BlobInfo, 0, len(ns.live))
+		for key, e := range ns.live {
+			ref := storage.BlobRef{Namespace: namespace, Key: []byte(key.key)}
+			infos = append(infos, store.newBlobInfo(ref, key.formatVer, e))
+		}
+	}
+	store.mu.RUnlock()
+
+	for _, info := range infos {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := walkFunc(info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WalkTrash executes walkFunc for each trashed blob in the given namespace, without restoring
+// it. If walkFunc returns a non-nil error, WalkTrash will stop iterating and return the error
+// immediately.
+func (store *Store) WalkTrash(ctx context.Context, namespace []byte, walkFunc func(storage.TrashInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// walkFunc may call back into the store, so it's called without holding the lock
+	store.mu.RLock()
+	var infos []storage.TrashInfo
+	if ns, ok := store.namespaces[string(namespace)]; ok {
+		infos = make([]storage.TrashInfo, 0, len(ns.trash))
+		for key, e := range ns.trash {
+			ref := storage.BlobRef{Namespace: namespace, Key: []byte(key.key)}
+			infos = append(infos, store.newTrashInfo(ref, key.formatVer, e))
+		}
+	}
+	store.mu.RUnlock()
+
+	for _, info := range infos {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := walkFunc(info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// openTrash opens a reader for the trashed blob.
+func (store *Store) openTrash(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	ns, ok := store.namespaces[string(ref.Namespace)]
+	if !ok {
+		return nil, store.notExist(ref, formatVer)
+	}
+	e := ns.trash[entryKey{key: string(ref.Key), formatVer: formatVer}]
+	if e == nil {
+		return nil, store.notExist(ref, formatVer)
+	}
+	return store.openEntry(e, formatVer)
+}
+
+// ImportTrash stores the blob directly in the trash, as if it was trashed at trashedAt
+func (store *Store) ImportTrash(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion, trashedAt time.Time, data io.Reader, size int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !ref.IsValid() {
+		return storage.ErrInvalidBlobRef.New("")
+	}
+	return Error.Wrap(store.importBlob(ctx, ref, formatVer, trashedAt, trashedAt, data, size))
+}
+
+// removeAllContent deletes everything in the folder.
+func removeAllContent(path string) error {
+	infos, err := ioutil.ReadDir(path)
+	if err != nil {
+		return err
+	}
+	var group errs.Group
+	for _, info := range infos {
+		group.Add(os.RemoveAll(filepath.Join(path, info.Name())))
+	}
+	return group.Err()
+}
@@ -0,0 +1,383 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storage/packstore"
+)
+
+var testConfig = packstore.Config{
+	MaxPackSize:      16 * memory.KiB.Int64(),
+	CompactDeadRatio: 0.5,
+}
+
+func writeBlob(ctx context.Context, t *testing.T, store storage.Blobs, ref storage.BlobRef, data []byte) {
+	writer, err := store.Create(ctx, ref, int64(len(data)))
+	require.NoError(t, err)
+	_, err = writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+}
+
+func requireBlob(ctx context.Context, t *testing.T, store storage.Blobs, ref storage.BlobRef, data []byte) {
+	reader, err := store.Open(ctx, ref)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, reader.Close()) }()
+
+	got, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, data, got)
+}
+
+func requireMissing(ctx context.Context, t *testing.T, store storage.Blobs, ref storage.BlobRef) {
+	_, err := store.Open(ctx, ref)
+	require.True(t, os.IsNotExist(err), "%v", err)
+}
+
+func countPacks(t *testing.T, dir string) int {
+	matches, err := filepath.Glob(filepath.Join(dir, "packs", "*.pack"))
+	require.NoError(t, err)
+	return len(matches)
+}
+
+func TestReplay(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir := ctx.Dir("store")
+	store, err := packstore.New(zaptest.NewLogger(t), dir, testConfig)
+	require.NoError(t, err)
+
+	namespace := testrand.Bytes(32)
+	live := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	deleted := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	trashed := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	restored := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	rewritten := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+
+	data := testrand.Bytes(memory.KiB)
+	newData := testrand.Bytes(2 * memory.KiB)
+	for _, ref := range []storage.BlobRef{live, deleted, trashed, restored, rewritten} {
+		writeBlob(ctx, t, store, ref, data)
+	}
+	writeBlob(ctx, t, store, rewritten, newData)
+
+	require.NoError(t, store.Delete(ctx, deleted))
+	require.NoError(t, store.Trash(ctx, restored))
+	_, err = store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	require.NoError(t, store.Trash(ctx, trashed))
+	require.NoError(t, store.Close())
+
+	// the state is rebuilt from the packs
+	store, err = packstore.New(zaptest.NewLogger(t), dir, testConfig)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	requireBlob(ctx, t, store, live, data)
+	requireBlob(ctx, t, store, restored, data)
+	requireBlob(ctx, t, store, rewritten, newData)
+	requireMissing(ctx, t, store, deleted)
+	requireMissing(ctx, t, store, trashed)
+
+	used, err := store.SpaceUsedForBlobs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2*len(data)+len(newData)), used)
+
+	trash, err := store.SpaceUsedForTrash(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(data)), trash)
+
+	keys, err := store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{trashed.Key}, keys)
+	requireBlob(ctx, t, store, trashed, data)
+}
+
+func TestConcurrentWrites(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir := ctx.Dir("store")
+	store, err := packstore.New(zaptest.NewLogger(t), dir, testConfig)
+	require.NoError(t, err)
+
+	namespace := testrand.Bytes(32)
+	old := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	oldData := testrand.Bytes(memory.KiB)
+	writeBlob(ctx, t, store, old, oldData)
+
+	refs := make([]storage.BlobRef, 16)
+	blobs := make([][]byte, len(refs))
+	for i := range refs {
+		refs[i] = storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		blobs[i] = testrand.Bytes(memory.KiB)
+	}
+
+	// writes rotate packs while the existing blob is read
+	var group errgroup.Group
+	for i := range refs {
+		i := i
+		group.Go(func() error {
+			writer, err := store.Create(ctx, refs[i], int64(len(blobs[i])))
+			if err != nil {
+				return err
+			}
+			if _, err := writer.Write(blobs[i]); err != nil {
+				return err
+			}
+			return writer.Commit(ctx)
+		})
+		group.Go(func() error {
+			reader, err := store.Open(ctx, old)
+			if err != nil {
+				return err
+			}
+			_, err = ioutil.ReadAll(reader)
+			return errs.Combine(err, reader.Close())
+		})
+	}
+	require.NoError(t, group.Wait())
+
+	check := func(store *packstore.Store) {
+		requireBlob(ctx, t, store, old, oldData)
+		for i, ref := range refs {
+			requireBlob(ctx, t, store, ref, blobs[i])
+		}
+	}
+	check(store)
+
+	require.NoError(t, store.Close())
+	store, err = packstore.New(zaptest.NewLogger(t), dir, testConfig)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+	check(store)
+}
+
+func TestTornRecord(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir := ctx.Dir("store")
+	store, err := packstore.New(zaptest.NewLogger(t), dir, testConfig)
+	require.NoError(t, err)
+
+	ref := storage.BlobRef{Namespace: testrand.Bytes(32), Key: testrand.Bytes(32)}
+	data := testrand.Bytes(memory.KiB)
+	writeBlob(ctx, t, store, ref, data)
+	require.NoError(t, store.Close())
+
+	// simulate a crash while appending a record
+	matches, err := filepath.Glob(filepath.Join(dir, "packs", "*.pack"))
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	stat, err := os.Stat(matches[0])
+	require.NoError(t, err)
+
+	file, err := os.OpenFile(matches[0], os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+	_, err = file.Write(testrand.Bytes(100))
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	store, err = packstore.New(zaptest.NewLogger(t), dir, testConfig)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	requireBlob(ctx, t, store, ref, data)
+
+	truncated, err := os.Stat(matches[0])
+	require.NoError(t, err)
+	assert.Equal(t, stat.Size(), truncated.Size())
+
+	// appending continues after the last valid record
+	other := storage.BlobRef{Namespace: ref.Namespace, Key: testrand.Bytes(32)}
+	writeBlob(ctx, t, store, other, data)
+	requireBlob(ctx, t, store, other, data)
+}
+
+func TestCompact(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir := ctx.Dir("store")
+	store, err := packstore.New(zaptest.NewLogger(t), dir, testConfig)
+	require.NoError(t, err)
+
+	namespace := testrand.Bytes(32)
+	blobs := make(map[string][]byte)
+	var refs []storage.BlobRef
+	for i := 0; i < 64; i++ {
+		ref := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		data := testrand.Bytes(memory.KiB)
+		writeBlob(ctx, t, store, ref, data)
+		refs = append(refs, ref)
+		blobs[string(ref.Key)] = data
+	}
+	packsBefore := countPacks(t, dir)
+	require.True(t, packsBefore > 2)
+
+	// delete most blobs, trash some of the remaining
+	var remaining, trashed []storage.BlobRef
+	for i, ref := range refs {
+		switch {
+		case i%4 == 0:
+			remaining = append(remaining, ref)
+		case i%8 == 1:
+			require.NoError(t, store.Trash(ctx, ref))
+			trashed = append(trashed, ref)
+		default:
+			require.NoError(t, store.Delete(ctx, ref))
+		}
+	}
+
+	require.NoError(t, store.Compact(ctx))
+	assert.True(t, countPacks(t, dir) < packsBefore)
+
+	check := func(store *packstore.Store) {
+		for _, ref := range remaining {
+			requireBlob(ctx, t, store, ref, blobs[string(ref.Key)])
+		}
+		used, err := store.SpaceUsedForBlobs(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(remaining))*memory.KiB.Int64(), used)
+
+		trash, err := store.SpaceUsedForTrash(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(trashed))*memory.KiB.Int64(), trash)
+	}
+	check(store)
+
+	// compaction doesn't revive any records when replaying
+	require.NoError(t, store.Close())
+	store, err = packstore.New(zaptest.NewLogger(t), dir, testConfig)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+	check(store)
+
+	for _, ref := range refs {
+		if !containsRef(remaining, ref) {
+			requireMissing(ctx, t, store, ref)
+		}
+	}
+
+	// trashed blobs survive compaction
+	keys, err := store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	assert.Len(t, keys, len(trashed))
+	for _, ref := range trashed {
+		requireBlob(ctx, t, store, ref, blobs[string(ref.Key)])
+	}
+
+	// removing everything removes all packs
+	for _, ref := range refs {
+		require.NoError(t, store.Delete(ctx, ref))
+	}
+	require.NoError(t, store.Compact(ctx))
+	require.NoError(t, store.Compact(ctx))
+	assert.Equal(t, 0, countPacks(t, dir))
+}
+
+func containsRef(refs []storage.BlobRef, ref storage.BlobRef) bool {
+	for _, other := range refs {
+		if string(other.Key) == string(ref.Key) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestConvert(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir := ctx.Dir("store")
+	source, err := filestore.NewAt(zaptest.NewLogger(t), dir)
+	require.NoError(t, err)
+	defer ctx.Check(source.Close)
+
+	namespace := testrand.Bytes(32)
+	live := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	trashed := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	data := testrand.Bytes(memory.KiB)
+
+	writeBlob(ctx, t, source, live, data)
+	writeBlob(ctx, t, source, trashed, data)
+	require.NoError(t, source.Trash(ctx, trashed))
+
+	sourceInfo, err := source.Stat(ctx, live)
+	require.NoError(t, err)
+	sourceStat, err := sourceInfo.Stat(ctx)
+	require.NoError(t, err)
+
+	var trashedAt time.Time
+	require.NoError(t, source.WalkTrash(ctx, namespace, func(info storage.TrashInfo) error {
+		trashedAt = info.TrashedAt()
+		return nil
+	}))
+	require.False(t, trashedAt.IsZero())
+
+	target, err := packstore.New(zaptest.NewLogger(t), dir, testConfig)
+	require.NoError(t, err)
+	defer ctx.Check(target.Close)
+
+	converted, err := packstore.Convert(ctx, zaptest.NewLogger(t), source, target)
+	require.NoError(t, err)
+	assert.Equal(t, 2, converted)
+
+	// the blobs are removed from the source
+	requireMissing(ctx, t, source, live)
+	requireMissing(ctx, t, source, trashed)
+	keys, err := source.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	requireBlob(ctx, t, target, live, data)
+	requireMissing(ctx, t, target, trashed)
+
+	// the modification time is kept, since it's used to determine the piece age
+	info, err := target.Stat(ctx, live)
+	require.NoError(t, err)
+	stat, err := info.Stat(ctx)
+	require.NoError(t, err)
+	assert.True(t, sourceStat.ModTime().Equal(stat.ModTime()))
+	assert.Equal(t, filestore.FormatV1, info.StorageFormatVersion())
+
+	// trashed blobs keep the time they were trashed
+	var trashedKeys [][]byte
+	require.NoError(t, target.WalkTrash(ctx, namespace, func(info storage.TrashInfo) error {
+		trashedKeys = append(trashedKeys, info.BlobRef().Key)
+		assert.True(t, trashedAt.Equal(info.TrashedAt()))
+		return nil
+	}))
+	assert.Equal(t, [][]byte{trashed.Key}, trashedKeys)
+
+	// trashed blobs can be restored or emptied
+	_, keys, err = target.EmptyTrash(ctx, namespace, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	keys, err = target.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{trashed.Key}, keys)
+	requireBlob(ctx, t, target, trashed, data)
+}
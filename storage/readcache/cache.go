@@ -0,0 +1,527 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package readcache implements caching frequently read blobs in memory and on a
+// fast disk.
+package readcache
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+)
+
+var (
+	// Error is the default error class for the read cache.
+	Error = errs.Class("readcache error")
+
+	mon = monkit.Package()
+)
+
+// trackedReads is how many blobs the read counts are tracked for, before the
+// least recently read ones are forgotten.
+const trackedReads = 100000
+
+// anyFormat matches blobs of any storage format version.
+const anyFormat = storage.FormatVersion(-1)
+
+// Config defines parameters for the read cache.
+type Config struct {
+	MemorySize   memory.Size `help:"how much memory is used for caching frequently read pieces, 0 disables the memory cache" default:"0"`
+	Dir          string      `help:"directory on a fast disk for caching frequently read pieces, which are evicted from the memory cache" default:""`
+	DirSize      memory.Size `help:"how much space is used for caching pieces in dir, 0 disables the disk cache" default:"0"`
+	MaxPieceSize memory.Size `help:"pieces larger than this are never cached" default:"4.0 MiB"`
+	MinReads     int         `help:"how often a piece must be read before it's cached" default:"2"`
+}
+
+// Enabled returns whether any cache is configured.
+func (config Config) Enabled() bool {
+	return config.MemorySize > 0 || (config.Dir != "" && config.DirSize > 0)
+}
+
+// Stats contains the statistics of the read cache.
+type Stats struct {
+	Hits       int64
+	Misses     int64
+	MemoryUsed int64
+	DiskUsed   int64
+}
+
+// HitRatio returns the ratio of the opened blobs that were served from the cache.
+func (stats Stats) HitRatio() float64 {
+	if stats.Hits+stats.Misses == 0 {
+		return 0
+	}
+	return float64(stats.Hits) / float64(stats.Hits+stats.Misses)
+}
+
+// Cache wraps a blob store and serves frequently read blobs from memory or from
+// a directory on a fast disk. Blobs are evicted in least recently used order.
+//
+// Blobs are removed from the cache when they're deleted, trashed or overwritten
+// through the cache. The disk cache is cleared on startup, since blobs may have
+// been deleted while the cache wasn't running.
+//
+// architecture: Database
+type Cache struct {
+	storage.Blobs
+
+	log    *zap.Logger
+	config Config
+	dir    string
+
+	mu     sync.Mutex
+	memory *lru
+	disk   *lru
+	reads  *lru
+	fills  map[string]*fill
+	hits   int64
+	misses int64
+
+	// generation is incremented by every invalidation. A blob opened before
+	// it was invalidated isn't added to the cache, since the old blob may
+	// have been read.
+	generation  uint64
+	invalidated *lru   // the generation of the recent invalidations by key
+	forgotten   uint64 // the latest generation evicted from invalidated
+}
+
+// memoryEntry is a blob cached in memory.
+type memoryEntry struct {
+	data   []byte
+	format storage.FormatVersion
+}
+
+// diskEntry is a blob cached on disk.
+type diskEntry struct {
+	path   string
+	format storage.FormatVersion
+}
+
+// fill is a blob being added to the cache. A fill is canceled by removing
+// it from Cache.fills, in which case the blob isn't added.
+type fill struct {
+	// key is only set, so that distinct fills never share the same address.
+	key string
+}
+
+// New creates a new read cache for blobs.
+func New(log *zap.Logger, blobs storage.Blobs, config Config) (*Cache, error) {
+	cache := &Cache{
+		Blobs:  blobs,
+		log:    log,
+		config: config,
+
+		memory: newLRU(config.MemorySize.Int64()),
+		disk:   newLRU(0),
+		reads:  newLRU(trackedReads),
+		fills:  map[string]*fill{},
+
+		invalidated: newLRU(trackedReads),
+	}
+
+	if config.Dir != "" && config.DirSize > 0 {
+		cache.dir = filepath.Join(config.Dir, "piece-cache")
+		if err := os.RemoveAll(cache.dir); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if err := os.MkdirAll(cache.dir, 0700); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		cache.disk = newLRU(config.DirSize.Int64())
+	}
+
+	return cache, nil
+}
+
+// Stats returns the statistics of the cache.
+func (cache *Cache) Stats() Stats {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	return Stats{
+		Hits:       cache.hits,
+		Misses:     cache.misses,
+		MemoryUsed: cache.memory.size,
+		DiskUsed:   cache.disk.size,
+	}
+}
+
+// Open opens a reader for the blob, which is served from the cache if possible.
+func (cache *Cache) Open(ctx context.Context, ref storage.BlobRef) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.open(ctx, ref, anyFormat, func() (storage.BlobReader, error) {
+		return cache.Blobs.Open(ctx, ref)
+	})
+}
+
+// OpenWithStorageFormat opens a reader for the blob with the storage format
+// version, which is served from the cache if possible.
+func (cache *Cache) OpenWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.open(ctx, ref, formatVer, func() (storage.BlobReader, error) {
+		return cache.Blobs.OpenWithStorageFormat(ctx, ref, formatVer)
+	})
+}
+
+// OpenUncached opens a reader for the blob in the underlying blob store. The
+// blob isn't served from the cache and the read doesn't count toward adding it
+// to the cache, so checks of the stored blob see what's on disk.
+func (cache *Cache) OpenUncached(ctx context.Context, ref storage.BlobRef) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.Blobs.Open(ctx, ref)
+}
+
+// Create removes the blob from the cache and creates a new blob.
+func (cache *Cache) Create(ctx context.Context, ref storage.BlobRef, size int64) (_ storage.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+	cache.invalidate(ref)
+	return cache.Blobs.Create(ctx, ref, size)
+}
+
+// Delete removes the blob from the cache and deletes it.
+func (cache *Cache) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	// the blob is removed again afterwards, in case it was read while it was deleted
+	cache.invalidate(ref)
+	defer cache.invalidate(ref)
+	return cache.Blobs.Delete(ctx, ref)
+}
+
+// DeleteWithStorageFormat removes the blob from the cache and deletes the blob
+// with the storage format version.
+func (cache *Cache) DeleteWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	cache.invalidate(ref)
+	defer cache.invalidate(ref)
+	return cache.Blobs.DeleteWithStorageFormat(ctx, ref, formatVer)
+}
+
+// Trash removes the blob from the cache and moves it to the trash.
+func (cache *Cache) Trash(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	cache.invalidate(ref)
+	defer cache.invalidate(ref)
+	return cache.Blobs.Trash(ctx, ref)
+}
+
+// RefreshUsage refreshes the space used by the underlying blob store, when it
+// tracks the space used per directory.
+func (cache *Cache) RefreshUsage(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if refresher, ok := cache.Blobs.(interface {
+		RefreshUsage(ctx context.Context) error
+	}); ok {
+		return refresher.RefreshUsage(ctx)
+	}
+	return nil
+}
+
+// Locations returns the status of the directories of the underlying blob
+// store, when it spans multiple directories.
+func (cache *Cache) Locations(ctx context.Context) []filestore.LocationStatus {
+	if locator, ok := cache.Blobs.(interface {
+		Locations(ctx context.Context) []filestore.LocationStatus
+	}); ok {
+		return locator.Locations(ctx)
+	}
+	return nil
+}
+
+// Close clears the cache. It doesn't close the underlying blob store.
+func (cache *Cache) Close() error {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.memory = newLRU(0)
+	cache.disk = newLRU(0)
+	cache.fills = map[string]*fill{}
+	if cache.dir != "" {
+		return Error.Wrap(os.RemoveAll(cache.dir))
+	}
+	return nil
+}
+
+// open returns a reader for the cached blob, or opens the blob with openBlob
+// and adds it to the cache when it's read frequently.
+func (cache *Cache) open(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion, openBlob func() (storage.BlobReader, error)) (_ storage.BlobReader, err error) {
+	key := cacheKey(ref)
+	reader, generation, ok := cache.lookup(key, formatVer)
+	if ok {
+		return reader, nil
+	}
+
+	reader, err = openBlob()
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := reader.Size()
+	if err != nil {
+		return reader, nil
+	}
+	admitted, ok := cache.admit(key, size, generation)
+	if !ok {
+		return reader, nil
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(io.NewSectionReader(reader, 0, size), data); err != nil {
+		cache.cancel(key, admitted)
+		cache.log.Debug("unable to read blob for caching", zap.Error(err))
+		return reader, nil
+	}
+	format := reader.StorageFormatVersion()
+	if err := reader.Close(); err != nil {
+		cache.log.Debug("unable to close blob", zap.Error(err))
+	}
+
+	cache.add(key, admitted, &memoryEntry{data: data, format: format})
+	return newMemoryReader(data, format), nil
+}
+
+// lookup returns a reader for the cached blob and records the hit or miss. It
+// also returns the current generation, which must be passed to admit.
+func (cache *Cache) lookup(key string, formatVer storage.FormatVersion) (storage.BlobReader, uint64, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	reader, ok := cache.lookupLocked(key, formatVer)
+	if ok {
+		cache.hits++
+		mon.Meter("readcache_hit").Mark(1)
+	} else {
+		cache.misses++
+		mon.Meter("readcache_miss").Mark(1)
+	}
+	mon.FloatVal("readcache_hit_ratio").Observe(float64(cache.hits) / float64(cache.hits+cache.misses))
+	return reader, cache.generation, ok
+}
+
+func (cache *Cache) lookupLocked(key string, formatVer storage.FormatVersion) (storage.BlobReader, bool) {
+	if item, ok := cache.memory.get(key); ok {
+		entry := item.value.(*memoryEntry)
+		if formatVer != anyFormat && formatVer != entry.format {
+			return nil, false
+		}
+		return newMemoryReader(entry.data, entry.format), true
+	}
+
+	if item, ok := cache.disk.get(key); ok {
+		entry := item.value.(*diskEntry)
+		if formatVer != anyFormat && formatVer != entry.format {
+			return nil, false
+		}
+		file, err := os.Open(entry.path)
+		if err != nil {
+			cache.log.Debug("unable to open cached blob", zap.Error(err))
+			cache.disk.remove(key)
+			return nil, false
+		}
+		return &diskReader{File: file, size: item.size, format: entry.format}, true
+	}
+
+	return nil, false
+}
+
+// admit records a read of the blob and returns whether it should be added to the
+// cache. The blob isn't added when it was invalidated after generation, since
+// it may have been opened before it was deleted. The returned fill must be
+// passed to add or cancel.
+func (cache *Cache) admit(key string, size int64, generation uint64) (*fill, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if size > cache.config.MaxPieceSize.Int64() {
+		return nil, false
+	}
+	if cache.invalidatedSince(key, generation) {
+		return nil, false
+	}
+	if _, filling := cache.fills[key]; filling {
+		return nil, false
+	}
+
+	var reads *int
+	if counted, ok := cache.reads.get(key); ok {
+		reads = counted.value.(*int)
+	} else {
+		reads = new(int)
+		cache.reads.add(key, 1, reads)
+	}
+	*reads++
+	if *reads < cache.config.MinReads {
+		return nil, false
+	}
+
+	cache.reads.remove(key)
+	admitted := &fill{key: key}
+	cache.fills[key] = admitted
+	return admitted, true
+}
+
+// add adds the blob to the memory cache, or to the disk cache when there's no
+// memory cache, unless the fill was canceled.
+func (cache *Cache) add(key string, admitted *fill, entry *memoryEntry) {
+	cache.mu.Lock()
+	if cache.fills[key] != admitted {
+		cache.mu.Unlock()
+		return
+	}
+
+	size := int64(len(entry.data))
+	var evicted []*item
+	if cache.memory.capacity > 0 {
+		delete(cache.fills, key)
+		evicted = cache.memory.add(key, size, entry)
+	} else {
+		evicted = []*item{{key: key, size: size, value: entry}}
+	}
+	cache.mu.Unlock()
+
+	for _, item := range evicted {
+		cache.demote(item)
+	}
+}
+
+// demote moves a blob evicted from the memory cache to the disk cache.
+func (cache *Cache) demote(evicted *item) {
+	if cache.dir == "" {
+		return
+	}
+	key, entry := evicted.key, evicted.value.(*memoryEntry)
+
+	cache.mu.Lock()
+	demoted, filling := cache.fills[key]
+	if !filling {
+		demoted = &fill{key: key}
+		cache.fills[key] = demoted
+	}
+	cache.mu.Unlock()
+
+	path := filepath.Join(cache.dir, key)
+	if err := ioutil.WriteFile(path, entry.data, 0600); err != nil {
+		cache.log.Debug("unable to write blob to disk cache", zap.Error(err))
+		cache.cancel(key, demoted)
+		_ = os.Remove(path)
+		return
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.fills[key] != demoted {
+		// the blob was deleted meanwhile
+		_ = os.Remove(path)
+		return
+	}
+	delete(cache.fills, key)
+
+	for _, item := range cache.disk.add(key, evicted.size, &diskEntry{path: path, format: entry.format}) {
+		cache.removeFile(item)
+	}
+	if _, ok := cache.disk.items[key]; !ok {
+		// the blob is larger than the disk cache
+		_ = os.Remove(path)
+	}
+}
+
+// cancel cancels adding the blob to the cache.
+func (cache *Cache) cancel(key string, canceled *fill) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.fills[key] == canceled {
+		delete(cache.fills, key)
+	}
+}
+
+// invalidate removes the blob from the cache and cancels adding it.
+func (cache *Cache) invalidate(ref storage.BlobRef) {
+	key := cacheKey(ref)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	delete(cache.fills, key)
+	cache.reads.remove(key)
+	cache.memory.remove(key)
+	if item, ok := cache.disk.remove(key); ok {
+		cache.removeFile(item)
+	}
+
+	cache.generation++
+	for _, item := range cache.invalidated.add(key, 1, cache.generation) {
+		if generation := item.value.(uint64); generation > cache.forgotten {
+			cache.forgotten = generation
+		}
+	}
+}
+
+// invalidatedSince returns whether the blob may have been invalidated after
+// generation. cache.mu must be held.
+func (cache *Cache) invalidatedSince(key string, generation uint64) bool {
+	if cache.forgotten > generation {
+		return true
+	}
+	element, ok := cache.invalidated.items[key]
+	return ok && element.Value.(*item).value.(uint64) > generation
+}
+
+// removeFile removes the file of an item of the disk cache.
+func (cache *Cache) removeFile(item *item) {
+	if err := os.Remove(item.value.(*diskEntry).path); err != nil && !os.IsNotExist(err) {
+		cache.log.Debug("unable to remove cached blob", zap.Error(err))
+	}
+}
+
+// cacheKey returns the key of the blob in the cache, which is also used as its
+// file name in the disk cache.
+func cacheKey(ref storage.BlobRef) string {
+	return hex.EncodeToString(ref.Namespace) + "-" + hex.EncodeToString(ref.Key)
+}
+
+// memoryReader reads a blob cached in memory.
+type memoryReader struct {
+	*bytes.Reader
+	format storage.FormatVersion
+}
+
+func newMemoryReader(data []byte, format storage.FormatVersion) *memoryReader {
+	return &memoryReader{Reader: bytes.NewReader(data), format: format}
+}
+
+// Close does nothing.
+func (reader *memoryReader) Close() error { return nil }
+
+// Size returns the size of the blob.
+func (reader *memoryReader) Size() (int64, error) { return reader.Reader.Size(), nil }
+
+// StorageFormatVersion returns the storage format version of the blob.
+func (reader *memoryReader) StorageFormatVersion() storage.FormatVersion { return reader.format }
+
+// diskReader reads a blob cached on disk.
+type diskReader struct {
+	*os.File
+	size   int64
+	format storage.FormatVersion
+}
+
+// Size returns the size of the blob.
+func (reader *diskReader) Size() (int64, error) { return reader.size, nil }
+
+// StorageFormatVersion returns the storage format version of the blob.
+func (reader *diskReader) StorageFormatVersion() storage.FormatVersion { return reader.format }
@@ -0,0 +1,224 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package readcache_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storage/readcache"
+)
+
+func TestCache(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+	blobs, err := filestore.NewAt(log, ctx.Dir("blobs"))
+	require.NoError(t, err)
+	defer ctx.Check(blobs.Close)
+
+	cache, err := readcache.New(log, blobs, readcache.Config{
+		MemorySize:   memory.KiB,
+		Dir:          ctx.Dir("cache"),
+		DirSize:      memory.KiB,
+		MaxPieceSize: memory.KiB,
+		MinReads:     2,
+	})
+	require.NoError(t, err)
+	defer ctx.Check(cache.Close)
+
+	write := func(size memory.Size) (storage.BlobRef, []byte) {
+		ref := storage.BlobRef{Namespace: testrand.NodeID().Bytes(), Key: testrand.PieceID().Bytes()}
+		data := testrand.BytesInt(size.Int())
+		writer, err := cache.Create(ctx, ref, int64(len(data)))
+		require.NoError(t, err)
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+		return ref, data
+	}
+	read := func(ref storage.BlobRef) ([]byte, error) {
+		reader, err := cache.Open(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		defer ctx.Check(reader.Close)
+		return ioutil.ReadAll(reader)
+	}
+
+	first, firstData := write(memory.KiB)
+	second, secondData := write(memory.KiB)
+	large, largeData := write(2 * memory.KiB)
+
+	// the blobs are cached on their second read
+	for _, ref := range []storage.BlobRef{first, first, large, large} {
+		_, err := read(ref)
+		require.NoError(t, err)
+	}
+	stats := cache.Stats()
+	assert.Zero(t, stats.Hits)
+	assert.EqualValues(t, 4, stats.Misses)
+	assert.Equal(t, memory.KiB.Int64(), stats.MemoryUsed)
+
+	data, err := read(first)
+	require.NoError(t, err)
+	assert.Equal(t, firstData, data)
+	data, err = read(large)
+	require.NoError(t, err)
+	assert.Equal(t, largeData, data)
+	assert.EqualValues(t, 1, cache.Stats().Hits)
+
+	// the first blob is moved to disk to make room for the second one
+	for i := 0; i < 3; i++ {
+		data, err = read(second)
+		require.NoError(t, err)
+		assert.Equal(t, secondData, data)
+	}
+	data, err = read(first)
+	require.NoError(t, err)
+	assert.Equal(t, firstData, data)
+	stats = cache.Stats()
+	assert.EqualValues(t, 3, stats.Hits)
+	assert.Equal(t, memory.KiB.Int64(), stats.MemoryUsed)
+	assert.Equal(t, memory.KiB.Int64(), stats.DiskUsed)
+
+	// deleted and trashed blobs aren't served from the cache
+	require.NoError(t, cache.Delete(ctx, first))
+	_, err = read(first)
+	assert.True(t, os.IsNotExist(err))
+
+	require.NoError(t, cache.Trash(ctx, second))
+	_, err = read(second)
+	assert.True(t, os.IsNotExist(err))
+
+	stats = cache.Stats()
+	assert.Zero(t, stats.MemoryUsed)
+	assert.Zero(t, stats.DiskUsed)
+}
+
+func TestCacheOpenUncached(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+	blobs, err := filestore.NewAt(log, ctx.Dir("blobs"))
+	require.NoError(t, err)
+	defer ctx.Check(blobs.Close)
+
+	cache, err := readcache.New(log, blobs, readcache.Config{
+		MemorySize:   memory.KiB,
+		MaxPieceSize: memory.KiB,
+		MinReads:     2,
+	})
+	require.NoError(t, err)
+	defer ctx.Check(cache.Close)
+
+	ref := storage.BlobRef{Namespace: testrand.NodeID().Bytes(), Key: testrand.PieceID().Bytes()}
+	write := func(blobs storage.Blobs, data []byte) {
+		writer, err := blobs.Create(ctx, ref, int64(len(data)))
+		require.NoError(t, err)
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+	}
+	read := func(open func(context.Context, storage.BlobRef) (storage.BlobReader, error)) []byte {
+		reader, err := open(ctx, ref)
+		require.NoError(t, err)
+		defer ctx.Check(reader.Close)
+		data, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		return data
+	}
+
+	data := testrand.BytesInt(memory.KiB.Int())
+	write(cache, data)
+
+	// uncached reads don't count toward adding the blob to the cache
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, data, read(cache.OpenUncached))
+	}
+	stats := cache.Stats()
+	assert.Zero(t, stats.Misses)
+	assert.Zero(t, stats.MemoryUsed)
+
+	read(cache.Open)
+	read(cache.Open)
+	assert.Equal(t, memory.KiB.Int64(), cache.Stats().MemoryUsed)
+
+	// the blob on disk is read even when a copy is cached
+	changed := testrand.BytesInt(memory.KiB.Int())
+	write(blobs, changed)
+	assert.Equal(t, changed, read(cache.OpenUncached))
+	assert.Equal(t, data, read(cache.Open))
+}
+
+// hookedBlobs calls opened after a blob was opened.
+type hookedBlobs struct {
+	storage.Blobs
+	opened func()
+}
+
+func (blobs *hookedBlobs) Open(ctx context.Context, ref storage.BlobRef) (storage.BlobReader, error) {
+	reader, err := blobs.Blobs.Open(ctx, ref)
+	if err == nil && blobs.opened != nil {
+		blobs.opened()
+	}
+	return reader, err
+}
+
+func TestCacheDeleteDuringMiss(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+	store, err := filestore.NewAt(log, ctx.Dir("blobs"))
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+	blobs := &hookedBlobs{Blobs: store}
+
+	cache, err := readcache.New(log, blobs, readcache.Config{
+		MemorySize:   memory.KiB,
+		MaxPieceSize: memory.KiB,
+		MinReads:     1,
+	})
+	require.NoError(t, err)
+	defer ctx.Check(cache.Close)
+
+	ref := storage.BlobRef{Namespace: testrand.NodeID().Bytes(), Key: testrand.PieceID().Bytes()}
+	data := testrand.BytesInt(memory.KiB.Int())
+	writer, err := cache.Create(ctx, ref, int64(len(data)))
+	require.NoError(t, err)
+	_, err = writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+
+	// the blob is deleted after it was opened, but before it's admitted
+	blobs.opened = func() {
+		blobs.opened = nil
+		require.NoError(t, cache.Delete(ctx, ref))
+	}
+	reader, err := cache.Open(ctx, ref)
+	require.NoError(t, err)
+	got, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, data, got)
+	require.NoError(t, reader.Close())
+
+	// the deleted blob wasn't added to the cache
+	assert.Zero(t, cache.Stats().MemoryUsed)
+	_, err = cache.Open(ctx, ref)
+	require.True(t, os.IsNotExist(err), "%v", err)
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package readcache
+
+import (
+	"container/list"
+)
+
+// lru is a least recently used list of items, which is limited by the total
+// size of its items.
+type lru struct {
+	capacity int64
+	size     int64
+	order    *list.List
+	items    map[string]*list.Element
+}
+
+// item is a single item of an lru.
+type item struct {
+	key   string
+	size  int64
+	value interface{}
+}
+
+func newLRU(capacity int64) *lru {
+	return &lru{
+		capacity: capacity,
+		order:    list.New(),
+		items:    map[string]*list.Element{},
+	}
+}
+
+// get returns the item with the key and marks it as the most recently used.
+func (lru *lru) get(key string) (*item, bool) {
+	element, ok := lru.items[key]
+	if !ok {
+		return nil, false
+	}
+	lru.order.MoveToFront(element)
+	return element.Value.(*item), true
+}
+
+// add adds an item, replacing any item with the same key, and returns the
+// least recently used items which were evicted to make room for it. Items
+// larger than the capacity are not added.
+func (lru *lru) add(key string, size int64, value interface{}) (evicted []*item) {
+	lru.remove(key)
+	if size > lru.capacity {
+		return nil
+	}
+
+	for lru.size+size > lru.capacity {
+		oldest := lru.order.Back()
+		evicted = append(evicted, lru.removeElement(oldest))
+	}
+
+	lru.items[key] = lru.order.PushFront(&item{key: key, size: size, value: value})
+	lru.size += size
+	return evicted
+}
+
+// remove removes the item with the key and returns it.
+func (lru *lru) remove(key string) (*item, bool) {
+	element, ok := lru.items[key]
+	if !ok {
+		return nil, false
+	}
+	return lru.removeElement(element), true
+}
+
+func (lru *lru) removeElement(element *list.Element) *item {
+	item := lru.order.Remove(element).(*item)
+	delete(lru.items, item.key)
+	lru.size -= item.size
+	return item
+}
@@ -6,6 +6,7 @@ package collector
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -20,7 +21,8 @@ var mon = monkit.Package()
 
 // Config defines parameters for storage node Collector.
 type Config struct {
-	Interval time.Duration `help:"how frequently expired pieces are collected" default:"1h0m0s"`
+	Interval          time.Duration `help:"how frequently expired pieces are collected" default:"1h0m0s"`
+	DeleteConcurrency int           `help:"how many expired pieces are deleted in parallel" default:"4"`
 }
 
 // Service implements collecting expired pieces on the storage node.
@@ -29,17 +31,25 @@ type Config struct {
 type Service struct {
 	log         *zap.Logger
 	pieces      *pieces.Store
+	expirations *pieces.ExpirationBuckets
 	usedSerials piecestore.UsedSerials
+	config      Config
 
 	Loop *sync2.Cycle
 }
 
-// NewService creates a new collector service.
-func NewService(log *zap.Logger, pieces *pieces.Store, usedSerials piecestore.UsedSerials, config Config) *Service {
+// NewService creates a new collector service. Expirations in buckets are only
+// collected when expirations is not nil.
+func NewService(log *zap.Logger, pieces *pieces.Store, expirations *pieces.ExpirationBuckets, usedSerials piecestore.UsedSerials, config Config) *Service {
+	if config.DeleteConcurrency <= 0 {
+		config.DeleteConcurrency = 1
+	}
 	return &Service{
 		log:         log,
 		pieces:      pieces,
+		expirations: expirations,
 		usedSerials: usedSerials,
+		config:      config,
 		Loop:        sync2.NewCycle(config.Interval),
 	}
 }
@@ -90,24 +100,117 @@ func (service *Service) Collect(ctx context.Context, now time.Time) (err error)
 			return err
 		}
 		if len(infos) == 0 {
-			return nil
+			break
+		}
+
+		deleted, failed := service.deleteExpired(ctx, infos)
+		count += deleted
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		for _, expired := range failed {
+			errfailed := service.pieces.DeleteFailed(ctx, expired, now)
+			if errfailed != nil {
+				service.log.Error("unable to update piece info", zap.Stringer("Satellite ID", expired.SatelliteID), zap.Stringer("Piece ID", expired.PieceID), zap.Error(errfailed))
+			}
+		}
+	}
+
+	if service.expirations == nil {
+		return nil
+	}
+
+	hours, err := service.expirations.Buckets(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	// pendingBytes are the bytes of expired pieces, which are left for the next
+	// collection, because deleting them failed or too many pieces expired.
+	var pendingBytes int64
+	defer func() { mon.IntVal("expired_pending_bytes").Observe(pendingBytes) }()
+
+	budget := maxBatches * batchSize
+	for _, hour := range hours {
+		// no expirations are added to the bucket while it's collected, since
+		// they would be lost when it's replaced
+		err := service.expirations.CollectBucket(ctx, hour, func(infos []pieces.ExpiredInfo) ([]pieces.ExpiredInfo, error) {
+			// a bucket larger than the budget is collected in chunks, the rest of
+			// the bucket is kept in front of the failed pieces, so that the next
+			// collection continues where this one stopped
+			chunk := infos
+			if len(chunk) > budget {
+				chunk = chunk[:budget]
+			}
+			unprocessed := infos[len(chunk):]
+			budget -= len(chunk)
+			if len(chunk) == 0 {
+				for _, info := range unprocessed {
+					pendingBytes += info.PieceSize
+				}
+				return infos, nil
+			}
+
+			var failed []pieces.ExpiredInfo
+			for len(chunk) > 0 {
+				batch := chunk
+				if len(batch) > batchSize {
+					batch = batch[:batchSize]
+				}
+				chunk = chunk[len(batch):]
+
+				deleted, batchFailed := service.deleteExpired(ctx, batch)
+				count += deleted
+				failed = append(failed, batchFailed...)
+			}
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			remaining := append(append([]pieces.ExpiredInfo{}, unprocessed...), failed...)
+			for _, info := range remaining {
+				pendingBytes += info.PieceSize
+			}
+			return remaining, nil
+		})
+		if err != nil {
+			return err
 		}
+	}
 
-		for _, expired := range infos {
+	return nil
+}
+
+// deleteExpired deletes the expired pieces in parallel and returns how many were
+// deleted and the pieces whose deletion failed.
+func (service *Service) deleteExpired(ctx context.Context, infos []pieces.ExpiredInfo) (deleted int64, failed []pieces.ExpiredInfo) {
+	defer mon.Task()(&ctx)(nil)
+
+	var mu sync.Mutex
+	limiter := sync2.NewLimiter(service.config.DeleteConcurrency)
+	for _, expired := range infos {
+		expired := expired
+		started := limiter.Go(ctx, func() {
 			err := service.pieces.Delete(ctx, expired.SatelliteID, expired.PieceID)
+
+			mu.Lock()
+			defer mu.Unlock()
 			if err != nil {
-				errfailed := service.pieces.DeleteFailed(ctx, expired, now)
-				if errfailed != nil {
-					service.log.Error("unable to update piece info", zap.Stringer("Satellite ID", expired.SatelliteID), zap.Stringer("Piece ID", expired.PieceID), zap.Error(errfailed))
-				}
 				service.log.Error("unable to delete piece", zap.Stringer("Satellite ID", expired.SatelliteID), zap.Stringer("Piece ID", expired.PieceID), zap.Error(err))
-				continue
+				failed = append(failed, expired)
+				return
 			}
 			service.log.Info("delete expired", zap.Stringer("Satellite ID", expired.SatelliteID), zap.Stringer("Piece ID", expired.PieceID))
-
-			count++
+			deleted++
+		})
+		if !started {
+			mu.Lock()
+			failed = append(failed, expired)
+			mu.Unlock()
 		}
 	}
+	limiter.Wait()
 
-	return nil
+	mon.IntVal("expired_deleted").Observe(deleted)
+	return deleted, failed
 }
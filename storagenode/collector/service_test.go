@@ -7,13 +7,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/memory"
+	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/collector"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
 )
 
 func TestCollector(t *testing.T) {
@@ -118,3 +125,64 @@ func TestCollector(t *testing.T) {
 		require.Equal(t, 0, serialsPresent)
 	})
 }
+
+func TestCollectExpirationBuckets(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		expirations, err := pieces.NewExpirationBuckets(log, ctx.Dir("expirations"), db.PieceExpirationDB())
+		require.NoError(t, err)
+		store := pieces.NewStore(log, db.Pieces(), db.V0PieceInfo(), expirations, db.PieceSpaceUsedDB(), db.PieceIndex())
+		service := collector.NewService(log, store, expirations, db.UsedSerials(), collector.Config{
+			Interval:          time.Hour,
+			DeleteConcurrency: 3,
+		})
+
+		satellite := testrand.NodeID()
+		now := time.Now()
+
+		writePiece := func(expiresAt time.Time) storj.PieceID {
+			pieceID := testrand.PieceID()
+			writer, err := store.Writer(ctx, satellite, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+			require.NoError(t, store.SetExpiration(ctx, satellite, pieceID, expiresAt, writer.Size()))
+			return pieceID
+		}
+
+		var expired []storj.PieceID
+		for i := 0; i < 10; i++ {
+			expired = append(expired, writePiece(now.Add(-time.Duration(i)*time.Hour-2*time.Hour)))
+		}
+		kept := writePiece(now.Add(24 * time.Hour))
+
+		// the piece was deleted before it expired
+		deleted := writePiece(now.Add(-3 * time.Hour))
+		require.NoError(t, store.Delete(ctx, satellite, deleted))
+
+		require.NoError(t, service.Collect(ctx, now))
+
+		exists := func(pieceID storj.PieceID) bool {
+			reader, err := store.Reader(ctx, satellite, pieceID)
+			if err != nil {
+				return false
+			}
+			require.NoError(t, reader.Close())
+			return true
+		}
+		for _, pieceID := range expired {
+			assert.False(t, exists(pieceID))
+		}
+		assert.True(t, exists(kept))
+
+		// collected buckets are dropped
+		hours, err := expirations.Buckets(ctx, now)
+		require.NoError(t, err)
+		assert.Empty(t, hours)
+
+		hours, err = expirations.Buckets(ctx, now.Add(48*time.Hour))
+		require.NoError(t, err)
+		assert.Len(t, hours, 1)
+	})
+}
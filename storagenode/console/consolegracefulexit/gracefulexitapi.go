@@ -0,0 +1,286 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consolegracefulexit
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/gracefulexit"
+)
+
+const (
+	contentType = "Content-Type"
+
+	applicationJSON = "application/json"
+)
+
+var mon = monkit.Package()
+
+// Error is error type of storagenode web console.
+var Error = errs.Class("graceful exit console web error")
+
+// GracefulExit represents graceful exit api controller.
+//
+// architecture: Endpoint
+type GracefulExit struct {
+	service *gracefulexit.Service
+
+	log *zap.Logger
+}
+
+// jsonOutput defines json structure of api response data.
+type jsonOutput struct {
+	Data  interface{} `json:"data"`
+	Error string      `json:"error"`
+}
+
+// startRequest is the request to start a graceful exit. Confirmed must be set
+// after the operator confirmed the graceful exit, since it can't be undone.
+type startRequest struct {
+	SatelliteID storj.NodeID `json:"satelliteId"`
+	Confirmed   bool         `json:"confirmed"`
+}
+
+// pauseResponse is the response to pausing a graceful exit.
+type pauseResponse struct {
+	// RemainingSeconds is how long the node may still pause, before the
+	// satellite counts the time as inactive.
+	RemainingSeconds int64 `json:"remainingSeconds"`
+}
+
+// partialRequest is the request to start a partial exit.
+type partialRequest struct {
+	Bytes int64 `json:"bytes"`
+}
+
+// NewGracefulExit creates new instance of graceful exit api controller.
+func NewGracefulExit(log *zap.Logger, service *gracefulexit.Service) *GracefulExit {
+	return &GracefulExit{
+		log:     log,
+		service: service,
+	}
+}
+
+// Satellites returns the satellites the node can start a graceful exit from.
+func (controller *GracefulExit) Satellites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(nil)
+
+	satellites, err := controller.service.NonExitingSatellites(ctx)
+	if err != nil {
+		controller.writeError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	controller.writeData(w, satellites)
+}
+
+// Progress returns the status of the graceful exits from each satellite.
+func (controller *GracefulExit) Progress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(nil)
+
+	statuses, err := controller.service.ExitStatuses(ctx)
+	if err != nil {
+		controller.writeError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	controller.writeData(w, statuses)
+}
+
+// Start starts a graceful exit from a satellite.
+func (controller *GracefulExit) Start(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(nil)
+
+	var request startRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		controller.writeError(w, http.StatusBadRequest, Error.Wrap(err))
+		return
+	}
+	if !request.Confirmed {
+		controller.writeError(w, http.StatusBadRequest, Error.New("graceful exit must be confirmed"))
+		return
+	}
+
+	err := controller.service.StartExit(ctx, request.SatelliteID)
+	if err != nil {
+		controller.writeServiceError(w, err)
+		return
+	}
+
+	controller.writeData(w, nil)
+}
+
+// Pause pauses the graceful exit from a satellite.
+func (controller *GracefulExit) Pause(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(nil)
+
+	satelliteID, err := storj.NodeIDFromString(mux.Vars(r)["id"])
+	if err != nil {
+		controller.writeError(w, http.StatusBadRequest, Error.Wrap(err))
+		return
+	}
+
+	remaining, err := controller.service.PauseExit(ctx, satelliteID)
+	if err != nil {
+		controller.writeServiceError(w, err)
+		return
+	}
+
+	controller.writeData(w, pauseResponse{RemainingSeconds: int64(remaining.Seconds())})
+}
+
+// Resume resumes the paused graceful exit from a satellite.
+func (controller *GracefulExit) Resume(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(nil)
+
+	satelliteID, err := storj.NodeIDFromString(mux.Vars(r)["id"])
+	if err != nil {
+		controller.writeError(w, http.StatusBadRequest, Error.Wrap(err))
+		return
+	}
+
+	if err := controller.service.ResumeExit(ctx, satelliteID); err != nil {
+		controller.writeServiceError(w, err)
+		return
+	}
+
+	controller.writeData(w, nil)
+}
+
+// PartialExits returns the status of the partial exits from each satellite.
+func (controller *GracefulExit) PartialExits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(nil)
+
+	statuses, err := controller.service.PartialExits(ctx)
+	if err != nil {
+		controller.writeError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	controller.writeData(w, statuses)
+}
+
+// StartPartial starts moving some of the data of a satellite to other nodes.
+func (controller *GracefulExit) StartPartial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(nil)
+
+	satelliteID, err := storj.NodeIDFromString(mux.Vars(r)["id"])
+	if err != nil {
+		controller.writeError(w, http.StatusBadRequest, Error.Wrap(err))
+		return
+	}
+
+	var request partialRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		controller.writeError(w, http.StatusBadRequest, Error.Wrap(err))
+		return
+	}
+
+	if err := controller.service.StartPartialExit(ctx, satelliteID, request.Bytes); err != nil {
+		controller.writeServiceError(w, err)
+		return
+	}
+
+	controller.writeData(w, nil)
+}
+
+// CancelPartial cancels the partial exit from a satellite.
+func (controller *GracefulExit) CancelPartial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(nil)
+
+	satelliteID, err := storj.NodeIDFromString(mux.Vars(r)["id"])
+	if err != nil {
+		controller.writeError(w, http.StatusBadRequest, Error.Wrap(err))
+		return
+	}
+
+	if err := controller.service.CancelPartialExit(ctx, satelliteID); err != nil {
+		controller.writeServiceError(w, err)
+		return
+	}
+
+	controller.writeData(w, nil)
+}
+
+// Receipt downloads the completion receipt signed by the satellite.
+func (controller *GracefulExit) Receipt(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(nil)
+
+	satelliteID, err := storj.NodeIDFromString(mux.Vars(r)["id"])
+	if err != nil {
+		controller.writeError(w, http.StatusBadRequest, Error.Wrap(err))
+		return
+	}
+
+	receipt, err := controller.service.Receipt(ctx, satelliteID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if gracefulexit.ErrNoReceipt.Has(err) {
+			status = http.StatusNotFound
+		}
+		controller.writeError(w, status, Error.Wrap(err))
+		return
+	}
+
+	w.Header().Set(contentType, "application/octet-stream")
+	w.Header().Set("Content-Disposition", `attachment; filename="graceful-exit-receipt-`+satelliteID.String()+`.pb"`)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(receipt); err != nil {
+		controller.log.Debug("failed to write receipt", zap.Error(err))
+	}
+}
+
+// writeData is helper method to write JSON to http.ResponseWriter and log encoding error.
+func (controller *GracefulExit) writeData(w http.ResponseWriter, data interface{}) {
+	w.Header().Set(contentType, applicationJSON)
+	w.WriteHeader(http.StatusOK)
+
+	output := jsonOutput{Data: data}
+
+	if err := json.NewEncoder(w).Encode(output); err != nil {
+		controller.log.Error("json encoder error", zap.Error(err))
+	}
+}
+
+// writeServiceError writes the error of a graceful exit which isn't allowed as
+// a bad request and any other error as a server error.
+func (controller *GracefulExit) writeServiceError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	if gracefulexit.ErrNotAllowed.Has(err) {
+		status = http.StatusBadRequest
+	}
+	controller.writeError(w, status, Error.Wrap(err))
+}
+
+// writeError writes a JSON error payload to http.ResponseWriter log encoding error.
+func (controller *GracefulExit) writeError(w http.ResponseWriter, status int, err error) {
+	if status >= http.StatusInternalServerError {
+		controller.log.Error("api handler server error", zap.Int("status code", status), zap.Error(err))
+	}
+
+	w.Header().Set(contentType, applicationJSON)
+	w.WriteHeader(status)
+
+	output := jsonOutput{Error: err.Error()}
+
+	if err := json.NewEncoder(w).Encode(output); err != nil {
+		controller.log.Error("json encoder error", zap.Error(err))
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consolepayouts
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/payouts"
+)
+
+const (
+	contentType = "Content-Type"
+
+	applicationJSON = "application/json"
+)
+
+var mon = monkit.Package()
+
+// Error is error type of storagenode web console.
+var Error = errs.Class("payouts console web error")
+
+// Payouts represents payouts api controller.
+//
+// architecture: Endpoint
+type Payouts struct {
+	service *payouts.Service
+
+	log *zap.Logger
+}
+
+// jsonOutput defines json structure of api response data.
+type jsonOutput struct {
+	Data  interface{} `json:"data"`
+	Error string      `json:"error"`
+}
+
+// NewPayouts creates new instance of payouts api controller.
+func NewPayouts(log *zap.Logger, service *payouts.Service) *Payouts {
+	return &Payouts{
+		log:     log,
+		service: service,
+	}
+}
+
+// Statements returns payout statements of a satellite, or of all satellites when satelliteId is not set.
+func (controller *Payouts) Statements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(nil)
+
+	satelliteID, ok, err := satelliteIDFromQuery(r)
+	if err != nil {
+		controller.writeError(w, http.StatusBadRequest, Error.Wrap(err))
+		return
+	}
+
+	var statements []payouts.Statement
+	if ok {
+		statements, err = controller.service.Statements(ctx, satelliteID)
+	} else {
+		statements, err = controller.service.AllStatements(ctx)
+	}
+	if err != nil {
+		controller.writeError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	controller.writeData(w, statements)
+}
+
+// HeldHistory returns held amount history of all satellites.
+func (controller *Payouts) HeldHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(nil)
+
+	history, err := controller.service.HeldHistory(ctx)
+	if err != nil {
+		controller.writeError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	controller.writeData(w, history)
+}
+
+// EstimatedPayouts returns estimated payouts of the current month for a satellite,
+// or for all satellites when satelliteId is not set.
+func (controller *Payouts) EstimatedPayouts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(nil)
+
+	satelliteID, ok, err := satelliteIDFromQuery(r)
+	if err != nil {
+		controller.writeError(w, http.StatusBadRequest, Error.Wrap(err))
+		return
+	}
+
+	now := time.Now()
+	if ok {
+		estimate, err := controller.service.EstimatedPayout(ctx, satelliteID, now)
+		if err != nil {
+			controller.writeError(w, http.StatusInternalServerError, Error.Wrap(err))
+			return
+		}
+		controller.writeData(w, []payouts.Estimate{estimate})
+		return
+	}
+
+	estimates, err := controller.service.EstimatedPayouts(ctx, now)
+	if err != nil {
+		controller.writeError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	controller.writeData(w, estimates)
+}
+
+// satelliteIDFromQuery parses the optional satelliteId query parameter.
+func satelliteIDFromQuery(r *http.Request) (_ storj.NodeID, ok bool, err error) {
+	id := r.URL.Query().Get("satelliteId")
+	if id == "" {
+		return storj.NodeID{}, false, nil
+	}
+
+	satelliteID, err := storj.NodeIDFromString(id)
+	if err != nil {
+		return storj.NodeID{}, false, err
+	}
+	return satelliteID, true, nil
+}
+
+// writeData is helper method to write JSON to http.ResponseWriter and log encoding error.
+func (controller *Payouts) writeData(w http.ResponseWriter, data interface{}) {
+	w.Header().Set(contentType, applicationJSON)
+	w.WriteHeader(http.StatusOK)
+
+	output := jsonOutput{Data: data}
+
+	if err := json.NewEncoder(w).Encode(output); err != nil {
+		controller.log.Error("json encoder error", zap.Error(err))
+	}
+}
+
+// writeError writes a JSON error payload to http.ResponseWriter log encoding error.
+func (controller *Payouts) writeError(w http.ResponseWriter, status int, err error) {
+	if status >= http.StatusInternalServerError {
+		controller.log.Error("api handler server error", zap.Int("status code", status), zap.Error(err))
+	}
+
+	w.Header().Set(contentType, applicationJSON)
+	w.WriteHeader(status)
+
+	output := jsonOutput{Error: err.Error()}
+
+	if err := json.NewEncoder(w).Encode(output); err != nil {
+		controller.log.Error("json encoder error", zap.Error(err))
+	}
+}
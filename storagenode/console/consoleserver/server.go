@@ -6,8 +6,10 @@ package consoleserver
 import (
 	"context"
 	"encoding/json"
+	"mime"
 	"net"
 	"net/http"
+	"net/url"
 	"path/filepath"
 
 	"github.com/gorilla/mux"
@@ -18,8 +20,16 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/storj/storagenode/console"
+	"storj.io/storj/storagenode/console/consolegracefulexit"
 	"storj.io/storj/storagenode/console/consolenotifications"
+	"storj.io/storj/storagenode/console/consolepayouts"
+	"storj.io/storj/storagenode/gracefulexit"
+	"storj.io/storj/storagenode/metrics"
 	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/payouts"
+	"storj.io/storj/storagenode/scrubber"
+	"storj.io/storj/storagenode/trust"
+	"storj.io/storj/storagenode/untrusted"
 )
 
 const (
@@ -48,24 +58,42 @@ type Server struct {
 
 	service       *console.Service
 	notifications *notifications.Service
+	payouts       *payouts.Service
+	scrubber      *scrubber.Service
+	gracefulExit  *gracefulexit.Service
+	untrusted     *untrusted.Service
+	metrics       *metrics.Service
 	listener      net.Listener
 
 	server http.Server
 }
 
 // NewServer creates new instance of storagenode console web server.
-func NewServer(logger *zap.Logger, assets http.FileSystem, notifications *notifications.Service, service *console.Service, listener net.Listener) *Server {
+func NewServer(logger *zap.Logger, assets http.FileSystem, notifications *notifications.Service, payouts *payouts.Service, scrubber *scrubber.Service, gracefulExit *gracefulexit.Service, untrusted *untrusted.Service, metrics *metrics.Service, service *console.Service, listener net.Listener) *Server {
 	server := Server{
 		log:           logger,
 		service:       service,
 		listener:      listener,
 		notifications: notifications,
+		payouts:       payouts,
+		scrubber:      scrubber,
+		gracefulExit:  gracefulExit,
+		untrusted:     untrusted,
+		metrics:       metrics,
 	}
 
 	router := mux.NewRouter()
 	apiRouter := router.PathPrefix("/api").Subrouter()
 	notificationRouter := router.PathPrefix("/api/notifications").Subrouter()
 	notificationController := consolenotifications.NewNotifications(server.log, server.notifications)
+	payoutsRouter := router.PathPrefix("/api/payouts").Subrouter()
+	payoutsController := consolepayouts.NewPayouts(server.log, server.payouts)
+	gracefulExitRouter := router.PathPrefix("/api/graceful-exit").Subrouter()
+	gracefulExitController := consolegracefulexit.NewGracefulExit(server.log, server.gracefulExit)
+
+	if server.metrics != nil {
+		router.Handle("/metrics", server.metrics).Methods(http.MethodGet)
+	}
 
 	if assets != nil {
 		fs := http.FileServer(assets)
@@ -81,9 +109,25 @@ func NewServer(logger *zap.Logger, assets http.FileSystem, notifications *notifi
 	apiRouter.Handle("/dashboard", http.HandlerFunc(server.dashboardHandler)).Methods(http.MethodGet)
 	apiRouter.Handle("/satellites", http.HandlerFunc(server.satellitesHandler)).Methods(http.MethodGet)
 	apiRouter.Handle("/satellite/{id}", http.HandlerFunc(server.satelliteHandler)).Methods(http.MethodGet)
+	apiRouter.Handle("/scrubber", http.HandlerFunc(server.scrubberHandler)).Methods(http.MethodGet)
+	apiRouter.Handle("/untrusted", http.HandlerFunc(server.untrustedHandler)).Methods(http.MethodGet)
+	apiRouter.Handle("/trust/pending", http.HandlerFunc(server.pendingSatellitesHandler)).Methods(http.MethodGet)
+	apiRouter.Handle("/trust/{id}/accept", server.sameOriginMiddleware(http.HandlerFunc(server.acceptSatelliteHandler))).Methods(http.MethodPost)
 	notificationRouter.Handle("/list", http.HandlerFunc(notificationController.ListNotifications)).Methods(http.MethodGet)
 	notificationRouter.Handle("/{id}/read", http.HandlerFunc(notificationController.ReadNotification)).Methods(http.MethodPost)
 	notificationRouter.Handle("/readall", http.HandlerFunc(notificationController.ReadAllNotifications)).Methods(http.MethodPost)
+	payoutsRouter.Handle("/statements", http.HandlerFunc(payoutsController.Statements)).Methods(http.MethodGet)
+	payoutsRouter.Handle("/held-history", http.HandlerFunc(payoutsController.HeldHistory)).Methods(http.MethodGet)
+	payoutsRouter.Handle("/estimated", http.HandlerFunc(payoutsController.EstimatedPayouts)).Methods(http.MethodGet)
+	gracefulExitRouter.Handle("/satellites", http.HandlerFunc(gracefulExitController.Satellites)).Methods(http.MethodGet)
+	gracefulExitRouter.Handle("/progress", http.HandlerFunc(gracefulExitController.Progress)).Methods(http.MethodGet)
+	gracefulExitRouter.Handle("/start", server.sameOriginMiddleware(http.HandlerFunc(gracefulExitController.Start))).Methods(http.MethodPost)
+	gracefulExitRouter.Handle("/{id}/pause", server.sameOriginMiddleware(http.HandlerFunc(gracefulExitController.Pause))).Methods(http.MethodPost)
+	gracefulExitRouter.Handle("/{id}/resume", server.sameOriginMiddleware(http.HandlerFunc(gracefulExitController.Resume))).Methods(http.MethodPost)
+	gracefulExitRouter.Handle("/{id}/receipt", http.HandlerFunc(gracefulExitController.Receipt)).Methods(http.MethodGet)
+	gracefulExitRouter.Handle("/partial", http.HandlerFunc(gracefulExitController.PartialExits)).Methods(http.MethodGet)
+	gracefulExitRouter.Handle("/{id}/partial", server.sameOriginMiddleware(http.HandlerFunc(gracefulExitController.StartPartial))).Methods(http.MethodPost)
+	gracefulExitRouter.Handle("/{id}/partial/cancel", server.sameOriginMiddleware(http.HandlerFunc(gracefulExitController.CancelPartial))).Methods(http.MethodPost)
 
 	server.server = http.Server{
 		Handler: router,
@@ -153,6 +197,45 @@ func (server *Server) satellitesHandler(w http.ResponseWriter, r *http.Request)
 	server.writeData(w, data)
 }
 
+// pendingSatellitesHandler lists satellites awaiting operator acceptance.
+func (server *Server) pendingSatellitesHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := server.service.PendingSatellites(ctx)
+	if err != nil {
+		server.writeError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	server.writeData(w, data)
+}
+
+// acceptSatelliteHandler accepts a pending satellite into the trust pool.
+func (server *Server) acceptSatelliteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	satelliteID, err := storj.NodeIDFromString(mux.Vars(r)["id"])
+	if err != nil {
+		server.writeError(w, http.StatusBadRequest, Error.Wrap(err))
+		return
+	}
+
+	if err = server.service.AcceptSatellite(ctx, satelliteID); err != nil {
+		if trust.ErrNotPending.Has(err) {
+			server.writeError(w, http.StatusNotFound, Error.Wrap(err))
+			return
+		}
+		server.writeError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	server.writeData(w, nil)
+}
+
 // satelliteHandler handles satellite API requests.
 func (server *Server) satelliteHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -186,6 +269,76 @@ func (server *Server) satelliteHandler(w http.ResponseWriter, r *http.Request) {
 	server.writeData(w, data)
 }
 
+// scrubberHandler handles piece integrity scrubber status API requests.
+func (server *Server) scrubberHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	status, err := server.scrubber.Status(ctx)
+	if err != nil {
+		server.writeError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	server.writeData(w, status)
+}
+
+// untrustedHandler handles requests for the data of satellites which are no longer trusted.
+func (server *Server) untrustedHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	satellites, err := server.untrusted.List(ctx)
+	if err != nil {
+		server.writeError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	var data struct {
+		Satellites     []untrusted.Satellite `json:"satellites"`
+		BytesReclaimed int64                 `json:"bytesReclaimed"`
+	}
+	data.Satellites = satellites
+	for _, satellite := range satellites {
+		data.BytesReclaimed += satellite.BytesReclaimed
+	}
+
+	server.writeData(w, data)
+}
+
+// sameOriginMiddleware guards endpoints, which change the state of the node,
+// against cross-site requests, since the dashboard isn't authenticated. The
+// request must have a JSON body, which browsers only send to another origin
+// after a CORS preflight the server never allows, and a browser request must
+// come from the origin of the dashboard.
+func (server *Server) sameOriginMiddleware(fn http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get(contentType))
+		if err != nil || mediaType != applicationJSON {
+			server.writeError(w, http.StatusUnsupportedMediaType, Error.New("content type must be %s", applicationJSON))
+			return
+		}
+
+		// browsers set the origin of cross-site requests, requests of other
+		// clients don't have one
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			origin = r.Header.Get("Referer")
+		}
+		if origin != "" {
+			originURL, err := url.Parse(origin)
+			if err != nil || originURL.Host != r.Host {
+				server.writeError(w, http.StatusForbidden, Error.New("cross-site request refused"))
+				return
+			}
+		}
+
+		fn.ServeHTTP(w, r)
+	})
+}
+
 // cacheMiddleware is a middleware for caching static files.
 func (server *Server) cacheMiddleware(fn http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
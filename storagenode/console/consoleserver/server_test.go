@@ -5,13 +5,17 @@ package consoleserver_test
 
 import (
 	"fmt"
+	"net"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/testcontext"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storagenode/console/consoleserver"
 )
 
 func TestConsole(t *testing.T) {
@@ -44,7 +48,114 @@ func TestConsole(t *testing.T) {
 				require.NotNil(t, req)
 				_ = req.Body.Close()
 				require.Equal(t, http.StatusOK, req.StatusCode)
+
+				req, err = http.Get(fmt.Sprintf("http://%s/api/untrusted", addr))
+				require.NoError(t, err)
+				require.NotNil(t, req)
+				_ = req.Body.Close()
+				require.Equal(t, http.StatusOK, req.StatusCode)
+
+				req, err = http.Get(fmt.Sprintf("http://%s/metrics", addr))
+				require.NoError(t, err)
+				require.NotNil(t, req)
+				_ = req.Body.Close()
+				require.Equal(t, http.StatusOK, req.StatusCode)
+				require.True(t, strings.HasPrefix(req.Header.Get("Content-Type"), "text/plain; version=0.0.4"))
+
+				req, err = http.Get(fmt.Sprintf("http://%s/api/graceful-exit/satellites", addr))
+				require.NoError(t, err)
+				require.NotNil(t, req)
+				_ = req.Body.Close()
+				require.Equal(t, http.StatusOK, req.StatusCode)
+
+				req, err = http.Get(fmt.Sprintf("http://%s/api/graceful-exit/progress", addr))
+				require.NoError(t, err)
+				require.NotNil(t, req)
+				_ = req.Body.Close()
+				require.Equal(t, http.StatusOK, req.StatusCode)
+
+				req, err = http.Get(fmt.Sprintf("http://%s/api/graceful-exit/%s/receipt", addr, satellite.ID()))
+				require.NoError(t, err)
+				require.NotNil(t, req)
+				_ = req.Body.Close()
+				require.Equal(t, http.StatusNotFound, req.StatusCode)
+
+				// starting a graceful exit must be confirmed
+				req, err = http.Post(fmt.Sprintf("http://%s/api/graceful-exit/start", addr), "application/json",
+					strings.NewReader(fmt.Sprintf(`{"satelliteId": %q}`, satellite.ID())))
+				require.NoError(t, err)
+				require.NotNil(t, req)
+				_ = req.Body.Close()
+				require.Equal(t, http.StatusBadRequest, req.StatusCode)
+
+				// cross-site requests can't change the state of the node
+				confirmed := fmt.Sprintf(`{"satelliteId": %q, "confirmed": true}`, satellite.ID())
+				req, err = http.Post(fmt.Sprintf("http://%s/api/graceful-exit/start", addr), "text/plain",
+					strings.NewReader(confirmed))
+				require.NoError(t, err)
+				_ = req.Body.Close()
+				require.Equal(t, http.StatusUnsupportedMediaType, req.StatusCode)
+
+				for _, path := range []string{
+					"/api/graceful-exit/start",
+					fmt.Sprintf("/api/graceful-exit/%s/pause", satellite.ID()),
+					fmt.Sprintf("/api/graceful-exit/%s/resume", satellite.ID()),
+					fmt.Sprintf("/api/graceful-exit/%s/partial", satellite.ID()),
+					fmt.Sprintf("/api/graceful-exit/%s/partial/cancel", satellite.ID()),
+					fmt.Sprintf("/api/trust/%s/accept", satellite.ID()),
+				} {
+					crossSite, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://%s%s", addr, path), strings.NewReader(confirmed))
+					require.NoError(t, err)
+					crossSite.Header.Set("Content-Type", "application/json")
+					crossSite.Header.Set("Origin", "http://example.test")
+
+					req, err = http.DefaultClient.Do(crossSite)
+					require.NoError(t, err)
+					_ = req.Body.Close()
+					require.Equal(t, http.StatusForbidden, req.StatusCode, path)
+				}
+
+				exits, err := planet.StorageNodes[0].DB.Satellites().ListGracefulExits(ctx)
+				require.NoError(t, err)
+				require.Empty(t, exits)
 			})
 		},
 	)
 }
+
+func TestConsoleSameOrigin(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := consoleserver.NewServer(zaptest.NewLogger(t), nil, nil, nil, nil, nil, nil, nil, nil, listener)
+	ctx.Go(func() error {
+		_ = server.Run(ctx)
+		return nil
+	})
+	defer ctx.Check(server.Close)
+
+	post := func(contentType, origin string) int {
+		// the invalid satellite ID is refused by the handler without touching the node
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://%s/api/trust/invalid/accept", listener.Addr()), strings.NewReader(`{}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+
+	require.Equal(t, http.StatusUnsupportedMediaType, post("text/plain", ""))
+	require.Equal(t, http.StatusUnsupportedMediaType, post("application/x-www-form-urlencoded", ""))
+	require.Equal(t, http.StatusForbidden, post("application/json", "http://example.test"))
+	require.Equal(t, http.StatusForbidden, post("application/json", "null"))
+
+	require.Equal(t, http.StatusBadRequest, post("application/json", ""))
+	require.Equal(t, http.StatusBadRequest, post("application/json; charset=utf-8", "http://"+listener.Addr().String()))
+}
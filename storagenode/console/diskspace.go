@@ -8,3 +8,14 @@ type DiskSpaceInfo struct {
 	Used      int64 `json:"used"`
 	Available int64 `json:"available"`
 }
+
+// LocationInfo stores the disk space usage of a single storage directory,
+// when pieces are spread across multiple directories.
+type LocationInfo struct {
+	Path      string `json:"path"`
+	Allocated int64  `json:"allocated"`
+	Used      int64  `json:"used"`
+	Available int64  `json:"available"`
+	// Error is set when the directory has failed and is not used.
+	Error string `json:"error,omitempty"`
+}
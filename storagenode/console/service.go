@@ -113,8 +113,9 @@ type Dashboard struct {
 
 	Satellites []SatelliteInfo `json:"satellites"`
 
-	DiskSpace DiskSpaceInfo `json:"diskSpace"`
-	Bandwidth BandwidthInfo `json:"bandwidth"`
+	DiskSpace DiskSpaceInfo  `json:"diskSpace"`
+	Locations []LocationInfo `json:"locations,omitempty"`
+	Bandwidth BandwidthInfo  `json:"bandwidth"`
 
 	LastPinged          time.Time    `json:"lastPinged"`
 	LastPingFromID      storj.NodeID `json:"lastPingFromID"`
@@ -175,6 +176,19 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 		Available: s.allocatedDiskSpace.Int64(),
 	}
 
+	for _, location := range s.pieceStore.Locations(ctx) {
+		info := LocationInfo{
+			Path:      location.Path,
+			Allocated: location.Allocated,
+			Used:      location.Used,
+			Available: location.Available,
+		}
+		if location.Err != nil {
+			info.Error = location.Err.Error()
+		}
+		data.Locations = append(data.Locations, info)
+	}
+
 	data.Bandwidth = BandwidthInfo{
 		Used:      bandwidthUsage,
 		Available: s.allocatedBandwidth.Int64(),
@@ -316,3 +330,23 @@ func (s *Service) VerifySatelliteID(ctx context.Context, satelliteID storj.NodeI
 
 	return nil
 }
+
+// PendingSatellites returns satellites added to the trust list that await
+// operator acceptance.
+func (s *Service) PendingSatellites(ctx context.Context) (_ []trust.Entry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.trust.PendingSatellites(ctx), nil
+}
+
+// AcceptSatellite accepts a pending satellite into the trust pool.
+func (s *Service) AcceptSatellite(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = s.trust.AcceptSatellite(ctx, satelliteID)
+	if err != nil {
+		return SNOServiceErr.Wrap(err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package contact
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/trust"
 )
 
@@ -27,7 +29,13 @@ type Chore struct {
 	service *Service
 	dialer  rpc.Dialer
 
-	trust *trust.Pool
+	trust         *trust.Pool
+	notifications *notifications.Service
+
+	offlineMu sync.Mutex
+	// offline contains the satellites the node failed to check in with
+	// during their last cycle.
+	offline map[storj.NodeID]bool
 
 	mu       sync.Mutex
 	cycles   map[storj.NodeID]*sync2.Cycle
@@ -42,13 +50,15 @@ var (
 const initialBackOff = time.Second
 
 // NewChore creates a new contact chore
-func NewChore(log *zap.Logger, interval time.Duration, trust *trust.Pool, dialer rpc.Dialer, service *Service) *Chore {
+func NewChore(log *zap.Logger, interval time.Duration, trust *trust.Pool, dialer rpc.Dialer, service *Service, notifications *notifications.Service) *Chore {
 	return &Chore{
 		log:     log,
 		service: service,
 		dialer:  dialer,
 
-		trust: trust,
+		trust:         trust,
+		notifications: notifications,
+		offline:       make(map[storj.NodeID]bool),
 
 		cycles:   make(map[storj.NodeID]*sync2.Cycle),
 		interval: interval,
@@ -124,6 +134,7 @@ func (chore *Chore) pingSatellite(ctx context.Context, satellite storj.NodeID) e
 		err := chore.pingSatelliteOnce(ctx, satellite)
 		attempts++
 		if err == nil {
+			chore.setOffline(ctx, satellite, false, nil)
 			return nil
 		}
 		chore.log.Error("ping satellite failed ", zap.Stringer("Satellite ID", satellite), zap.Int("attempts", attempts), zap.Error(err))
@@ -136,12 +147,36 @@ func (chore *Chore) pingSatellite(ctx context.Context, satellite storj.NodeID) e
 		interval *= 2
 		if interval >= chore.interval {
 			chore.log.Info("retries timed out for this cycle", zap.Stringer("Satellite ID", satellite))
+			chore.setOffline(ctx, satellite, true, err)
 			return nil
 		}
 	}
 
 }
 
+// setOffline records whether the satellite could be reached and notifies the
+// operator when it couldn't be reached for a whole cycle.
+func (chore *Chore) setOffline(ctx context.Context, satellite storj.NodeID, offline bool, err error) {
+	chore.offlineMu.Lock()
+	changed := chore.offline[satellite] != offline
+	if offline {
+		chore.offline[satellite] = true
+	} else {
+		delete(chore.offline, satellite)
+	}
+	chore.offlineMu.Unlock()
+
+	if !changed || !offline {
+		return
+	}
+	chore.notifications.Notify(ctx, notifications.NewNotification{
+		SenderID: satellite,
+		Type:     notifications.TypeSatelliteOffline,
+		Title:    "Satellite unreachable",
+		Message:  fmt.Sprintf("The node failed to check in with satellite %s: %v", satellite, err),
+	})
+}
+
 func (chore *Chore) pingSatelliteOnce(ctx context.Context, id storj.NodeID) (err error) {
 	defer mon.Task()(&ctx, id)(&err)
 
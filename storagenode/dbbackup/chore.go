@@ -0,0 +1,72 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package dbbackup implements backing up the storage node databases periodically,
+// so that corrupt databases can be restored from the backups.
+package dbbackup
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+var mon = monkit.Package()
+
+// Config defines parameters for the database backup chore.
+type Config struct {
+	Interval time.Duration `help:"how frequently the databases are backed up, 0 disables the backups" default:"24h0m0s"`
+}
+
+// DB is the database which is backed up.
+type DB interface {
+	// Backup copies the databases into the backup directory.
+	Backup(ctx context.Context) error
+}
+
+// Chore backs up the databases periodically.
+//
+// architecture: Chore
+type Chore struct {
+	log  *zap.Logger
+	db   DB
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new database backup chore.
+func NewChore(log *zap.Logger, db DB, config Config) *Chore {
+	return &Chore{
+		log:  log,
+		db:   db,
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the background process for backing up the databases.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, chore.Backup)
+}
+
+// Backup backs up the databases and logs any errors.
+func (chore *Chore) Backup(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	start := time.Now()
+	if err := chore.db.Backup(ctx); err != nil {
+		chore.log.Error("could not back up databases", zap.Error(err))
+		return nil
+	}
+	chore.log.Debug("backed up databases", zap.Duration("Duration", time.Since(start)))
+	return nil
+}
+
+// Close stops the background process for backing up the databases.
+func (chore *Chore) Close() (err error) {
+	chore.Loop.Close()
+	return nil
+}
@@ -6,12 +6,19 @@ package gracefulexit
 import (
 	"context"
 	"sync"
+	"time"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/errs2"
 	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/gracefulexitpb"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/ratelimit"
 	"storj.io/storj/storagenode/satellites"
 	"storj.io/storj/storagenode/trust"
 )
@@ -31,6 +38,12 @@ type Chore struct {
 	exitingMap sync.Map
 	Loop       *sync2.Cycle
 	limiter    *sync2.Limiter
+	// bandwidth is shared by the transfers of all workers.
+	bandwidth *ratelimit.Bucket
+
+	mu       sync.Mutex
+	progress map[storj.NodeID]*progress
+	paused   map[storj.NodeID]bool
 }
 
 // NewChore instantiates Chore.
@@ -44,6 +57,207 @@ func NewChore(log *zap.Logger, config Config, store *pieces.Store, trust *trust.
 		config:      config,
 		Loop:        sync2.NewCycle(config.ChoreInterval),
 		limiter:     sync2.NewLimiter(config.NumWorkers),
+		bandwidth:   ratelimit.NewBucket(config.MaxBytesPerSecond),
+		progress:    make(map[storj.NodeID]*progress),
+		paused:      make(map[storj.NodeID]bool),
+	}
+}
+
+// Progress returns the counters of the graceful exit from the satellite, if
+// a worker was started for it since the node was started.
+func (chore *Chore) Progress(satelliteID storj.NodeID) (_ Progress, ok bool) {
+	chore.mu.Lock()
+	defer chore.mu.Unlock()
+
+	progress, ok := chore.progress[satelliteID]
+	if !ok {
+		return Progress{}, false
+	}
+	return progress.get(), true
+}
+
+// satelliteProgress returns the progress tracker shared by the workers of the satellite.
+func (chore *Chore) satelliteProgress(satelliteID storj.NodeID) *progress {
+	chore.mu.Lock()
+	defer chore.mu.Unlock()
+
+	p, ok := chore.progress[satelliteID]
+	if !ok {
+		p = newProgress()
+		chore.progress[satelliteID] = p
+	}
+	return p
+}
+
+// Paused returns whether the graceful exit from the satellite is paused.
+func (chore *Chore) Paused(satelliteID storj.NodeID) bool {
+	chore.mu.Lock()
+	defer chore.mu.Unlock()
+	return chore.paused[satelliteID]
+}
+
+// setPaused changes whether the graceful exit from the satellite is paused.
+func (chore *Chore) setPaused(satelliteID storj.NodeID, paused bool) {
+	chore.mu.Lock()
+	defer chore.mu.Unlock()
+	if paused {
+		chore.paused[satelliteID] = true
+	} else {
+		delete(chore.paused, satelliteID)
+	}
+}
+
+// Pause stops the worker of the satellite and reports the pause to the
+// satellite. It returns how long the node may still pause, before the
+// satellite counts the time as inactive. The pause ends when the node restarts.
+func (chore *Chore) Pause(ctx context.Context, satelliteID storj.NodeID) (remaining time.Duration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	chore.setPaused(satelliteID, true)
+	if value, ok := chore.exitingMap.Load(satelliteID); ok {
+		value.(*Worker).cancel()
+	}
+
+	err = chore.dialSatellite(ctx, satelliteID, func(conn *rpc.Conn) error {
+		client := gracefulexitpb.NewDRPCGracefulExitPauseClient(conn.Raw())
+		resp, err := client.Pause(ctx, &gracefulexitpb.PauseRequest{})
+		if err != nil {
+			return err
+		}
+		remaining = time.Duration(resp.Remaining)
+		return nil
+	})
+	if err != nil {
+		chore.setPaused(satelliteID, false)
+		return 0, Error.Wrap(err)
+	}
+
+	chore.log.Info("graceful exit paused", zap.Stringer("Satellite ID", satelliteID), zap.Duration("remaining", remaining))
+	return remaining, nil
+}
+
+// Resume reports the end of the pause to the satellite and lets the chore
+// start a worker for the satellite on its next run.
+func (chore *Chore) Resume(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = chore.dialSatellite(ctx, satelliteID, func(conn *rpc.Conn) error {
+		client := gracefulexitpb.NewDRPCGracefulExitPauseClient(conn.Raw())
+		_, err := client.Resume(ctx, &gracefulexitpb.ResumeRequest{})
+		return err
+	})
+	if err != nil {
+		// the satellite ends the pause when the worker connects to it
+		chore.log.Warn("failed to report resumed graceful exit", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+	}
+
+	chore.setPaused(satelliteID, false)
+	chore.log.Info("graceful exit resumed", zap.Stringer("Satellite ID", satelliteID))
+	return nil
+}
+
+// dialSatellite dials the satellite and calls fn with the connection.
+func (chore *Chore) dialSatellite(ctx context.Context, satelliteID storj.NodeID, fn func(conn *rpc.Conn) error) (err error) {
+	addr, err := chore.trust.GetAddress(ctx, satelliteID)
+	if err != nil {
+		return err
+	}
+
+	conn, err := chore.dialer.DialAddressID(ctx, addr, satelliteID)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	return fn(conn)
+}
+
+// StartPartialExit asks the satellite to move the requested bytes of the node
+// to other nodes and records the partial exit. The chore starts a worker for it
+// once the satellite queued the pieces to transfer.
+func (chore *Chore) StartPartialExit(ctx context.Context, satelliteID storj.NodeID, requestedBytes int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = chore.dialSatellite(ctx, satelliteID, func(conn *rpc.Conn) error {
+		client := gracefulexitpb.NewDRPCPartialExitClient(conn.Raw())
+		_, err := client.StartPartialExit(ctx, &gracefulexitpb.StartPartialExitRequest{Bytes: requestedBytes})
+		return err
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = chore.satelliteDB.InitiatePartialExit(ctx, satelliteID, time.Now().UTC(), requestedBytes)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	chore.log.Info("partial exit started", zap.Stringer("Satellite ID", satelliteID), zap.Int64("bytes", requestedBytes))
+	return nil
+}
+
+// CancelPartialExit stops the worker of the partial exit from the satellite
+// and reports the cancellation to the satellite.
+func (chore *Chore) CancelPartialExit(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := chore.stopWorker(ctx, satelliteID); err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = chore.dialSatellite(ctx, satelliteID, func(conn *rpc.Conn) error {
+		client := gracefulexitpb.NewDRPCPartialExitClient(conn.Raw())
+		_, err := client.CancelPartialExit(ctx, &gracefulexitpb.CancelPartialExitRequest{})
+		return err
+	})
+	// the satellite may have finished the partial exit already
+	if err != nil && !errs2.IsRPC(err, rpcstatus.FailedPrecondition) {
+		return Error.Wrap(err)
+	}
+
+	err = chore.satelliteDB.CompletePartialExit(ctx, satelliteID, time.Now().UTC())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	chore.log.Info("partial exit canceled", zap.Stringer("Satellite ID", satelliteID))
+	return nil
+}
+
+// partialExitActive returns whether the satellite didn't finish the partial
+// exit of the node yet.
+func (chore *Chore) partialExitActive(ctx context.Context, satelliteID storj.NodeID) (active bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = chore.dialSatellite(ctx, satelliteID, func(conn *rpc.Conn) error {
+		client := gracefulexitpb.NewDRPCPartialExitClient(conn.Raw())
+		resp, err := client.GetPartialExit(ctx, &gracefulexitpb.GetPartialExitRequest{})
+		if err != nil {
+			return err
+		}
+		active = resp.FinishedAt == 0
+		return nil
+	})
+	if errs2.IsRPC(err, rpcstatus.NotFound) {
+		return false, nil
+	}
+	return active, err
+}
+
+// stopWorker cancels the worker of the satellite and waits until it stopped.
+func (chore *Chore) stopWorker(ctx context.Context, satelliteID storj.NodeID) error {
+	value, ok := chore.exitingMap.Load(satelliteID)
+	if !ok {
+		return nil
+	}
+	worker := value.(*Worker)
+	worker.cancel()
+
+	select {
+	case <-worker.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
 
@@ -60,10 +274,16 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 			return nil
 		}
 
-		if len(satellites) == 0 {
+		partialExits, err := chore.satelliteDB.ListPartialExits(ctx)
+		if err != nil {
+			chore.log.Error("error retrieving partial exits.", zap.Error(err))
 			return nil
 		}
-		chore.log.Debug("exiting", zap.Int("satellites", len(satellites)))
+
+		if len(satellites) == 0 && len(partialExits) == 0 {
+			return nil
+		}
+		chore.log.Debug("exiting", zap.Int("satellites", len(satellites)), zap.Int("partial exits", len(partialExits)))
 
 		for _, satellite := range satellites {
 			mon.Meter("satellite_gracefulexit_request").Mark(1) //locked
@@ -71,33 +291,36 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 				continue
 			}
 			satelliteID := satellite.SatelliteID
-			addr, err := chore.trust.GetAddress(ctx, satelliteID)
-			if err != nil {
-				chore.log.Error("failed to get satellite address.", zap.Error(err))
+			if chore.Paused(satelliteID) {
+				chore.log.Debug("skipping for satellite, graceful exit is paused.", zap.Stringer("Satellite ID", satelliteID))
 				continue
 			}
+			chore.startWorker(ctx, satelliteID, false)
+		}
 
-			worker := NewWorker(chore.log, chore.store, chore.satelliteDB, chore.dialer, satelliteID, addr, chore.config)
-			if _, ok := chore.exitingMap.LoadOrStore(satelliteID, worker); ok {
-				// already running a worker for this satellite
-				chore.log.Debug("skipping for satellite, worker already exists.", zap.Stringer("Satellite ID", satelliteID))
+		for _, partialExit := range partialExits {
+			if partialExit.FinishedAt != nil {
+				continue
+			}
+			satelliteID := partialExit.SatelliteID
+			if _, ok := chore.exitingMap.Load(satelliteID); ok {
 				continue
 			}
 
-			chore.limiter.Go(ctx, func() {
-				err := worker.Run(ctx, func() {
-					chore.log.Debug("finished for satellite.", zap.Stringer("Satellite ID", satelliteID))
-					chore.exitingMap.Delete(satelliteID)
-				})
-
+			// a worker of a finished partial exit would start a graceful exit
+			active, err := chore.partialExitActive(ctx, satelliteID)
+			if err != nil {
+				chore.log.Error("failed to get partial exit from satellite.", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+				continue
+			}
+			if !active {
+				err = chore.satelliteDB.CompletePartialExit(ctx, satelliteID, time.Now().UTC())
 				if err != nil {
-					chore.log.Error("worker failed", zap.Error(err))
+					chore.log.Error("failed to complete partial exit.", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
 				}
-
-				if err := worker.Close(); err != nil {
-					chore.log.Error("closing worker failed", zap.Error(err))
-				}
-			})
+				continue
+			}
+			chore.startWorker(ctx, satelliteID, true)
 		}
 		chore.limiter.Wait()
 
@@ -107,6 +330,51 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 	return err
 }
 
+// startWorker starts a worker for the graceful or partial exit from the
+// satellite, unless one is running already.
+func (chore *Chore) startWorker(ctx context.Context, satelliteID storj.NodeID, partial bool) {
+	addr, err := chore.trust.GetAddress(ctx, satelliteID)
+	if err != nil {
+		chore.log.Error("failed to get satellite address.", zap.Error(err))
+		return
+	}
+
+	workerCtx, cancel := context.WithCancel(ctx)
+	worker := NewWorker(chore.log, chore.store, chore.satelliteDB, chore.dialer, satelliteID, addr, chore.config)
+	if !partial {
+		worker.progress = chore.satelliteProgress(satelliteID)
+	}
+	worker.partial = partial
+	worker.bandwidth = chore.bandwidth
+	worker.cancel = cancel
+	if _, ok := chore.exitingMap.LoadOrStore(satelliteID, worker); ok {
+		cancel()
+		// already running a worker for this satellite
+		chore.log.Debug("skipping for satellite, worker already exists.", zap.Stringer("Satellite ID", satelliteID))
+		return
+	}
+
+	chore.limiter.Go(ctx, func() {
+		defer cancel()
+		err := worker.Run(workerCtx, func() {
+			chore.log.Debug("finished for satellite.", zap.Stringer("Satellite ID", satelliteID))
+			chore.exitingMap.Delete(satelliteID)
+		})
+
+		switch {
+		case err == nil:
+		case workerCtx.Err() != nil && ctx.Err() == nil:
+			chore.log.Info("worker stopped.", zap.Stringer("Satellite ID", satelliteID))
+		default:
+			chore.log.Error("worker failed", zap.Error(err))
+		}
+
+		if err := worker.Close(); err != nil {
+			chore.log.Error("closing worker failed", zap.Error(err))
+		}
+	})
+}
+
 // Close closes chore.
 func (chore *Chore) Close() error {
 	chore.Loop.Close()
@@ -26,4 +26,5 @@ type Config struct {
 	NumConcurrentTransfers int           `help:"number of concurrent transfers per graceful exit worker" default:"5"`
 	MinBytesPerSecond      memory.Size   `help:"the minimum acceptable bytes that an exiting node can transfer per second to the new node" default:"5KB"`
 	MinDownloadTimeout     time.Duration `help:"the minimum duration for downloading a piece from storage nodes before timing out" default:"2m"`
+	MaxBytesPerSecond      memory.Size   `help:"how many bytes per second the graceful exit transfers of all workers may use together, 0 means unlimited" default:"0"`
 }
@@ -71,3 +71,46 @@ func TestDB(t *testing.T) {
 		}
 	})
 }
+
+// TestPartialExitDB tests the partial exit database calls
+func TestPartialExitDB(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		satelliteID := testrand.NodeID()
+		start := time.Now()
+
+		exits, err := db.Satellites().ListPartialExits(ctx)
+		require.NoError(t, err)
+		require.Len(t, exits, 0)
+
+		require.NoError(t, db.Satellites().InitiatePartialExit(ctx, satelliteID, start, 5000))
+		require.NoError(t, db.Satellites().UpdatePartialExit(ctx, satelliteID, 1000))
+		require.NoError(t, db.Satellites().UpdatePartialExit(ctx, satelliteID, 1000))
+
+		exits, err = db.Satellites().ListPartialExits(ctx)
+		require.NoError(t, err)
+		require.Len(t, exits, 1)
+		require.Equal(t, satelliteID, exits[0].SatelliteID)
+		require.Equal(t, int64(5000), exits[0].RequestedBytes)
+		require.Equal(t, int64(2000), exits[0].BytesDeleted)
+		require.Equal(t, start.UTC(), exits[0].InitiatedAt)
+		require.Nil(t, exits[0].FinishedAt)
+
+		stop := time.Now()
+		require.NoError(t, db.Satellites().CompletePartialExit(ctx, satelliteID, stop))
+
+		exits, err = db.Satellites().ListPartialExits(ctx)
+		require.NoError(t, err)
+		require.Len(t, exits, 1)
+		require.Equal(t, stop.UTC(), *exits[0].FinishedAt)
+
+		// a new partial exit replaces the finished one
+		require.NoError(t, db.Satellites().InitiatePartialExit(ctx, satelliteID, stop, 3000))
+
+		exits, err = db.Satellites().ListPartialExits(ctx)
+		require.NoError(t, err)
+		require.Len(t, exits, 1)
+		require.Equal(t, int64(3000), exits[0].RequestedBytes)
+		require.Zero(t, exits[0].BytesDeleted)
+		require.Nil(t, exits[0].FinishedAt)
+	})
+}
@@ -0,0 +1,416 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// ErrNotAllowed is the error class for graceful exits which can't be started.
+	ErrNotAllowed = errs.Class("graceful exit not allowed")
+	// ErrNoReceipt is the error class for graceful exits without a completion receipt.
+	ErrNoReceipt = errs.Class("no graceful exit receipt")
+)
+
+// Satellite is a trusted satellite the node can start a graceful exit from.
+type Satellite struct {
+	ID        storj.NodeID `json:"id"`
+	Address   string       `json:"address"`
+	SpaceUsed int64        `json:"spaceUsed"`
+}
+
+// ExitStatus contains the status of a graceful exit from a satellite.
+type ExitStatus struct {
+	SatelliteID storj.NodeID `json:"satelliteId"`
+	Address     string       `json:"address"`
+	// Status is one of "exiting", "succeeded" and "failed".
+	Status      string     `json:"status"`
+	Paused      bool       `json:"paused"`
+	InitiatedAt *time.Time `json:"initiatedAt"`
+	FinishedAt  *time.Time `json:"finishedAt"`
+
+	StartingDiskUsage int64   `json:"startingDiskUsage"`
+	BytesTransferred  int64   `json:"bytesTransferred"`
+	BytesRemaining    int64   `json:"bytesRemaining"`
+	PercentComplete   float64 `json:"percentComplete"`
+
+	// The piece counters are since the node was started, PiecesRemaining is
+	// -1 when the pieces weren't counted yet.
+	PiecesTransferred int64 `json:"piecesTransferred"`
+	PiecesFailed      int64 `json:"piecesFailed"`
+	PiecesRemaining   int64 `json:"piecesRemaining"`
+
+	// EstimatedCompletion is estimated from the transfer rate since the
+	// graceful exit was started, when any bytes were transferred.
+	EstimatedCompletion *time.Time `json:"estimatedCompletion"`
+
+	// CompletionReceipt is the hex encoded receipt signed by the satellite,
+	// once the graceful exit finished.
+	CompletionReceipt string `json:"completionReceipt"`
+}
+
+// PartialExitStatus contains the status of a partial exit from a satellite.
+type PartialExitStatus struct {
+	SatelliteID storj.NodeID `json:"satelliteId"`
+	Address     string       `json:"address"`
+	// Status is one of "exiting" and "finished".
+	Status      string     `json:"status"`
+	InitiatedAt time.Time  `json:"initiatedAt"`
+	FinishedAt  *time.Time `json:"finishedAt"`
+
+	RequestedBytes   int64 `json:"requestedBytes"`
+	BytesTransferred int64 `json:"bytesTransferred"`
+}
+
+// Service provides the graceful exit status and starts graceful exits for the
+// storage node operator dashboard.
+//
+// architecture: Service
+type Service struct {
+	log        *zap.Logger
+	trust      *trust.Pool
+	satellites satellites.DB
+	usageCache *pieces.BlobsUsageCache
+	chore      *Chore
+}
+
+// NewService creates a new graceful exit service.
+func NewService(log *zap.Logger, trust *trust.Pool, satellites satellites.DB, usageCache *pieces.BlobsUsageCache, chore *Chore) *Service {
+	return &Service{
+		log:        log,
+		trust:      trust,
+		satellites: satellites,
+		usageCache: usageCache,
+		chore:      chore,
+	}
+}
+
+// NonExitingSatellites returns the trusted satellites the node hasn't started
+// a graceful exit from.
+func (service *Service) NonExitingSatellites(ctx context.Context) (_ []Satellite, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exits, err := service.satellites.ListGracefulExits(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	exiting := make(map[storj.NodeID]bool, len(exits))
+	for _, exit := range exits {
+		exiting[exit.SatelliteID] = true
+	}
+
+	list := []Satellite{}
+	for _, id := range service.trust.GetSatellites(ctx) {
+		if exiting[id] {
+			continue
+		}
+		address, err := service.trust.GetAddress(ctx, id)
+		if err != nil {
+			service.log.Debug("graceful exit: get satellite address", zap.Stringer("Satellite ID", id), zap.Error(err))
+			continue
+		}
+		_, spaceUsed, err := service.usageCache.SpaceUsedBySatellite(ctx, id)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		list = append(list, Satellite{ID: id, Address: address, SpaceUsed: spaceUsed})
+	}
+	sort.Slice(list, func(i, k int) bool { return list[i].Address < list[k].Address })
+	return list, nil
+}
+
+// ExitStatuses returns the status of the graceful exits the node started.
+func (service *Service) ExitStatuses(ctx context.Context) (_ []ExitStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exits, err := service.satellites.ListGracefulExits(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	now := time.Now()
+	statuses := make([]ExitStatus, 0, len(exits))
+	for _, exit := range exits {
+		status := ExitStatus{
+			SatelliteID:       exit.SatelliteID,
+			Status:            "exiting",
+			InitiatedAt:       exit.InitiatedAt,
+			FinishedAt:        exit.FinishedAt,
+			StartingDiskUsage: exit.StartingDiskUsage,
+			BytesTransferred:  exit.BytesDeleted,
+			PiecesRemaining:   -1,
+			CompletionReceipt: hex.EncodeToString(exit.CompletionReceipt),
+		}
+
+		// the satellite may not be trusted anymore
+		status.Address, err = service.trust.GetAddress(ctx, exit.SatelliteID)
+		if err != nil {
+			service.log.Debug("graceful exit: get satellite address", zap.Stringer("Satellite ID", exit.SatelliteID), zap.Error(err))
+		}
+
+		_, status.BytesRemaining, err = service.usageCache.SpaceUsedBySatellite(ctx, exit.SatelliteID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		if exit.StartingDiskUsage > 0 {
+			status.PercentComplete = float64(exit.BytesDeleted) / float64(exit.StartingDiskUsage) * 100
+		}
+
+		if progress, ok := service.chore.Progress(exit.SatelliteID); ok {
+			status.PiecesTransferred = progress.PiecesTransferred
+			status.PiecesFailed = progress.PiecesFailed
+			status.PiecesRemaining = progress.PiecesRemaining
+		}
+
+		switch satellites.Status(exit.Status) {
+		case satellites.ExitSucceeded:
+			status.Status = "succeeded"
+			status.PercentComplete = 100
+			status.BytesRemaining = 0
+			status.PiecesRemaining = 0
+		case satellites.ExitFailed:
+			status.Status = "failed"
+		default:
+			status.Paused = service.chore.Paused(exit.SatelliteID)
+			status.EstimatedCompletion = estimateCompletion(exit, status.BytesRemaining, now)
+		}
+
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// estimateCompletion estimates when the remaining bytes are transferred, based
+// on the transfer rate since the graceful exit was started.
+func estimateCompletion(exit satellites.ExitProgress, bytesRemaining int64, now time.Time) *time.Time {
+	if exit.InitiatedAt == nil || exit.BytesDeleted <= 0 {
+		return nil
+	}
+	elapsed := now.Sub(*exit.InitiatedAt)
+	if elapsed <= 0 {
+		return nil
+	}
+	remaining := time.Duration(float64(elapsed) * float64(bytesRemaining) / float64(exit.BytesDeleted))
+	completion := now.Add(remaining)
+	return &completion
+}
+
+// StartExit starts a graceful exit from a trusted satellite.
+func (service *Service) StartExit(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := service.trust.GetAddress(ctx, satelliteID); err != nil {
+		return ErrNotAllowed.New("satellite %s is not trusted", satelliteID)
+	}
+
+	exits, err := service.satellites.ListGracefulExits(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, exit := range exits {
+		if exit.SatelliteID == satelliteID {
+			return ErrNotAllowed.New("graceful exit from satellite %s was already started", satelliteID)
+		}
+	}
+
+	partial, err := service.partialExiting(ctx, satelliteID)
+	if err != nil {
+		return err
+	}
+	if partial {
+		return ErrNotAllowed.New("partial exit from satellite %s is not finished", satelliteID)
+	}
+
+	_, spaceUsed, err := service.usageCache.SpaceUsedBySatellite(ctx, satelliteID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = service.satellites.InitiateGracefulExit(ctx, satelliteID, time.Now().UTC(), spaceUsed)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// the chore picks the graceful exit up on its next run
+	service.log.Info("graceful exit started", zap.Stringer("Satellite ID", satelliteID))
+	return nil
+}
+
+// PauseExit pauses the graceful exit from a satellite until it's resumed or the
+// node restarts. It returns how long the node may still pause, before the
+// satellite counts the time as inactive.
+func (service *Service) PauseExit(ctx context.Context, satelliteID storj.NodeID) (_ time.Duration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := service.checkExiting(ctx, satelliteID); err != nil {
+		return 0, err
+	}
+	if service.chore.Paused(satelliteID) {
+		return 0, ErrNotAllowed.New("graceful exit from satellite %s is already paused", satelliteID)
+	}
+	return service.chore.Pause(ctx, satelliteID)
+}
+
+// ResumeExit resumes the paused graceful exit from a satellite.
+func (service *Service) ResumeExit(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := service.checkExiting(ctx, satelliteID); err != nil {
+		return err
+	}
+	if !service.chore.Paused(satelliteID) {
+		return ErrNotAllowed.New("graceful exit from satellite %s is not paused", satelliteID)
+	}
+	return service.chore.Resume(ctx, satelliteID)
+}
+
+// checkExiting returns an ErrNotAllowed error, unless the node is exiting from
+// the satellite.
+func (service *Service) checkExiting(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	exits, err := service.satellites.ListGracefulExits(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, exit := range exits {
+		if exit.SatelliteID == satelliteID && exit.FinishedAt == nil {
+			return nil
+		}
+	}
+	return ErrNotAllowed.New("node is not exiting from satellite %s", satelliteID)
+}
+
+// PartialExits returns the status of the partial exits the node started.
+func (service *Service) PartialExits(ctx context.Context) (_ []PartialExitStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exits, err := service.satellites.ListPartialExits(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	statuses := make([]PartialExitStatus, 0, len(exits))
+	for _, exit := range exits {
+		status := PartialExitStatus{
+			SatelliteID:      exit.SatelliteID,
+			Status:           "exiting",
+			InitiatedAt:      exit.InitiatedAt,
+			FinishedAt:       exit.FinishedAt,
+			RequestedBytes:   exit.RequestedBytes,
+			BytesTransferred: exit.BytesDeleted,
+		}
+		if exit.FinishedAt != nil {
+			status.Status = "finished"
+		}
+
+		// the satellite may not be trusted anymore
+		status.Address, err = service.trust.GetAddress(ctx, exit.SatelliteID)
+		if err != nil {
+			service.log.Debug("partial exit: get satellite address", zap.Stringer("Satellite ID", exit.SatelliteID), zap.Error(err))
+		}
+
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// StartPartialExit starts moving the requested bytes stored for a trusted
+// satellite to other nodes, without leaving the satellite.
+func (service *Service) StartPartialExit(ctx context.Context, satelliteID storj.NodeID, requestedBytes int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := service.trust.GetAddress(ctx, satelliteID); err != nil {
+		return ErrNotAllowed.New("satellite %s is not trusted", satelliteID)
+	}
+	if requestedBytes <= 0 {
+		return ErrNotAllowed.New("requested bytes must be positive")
+	}
+
+	exits, err := service.satellites.ListGracefulExits(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, exit := range exits {
+		if exit.SatelliteID == satelliteID {
+			return ErrNotAllowed.New("graceful exit from satellite %s was already started", satelliteID)
+		}
+	}
+
+	partial, err := service.partialExiting(ctx, satelliteID)
+	if err != nil {
+		return err
+	}
+	if partial {
+		return ErrNotAllowed.New("partial exit from satellite %s was already started", satelliteID)
+	}
+
+	_, spaceUsed, err := service.usageCache.SpaceUsedBySatellite(ctx, satelliteID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if requestedBytes > spaceUsed {
+		return ErrNotAllowed.New("requested %d bytes, but only %d bytes are stored for satellite %s", requestedBytes, spaceUsed, satelliteID)
+	}
+
+	return service.chore.StartPartialExit(ctx, satelliteID, requestedBytes)
+}
+
+// CancelPartialExit stops the partial exit from a satellite. The pieces
+// transferred so far stay on the receiving nodes.
+func (service *Service) CancelPartialExit(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	partial, err := service.partialExiting(ctx, satelliteID)
+	if err != nil {
+		return err
+	}
+	if !partial {
+		return ErrNotAllowed.New("node is not partially exiting from satellite %s", satelliteID)
+	}
+	return service.chore.CancelPartialExit(ctx, satelliteID)
+}
+
+// partialExiting returns whether the node has an unfinished partial exit from
+// the satellite.
+func (service *Service) partialExiting(ctx context.Context, satelliteID storj.NodeID) (_ bool, err error) {
+	exits, err := service.satellites.ListPartialExits(ctx)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	for _, exit := range exits {
+		if exit.SatelliteID == satelliteID && exit.FinishedAt == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Receipt returns the completion receipt signed by the satellite, once the
+// graceful exit from it finished.
+func (service *Service) Receipt(ctx context.Context, satelliteID storj.NodeID) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exits, err := service.satellites.ListGracefulExits(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, exit := range exits {
+		if exit.SatelliteID == satelliteID && len(exit.CompletionReceipt) > 0 {
+			return exit.CompletionReceipt, nil
+		}
+	}
+	return nil, ErrNoReceipt.New("%s", satelliteID)
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/storagenode/gracefulexit"
+	"storj.io/storj/storagenode/satellites"
+)
+
+func TestService(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 2, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		storagenode := planet.StorageNodes[0]
+		storagenode.GracefulExit.Chore.Loop.Pause()
+		service := storagenode.GracefulExit.Service
+
+		exitingSatellite := planet.Satellites[0]
+
+		nonExiting, err := service.NonExitingSatellites(ctx)
+		require.NoError(t, err)
+		require.Len(t, nonExiting, 2)
+
+		// untrusted satellites can't be exited
+		err = service.StartExit(ctx, testrand.NodeID())
+		require.True(t, gracefulexit.ErrNotAllowed.Has(err))
+
+		require.NoError(t, service.StartExit(ctx, exitingSatellite.ID()))
+		err = service.StartExit(ctx, exitingSatellite.ID())
+		require.True(t, gracefulexit.ErrNotAllowed.Has(err))
+
+		nonExiting, err = service.NonExitingSatellites(ctx)
+		require.NoError(t, err)
+		require.Len(t, nonExiting, 1)
+		require.Equal(t, planet.Satellites[1].ID(), nonExiting[0].ID)
+
+		_, err = service.Receipt(ctx, exitingSatellite.ID())
+		require.True(t, gracefulexit.ErrNoReceipt.Has(err))
+
+		statuses, err := service.ExitStatuses(ctx)
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		require.Equal(t, exitingSatellite.ID(), statuses[0].SatelliteID)
+		require.Equal(t, exitingSatellite.Addr(), statuses[0].Address)
+		require.Equal(t, "exiting", statuses[0].Status)
+		require.EqualValues(t, -1, statuses[0].PiecesRemaining)
+		require.Nil(t, statuses[0].EstimatedCompletion)
+
+		receipt := testrand.Bytes(64)
+		err = storagenode.DB.Satellites().CompleteGracefulExit(ctx, exitingSatellite.ID(), time.Now(), satellites.ExitSucceeded, receipt)
+		require.NoError(t, err)
+
+		statuses, err = service.ExitStatuses(ctx)
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		require.Equal(t, "succeeded", statuses[0].Status)
+		require.EqualValues(t, 100, statuses[0].PercentComplete)
+
+		stored, err := service.Receipt(ctx, exitingSatellite.ID())
+		require.NoError(t, err)
+		require.Equal(t, receipt, stored)
+	})
+}
+
+func TestServicePause(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		storagenode := planet.StorageNodes[0]
+		storagenode.GracefulExit.Chore.Loop.Pause()
+		service := storagenode.GracefulExit.Service
+
+		// only exiting nodes can pause
+		_, err := service.PauseExit(ctx, satellite.ID())
+		require.True(t, gracefulexit.ErrNotAllowed.Has(err))
+
+		require.NoError(t, service.StartExit(ctx, satellite.ID()))
+		_, err = satellite.Overlay.DB.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:          storagenode.ID(),
+			ExitInitiatedAt: time.Now().UTC(),
+		})
+		require.NoError(t, err)
+
+		remaining, err := service.PauseExit(ctx, satellite.ID())
+		require.NoError(t, err)
+		require.True(t, remaining > 0)
+		require.True(t, storagenode.GracefulExit.Chore.Paused(satellite.ID()))
+
+		_, err = service.PauseExit(ctx, satellite.ID())
+		require.True(t, gracefulexit.ErrNotAllowed.Has(err))
+
+		statuses, err := service.ExitStatuses(ctx)
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		require.True(t, statuses[0].Paused)
+
+		pause, err := satellite.DB.GracefulExit().GetPause(ctx, storagenode.ID())
+		require.NoError(t, err)
+		require.NotNil(t, pause.PausedAt)
+
+		require.NoError(t, service.ResumeExit(ctx, satellite.ID()))
+		require.False(t, storagenode.GracefulExit.Chore.Paused(satellite.ID()))
+
+		err = service.ResumeExit(ctx, satellite.ID())
+		require.True(t, gracefulexit.ErrNotAllowed.Has(err))
+
+		pause, err = satellite.DB.GracefulExit().GetPause(ctx, storagenode.ID())
+		require.NoError(t, err)
+		require.Nil(t, pause.PausedAt)
+	})
+}
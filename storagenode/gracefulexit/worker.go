@@ -8,6 +8,7 @@ import (
 	"context"
 	"io"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -24,10 +25,46 @@ import (
 	"storj.io/common/sync2"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/ratelimit"
 	"storj.io/storj/storagenode/satellites"
 	"storj.io/uplink/ecclient"
 )
 
+// Progress contains the counters of the graceful exit from a satellite since
+// the node was started.
+type Progress struct {
+	PiecesTransferred int64
+	PiecesFailed      int64
+	// PiecesRemaining is the number of pieces still stored for the satellite,
+	// or -1 when they weren't counted yet.
+	PiecesRemaining int64
+}
+
+// progress tracks the Progress of the workers of a satellite.
+type progress struct {
+	mu       sync.Mutex
+	progress Progress
+}
+
+// newProgress creates a progress tracker with uncounted remaining pieces.
+func newProgress() *progress {
+	return &progress{progress: Progress{PiecesRemaining: -1}}
+}
+
+// get returns the current progress.
+func (p *progress) get() Progress {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.progress
+}
+
+// update changes the progress with fn.
+func (p *progress) update(fn func(progress *Progress)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fn(&p.progress)
+}
+
 // Worker is responsible for completing the graceful exit for a given satellite.
 type Worker struct {
 	log                *zap.Logger
@@ -40,10 +77,29 @@ type Worker struct {
 	ecclient           ecclient.Client
 	minBytesPerSecond  memory.Size
 	minDownloadTimeout time.Duration
+	progress           *progress
+	bandwidth          *ratelimit.Bucket
+	// cancel stops the worker when the graceful exit is paused or the partial
+	// exit is canceled.
+	cancel func()
+	// stopped is closed when Run returns.
+	stopped chan struct{}
+	// partial is set when the worker transfers pieces for a partial exit,
+	// which finishes without leaving the satellite.
+	partial bool
 }
 
 // NewWorker instantiates Worker.
 func NewWorker(log *zap.Logger, store *pieces.Store, satelliteDB satellites.DB, dialer rpc.Dialer, satelliteID storj.NodeID, satelliteAddr string, config Config) *Worker {
+	minBytesPerSecond := config.MinBytesPerSecond
+	if config.MaxBytesPerSecond > 0 && config.NumWorkers > 0 && config.NumConcurrentTransfers > 0 {
+		// the transfers share the bandwidth, so each may get less than the minimum
+		share := config.MaxBytesPerSecond / memory.Size(config.NumWorkers*config.NumConcurrentTransfers)
+		if share < minBytesPerSecond {
+			minBytesPerSecond = share
+		}
+	}
+
 	return &Worker{
 		log:                log,
 		store:              store,
@@ -53,18 +109,57 @@ func NewWorker(log *zap.Logger, store *pieces.Store, satelliteDB satellites.DB,
 		satelliteID:        satelliteID,
 		satelliteAddr:      satelliteAddr,
 		ecclient:           ecclient.NewClient(log, dialer, 0),
-		minBytesPerSecond:  config.MinBytesPerSecond,
+		minBytesPerSecond:  minBytesPerSecond,
 		minDownloadTimeout: config.MinDownloadTimeout,
+		progress:           newProgress(),
+		bandwidth:          ratelimit.NewBucket(config.MaxBytesPerSecond),
+		cancel:             func() {},
+		stopped:            make(chan struct{}),
+	}
+}
+
+// Progress returns the counters of the graceful exit from the satellite.
+func (worker *Worker) Progress() Progress {
+	return worker.progress.get()
+}
+
+// countPieces counts the pieces stored for the satellite, when they weren't
+// counted before.
+func (worker *Worker) countPieces(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if worker.progress.get().PiecesRemaining >= 0 {
+		return nil
+	}
+
+	var count int64
+	err = worker.store.WalkSatellitePieces(ctx, worker.satelliteID, func(pieces.StoredPieceAccess) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		return err
 	}
+	worker.progress.update(func(progress *Progress) {
+		progress.PiecesRemaining = count
+	})
+	return nil
 }
 
 // Run calls the satellite endpoint, transfers pieces, validates, and responds with success or failure.
 // It also marks the satellite finished once all the pieces have been transferred
 func (worker *Worker) Run(ctx context.Context, done func()) (err error) {
 	defer mon.Task()(&ctx)(&err)
+	defer close(worker.stopped)
 	defer done()
 
-	worker.log.Debug("running worker")
+	worker.log.Debug("running worker", zap.Bool("partial", worker.partial))
+
+	if !worker.partial {
+		if err := worker.countPieces(ctx); err != nil {
+			worker.log.Debug("failed to count pieces", zap.Stringer("Satellite ID", worker.satelliteID), zap.Error(err))
+		}
+	}
 
 	conn, err := worker.dialer.DialAddressID(ctx, worker.satelliteAddr, worker.satelliteID)
 	if err != nil {
@@ -85,9 +180,15 @@ func (worker *Worker) Run(ctx context.Context, done func()) (err error) {
 		response, err := c.Recv()
 		if errs.Is(err, io.EOF) {
 			// Done
+			if worker.partial {
+				// let the transfers finish before the partial exit is completed
+				worker.limiter.Wait()
+				worker.log.Info("partial exit finished.", zap.Stringer("Satellite ID", worker.satelliteID))
+				return errs.Wrap(worker.satelliteDB.CompletePartialExit(ctx, worker.satelliteID, time.Now()))
+			}
 			return nil
 		}
-		if errs2.IsRPC(err, rpcstatus.FailedPrecondition) {
+		if errs2.IsRPC(err, rpcstatus.FailedPrecondition) && !worker.partial {
 			// delete the entry from satellite table and inform graceful exit has failed to start
 			deleteErr := worker.satelliteDB.CancelGracefulExit(ctx, worker.satelliteID)
 			if deleteErr != nil {
@@ -210,7 +311,8 @@ func (worker *Worker) transferPiece(ctx context.Context, transferPiece *pb.Trans
 	putCtx, cancel := context.WithTimeout(ctx, maxTransferTime)
 	defer cancel()
 
-	pieceHash, peerID, err := worker.ecclient.PutPiece(putCtx, ctx, addrLimit, pk, reader)
+	throttled := &throttledReader{ReadCloser: reader, ctx: putCtx, bandwidth: worker.bandwidth}
+	pieceHash, peerID, err := worker.ecclient.PutPiece(putCtx, ctx, addrLimit, pk, throttled)
 	if err != nil {
 		if piecestore.ErrVerifyUntrusted.Has(err) {
 			worker.log.Error("failed hash verification.",
@@ -268,6 +370,9 @@ func (worker *Worker) transferPiece(ctx context.Context, transferPiece *pb.Trans
 			},
 		},
 	}
+	worker.progress.update(func(progress *Progress) {
+		progress.PiecesTransferred++
+	})
 	worker.log.Info("piece transferred to new storagenode",
 		zap.Stringer("Storagenode ID", addrLimit.Limit.StorageNodeId),
 		zap.Stringer("Satellite ID", worker.satelliteID),
@@ -290,8 +395,16 @@ func (worker *Worker) deleteOnePiece(ctx context.Context, pieceID storj.PieceID)
 		worker.log.Debug("failed to retrieve piece info", zap.Stringer("Satellite ID", worker.satelliteID), zap.Error(err))
 		return err
 	}
-	// update graceful exit progress
 	size := piece.Size()
+	if worker.partial {
+		return worker.satelliteDB.UpdatePartialExit(ctx, worker.satelliteID, size)
+	}
+	// update graceful exit progress
+	worker.progress.update(func(progress *Progress) {
+		if progress.PiecesRemaining > 0 {
+			progress.PiecesRemaining--
+		}
+	})
 	return worker.satelliteDB.UpdateGracefulExit(ctx, worker.satelliteID, size)
 }
 
@@ -340,10 +453,19 @@ func (worker *Worker) deleteAllPieces(ctx context.Context) error {
 		worker.log.Debug("failed to retrieve piece info", zap.Stringer("Satellite ID", worker.satelliteID), zap.Error(err))
 	}
 	// update graceful exit progress
+	if err == nil {
+		worker.progress.update(func(progress *Progress) {
+			progress.PiecesRemaining = 0
+		})
+	}
 	return worker.satelliteDB.UpdateGracefulExit(ctx, worker.satelliteID, totalDeleted)
 }
 
 func (worker *Worker) handleFailure(ctx context.Context, transferError pb.TransferFailed_Error, pieceID pb.PieceID, send func(*pb.StorageNodeMessage) error) {
+	worker.progress.update(func(progress *Progress) {
+		progress.PiecesFailed++
+	})
+
 	failure := &pb.StorageNodeMessage{
 		Message: &pb.StorageNodeMessage_Failed{
 			Failed: &pb.TransferFailed{
@@ -359,6 +481,25 @@ func (worker *Worker) handleFailure(ctx context.Context, transferError pb.Transf
 	}
 }
 
+// throttledReader takes the bytes read from the bandwidth bucket.
+type throttledReader struct {
+	io.ReadCloser
+	ctx       context.Context
+	bandwidth *ratelimit.Bucket
+}
+
+// Read reads from the reader and waits until the bandwidth allows the read bytes.
+func (throttled *throttledReader) Read(p []byte) (n int, err error) {
+	n, err = throttled.ReadCloser.Read(p)
+	if n > 0 {
+		priority := ratelimit.ActionPriority(pb.PieceAction_PUT_GRACEFUL_EXIT)
+		if waitErr := throttled.bandwidth.Wait(throttled.ctx, priority, int64(n)); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
+
 // Close halts the worker.
 func (worker *Worker) Close() error {
 	worker.limiter.Wait()
@@ -0,0 +1,240 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package metrics implements serving node level metrics in the Prometheus
+// text exposition format.
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/date"
+	"storj.io/storj/private/version"
+	"storj.io/storj/private/version/checker"
+	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// Error is the default error class for metrics.
+	Error = errs.Class("metrics")
+
+	mon = monkit.Package()
+)
+
+// RequestCounter counts the requests the node is handling.
+type RequestCounter interface {
+	LiveRequests() int32
+}
+
+// Service collects node level metrics from the databases and services of the
+// storage node. Unlike the monkit metrics of the debug server, these are
+// curated for operators.
+//
+// architecture: Service
+type Service struct {
+	log                *zap.Logger
+	trust              *trust.Pool
+	store              *pieces.Store
+	reputationDB       reputation.DB
+	bandwidthDB        bandwidth.DB
+	requests           RequestCounter
+	version            *checker.Service
+	versionInfo        version.Info
+	allocatedDiskSpace int64
+}
+
+// NewService creates a new metrics service. requests and version are optional.
+func NewService(log *zap.Logger, trust *trust.Pool, store *pieces.Store, reputationDB reputation.DB, bandwidthDB bandwidth.DB,
+	requests RequestCounter, version *checker.Service, versionInfo version.Info, allocatedDiskSpace int64) *Service {
+	return &Service{
+		log:                log,
+		trust:              trust,
+		store:              store,
+		reputationDB:       reputationDB,
+		bandwidthDB:        bandwidthDB,
+		requests:           requests,
+		version:            version,
+		versionInfo:        versionInfo,
+		allocatedDiskSpace: allocatedDiskSpace,
+	}
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format.
+func (service *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var buf bytes.Buffer
+	if err = service.Write(ctx, &buf, time.Now()); err != nil {
+		service.log.Error("failed to collect metrics", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, err = w.Write(buf.Bytes())
+}
+
+// Write collects the metrics and writes them to buf. now determines the
+// month of the bandwidth metrics.
+func (service *Service) Write(ctx context.Context, buf *bytes.Buffer, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	w := &writer{buf: buf}
+	satellites := service.trust.GetSatellites(ctx)
+	sort.Slice(satellites, func(i, k int) bool {
+		return satellites[i].Less(satellites[k])
+	})
+
+	if err := service.writeDiskSpace(ctx, w, satellites); err != nil {
+		return Error.Wrap(err)
+	}
+	if err := service.writeReputation(ctx, w, satellites); err != nil {
+		return Error.Wrap(err)
+	}
+	if err := service.writeBandwidth(ctx, w, satellites, now); err != nil {
+		return Error.Wrap(err)
+	}
+
+	if service.requests != nil {
+		w.family("storj_node_live_requests", "gauge", "Number of requests the node is handling.")
+		w.sample("storj_node_live_requests", nil, float64(service.requests.LiveRequests()))
+	}
+
+	w.family("storj_node_info", "gauge", "Version of the node.")
+	w.sample("storj_node_info", labels{{"version", service.versionInfo.Version.String()}}, 1)
+
+	if service.version != nil && service.version.Checked() {
+		_, allowed := service.version.IsAllowed(ctx)
+		w.family("storj_node_version_allowed", "gauge", "Whether the version of the node is allowed by the version server.")
+		w.sample("storj_node_version_allowed", nil, boolValue(allowed))
+	}
+
+	return nil
+}
+
+func (service *Service) writeDiskSpace(ctx context.Context, w *writer, satellites []storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	w.family("storj_node_disk_space_used_bytes", "gauge", "Disk space used by pieces of a satellite.")
+	for _, satellite := range satellites {
+		_, contentSize, err := service.store.SpaceUsedBySatellite(ctx, satellite)
+		if err != nil {
+			return err
+		}
+		w.sample("storj_node_disk_space_used_bytes", labels{{"satellite", satellite.String()}}, float64(contentSize))
+	}
+
+	trash, err := service.store.SpaceUsedForTrash(ctx)
+	if err != nil {
+		return err
+	}
+	w.family("storj_node_disk_space_trash_bytes", "gauge", "Disk space used by trashed pieces.")
+	w.sample("storj_node_disk_space_trash_bytes", nil, float64(trash))
+
+	used, err := service.store.SpaceUsedForPiecesAndTrash(ctx)
+	if err != nil {
+		return err
+	}
+	free := service.allocatedDiskSpace - used
+	if free < 0 {
+		free = 0
+	}
+	w.family("storj_node_disk_space_allocated_bytes", "gauge", "Disk space allocated to the node.")
+	w.sample("storj_node_disk_space_allocated_bytes", nil, float64(service.allocatedDiskSpace))
+	w.family("storj_node_disk_space_free_bytes", "gauge", "Allocated disk space which is not used by pieces or trash.")
+	w.sample("storj_node_disk_space_free_bytes", nil, float64(free))
+	return nil
+}
+
+func (service *Service) writeReputation(ctx context.Context, w *writer, satellites []storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stats := make([]*reputation.Stats, 0, len(satellites))
+	for _, satellite := range satellites {
+		satelliteStats, err := service.reputationDB.Get(ctx, satellite)
+		if err != nil {
+			return err
+		}
+		// stats which were never synced from the satellite are unknown
+		if satelliteStats.UpdatedAt.IsZero() {
+			continue
+		}
+		stats = append(stats, satelliteStats)
+	}
+
+	w.family("storj_node_audit_score", "gauge", "Audit reputation score on a satellite.")
+	for _, s := range stats {
+		w.sample("storj_node_audit_score", labels{{"satellite", s.SatelliteID.String()}}, s.Audit.Score)
+	}
+	w.family("storj_node_uptime_score", "gauge", "Uptime reputation score on a satellite.")
+	for _, s := range stats {
+		w.sample("storj_node_uptime_score", labels{{"satellite", s.SatelliteID.String()}}, s.Uptime.Score)
+	}
+	w.family("storj_node_disqualified", "gauge", "Whether the node is disqualified on a satellite.")
+	for _, s := range stats {
+		w.sample("storj_node_disqualified", labels{{"satellite", s.SatelliteID.String()}}, boolValue(s.Disqualified != nil))
+	}
+	w.family("storj_node_suspension_score", "gauge", "Unknown audit reputation score on a satellite, the node is suspended when it drops too low.")
+	for _, s := range stats {
+		w.sample("storj_node_suspension_score", labels{{"satellite", s.SatelliteID.String()}}, s.UnknownAudit.Score)
+	}
+	w.family("storj_node_suspended", "gauge", "Whether the node is suspended on a satellite.")
+	for _, s := range stats {
+		w.sample("storj_node_suspended", labels{{"satellite", s.SatelliteID.String()}}, boolValue(s.Suspended != nil))
+	}
+	return nil
+}
+
+func (service *Service) writeBandwidth(ctx context.Context, w *writer, satellites []storj.NodeID, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	from, to := date.MonthBoundary(now.UTC())
+	usages, err := service.bandwidthDB.SummaryBySatellite(ctx, from, to)
+	if err != nil {
+		return err
+	}
+
+	w.family("storj_node_bandwidth_month_bytes", "gauge", "Bandwidth used for a satellite in the current month by action.")
+	for _, satellite := range satellites {
+		usage, ok := usages[satellite]
+		if !ok {
+			usage = &bandwidth.Usage{}
+		}
+		for _, action := range []struct {
+			name   string
+			amount int64
+		}{
+			{"put", usage.Put},
+			{"get", usage.Get},
+			{"get_audit", usage.GetAudit},
+			{"get_repair", usage.GetRepair},
+			{"put_repair", usage.PutRepair},
+			{"delete", usage.Delete},
+		} {
+			w.sample("storj_node_bandwidth_month_bytes",
+				labels{{"satellite", satellite.String()}, {"action", action.name}}, float64(action.amount))
+		}
+	}
+	return nil
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
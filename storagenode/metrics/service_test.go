@@ -0,0 +1,98 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metrics_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/rpc"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/version"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/metrics"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+	"storj.io/storj/storagenode/trust"
+)
+
+type requestCounter int32
+
+func (counter requestCounter) LiveRequests() int32 { return int32(counter) }
+
+func TestWrite(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), db.PieceIndex())
+
+		synced, unsynced := testrand.NodeID(), testrand.NodeID()
+		pool, err := trust.NewPool(log, trust.Dialer(rpc.Dialer{}), trust.Config{
+			Sources: []trust.Source{
+				&trust.StaticURLSource{URL: trust.SatelliteURL{ID: synced, Host: "localhost", Port: 7777}},
+				&trust.StaticURLSource{URL: trust.SatelliteURL{ID: unsynced, Host: "localhost", Port: 7778}},
+			},
+			CachePath: ctx.File("trust-cache.json"),
+		})
+		require.NoError(t, err)
+		require.NoError(t, pool.Refresh(ctx))
+
+		writer, err := store.Writer(ctx, synced, testrand.PieceID())
+		require.NoError(t, err)
+		_, err = writer.Write(testrand.BytesInt(memory.KiB.Int()))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+
+		now := time.Now()
+		require.NoError(t, db.Reputation().Store(ctx, reputation.Stats{
+			SatelliteID:  synced,
+			Audit:        reputation.Metric{Score: 0.75},
+			Uptime:       reputation.Metric{Score: 1},
+			UnknownAudit: reputation.Metric{Score: 0.5},
+			Suspended:    &now,
+			UpdatedAt:    now.UTC(),
+		}))
+		require.NoError(t, db.Bandwidth().Add(ctx, synced, pb.PieceAction_GET, 100, now.UTC()))
+
+		semver, err := version.NewSemVer("v1.2.3")
+		require.NoError(t, err)
+
+		service := metrics.NewService(log, pool, store, db.Reputation(), db.Bandwidth(),
+			requestCounter(3), nil, version.Info{Version: semver}, memory.MiB.Int64())
+
+		var buf bytes.Buffer
+		require.NoError(t, service.Write(ctx, &buf, now))
+		output := buf.String()
+
+		assert.Contains(t, output, "# TYPE storj_node_disk_space_used_bytes gauge\n")
+		assert.Contains(t, output, fmt.Sprintf("storj_node_disk_space_used_bytes{satellite=%q} 1024\n", synced))
+		assert.Contains(t, output, fmt.Sprintf("storj_node_disk_space_used_bytes{satellite=%q} 0\n", unsynced))
+		assert.Contains(t, output, "storj_node_disk_space_allocated_bytes 1.048576e+06\n")
+
+		assert.Contains(t, output, fmt.Sprintf("storj_node_audit_score{satellite=%q} 0.75\n", synced))
+		assert.Contains(t, output, fmt.Sprintf("storj_node_uptime_score{satellite=%q} 1\n", synced))
+		assert.Contains(t, output, fmt.Sprintf("storj_node_disqualified{satellite=%q} 0\n", synced))
+		assert.Contains(t, output, fmt.Sprintf("storj_node_suspension_score{satellite=%q} 0.5\n", synced))
+		assert.Contains(t, output, fmt.Sprintf("storj_node_suspended{satellite=%q} 1\n", synced))
+		// reputation which was never synced is not reported
+		assert.NotContains(t, output, fmt.Sprintf("storj_node_audit_score{satellite=%q}", unsynced))
+
+		assert.Contains(t, output, fmt.Sprintf("storj_node_bandwidth_month_bytes{satellite=%q,action=\"get\"} 100\n", synced))
+		assert.Contains(t, output, fmt.Sprintf("storj_node_bandwidth_month_bytes{satellite=%q,action=\"put\"} 0\n", unsynced))
+
+		assert.Contains(t, output, "storj_node_live_requests 3\n")
+		assert.Contains(t, output, "storj_node_info{version=\"v1.2.3\"} 1\n")
+		// the version is not reported as allowed before it was checked
+		assert.NotContains(t, output, "storj_node_version_allowed")
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// label is a name and value pair of a sample.
+type label struct {
+	name  string
+	value string
+}
+
+type labels []label
+
+// labelEscaper escapes label values, see
+// https://prometheus.io/docs/instrumenting/exposition_formats/
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// writer writes metric families in the Prometheus text exposition format.
+type writer struct {
+	buf *bytes.Buffer
+}
+
+// family writes the help and type lines of a metric family, which must
+// precede its samples.
+func (w *writer) family(name, typ, help string) {
+	fmt.Fprintf(w.buf, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+// sample writes one sample of a metric family.
+func (w *writer) sample(name string, labels labels, value float64) {
+	w.buf.WriteString(name)
+	if len(labels) > 0 {
+		w.buf.WriteByte('{')
+		for i, label := range labels {
+			if i > 0 {
+				w.buf.WriteByte(',')
+			}
+			fmt.Fprintf(w.buf, `%s="%s"`, label.name, labelEscaper.Replace(label.value))
+		}
+		w.buf.WriteByte('}')
+	}
+	w.buf.WriteByte(' ')
+	w.buf.WriteString(strconv.FormatFloat(value, 'g', -1, 64))
+	w.buf.WriteByte('\n')
+}
@@ -5,6 +5,8 @@ package monitor
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -16,6 +18,7 @@ import (
 	"storj.io/common/sync2"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/pieces"
 )
 
@@ -24,6 +27,10 @@ var (
 
 	// Error is the default error class for piecestore monitor errors
 	Error = errs.Class("piecestore monitor")
+	// ErrNotEnoughSpace is the error class for reservations which exceed the available space.
+	ErrNotEnoughSpace = errs.Class("not enough space")
+	// ErrReadOnly is the error class for reservations while the disk is nearly full.
+	ErrReadOnly = errs.Class("storage node is read-only")
 )
 
 // Config defines parameters for storage node disk and bandwidth usage monitoring.
@@ -31,6 +38,8 @@ type Config struct {
 	Interval         time.Duration `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
 	MinimumDiskSpace memory.Size   `help:"how much disk space a node at minimum has to advertise" default:"500GB"`
 	MinimumBandwidth memory.Size   `help:"how much bandwidth a node at minimum has to advertise" default:"500GB"`
+	SafetyMargin     memory.Size   `help:"how much space must stay free on the disk, the node doesn't accept uploads and advertises no free space below it" default:"1GB"`
+	NotifyDiskUsage  float64       `help:"notify the operator when this fraction of the allocated disk space is used, 0 disables the notification" default:"0.95"`
 }
 
 // Service which monitors disk usage
@@ -40,21 +49,50 @@ type Service struct {
 	log                *zap.Logger
 	store              *pieces.Store
 	contact            *contact.Service
+	notifications      *notifications.Service
 	usageDB            bandwidth.DB
 	allocatedDiskSpace int64
 	allocatedBandwidth int64
 	Loop               *sync2.Cycle
 	Config             Config
+
+	mu       sync.Mutex
+	reserved int64
+	readOnly bool
+
+	// diskNearlyFull is whether the operator was notified about the used
+	// disk space, until it drops below the threshold again.
+	diskNearlyFull bool
+}
+
+// Reservation is disk space reserved for an upload, until it's committed or
+// canceled.
+type Reservation struct {
+	service *Service
+	size    int64
+	once    sync.Once
+}
+
+// Release releases the reserved space. After an upload is committed, its space
+// is accounted for by the used space instead.
+func (reservation *Reservation) Release() {
+	reservation.once.Do(func() {
+		service := reservation.service
+		service.mu.Lock()
+		defer service.mu.Unlock()
+		service.reserved -= reservation.size
+	})
 }
 
 // TODO: should it be responsible for monitoring actual bandwidth as well?
 
 // NewService creates a new storage node monitoring service.
-func NewService(log *zap.Logger, store *pieces.Store, contact *contact.Service, usageDB bandwidth.DB, allocatedDiskSpace, allocatedBandwidth int64, interval time.Duration, config Config) *Service {
+func NewService(log *zap.Logger, store *pieces.Store, contact *contact.Service, notifications *notifications.Service, usageDB bandwidth.DB, allocatedDiskSpace, allocatedBandwidth int64, interval time.Duration, config Config) *Service {
 	return &Service{
 		log:                log,
 		store:              store,
 		contact:            contact,
+		notifications:      notifications,
 		usageDB:            usageDB,
 		allocatedDiskSpace: allocatedDiskSpace,
 		allocatedBandwidth: allocatedBandwidth,
@@ -152,14 +190,133 @@ func (service *Service) updateNodeInformation(ctx context.Context) (err error) {
 		return Error.Wrap(err)
 	}
 
+	service.notifyDiskUsage(ctx, usedSpace)
+
+	freeDisk := service.allocatedDiskSpace - usedSpace
+	if readOnly, err := service.checkReadOnly(ctx); err != nil {
+		return Error.Wrap(err)
+	} else if readOnly {
+		freeDisk = 0
+	}
+
 	service.contact.UpdateSelf(&pb.NodeCapacity{
 		FreeBandwidth: service.allocatedBandwidth - usedBandwidth,
-		FreeDisk:      service.allocatedDiskSpace - usedSpace,
+		FreeDisk:      freeDisk,
 	})
 
 	return nil
 }
 
+// notifyDiskUsage notifies the operator once the used space exceeds the
+// threshold, until it drops below it again.
+func (service *Service) notifyDiskUsage(ctx context.Context, usedSpace int64) {
+	if service.Config.NotifyDiskUsage <= 0 || service.allocatedDiskSpace <= 0 {
+		return
+	}
+
+	usage := float64(usedSpace) / float64(service.allocatedDiskSpace)
+	nearlyFull := usage >= service.Config.NotifyDiskUsage
+	if nearlyFull == service.diskNearlyFull {
+		return
+	}
+	service.diskNearlyFull = nearlyFull
+	if !nearlyFull {
+		return
+	}
+
+	service.log.Warn("Allocated disk space is nearly full", zap.Float64("usage", usage))
+	service.notifications.Notify(ctx, notifications.NewNotification{
+		SenderID: service.contact.Local().Id,
+		Type:     notifications.TypeDiskSpace,
+		Title:    "Disk space nearly full",
+		Message: fmt.Sprintf("The node uses %s of the %s allocated disk space (%.1f%%). It stops accepting uploads when the allocated space is full.",
+			memory.Size(usedSpace).Base10String(), memory.Size(service.allocatedDiskSpace).Base10String(), usage*100),
+	})
+}
+
+// ReadOnly returns whether the node doesn't accept uploads, because the disk is
+// nearly full.
+func (service *Service) ReadOnly() bool {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.readOnly
+}
+
+// checkReadOnly updates whether the node is read-only, based on the free space
+// on the disk and the outstanding reservations.
+func (service *Service) checkReadOnly(ctx context.Context) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	storageStatus, err := service.store.StorageStatus(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.setReadOnly(storageStatus.DiskFree-service.reserved < service.Config.SafetyMargin.Int64())
+	return service.readOnly, nil
+}
+
+// setReadOnly updates whether the node is read-only. When the node becomes
+// read-only, it immediately advertises no free disk space.
+func (service *Service) setReadOnly(readOnly bool) {
+	if readOnly == service.readOnly {
+		return
+	}
+	service.readOnly = readOnly
+	if !readOnly {
+		service.log.Info("Free disk space is above the safety margin again, accepting uploads")
+		return
+	}
+
+	service.log.Warn("Free disk space is below the safety margin, not accepting uploads",
+		zap.Int64("Safety Margin", service.Config.SafetyMargin.Int64()))
+	capacity := service.contact.Local().Capacity
+	capacity.FreeDisk = 0
+	service.contact.UpdateSelf(&capacity)
+}
+
+// ReserveSpace reserves disk space for an upload. It fails when the size exceeds
+// the available space, or when the free space on the disk would drop below the
+// safety margin.
+func (service *Service) ReserveSpace(ctx context.Context, size int64) (_ *Reservation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	usedSpace, err := service.usedSpace(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	storageStatus, err := service.store.StorageStatus(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	// the reserved space is subtracted from the free disk space, even though
+	// uploads in progress have already written part of it
+	diskFree := storageStatus.DiskFree - service.reserved
+	service.setReadOnly(diskFree < service.Config.SafetyMargin.Int64())
+	if service.readOnly {
+		mon.Meter("reservation_read_only").Mark(1)
+		return nil, ErrReadOnly.New("free disk space is below the safety margin")
+	}
+	if diskFree-size < service.Config.SafetyMargin.Int64() {
+		mon.Meter("reservation_not_enough_space").Mark(1)
+		return nil, ErrNotEnoughSpace.New("requested %d bytes, free disk space above the safety margin %d bytes", size, diskFree-service.Config.SafetyMargin.Int64())
+	}
+	if available := service.allocatedDiskSpace - usedSpace - service.reserved; size > available {
+		mon.Meter("reservation_not_enough_space").Mark(1)
+		return nil, ErrNotEnoughSpace.New("requested %d bytes, available %d bytes", size, available)
+	}
+
+	service.reserved += size
+	mon.IntVal("reserved_space").Observe(service.reserved)
+	return &Reservation{service: service, size: size}, nil
+}
+
 func (service *Service) usedSpace(ctx context.Context) (_ int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 	usedSpace, err := service.store.SpaceUsedForPiecesAndTrash(ctx)
@@ -187,11 +344,15 @@ func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error)
 	}
 	allocatedSpace := service.allocatedDiskSpace
 
+	service.mu.Lock()
+	reserved := service.reserved
+	service.mu.Unlock()
+
 	mon.IntVal("allocated_space").Observe(allocatedSpace)
 	mon.IntVal("used_space").Observe(usedSpace)
-	mon.IntVal("available_space").Observe(allocatedSpace - usedSpace)
+	mon.IntVal("available_space").Observe(allocatedSpace - usedSpace - reserved)
 
-	return allocatedSpace - usedSpace, nil
+	return allocatedSpace - usedSpace - reserved, nil
 }
 
 // AvailableBandwidth returns available bandwidth for upload/download
@@ -0,0 +1,77 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package monitor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestReserveSpace(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), db.PieceIndex())
+		self := contact.NewService(log, &overlay.NodeDossier{})
+
+		// the directories of the blob store may use some space already
+		used, err := store.SpaceUsedForPiecesAndTrash(ctx)
+		require.NoError(t, err)
+		service := monitor.NewService(log, store, self, nil, db.Bandwidth(), used+10*memory.KiB.Int64(), memory.GB.Int64(), time.Hour, monitor.Config{})
+
+		first, err := service.ReserveSpace(ctx, 6*memory.KiB.Int64())
+		require.NoError(t, err)
+		available, err := service.AvailableSpace(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 4*memory.KiB.Int64(), available)
+
+		_, err = service.ReserveSpace(ctx, 6*memory.KiB.Int64())
+		require.True(t, monitor.ErrNotEnoughSpace.Has(err), err)
+
+		// releasing twice doesn't free more space
+		first.Release()
+		first.Release()
+		second, err := service.ReserveSpace(ctx, 6*memory.KiB.Int64())
+		require.NoError(t, err)
+		second.Release()
+		available, err = service.AvailableSpace(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 10*memory.KiB.Int64(), available)
+	})
+}
+
+func TestReadOnly(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), db.PieceIndex())
+		self := contact.NewService(log, &overlay.NodeDossier{})
+		self.UpdateSelf(&pb.NodeCapacity{FreeDisk: memory.TB.Int64()})
+
+		status, err := store.StorageStatus(ctx)
+		require.NoError(t, err)
+
+		// the disk is below the safety margin
+		service := monitor.NewService(log, store, self, nil, db.Bandwidth(), memory.TB.Int64(), memory.GB.Int64(), time.Hour, monitor.Config{
+			SafetyMargin: memory.Size(status.DiskFree) + memory.GB,
+		})
+
+		_, err = service.ReserveSpace(ctx, memory.KiB.Int64())
+		require.True(t, monitor.ErrReadOnly.Has(err), err)
+		assert.True(t, service.ReadOnly())
+		assert.Zero(t, self.Local().Capacity.FreeDisk)
+	})
+}
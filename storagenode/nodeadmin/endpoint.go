@@ -0,0 +1,410 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodeadmin implements the private endpoint for administrating the
+// storage node locally, without restarting it.
+package nodeadmin
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/private/nodeadminpb"
+	"storj.io/storj/private/version"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/retain"
+	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/trust"
+	"storj.io/storj/storagenode/untrusted"
+)
+
+var mon = monkit.Package()
+
+// defaultPieceLimit is the number of pieces listed, when the request doesn't
+// set a limit.
+const defaultPieceLimit = 1000
+
+// notifyTypes are the notification types which the processes next to the
+// node, like the storage node updater, may send over Notify. The other types
+// are only produced by the node itself.
+var notifyTypes = notifications.Types{notifications.TypeUpdateFailed}
+
+// Chore is a chore which can be triggered by an administrator.
+type Chore struct {
+	Name     string
+	Interval time.Duration
+	// Trigger runs the chore and waits until the run completed.
+	Trigger func(ctx context.Context)
+}
+
+// triggered records the last run of a chore triggered by an administrator.
+type triggered struct {
+	at       time.Time
+	duration time.Duration
+}
+
+// Endpoint implements the private node admin endpoint.
+//
+// architecture: Endpoint
+type Endpoint struct {
+	log           *zap.Logger
+	nodeID        storj.NodeID
+	version       version.Info
+	trust         *trust.Pool
+	satellites    satellites.DB
+	store         *pieces.Store
+	usageCache    *pieces.BlobsUsageCache
+	retain        *retain.Service
+	untrusted     *untrusted.Service
+	notifications *notifications.Service
+	chores        []Chore
+
+	mu        sync.Mutex
+	triggered map[string]triggered
+}
+
+// NewEndpoint creates a new node admin endpoint.
+func NewEndpoint(log *zap.Logger, nodeID storj.NodeID, version version.Info, trust *trust.Pool, satellites satellites.DB, store *pieces.Store, usageCache *pieces.BlobsUsageCache,
+	retain *retain.Service, untrusted *untrusted.Service, notifications *notifications.Service, chores []Chore) *Endpoint {
+	return &Endpoint{
+		log:           log,
+		nodeID:        nodeID,
+		version:       version,
+		trust:         trust,
+		satellites:    satellites,
+		store:         store,
+		usageCache:    usageCache,
+		retain:        retain,
+		untrusted:     untrusted,
+		notifications: notifications,
+		chores:        chores,
+		triggered:     make(map[string]triggered),
+	}
+}
+
+// ListSatellites lists the trusted satellites and the satellites the node
+// gracefully exited from.
+func (endpoint *Endpoint) ListSatellites(ctx context.Context, req *nodeadminpb.ListSatellitesRequest) (_ *nodeadminpb.ListSatellitesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	trusted := map[storj.NodeID]bool{}
+	ids := endpoint.trust.GetSatellites(ctx)
+	for _, id := range ids {
+		trusted[id] = true
+	}
+
+	exits, err := endpoint.satellites.ListGracefulExits(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	statuses := map[storj.NodeID]satellites.Status{}
+	for _, exit := range exits {
+		statuses[exit.SatelliteID] = satellites.Status(exit.Status)
+		if !trusted[exit.SatelliteID] {
+			ids = append(ids, exit.SatelliteID)
+		}
+	}
+	sort.Slice(ids, func(i, k int) bool { return ids[i].Less(ids[k]) })
+
+	resp := &nodeadminpb.ListSatellitesResponse{}
+	for _, id := range ids {
+		satellite := &nodeadminpb.Satellite{
+			Id:      id.Bytes(),
+			Trusted: trusted[id],
+			Status:  statusName(statuses[id]),
+		}
+		if trusted[id] {
+			satellite.Address, err = endpoint.trust.GetAddress(ctx, id)
+			if err != nil {
+				endpoint.log.Debug("failed to get satellite address", zap.Stringer("Satellite ID", id), zap.Error(err))
+			}
+		}
+		_, satellite.SpaceUsed, err = endpoint.usageCache.SpaceUsedBySatellite(ctx, id)
+		if err != nil {
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		resp.Satellites = append(resp.Satellites, satellite)
+	}
+	return resp, nil
+}
+
+// statusName returns the name of the graceful exit status.
+func statusName(status satellites.Status) string {
+	switch status {
+	case satellites.Exiting:
+		return "exiting"
+	case satellites.ExitSucceeded:
+		return "exit-succeeded"
+	case satellites.ExitFailed:
+		return "exit-failed"
+	default:
+		return "normal"
+	}
+}
+
+// ListChores lists the chores which can be triggered.
+func (endpoint *Endpoint) ListChores(ctx context.Context, req *nodeadminpb.ListChoresRequest) (_ *nodeadminpb.ListChoresResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.mu.Lock()
+	defer endpoint.mu.Unlock()
+
+	resp := &nodeadminpb.ListChoresResponse{}
+	for _, chore := range endpoint.chores {
+		info := &nodeadminpb.Chore{
+			Name:     chore.Name,
+			Interval: int64(chore.Interval),
+		}
+		if last, ok := endpoint.triggered[chore.Name]; ok {
+			info.LastTriggered = last.at.Unix()
+			info.LastDuration = int64(last.duration)
+		}
+		resp.Chores = append(resp.Chores, info)
+	}
+	return resp, nil
+}
+
+// TriggerChore runs a chore and waits until the run completed.
+func (endpoint *Endpoint) TriggerChore(ctx context.Context, req *nodeadminpb.TriggerChoreRequest) (_ *nodeadminpb.TriggerChoreResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, chore := range endpoint.chores {
+		if chore.Name != req.Name {
+			continue
+		}
+
+		endpoint.log.Info("chore triggered", zap.String("Chore", chore.Name))
+		start := time.Now()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			chore.Trigger(ctx)
+		}()
+		select {
+		case <-done:
+		case <-ctx.Done():
+			// the chore keeps running, only waiting for it is canceled
+			return nil, rpcstatus.Error(rpcstatus.Canceled, ctx.Err().Error())
+		}
+		duration := time.Since(start)
+
+		endpoint.mu.Lock()
+		endpoint.triggered[chore.Name] = triggered{at: start, duration: duration}
+		endpoint.mu.Unlock()
+
+		return &nodeadminpb.TriggerChoreResponse{Duration: int64(duration)}, nil
+	}
+	return nil, rpcstatus.Errorf(rpcstatus.NotFound, "unknown chore %q", req.Name)
+}
+
+// RestoreTrash restores the trashed pieces of a satellite.
+func (endpoint *Endpoint) RestoreTrash(ctx context.Context, req *nodeadminpb.RestoreTrashRequest) (_ *nodeadminpb.RestoreTrashResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satelliteID, err := storj.NodeIDFromBytes(req.SatelliteId)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	endpoint.log.Info("restoring trash", zap.Stringer("Satellite ID", satelliteID))
+	if err := endpoint.store.RestoreTrash(ctx, satelliteID); err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	return &nodeadminpb.RestoreTrashResponse{}, nil
+}
+
+// ListPendingSatellites lists the satellites added to the trust lists, which
+// aren't trusted until they are accepted.
+func (endpoint *Endpoint) ListPendingSatellites(ctx context.Context, req *nodeadminpb.ListPendingSatellitesRequest) (_ *nodeadminpb.ListPendingSatellitesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	resp := &nodeadminpb.ListPendingSatellitesResponse{}
+	for _, entry := range endpoint.trust.PendingSatellites(ctx) {
+		resp.Satellites = append(resp.Satellites, &nodeadminpb.PendingSatellite{
+			Id:        entry.SatelliteURL.ID.Bytes(),
+			Address:   entry.SatelliteURL.Address(),
+			Source:    entry.Source,
+			SignedBy:  entry.SignedBy,
+			FetchedAt: entry.FetchedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+// AcceptSatellite trusts a pending satellite.
+func (endpoint *Endpoint) AcceptSatellite(ctx context.Context, req *nodeadminpb.AcceptSatelliteRequest) (_ *nodeadminpb.AcceptSatelliteResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satelliteID, err := storj.NodeIDFromBytes(req.SatelliteId)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	if err := endpoint.trust.AcceptSatellite(ctx, satelliteID); err != nil {
+		if trust.ErrNotPending.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	endpoint.log.Info("satellite accepted", zap.Stringer("Satellite ID", satelliteID))
+	return &nodeadminpb.AcceptSatelliteResponse{}, nil
+}
+
+// ListUntrustedSatellites lists the satellites which are no longer trusted,
+// with the cleanup state of their data.
+func (endpoint *Endpoint) ListUntrustedSatellites(ctx context.Context, req *nodeadminpb.ListUntrustedSatellitesRequest) (_ *nodeadminpb.ListUntrustedSatellitesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list, err := endpoint.untrusted.List(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	resp := &nodeadminpb.ListUntrustedSatellitesResponse{}
+	for _, satellite := range list {
+		info := &nodeadminpb.UntrustedSatellite{
+			Id:             satellite.SatelliteID.Bytes(),
+			State:          string(satellite.State),
+			UntrustedAt:    satellite.UntrustedAt.Unix(),
+			BytesReclaimed: satellite.BytesReclaimed,
+		}
+		if satellite.NextActionAt != nil {
+			info.NextActionAt = satellite.NextActionAt.Unix()
+		}
+		resp.Satellites = append(resp.Satellites, info)
+	}
+	return resp, nil
+}
+
+// PurgeSatellite deletes all data of an untrusted satellite right away.
+func (endpoint *Endpoint) PurgeSatellite(ctx context.Context, req *nodeadminpb.PurgeSatelliteRequest) (_ *nodeadminpb.PurgeSatelliteResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satelliteID, err := storj.NodeIDFromBytes(req.SatelliteId)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	deleted, err := endpoint.untrusted.Purge(ctx, satelliteID)
+	if err != nil {
+		if untrusted.ErrTrusted.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+		}
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	endpoint.log.Info("satellite data purged", zap.Stringer("Satellite ID", satelliteID), zap.Int64("bytes", deleted))
+	return &nodeadminpb.PurgeSatelliteResponse{BytesDeleted: deleted}, nil
+}
+
+// ListPieces lists the pieces stored for a satellite with their sizes.
+func (endpoint *Endpoint) ListPieces(ctx context.Context, req *nodeadminpb.ListPiecesRequest) (_ *nodeadminpb.ListPiecesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satelliteID, err := storj.NodeIDFromBytes(req.SatelliteId)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultPieceLimit
+	}
+
+	resp := &nodeadminpb.ListPiecesResponse{}
+	_, resp.TotalSize, err = endpoint.usageCache.SpaceUsedBySatellite(ctx, satelliteID)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	errLimit := errs.New("limit reached")
+	err = endpoint.store.WalkSatellitePieces(ctx, satelliteID, func(access pieces.StoredPieceAccess) error {
+		if len(resp.Pieces) >= limit {
+			resp.More = true
+			return errLimit
+		}
+
+		_, contentSize, err := access.Size(ctx)
+		if err != nil {
+			return err
+		}
+		modTime, err := access.ModTime(ctx)
+		if err != nil {
+			return err
+		}
+		pieceID := access.PieceID()
+		resp.Pieces = append(resp.Pieces, &nodeadminpb.Piece{
+			Id:       pieceID.Bytes(),
+			Size_:    contentSize,
+			StoredAt: modTime.Unix(),
+		})
+		return nil
+	})
+	if err != nil && !errs.Is(err, errLimit) {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+// ListRetainRequests lists the retain requests which are queued or being processed.
+func (endpoint *Endpoint) ListRetainRequests(ctx context.Context, req *nodeadminpb.ListRetainRequestsRequest) (_ *nodeadminpb.ListRetainRequestsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	status := endpoint.retain.Status()
+	resp := &nodeadminpb.ListRetainRequestsResponse{
+		Status: status.String(),
+	}
+	for _, request := range endpoint.retain.Queued() {
+		resp.Requests = append(resp.Requests, &nodeadminpb.RetainRequest{
+			SatelliteId:   request.SatelliteID.Bytes(),
+			CreatedBefore: request.CreatedBefore.Unix(),
+			Processing:    request.Processing,
+		})
+	}
+	return resp, nil
+}
+
+// Notify adds a notification to the dashboard of the node and delivers it
+// through the configured notification channels. The storage node updater uses
+// it to report updates which were rolled back.
+func (endpoint *Endpoint) Notify(ctx context.Context, req *nodeadminpb.NotifyRequest) (_ *nodeadminpb.NotifyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	typ, err := notifications.ParseType(req.Type)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+	if !notifyTypes.Contains(typ) {
+		return nil, rpcstatus.Errorf(rpcstatus.PermissionDenied, "notifications of type %q are only sent by the node", typ)
+	}
+
+	_, err = endpoint.notifications.Receive(ctx, notifications.NewNotification{
+		SenderID: endpoint.nodeID,
+		Type:     typ,
+		Title:    req.Title,
+		Message:  req.Message,
+	})
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	return &nodeadminpb.NotifyResponse{}, nil
+}
+
+// GetVersion returns the version of the running node. The storage node
+// updater uses it to verify the node runs the version it updated to.
+func (endpoint *Endpoint) GetVersion(ctx context.Context, req *nodeadminpb.GetVersionRequest) (_ *nodeadminpb.GetVersionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return &nodeadminpb.GetVersionResponse{
+		Version:    endpoint.version.Version.String(),
+		CommitHash: endpoint.version.CommitHash,
+		Release:    endpoint.version.Release,
+	}, nil
+}
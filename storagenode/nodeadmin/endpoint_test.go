@@ -0,0 +1,177 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeadmin_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/bloomfilter"
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/nodeadminpb"
+	"storj.io/storj/private/version"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/nodeadmin"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/retain"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestEndpoint(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		usageCache := pieces.NewBlobsUsageCache(log, db.Pieces())
+		store := pieces.NewStore(log, usageCache, db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), db.PieceIndex())
+		retainService := retain.NewService(log, store, retain.Config{Status: retain.Enabled, Concurrency: 1})
+
+		notificationService := notifications.NewService(log, db.Notifications())
+
+		triggers := 0
+		endpoint := nodeadmin.NewEndpoint(log, testrand.NodeID(), version.Info{}, nil, db.Satellites(), store, usageCache, retainService, nil, notificationService, []nodeadmin.Chore{
+			{Name: "test", Interval: time.Hour, Trigger: func(context.Context) { triggers++ }},
+		})
+
+		t.Run("chores", func(t *testing.T) {
+			chores, err := endpoint.ListChores(ctx, &nodeadminpb.ListChoresRequest{})
+			require.NoError(t, err)
+			require.Len(t, chores.Chores, 1)
+			assert.Equal(t, "test", chores.Chores[0].Name)
+			assert.Equal(t, int64(time.Hour), chores.Chores[0].Interval)
+			assert.Zero(t, chores.Chores[0].LastTriggered)
+
+			_, err = endpoint.TriggerChore(ctx, &nodeadminpb.TriggerChoreRequest{Name: "unknown"})
+			require.Error(t, err)
+			assert.Equal(t, rpcstatus.NotFound, rpcstatus.Code(err))
+
+			_, err = endpoint.TriggerChore(ctx, &nodeadminpb.TriggerChoreRequest{Name: "test"})
+			require.NoError(t, err)
+			assert.Equal(t, 1, triggers)
+
+			chores, err = endpoint.ListChores(ctx, &nodeadminpb.ListChoresRequest{})
+			require.NoError(t, err)
+			assert.NotZero(t, chores.Chores[0].LastTriggered)
+
+			// waiting for a chore stops when the request is canceled
+			release := make(chan struct{})
+			defer close(release)
+			blocking := nodeadmin.NewEndpoint(log, testrand.NodeID(), version.Info{}, nil, db.Satellites(), store, usageCache, retainService, nil, notificationService, []nodeadmin.Chore{
+				{Name: "blocking", Interval: time.Hour, Trigger: func(context.Context) { <-release }},
+			})
+			triggerCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+			_, err = blocking.TriggerChore(triggerCtx, &nodeadminpb.TriggerChoreRequest{Name: "blocking"})
+			require.Error(t, err)
+			assert.Equal(t, rpcstatus.Canceled, rpcstatus.Code(err))
+		})
+
+		t.Run("pieces and trash", func(t *testing.T) {
+			satellite := testrand.NodeID()
+			var ids []storj.PieceID
+			for i := 0; i < 3; i++ {
+				pieceID := testrand.PieceID()
+				writer, err := store.Writer(ctx, satellite, pieceID)
+				require.NoError(t, err)
+				_, err = writer.Write(testrand.Bytes(memory.KiB))
+				require.NoError(t, err)
+				require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+				ids = append(ids, pieceID)
+			}
+
+			resp, err := endpoint.ListPieces(ctx, &nodeadminpb.ListPiecesRequest{SatelliteId: satellite.Bytes(), Limit: 2})
+			require.NoError(t, err)
+			assert.Len(t, resp.Pieces, 2)
+			assert.True(t, resp.More)
+			assert.Equal(t, 3*memory.KiB.Int64(), resp.TotalSize)
+			assert.Equal(t, memory.KiB.Int64(), resp.Pieces[0].Size_)
+
+			// the response survives the wire
+			data, err := proto.Marshal(resp)
+			require.NoError(t, err)
+			decoded := &nodeadminpb.ListPiecesResponse{}
+			require.NoError(t, proto.Unmarshal(data, decoded))
+			assert.True(t, proto.Equal(resp, decoded))
+
+			require.NoError(t, store.Trash(ctx, satellite, ids[0]))
+			resp, err = endpoint.ListPieces(ctx, &nodeadminpb.ListPiecesRequest{SatelliteId: satellite.Bytes()})
+			require.NoError(t, err)
+			assert.Len(t, resp.Pieces, 2)
+			assert.False(t, resp.More)
+
+			_, err = endpoint.RestoreTrash(ctx, &nodeadminpb.RestoreTrashRequest{SatelliteId: []byte{1, 2, 3}})
+			require.Error(t, err)
+			assert.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err))
+
+			_, err = endpoint.RestoreTrash(ctx, &nodeadminpb.RestoreTrashRequest{SatelliteId: satellite.Bytes()})
+			require.NoError(t, err)
+			resp, err = endpoint.ListPieces(ctx, &nodeadminpb.ListPiecesRequest{SatelliteId: satellite.Bytes()})
+			require.NoError(t, err)
+			assert.Len(t, resp.Pieces, 3)
+		})
+
+		t.Run("retain queue", func(t *testing.T) {
+			satellite := testrand.NodeID()
+			createdBefore := time.Now().Truncate(time.Second)
+			require.True(t, retainService.Queue(retain.Request{
+				SatelliteID:   satellite,
+				CreatedBefore: createdBefore,
+				Filter:        bloomfilter.NewOptimal(10, 0.1),
+			}))
+
+			resp, err := endpoint.ListRetainRequests(ctx, &nodeadminpb.ListRetainRequestsRequest{})
+			require.NoError(t, err)
+			assert.Equal(t, "enabled", resp.Status)
+			require.Len(t, resp.Requests, 1)
+			assert.Equal(t, satellite.Bytes(), resp.Requests[0].SatelliteId)
+			assert.Equal(t, createdBefore.Unix(), resp.Requests[0].CreatedBefore)
+			assert.False(t, resp.Requests[0].Processing)
+		})
+
+		t.Run("notify", func(t *testing.T) {
+			_, err := endpoint.Notify(ctx, &nodeadminpb.NotifyRequest{Type: "unknown"})
+			require.Error(t, err)
+			assert.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err))
+
+			// only the node itself reports its reputation
+			_, err = endpoint.Notify(ctx, &nodeadminpb.NotifyRequest{Type: "disqualification"})
+			require.Error(t, err)
+			assert.Equal(t, rpcstatus.PermissionDenied, rpcstatus.Code(err))
+
+			_, err = endpoint.Notify(ctx, &nodeadminpb.NotifyRequest{
+				Type:    "update-failed",
+				Title:   "Update failed",
+				Message: "message",
+			})
+			require.NoError(t, err)
+
+			page, err := notificationService.List(ctx, notifications.Cursor{Limit: 10, Page: 1})
+			require.NoError(t, err)
+			require.Len(t, page.Notifications, 1)
+			assert.Equal(t, notifications.TypeUpdateFailed, page.Notifications[0].Type)
+			assert.Equal(t, "Update failed", page.Notifications[0].Title)
+		})
+
+		t.Run("version", func(t *testing.T) {
+			semver, err := version.NewSemVer("v1.2.3")
+			require.NoError(t, err)
+
+			versioned := nodeadmin.NewEndpoint(log, testrand.NodeID(), version.Info{Version: semver, Release: true}, nil, db.Satellites(), store, usageCache, retainService, nil, notificationService, nil)
+			resp, err := versioned.GetVersion(ctx, &nodeadminpb.GetVersionRequest{})
+			require.NoError(t, err)
+			assert.Equal(t, "v1.2.3", resp.Version)
+			assert.True(t, resp.Release)
+		})
+	})
+}
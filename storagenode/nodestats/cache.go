@@ -5,6 +5,7 @@ package nodestats
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"time"
 
@@ -15,6 +16,8 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/storj/private/date"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/payouts"
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/storageusage"
 	"storj.io/storj/storagenode/trust"
@@ -30,12 +33,16 @@ type Config struct {
 	MaxSleep       time.Duration `help:"maximum duration to wait before requesting data" releaseDefault:"300s" devDefault:"1s"`
 	ReputationSync time.Duration `help:"how often to sync reputation" releaseDefault:"4h" devDefault:"1m"`
 	StorageSync    time.Duration `help:"how often to sync storage" releaseDefault:"12h" devDefault:"2m"`
+	PayoutSync     time.Duration `help:"how often to sync payout statements" releaseDefault:"12h" devDefault:"2m"`
+
+	NotifyAuditScore float64 `help:"notify the operator when the audit score on a satellite drops below it, 0 disables the notification" default:"0.8"`
 }
 
 // CacheStorage encapsulates cache DBs
 type CacheStorage struct {
 	Reputation   reputation.DB
 	StorageUsage storageusage.DB
+	Payouts      payouts.DB
 }
 
 // Cache runs cache loop and stores reputation stats
@@ -45,25 +52,32 @@ type CacheStorage struct {
 type Cache struct {
 	log *zap.Logger
 
-	db      CacheStorage
-	service *Service
-	trust   *trust.Pool
+	db            CacheStorage
+	service       *Service
+	trust         *trust.Pool
+	notifications *notifications.Service
+
+	notifyAuditScore float64
 
 	maxSleep   time.Duration
 	Reputation *sync2.Cycle
 	Storage    *sync2.Cycle
+	Payouts    *sync2.Cycle
 }
 
 // NewCache creates new caching service instance
-func NewCache(log *zap.Logger, config Config, db CacheStorage, service *Service, trust *trust.Pool) *Cache {
+func NewCache(log *zap.Logger, config Config, db CacheStorage, service *Service, trust *trust.Pool, notifications *notifications.Service) *Cache {
 	return &Cache{
-		log:        log,
-		db:         db,
-		service:    service,
-		trust:      trust,
-		maxSleep:   config.MaxSleep,
-		Reputation: sync2.NewCycle(config.ReputationSync),
-		Storage:    sync2.NewCycle(config.StorageSync),
+		log:              log,
+		db:               db,
+		service:          service,
+		trust:            trust,
+		notifications:    notifications,
+		notifyAuditScore: config.NotifyAuditScore,
+		maxSleep:         config.MaxSleep,
+		Reputation:       sync2.NewCycle(config.ReputationSync),
+		Storage:          sync2.NewCycle(config.StorageSync),
+		Payouts:          sync2.NewCycle(config.PayoutSync),
 	}
 }
 
@@ -95,6 +109,18 @@ func (cache *Cache) Run(ctx context.Context) error {
 
 		return nil
 	})
+	cache.Payouts.Start(ctx, &group, func(ctx context.Context) error {
+		if err := cache.sleep(ctx); err != nil {
+			return err
+		}
+
+		err := cache.CachePayoutStatements(ctx)
+		if err != nil {
+			cache.log.Error("Get payout statements query failed", zap.Error(err))
+		}
+
+		return nil
+	})
 
 	return group.Wait()
 }
@@ -110,14 +136,70 @@ func (cache *Cache) CacheReputationStats(ctx context.Context) (err error) {
 			return err
 		}
 
+		previous, err := cache.db.Reputation.Get(ctx, satellite)
+		if err != nil {
+			return err
+		}
+
+		// satellites which don't serve the suspension yet keep the last known state
+		stats.UnknownAudit, stats.Suspended, err = cache.service.GetSuspension(ctx, satellite)
+		if err != nil {
+			cache.log.Warn("Get suspension query failed", zap.Stringer("Satellite ID", satellite), zap.Error(err))
+			stats.UnknownAudit, stats.Suspended = previous.UnknownAudit, previous.Suspended
+			if previous.UpdatedAt.IsZero() {
+				stats.UnknownAudit.Score = 1
+			}
+		}
+
 		if err = cache.db.Reputation.Store(ctx, *stats); err != nil {
 			return err
 		}
 
+		cache.notifyReputation(ctx, previous, stats)
 		return nil
 	})
 }
 
+// notifyReputation notifies the operator when the node got disqualified or
+// suspended, or its audit score dropped below the threshold since the
+// previous stats.
+func (cache *Cache) notifyReputation(ctx context.Context, previous, stats *reputation.Stats) {
+	if stats.Disqualified != nil && previous.Disqualified == nil {
+		cache.notifications.Notify(ctx, notifications.NewNotification{
+			SenderID: stats.SatelliteID,
+			Type:     notifications.TypeDisqualification,
+			Title:    "Disqualified",
+			Message:  fmt.Sprintf("The node was disqualified on satellite %s at %s.", stats.SatelliteID, stats.Disqualified.Format(time.RFC1123)),
+		})
+		return
+	}
+
+	if stats.Suspended != nil && previous.Suspended == nil {
+		cache.notifications.Notify(ctx, notifications.NewNotification{
+			SenderID: stats.SatelliteID,
+			Type:     notifications.TypeSuspension,
+			Title:    "Suspended",
+			Message: fmt.Sprintf("The node was suspended on satellite %s at %s, because too many audits had an unknown result (score %.1f%%).",
+				stats.SatelliteID, stats.Suspended.Format(time.RFC1123), stats.UnknownAudit.Score*100),
+		})
+	}
+
+	// stats which were never synced have a zero score, which isn't a drop
+	previousScore := previous.Audit.Score
+	if previous.UpdatedAt.IsZero() {
+		previousScore = 1
+	}
+	if stats.Audit.Score < cache.notifyAuditScore && previousScore >= cache.notifyAuditScore {
+		cache.notifications.Notify(ctx, notifications.NewNotification{
+			SenderID: stats.SatelliteID,
+			Type:     notifications.TypeLowAuditScore,
+			Title:    "Audit score dropped",
+			Message: fmt.Sprintf("The audit score on satellite %s dropped to %.1f%%, below %.1f%%. The node is disqualified when it keeps failing audits.",
+				stats.SatelliteID, stats.Audit.Score*100, cache.notifyAuditScore*100),
+		})
+	}
+}
+
 // CacheSpaceUsage queries disk space usage from all the satellites
 // known to the storagenode and stores information into db
 func (cache *Cache) CacheSpaceUsage(ctx context.Context) (err error) {
@@ -141,6 +223,38 @@ func (cache *Cache) CacheSpaceUsage(ctx context.Context) (err error) {
 	})
 }
 
+// CachePayoutStatements queries payout statements, which are not stored yet,
+// from all the satellites known to the storagenode and stores them into db
+func (cache *Cache) CachePayoutStatements(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return cache.satelliteLoop(ctx, func(satellite storj.NodeID) error {
+		stored, err := cache.db.Payouts.AllSatellite(ctx, satellite)
+		if err != nil {
+			return err
+		}
+
+		// the latest statement is fetched again in case the satellite corrected it
+		var since time.Time
+		if len(stored) > 0 {
+			since = stored[len(stored)-1].Period
+		}
+
+		statements, err := cache.service.GetPayoutStatements(ctx, satellite, since)
+		if err != nil {
+			return err
+		}
+
+		for _, statement := range statements {
+			if err = cache.db.Payouts.Store(ctx, statement); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
 // sleep for random interval in [0;maxSleep)
 // returns error if context was cancelled
 func (cache *Cache) sleep(ctx context.Context) error {
@@ -178,5 +292,6 @@ func (cache *Cache) Close() error {
 	defer mon.Task()(nil)(nil)
 	cache.Reputation.Close()
 	cache.Storage.Close()
+	cache.Payouts.Close()
 	return nil
 }
@@ -14,6 +14,8 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
+	"storj.io/storj/private/nodestatspb"
+	"storj.io/storj/storagenode/payouts"
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/storageusage"
 	"storj.io/storj/storagenode/trust"
@@ -32,6 +34,8 @@ var (
 type Client struct {
 	conn *rpc.Conn
 	pb.DRPCNodeStatsClient
+	nodestatspb.DRPCPayoutsClient
+	nodestatspb.DRPCSuspensionClient
 }
 
 // Close closes underlying client connection
@@ -97,6 +101,29 @@ func (s *Service) GetReputationStats(ctx context.Context, satelliteID storj.Node
 	}, nil
 }
 
+// GetSuspension retrieves the unknown audit reputation and suspension status
+// from particular satellite.
+func (s *Service) GetSuspension(ctx context.Context, satelliteID storj.NodeID) (_ reputation.Metric, _ *time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, err := s.dial(ctx, satelliteID)
+	if err != nil {
+		return reputation.Metric{}, nil, NodeStatsServiceErr.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	resp, err := client.GetSuspension(ctx, &nodestatspb.GetSuspensionRequest{})
+	if err != nil {
+		return reputation.Metric{}, nil, NodeStatsServiceErr.Wrap(err)
+	}
+
+	return reputation.Metric{
+		Alpha: resp.GetUnknownAuditReputationAlpha(),
+		Beta:  resp.GetUnknownAuditReputationBeta(),
+		Score: resp.GetUnknownAuditReputationScore(),
+	}, resp.GetSuspended(), nil
+}
+
 // GetDailyStorageUsage returns daily storage usage over a period of time for a particular satellite
 func (s *Service) GetDailyStorageUsage(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (_ []storageusage.Stamp, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -115,6 +142,24 @@ func (s *Service) GetDailyStorageUsage(ctx context.Context, satelliteID storj.No
 	return fromSpaceUsageResponse(resp, satelliteID), nil
 }
 
+// GetPayoutStatements returns payout statements since a period for a particular satellite
+func (s *Service) GetPayoutStatements(ctx context.Context, satelliteID storj.NodeID, since time.Time) (_ []payouts.Statement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, err := s.dial(ctx, satelliteID)
+	if err != nil {
+		return nil, NodeStatsServiceErr.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	resp, err := client.PayoutStatements(ctx, &nodestatspb.PayoutStatementsRequest{Since: since})
+	if err != nil {
+		return nil, NodeStatsServiceErr.Wrap(err)
+	}
+
+	return fromPayoutStatementsResponse(resp, satelliteID), nil
+}
+
 // dial dials the NodeStats client for the satellite by id
 func (s *Service) dial(ctx context.Context, satelliteID storj.NodeID) (_ *Client, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -130,8 +175,10 @@ func (s *Service) dial(ctx context.Context, satelliteID storj.NodeID) (_ *Client
 	}
 
 	return &Client{
-		conn:                conn,
-		DRPCNodeStatsClient: pb.NewDRPCNodeStatsClient(conn.Raw()),
+		conn:                 conn,
+		DRPCNodeStatsClient:  pb.NewDRPCNodeStatsClient(conn.Raw()),
+		DRPCPayoutsClient:    nodestatspb.NewDRPCPayoutsClient(conn.Raw()),
+		DRPCSuspensionClient: nodestatspb.NewDRPCSuspensionClient(conn.Raw()),
 	}, nil
 }
 
@@ -149,3 +196,36 @@ func fromSpaceUsageResponse(resp *pb.DailyStorageUsageResponse, satelliteID stor
 
 	return stamps
 }
+
+// fromPayoutStatementsResponse get payout statements from nodestatspb.PayoutStatementsResponse
+func fromPayoutStatementsResponse(resp *nodestatspb.PayoutStatementsResponse, satelliteID storj.NodeID) []payouts.Statement {
+	var statements []payouts.Statement
+
+	for _, pbStatement := range resp.GetStatements() {
+		statements = append(statements, payouts.Statement{
+			SatelliteID:    satelliteID,
+			Period:         pbStatement.Period,
+			CreatedAt:      pbStatement.CreatedAt,
+			NodeAgeMonths:  int(pbStatement.NodeAgeMonths),
+			UsageAtRest:    pbStatement.UsageAtRest,
+			UsagePut:       pbStatement.UsagePut,
+			UsageGet:       pbStatement.UsageGet,
+			UsagePutRepair: pbStatement.UsagePutRepair,
+			UsageGetRepair: pbStatement.UsageGetRepair,
+			UsageGetAudit:  pbStatement.UsageGetAudit,
+			CompAtRest:     pbStatement.CompAtRest,
+			CompPut:        pbStatement.CompPut,
+			CompGet:        pbStatement.CompGet,
+			CompPutRepair:  pbStatement.CompPutRepair,
+			CompGetRepair:  pbStatement.CompGetRepair,
+			CompGetAudit:   pbStatement.CompGetAudit,
+			HeldPercent:    int(pbStatement.HeldPercent),
+			Held:           pbStatement.Held,
+			Disposed:       pbStatement.Disposed,
+			Owed:           pbStatement.Owed,
+			GracefulExit:   pbStatement.GracefulExit,
+		})
+	}
+
+	return statements
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// Channel delivers notifications to the operator outside of the dashboard.
+type Channel interface {
+	// Name returns the name of the channel, used in logs.
+	Name() string
+	// Send delivers the notification.
+	Send(ctx context.Context, notification Notification) error
+}
+
+// Types is a set of notification types that implements pflag.Value. An empty
+// set contains all types.
+type Types []Type
+
+// Contains returns whether the set contains the notification type.
+func (types Types) Contains(t Type) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, typ := range types {
+		if typ == t {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the comma separated names of the types.
+func (types Types) String() string {
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		names = append(names, t.String())
+	}
+	return strings.Join(names, ",")
+}
+
+// Set implements pflag.Value by parsing a comma separated list of type names.
+func (types *Types) Set(value string) error {
+	var toSet Types
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		t, err := ParseType(name)
+		if err != nil {
+			return err
+		}
+		toSet = append(toSet, t)
+	}
+	*types = toSet
+	return nil
+}
+
+// Type returns the type of the pflag.Value.
+func (types Types) Type() string {
+	return "notification-types"
+}
+
+// Route delivers the notifications of the selected types over a channel.
+type Route struct {
+	Channel Channel
+	Types   Types
+}
+
+// Config defines the channels notifications are delivered over, in addition
+// to the dashboard.
+type Config struct {
+	Email   EmailConfig
+	Webhook WebhookConfig
+	Script  ScriptConfig
+}
+
+// NewRoutes creates the routes for the channels enabled in the config.
+func NewRoutes(log *zap.Logger, config Config) (routes []Route, err error) {
+	if config.Email.ServerAddress != "" {
+		channel, err := NewEmailChannel(config.Email)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, Route{Channel: channel, Types: config.Email.Types})
+	}
+	if config.Webhook.URL != "" {
+		channel, err := NewWebhookChannel(config.Webhook)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, Route{Channel: channel, Types: config.Webhook.Types})
+	}
+	if config.Script.Path != "" {
+		routes = append(routes, Route{Channel: NewScriptChannel(config.Script), Types: config.Script.Types})
+	}
+
+	for _, route := range routes {
+		log.Info("Delivering notifications", zap.String("channel", route.Channel.Name()), zap.Stringer("types", route.Types))
+	}
+	return routes, nil
+}
+
+// payload is the JSON representation of a notification delivered to webhooks
+// and scripts.
+type payload struct {
+	Notification
+	TypeName string `json:"typeName"`
+}
+
+func newPayload(notification Notification) payload {
+	return payload{
+		Notification: notification,
+		TypeName:     notification.Type.String(),
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestTypes(t *testing.T) {
+	var types notifications.Types
+	require.NoError(t, types.Set(""))
+	assert.True(t, types.Contains(notifications.TypeDiskSpace))
+
+	require.NoError(t, types.Set("disqualification, disk-space"))
+	assert.Equal(t, notifications.Types{notifications.TypeDisqualification, notifications.TypeDiskSpace}, types)
+	assert.Equal(t, "disqualification,disk-space", types.String())
+	assert.True(t, types.Contains(notifications.TypeDiskSpace))
+	assert.False(t, types.Contains(notifications.TypeSatelliteOffline))
+
+	require.Error(t, types.Set("disqualification,unknown"))
+}
+
+type recordingChannel struct {
+	mu       sync.Mutex
+	received []notifications.Notification
+	done     chan struct{}
+}
+
+func (channel *recordingChannel) Name() string { return "recording" }
+
+func (channel *recordingChannel) Send(ctx context.Context, notification notifications.Notification) error {
+	channel.mu.Lock()
+	defer channel.mu.Unlock()
+	channel.received = append(channel.received, notification)
+	channel.done <- struct{}{}
+	return nil
+}
+
+func TestServiceRoutes(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		all := &recordingChannel{done: make(chan struct{}, 10)}
+		disk := &recordingChannel{done: make(chan struct{}, 10)}
+
+		service := notifications.NewService(zaptest.NewLogger(t), db.Notifications(),
+			notifications.Route{Channel: all},
+			notifications.Route{Channel: disk, Types: notifications.Types{notifications.TypeDiskSpace}},
+		)
+		runCtx, cancel := context.WithCancel(ctx)
+		ctx.Go(func() error { return service.Run(runCtx) })
+		defer cancel()
+
+		for _, typ := range []notifications.Type{notifications.TypeSatelliteOffline, notifications.TypeDiskSpace} {
+			_, err := service.Receive(ctx, notifications.NewNotification{
+				SenderID: testrand.NodeID(),
+				Type:     typ,
+				Title:    typ.String(),
+				Message:  "message",
+			})
+			require.NoError(t, err)
+		}
+
+		<-all.done
+		<-all.done
+		<-disk.done
+
+		all.mu.Lock()
+		require.Len(t, all.received, 2)
+		assert.Equal(t, notifications.TypeSatelliteOffline, all.received[0].Type)
+		assert.Equal(t, notifications.TypeDiskSpace, all.received[1].Type)
+		all.mu.Unlock()
+
+		disk.mu.Lock()
+		require.Len(t, disk.received, 1)
+		assert.Equal(t, notifications.TypeDiskSpace, disk.received[0].Type)
+		disk.mu.Unlock()
+
+		// the notifications are shown on the dashboard as well
+		count, err := service.UnreadAmount(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+}
+
+type failingChannel struct {
+	recordingChannel
+	failures int
+	failed   chan struct{}
+}
+
+func (channel *failingChannel) Send(ctx context.Context, notification notifications.Notification) error {
+	channel.mu.Lock()
+	if channel.failures > 0 {
+		channel.failures--
+		channel.mu.Unlock()
+		channel.failed <- struct{}{}
+		return errs.New("channel unavailable")
+	}
+	channel.mu.Unlock()
+	return channel.recordingChannel.Send(ctx, notification)
+}
+
+func TestServiceRetry(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		working := &recordingChannel{done: make(chan struct{}, 10)}
+		failing := &failingChannel{
+			recordingChannel: recordingChannel{done: make(chan struct{}, 10)},
+			failures:         1,
+			failed:           make(chan struct{}, 10),
+		}
+
+		service := notifications.NewService(zaptest.NewLogger(t), db.Notifications(),
+			notifications.Route{Channel: working},
+			notifications.Route{Channel: failing, Types: notifications.Types{notifications.TypeDisqualification}},
+		)
+		runCtx, cancel := context.WithCancel(ctx)
+		ctx.Go(func() error { return service.Run(runCtx) })
+		defer cancel()
+		defer ctx.Check(service.Close)
+
+		notification, err := service.Receive(ctx, notifications.NewNotification{
+			SenderID: testrand.NodeID(),
+			Type:     notifications.TypeDisqualification,
+			Title:    "Disqualified",
+			Message:  "message",
+		})
+		require.NoError(t, err)
+
+		<-working.done
+		<-failing.failed
+
+		// the failed channel gets the notification with the retry
+		service.Retry.TriggerWait()
+		<-failing.done
+
+		// the delivery is sequential, so the first notification was marked
+		// as delivered once the second one arrives
+		_, err = service.Receive(ctx, notifications.NewNotification{
+			SenderID: testrand.NodeID(),
+			Type:     notifications.TypeDiskSpace,
+			Title:    "Disk space",
+			Message:  "message",
+		})
+		require.NoError(t, err)
+		<-working.done
+
+		working.mu.Lock()
+		require.Len(t, working.received, 2)
+		assert.Equal(t, notification.ID, working.received[0].ID)
+		working.mu.Unlock()
+
+		failing.mu.Lock()
+		require.Len(t, failing.received, 1)
+		assert.Equal(t, notification.ID, failing.received[0].ID)
+		failing.mu.Unlock()
+
+		undelivered, err := db.Notifications().ListUndelivered(ctx, notification.CreatedAt.Add(-time.Minute), 10)
+		require.NoError(t, err)
+		for _, pending := range undelivered {
+			assert.NotEqual(t, notification.ID, pending.ID)
+		}
+	})
+}
+
+func TestWebhookChannel(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	received := make(chan map[string]interface{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- body
+	}))
+	defer server.Close()
+
+	_, err := notifications.NewWebhookChannel(notifications.WebhookConfig{URL: "ftp://example.test"})
+	require.Error(t, err)
+
+	channel, err := notifications.NewWebhookChannel(notifications.WebhookConfig{URL: server.URL})
+	require.NoError(t, err)
+
+	require.NoError(t, channel.Send(ctx, notifications.Notification{
+		Type:    notifications.TypeDisqualification,
+		Title:   "Disqualified",
+		Message: "message",
+	}))
+
+	body := <-received
+	assert.Equal(t, "disqualification", body["typeName"])
+	assert.Equal(t, "Disqualified", body["title"])
+	assert.Equal(t, "message", body["message"])
+}
+
+func TestScriptChannel(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test script is a shell script")
+	}
+
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	output := ctx.File("output")
+	script := ctx.File("notify.sh")
+	require.NoError(t, ioutil.WriteFile(script, []byte("#!/bin/sh\necho \"$NOTIFICATION_TYPE $NOTIFICATION_TITLE\" > "+output+"\ncat >> "+output+"\n"), 0700))
+
+	channel := notifications.NewScriptChannel(notifications.ScriptConfig{Path: script})
+	require.NoError(t, channel.Send(ctx, notifications.Notification{
+		Type:    notifications.TypeSatelliteOffline,
+		Title:   "Satellite unreachable",
+		Message: "message",
+	}))
+
+	data, err := ioutil.ReadFile(output)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "satellite-offline Satellite unreachable\n")
+	assert.Contains(t, string(data), `"typeName":"satellite-offline"`)
+
+	failing := notifications.NewScriptChannel(notifications.ScriptConfig{Path: ctx.File("missing.sh")})
+	require.Error(t, failing.Send(ctx, notifications.Notification{}))
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+
+	"storj.io/storj/private/post"
+)
+
+// EmailConfig defines the SMTP server notifications are emailed through.
+type EmailConfig struct {
+	ServerAddress string `help:"SMTP server address notifications are emailed through, empty disables email notifications" default:""`
+	Login         string `help:"SMTP login" default:""`
+	Password      string `help:"SMTP password" default:""`
+	From          string `help:"sender email address of notifications" default:""`
+	To            string `help:"comma separated recipients of notification emails" default:""`
+	Types         Types  `help:"comma separated notification types which are emailed, empty for all types" default:""`
+}
+
+// EmailChannel emails notifications through an SMTP server.
+type EmailChannel struct {
+	sender *post.SMTPSender
+	to     []post.Address
+}
+
+// NewEmailChannel creates a new email channel.
+func NewEmailChannel(config EmailConfig) (*EmailChannel, error) {
+	host, _, err := net.SplitHostPort(config.ServerAddress)
+	if err != nil {
+		return nil, Error.New("invalid SMTP server address %q: %w", config.ServerAddress, err)
+	}
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, Error.New("invalid sender address %q: %w", config.From, err)
+	}
+	to, err := mail.ParseAddressList(config.To)
+	if err != nil {
+		return nil, Error.New("invalid recipients %q: %w", config.To, err)
+	}
+
+	channel := &EmailChannel{
+		sender: &post.SMTPSender{
+			ServerAddress: config.ServerAddress,
+			From:          *from,
+			Auth:          smtp.PlainAuth("", config.Login, config.Password, host),
+		},
+	}
+	for _, address := range to {
+		channel.to = append(channel.to, *address)
+	}
+	return channel, nil
+}
+
+// Name returns the name of the channel.
+func (channel *EmailChannel) Name() string { return "email" }
+
+// Send emails the notification to the recipients.
+func (channel *EmailChannel) Send(ctx context.Context, notification Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	msg := &post.Message{
+		From:      channel.sender.From,
+		To:        channel.to,
+		Subject:   fmt.Sprintf("Storage node: %s", notification.Title),
+		Date:      notification.CreatedAt,
+		PlainText: notification.Message,
+	}
+	return Error.Wrap(channel.sender.SendEmail(ctx, msg))
+}
@@ -5,6 +5,7 @@ package notifications
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
@@ -21,6 +22,11 @@ type DB interface {
 	Read(ctx context.Context, notificationID uuid.UUID) error
 	ReadAll(ctx context.Context) error
 	UnreadAmount(ctx context.Context) (int, error)
+	// ListUndelivered returns the notifications created since the given time
+	// which weren't delivered over all their channels yet.
+	ListUndelivered(ctx context.Context, since time.Time, limit int) ([]Notification, error)
+	// MarkDelivered marks the notification as delivered over all its channels.
+	MarkDelivered(ctx context.Context, notificationID uuid.UUID, deliveredAt time.Time) error
 }
 
 // Type is a numeric value of specific notification type.
@@ -36,8 +42,49 @@ const (
 	TypeUptimeCheckFailure Type = 2
 	// TypeDisqualification is a notification type which describes node's disqualification status.
 	TypeDisqualification Type = 3
+	// TypeLowAuditScore is a notification type which describes node's audit score dropping below the threshold.
+	TypeLowAuditScore Type = 4
+	// TypeSatelliteOffline is a notification type which describes a satellite the node can't check in with.
+	TypeSatelliteOffline Type = 5
+	// TypeDiskSpace is a notification type which describes the allocated disk space being nearly full.
+	TypeDiskSpace Type = 6
+	// TypeUpdateFailed is a notification type which describes an update of the node which was rolled back.
+	TypeUpdateFailed Type = 7
+	// TypeSuspension is a notification type which describes node's suspension status.
+	TypeSuspension Type = 8
 )
 
+// typeNames are the names of the notification types used in the configuration.
+var typeNames = map[Type]string{
+	TypeCustom:             "custom",
+	TypeAuditCheckFailure:  "audit-check-failure",
+	TypeUptimeCheckFailure: "uptime-check-failure",
+	TypeDisqualification:   "disqualification",
+	TypeLowAuditScore:      "low-audit-score",
+	TypeSatelliteOffline:   "satellite-offline",
+	TypeDiskSpace:          "disk-space",
+	TypeUpdateFailed:       "update-failed",
+	TypeSuspension:         "suspension",
+}
+
+// String returns the name of the notification type.
+func (t Type) String() string {
+	if name, ok := typeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("type-%d", int(t))
+}
+
+// ParseType parses the name of a notification type.
+func ParseType(name string) (Type, error) {
+	for t, typeName := range typeNames {
+		if typeName == name {
+			return t, nil
+		}
+	}
+	return 0, Error.New("unknown notification type %q", name)
+}
+
 // NewNotification holds notification entity info which is being received from satellite or local client.
 type NewNotification struct {
 	SenderID storj.NodeID
@@ -0,0 +1,59 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// ScriptConfig defines the local script notifications are passed to.
+type ScriptConfig struct {
+	Path  string `help:"path of a script which is run for every notification, empty disables the script" default:""`
+	Types Types  `help:"comma separated notification types which are passed to the script, empty for all types" default:""`
+}
+
+// ScriptChannel runs a local script for every notification. The notification
+// is written as JSON to the standard input of the script and its type, title
+// and message are passed in the NOTIFICATION_TYPE, NOTIFICATION_TITLE and
+// NOTIFICATION_MESSAGE environment variables.
+type ScriptChannel struct {
+	path string
+}
+
+// NewScriptChannel creates a new script channel.
+func NewScriptChannel(config ScriptConfig) *ScriptChannel {
+	return &ScriptChannel{path: config.Path}
+}
+
+// Name returns the name of the channel.
+func (channel *ScriptChannel) Name() string { return "script" }
+
+// Send runs the script for the notification.
+func (channel *ScriptChannel) Send(ctx context.Context, notification Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	input, err := json.Marshal(newPayload(notification))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	cmd := exec.CommandContext(ctx, channel.path)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Env = append(os.Environ(),
+		"NOTIFICATION_TYPE="+notification.Type.String(),
+		"NOTIFICATION_TITLE="+notification.Title,
+		"NOTIFICATION_MESSAGE="+notification.Message,
+	)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return Error.New("script %q failed: %w: %s", channel.path, err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
@@ -5,28 +5,192 @@ package notifications
 
 import (
 	"context"
+	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/errs2"
+	"storj.io/common/sync2"
 )
 
 var (
+	// Error is the default error class for notifications.
+	Error = errs.Class("notifications")
+
 	mon = monkit.Package()
 )
 
+const (
+	// queueSize is the number of notifications waiting for delivery over the
+	// channels, further notifications are delivered by the next retry.
+	queueSize = 100
+	// sendTimeout is how long delivering a notification over a channel may take.
+	sendTimeout = time.Minute
+	// retryInterval is how often the delivery of undelivered notifications is retried.
+	retryInterval = 10 * time.Minute
+	// retryWindow is how long the delivery of a notification is retried,
+	// before it's only shown on the dashboard.
+	retryWindow = 24 * time.Hour
+)
+
 // Service is the notification service between storage nodes and satellites.
 // architecture: Service
 type Service struct {
-	log *zap.Logger
-	db  DB
+	log    *zap.Logger
+	db     DB
+	routes []Route
+	queue  chan Notification
+
+	// Retry queues the notifications which weren't delivered over all
+	// their channels again.
+	Retry *sync2.Cycle
+
+	// attempts are the pending deliveries, only used by the delivery loop.
+	attempts map[uuid.UUID]*attempt
 }
 
-// NewService creates a new notification service.
-func NewService(log *zap.Logger, db DB) *Service {
+// attempt tracks the delivery of a notification over the routes.
+type attempt struct {
+	createdAt time.Time
+	// sent are the indexes of the routes the notification was delivered over.
+	sent      map[int]bool
+	delivered bool
+}
+
+// NewService creates a new notification service. Notifications are delivered
+// over the channels of routes, in addition to the dashboard, while the service
+// runs.
+func NewService(log *zap.Logger, db DB, routes ...Route) *Service {
 	return &Service{
-		log: log,
-		db:  db,
+		log:      log,
+		db:       db,
+		routes:   routes,
+		queue:    make(chan Notification, queueSize),
+		Retry:    sync2.NewCycle(retryInterval),
+		attempts: make(map[uuid.UUID]*attempt),
+	}
+}
+
+// Run delivers the received notifications over the channels and retries
+// the ones which failed.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(service.routes) == 0 {
+		<-ctx.Done()
+		return nil
+	}
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		return service.Retry.Run(ctx, service.retry)
+	})
+	group.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case notification := <-service.queue:
+				service.deliver(ctx, notification)
+			}
+		}
+	})
+	return errs2.IgnoreCanceled(group.Wait())
+}
+
+// Close stops retrying the delivery of notifications.
+func (service *Service) Close() error {
+	service.Retry.Close()
+	return nil
+}
+
+// retry queues the notifications of the retry window, which weren't
+// delivered over all their channels, again.
+func (service *Service) retry(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// leave room in the queue for new notifications
+	undelivered, err := service.db.ListUndelivered(ctx, time.Now().Add(-retryWindow), queueSize/2)
+	if err != nil {
+		service.log.Warn("Failed to list undelivered notifications", zap.Error(err))
+		return nil
+	}
+
+	for _, notification := range undelivered {
+		select {
+		case <-ctx.Done():
+			return nil
+		case service.queue <- notification:
+		}
+	}
+	return nil
+}
+
+// deliver sends the notification over the channels of the routes matching
+// its type, which it wasn't delivered over yet. The notification is marked
+// as delivered once it was sent over all of them.
+func (service *Service) deliver(ctx context.Context, notification Notification) {
+	service.expireAttempts()
+
+	current, ok := service.attempts[notification.ID]
+	if !ok {
+		current = &attempt{createdAt: notification.CreatedAt, sent: make(map[int]bool)}
+		service.attempts[notification.ID] = current
+	}
+	if current.delivered {
+		return
+	}
+
+	failed := false
+	for i, route := range service.routes {
+		if current.sent[i] || !route.Types.Contains(notification.Type) {
+			continue
+		}
+
+		sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+		err := route.Channel.Send(sendCtx, notification)
+		cancel()
+		if err != nil {
+			service.log.Warn("Failed to deliver notification, retrying later",
+				zap.String("channel", route.Channel.Name()),
+				zap.Stringer("type", notification.Type),
+				zap.Error(err))
+			failed = true
+			continue
+		}
+		current.sent[i] = true
+	}
+	if failed {
+		return
+	}
+
+	if err := service.db.MarkDelivered(ctx, notification.ID, time.Now()); err != nil {
+		service.log.Warn("Failed to mark notification as delivered",
+			zap.Stringer("type", notification.Type),
+			zap.Error(err))
+		return
+	}
+	current.delivered = true
+}
+
+// expireAttempts forgets the deliveries outside of the retry window and
+// logs the notifications which were given up on.
+func (service *Service) expireAttempts() {
+	expiration := time.Now().Add(-retryWindow)
+	for id, current := range service.attempts {
+		if !current.createdAt.Before(expiration) {
+			continue
+		}
+		if !current.delivered {
+			service.log.Warn("Giving up delivering notification, it's only shown on the dashboard",
+				zap.String("id", id.String()),
+				zap.Time("created at", current.createdAt))
+		}
+		delete(service.attempts, id)
 	}
 }
 
@@ -37,9 +201,29 @@ func (service *Service) Receive(ctx context.Context, newNotification NewNotifica
 		return Notification{}, err
 	}
 
+	if len(service.routes) > 0 {
+		select {
+		case service.queue <- notification:
+		default:
+			service.log.Warn("Notification delivery queue is full, retrying the delivery later",
+				zap.Stringer("type", notification.Type))
+		}
+	}
+
 	return notification, nil
 }
 
+// Notify notifies the operator on behalf of the node and only logs failures,
+// for producers which can't handle them. It's a no-op for a nil service.
+func (service *Service) Notify(ctx context.Context, newNotification NewNotification) {
+	if service == nil {
+		return
+	}
+	if _, err := service.Receive(ctx, newNotification); err != nil {
+		service.log.Warn("Failed to store notification", zap.Stringer("type", newNotification.Type), zap.Error(err))
+	}
+}
+
 // Read - change notification status to Read by ID.
 func (service *Service) Read(ctx context.Context, notificationID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -0,0 +1,76 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/zeebo/errs"
+)
+
+// WebhookConfig defines the URL notifications are posted to.
+type WebhookConfig struct {
+	URL   string `help:"URL notifications are posted to as JSON, empty disables the webhook" default:""`
+	Types Types  `help:"comma separated notification types which are posted to the webhook, empty for all types" default:""`
+}
+
+// WebhookChannel posts notifications as JSON to a URL.
+type WebhookChannel struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookChannel creates a new webhook channel.
+func NewWebhookChannel(config WebhookConfig) (*WebhookChannel, error) {
+	u, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, Error.New("invalid webhook URL %q: %w", config.URL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, Error.New("invalid webhook URL %q: scheme must be http or https", config.URL)
+	}
+	return &WebhookChannel{
+		url:    config.URL,
+		client: http.DefaultClient,
+	}, nil
+}
+
+// Name returns the name of the channel.
+func (channel *WebhookChannel) Name() string { return "webhook" }
+
+// Send posts the notification to the webhook.
+func (channel *WebhookChannel) Send(ctx context.Context, notification Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(newPayload(notification))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, channel.url, bytes.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := channel.client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		err = errs.Combine(err, Error.Wrap(resp.Body.Close()))
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Error.New("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
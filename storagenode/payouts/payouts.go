@@ -0,0 +1,116 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package payouts contains payout statements received from satellites
+// and estimates of payouts for the current month.
+package payouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for payouts package.
+	Error = errs.Class("payouts error")
+	// ErrNoStatement is returned when a statement does not exist.
+	ErrNoStatement = errs.Class("payout statement not found")
+)
+
+// DB works with payout statements database
+//
+// architecture: Database
+type DB interface {
+	// Store inserts or updates a payout statement.
+	Store(ctx context.Context, statement Statement) error
+	// Get returns the payout statement of a satellite for the period.
+	Get(ctx context.Context, satelliteID storj.NodeID, period time.Time) (Statement, error)
+	// AllSatellite returns payout statements of a satellite ordered by period.
+	AllSatellite(ctx context.Context, satelliteID storj.NodeID) ([]Statement, error)
+	// All returns payout statements of all satellites ordered by satellite and period.
+	All(ctx context.Context) ([]Statement, error)
+}
+
+// Statement is a monthly payout statement issued by a satellite.
+//
+// All compensation and held amounts are in micro dollars.
+type Statement struct {
+	SatelliteID storj.NodeID `json:"satelliteId"`
+	Period      time.Time    `json:"period"`
+	CreatedAt   time.Time    `json:"createdAt"`
+
+	NodeAgeMonths int `json:"nodeAgeMonths"`
+
+	// UsageAtRest is in byte hours, other usages are in bytes.
+	UsageAtRest    float64 `json:"usageAtRest"`
+	UsagePut       int64   `json:"usagePut"`
+	UsageGet       int64   `json:"usageGet"`
+	UsagePutRepair int64   `json:"usagePutRepair"`
+	UsageGetRepair int64   `json:"usageGetRepair"`
+	UsageGetAudit  int64   `json:"usageGetAudit"`
+
+	CompAtRest    int64 `json:"compAtRest"`
+	CompPut       int64 `json:"compPut"`
+	CompGet       int64 `json:"compGet"`
+	CompPutRepair int64 `json:"compPutRepair"`
+	CompGetRepair int64 `json:"compGetRepair"`
+	CompGetAudit  int64 `json:"compGetAudit"`
+
+	HeldPercent int   `json:"heldPercent"`
+	Held        int64 `json:"held"`
+	Disposed    int64 `json:"disposed"`
+	Owed        int64 `json:"owed"`
+
+	GracefulExit bool `json:"gracefulExit"`
+}
+
+// HeldHistory is the held amount history of a satellite.
+type HeldHistory struct {
+	SatelliteID storj.NodeID `json:"satelliteId"`
+	// Total is the amount which is currently held by the satellite.
+	Total  int64        `json:"total"`
+	Months []HeldPeriod `json:"months"`
+}
+
+// HeldPeriod is the amount held and returned in a period.
+type HeldPeriod struct {
+	Period   time.Time `json:"period"`
+	Held     int64     `json:"held"`
+	Disposed int64     `json:"disposed"`
+}
+
+// Estimate is an estimated payout of a satellite for a period which has not been paid yet.
+//
+// All compensation and held amounts are in micro dollars.
+type Estimate struct {
+	SatelliteID storj.NodeID `json:"satelliteId"`
+	Period      time.Time    `json:"period"`
+
+	NodeAgeMonths int `json:"nodeAgeMonths"`
+
+	// UsageAtRest is in byte hours, other usages are in bytes.
+	UsageAtRest    float64 `json:"usageAtRest"`
+	UsagePut       int64   `json:"usagePut"`
+	UsageGet       int64   `json:"usageGet"`
+	UsagePutRepair int64   `json:"usagePutRepair"`
+	UsageGetRepair int64   `json:"usageGetRepair"`
+	UsageGetAudit  int64   `json:"usageGetAudit"`
+
+	CompAtRest    int64 `json:"compAtRest"`
+	CompPut       int64 `json:"compPut"`
+	CompGet       int64 `json:"compGet"`
+	CompPutRepair int64 `json:"compPutRepair"`
+	CompGetRepair int64 `json:"compGetRepair"`
+	CompGetAudit  int64 `json:"compGetAudit"`
+
+	HeldPercent int   `json:"heldPercent"`
+	Held        int64 `json:"held"`
+	Payout      int64 `json:"payout"`
+}
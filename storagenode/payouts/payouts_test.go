@@ -0,0 +1,131 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/payout"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/payouts"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+	"storj.io/storj/storagenode/storageusage"
+)
+
+func TestPayoutsDB(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		payoutsDB := db.Payouts()
+
+		satellite0 := testrand.NodeID()
+		satellite1 := testrand.NodeID()
+		january := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+		february := january.AddDate(0, 1, 0)
+
+		statement := payouts.Statement{
+			SatelliteID:   satellite0,
+			Period:        january,
+			CreatedAt:     february.Add(72 * time.Hour),
+			NodeAgeMonths: 2,
+			UsageAtRest:   1e12,
+			UsageGet:      1000,
+			CompAtRest:    1500,
+			CompGet:       20,
+			HeldPercent:   75,
+			Held:          1140,
+			Owed:          380,
+		}
+
+		_, err := payoutsDB.Get(ctx, satellite0, january)
+		require.True(t, payouts.ErrNoStatement.Has(err))
+
+		require.NoError(t, payoutsDB.Store(ctx, statement))
+
+		stored, err := payoutsDB.Get(ctx, satellite0, january)
+		require.NoError(t, err)
+		assert.Equal(t, statement, stored)
+
+		// storing again replaces the statement
+		statement.Owed = 400
+		require.NoError(t, payoutsDB.Store(ctx, statement))
+
+		next := statement
+		next.Period = february
+		require.NoError(t, payoutsDB.Store(ctx, next))
+
+		other := statement
+		other.SatelliteID = satellite1
+		require.NoError(t, payoutsDB.Store(ctx, other))
+
+		statements, err := payoutsDB.AllSatellite(ctx, satellite0)
+		require.NoError(t, err)
+		require.Len(t, statements, 2)
+		assert.Equal(t, statement, statements[0])
+		assert.Equal(t, next, statements[1])
+
+		statements, err = payoutsDB.All(ctx)
+		require.NoError(t, err)
+		require.Len(t, statements, 3)
+	})
+}
+
+func TestHeldHistoryAndEstimate(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		service, err := payouts.NewService(zaptest.NewLogger(t), db.Payouts(), db.Bandwidth(), db.StorageUsage(), db.Satellites(), nil, payouts.Config{
+			AtRestTBMonthPrice: "1.50",
+			GetTBPrice:         "20",
+			PutTBPrice:         "0",
+			GetRepairTBPrice:   "10",
+			PutRepairTBPrice:   "0",
+			GetAuditTBPrice:    "10",
+			HeldPercents:       "75,75,75,50",
+		})
+		require.NoError(t, err)
+
+		satellite := testrand.NodeID()
+		now := time.Now().UTC()
+		period := payout.PeriodStart(now)
+		previous := period.AddDate(0, -1, 0)
+
+		for i, held := range []int64{300, 200} {
+			require.NoError(t, db.Payouts().Store(ctx, payouts.Statement{
+				SatelliteID:   satellite,
+				Period:        previous.AddDate(0, i-1, 0),
+				NodeAgeMonths: i + 2,
+				HeldPercent:   75,
+				Held:          held,
+				Disposed:      int64(i) * 100,
+			}))
+		}
+
+		history, err := service.HeldHistory(ctx)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Equal(t, satellite, history[0].SatelliteID)
+		assert.Equal(t, int64(400), history[0].Total)
+		require.Len(t, history[0].Months, 2)
+
+		require.NoError(t, db.Bandwidth().Add(ctx, satellite, pb.PieceAction_GET, 1e12, period))
+		require.NoError(t, db.StorageUsage().Store(ctx, []storageusage.Stamp{
+			{SatelliteID: satellite, AtRestTotal: 720 * 1e12, IntervalStart: period},
+		}))
+
+		estimate, err := service.EstimatedPayout(ctx, satellite, now)
+		require.NoError(t, err)
+		assert.True(t, period.Equal(estimate.Period))
+		assert.Equal(t, 4, estimate.NodeAgeMonths)
+		assert.Equal(t, 50, estimate.HeldPercent)
+		assert.Equal(t, int64(1500000), estimate.CompAtRest)
+		assert.Equal(t, int64(20000000), estimate.CompGet)
+		assert.Equal(t, int64(10750000), estimate.Held)
+		assert.Equal(t, int64(10750000), estimate.Payout)
+	})
+}
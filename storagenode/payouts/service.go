@@ -0,0 +1,218 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/payout"
+	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/storageusage"
+	"storj.io/storj/storagenode/trust"
+)
+
+// Config contains rates used to estimate payouts of the current month.
+//
+// Satellites calculate the actual payouts, the rates should match the satellite rates.
+type Config struct {
+	AtRestTBMonthPrice string `help:"estimated payout for storing a TB for a month in dollars" default:"1.50"`
+	GetTBPrice         string `help:"estimated payout for each TB of download egress in dollars" default:"20"`
+	PutTBPrice         string `help:"estimated payout for each TB of upload ingress in dollars" default:"0"`
+	GetRepairTBPrice   string `help:"estimated payout for each TB of repair egress in dollars" default:"10"`
+	PutRepairTBPrice   string `help:"estimated payout for each TB of repair ingress in dollars" default:"0"`
+	GetAuditTBPrice    string `help:"estimated payout for each TB of audit egress in dollars" default:"10"`
+
+	HeldPercents string `help:"comma separated percentages of the payout held back by satellites in each month of node age" default:"75,75,75,50,50,50,25,25,25"`
+}
+
+// Service provides payout statements, held amount history and payout estimates.
+//
+// architecture: Service
+type Service struct {
+	log *zap.Logger
+
+	db           DB
+	bandwidth    bandwidth.DB
+	storageUsage storageusage.DB
+	satellites   satellites.DB
+	trust        *trust.Pool
+
+	rates        payout.Rates
+	heldPercents payout.HeldPercents
+}
+
+// NewService creates a new payouts service.
+func NewService(log *zap.Logger, db DB, bandwidth bandwidth.DB, storageUsage storageusage.DB, satellites satellites.DB, trust *trust.Pool, config Config) (*Service, error) {
+	rates, err := payout.ParseRates(payout.Prices{
+		AtRestTBMonth: config.AtRestTBMonthPrice,
+		Put:           config.PutTBPrice,
+		Get:           config.GetTBPrice,
+		PutRepair:     config.PutRepairTBPrice,
+		GetRepair:     config.GetRepairTBPrice,
+		GetAudit:      config.GetAuditTBPrice,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	heldPercents, err := payout.ParseHeldPercents(config.HeldPercents)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &Service{
+		log:          log,
+		db:           db,
+		bandwidth:    bandwidth,
+		storageUsage: storageUsage,
+		satellites:   satellites,
+		trust:        trust,
+		rates:        rates,
+		heldPercents: heldPercents,
+	}, nil
+}
+
+// Statements returns cached payout statements of a satellite.
+func (service *Service) Statements(ctx context.Context, satelliteID storj.NodeID) (_ []Statement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	statements, err := service.db.AllSatellite(ctx, satelliteID)
+	return statements, Error.Wrap(err)
+}
+
+// AllStatements returns cached payout statements of all satellites.
+func (service *Service) AllStatements(ctx context.Context) (_ []Statement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	statements, err := service.db.All(ctx)
+	return statements, Error.Wrap(err)
+}
+
+// HeldHistory returns held amount history of all satellites.
+func (service *Service) HeldHistory(ctx context.Context) (_ []HeldHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	statements, err := service.db.All(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var history []HeldHistory
+	for _, statement := range statements {
+		if len(history) == 0 || history[len(history)-1].SatelliteID != statement.SatelliteID {
+			history = append(history, HeldHistory{SatelliteID: statement.SatelliteID})
+		}
+
+		satellite := &history[len(history)-1]
+		satellite.Total += statement.Held - statement.Disposed
+		satellite.Months = append(satellite.Months, HeldPeriod{
+			Period:   statement.Period,
+			Held:     statement.Held,
+			Disposed: statement.Disposed,
+		})
+	}
+
+	return history, nil
+}
+
+// EstimatedPayout estimates the payout of a satellite for the month containing now from local usage.
+func (service *Service) EstimatedPayout(ctx context.Context, satelliteID storj.NodeID, now time.Time) (_ Estimate, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	period := payout.PeriodStart(now)
+
+	bandwidthUsage, err := service.bandwidth.SatelliteSummary(ctx, satelliteID, period, now)
+	if err != nil {
+		return Estimate{}, Error.Wrap(err)
+	}
+
+	atRest, err := service.storageUsage.SatelliteSummary(ctx, satelliteID, period, now)
+	if err != nil {
+		return Estimate{}, Error.Wrap(err)
+	}
+
+	ageMonths, err := service.nodeAgeMonths(ctx, satelliteID, period)
+	if err != nil {
+		return Estimate{}, Error.Wrap(err)
+	}
+
+	estimate := Estimate{
+		SatelliteID:    satelliteID,
+		Period:         period,
+		NodeAgeMonths:  ageMonths,
+		UsageAtRest:    atRest,
+		UsagePut:       bandwidthUsage.Put,
+		UsageGet:       bandwidthUsage.Get,
+		UsagePutRepair: bandwidthUsage.PutRepair,
+		UsageGetRepair: bandwidthUsage.GetRepair,
+		UsageGetAudit:  bandwidthUsage.GetAudit,
+	}
+
+	compensation := service.rates.Compensation(payout.Usage{
+		AtRest:    atRest,
+		Put:       bandwidthUsage.Put,
+		Get:       bandwidthUsage.Get,
+		PutRepair: bandwidthUsage.PutRepair,
+		GetRepair: bandwidthUsage.GetRepair,
+		GetAudit:  bandwidthUsage.GetAudit,
+	})
+	estimate.CompAtRest = compensation.AtRest
+	estimate.CompPut = compensation.Put
+	estimate.CompGet = compensation.Get
+	estimate.CompPutRepair = compensation.PutRepair
+	estimate.CompGetRepair = compensation.GetRepair
+	estimate.CompGetAudit = compensation.GetAudit
+
+	total := compensation.Total()
+
+	estimate.HeldPercent = service.heldPercents.Percent(ageMonths)
+	estimate.Held = payout.Held(total, estimate.HeldPercent)
+	estimate.Payout = total - estimate.Held
+
+	return estimate, nil
+}
+
+// EstimatedPayouts estimates payouts of all trusted satellites for the month containing now.
+func (service *Service) EstimatedPayouts(ctx context.Context, now time.Time) (_ []Estimate, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var estimates []Estimate
+	for _, satelliteID := range service.trust.GetSatellites(ctx) {
+		estimate, err := service.EstimatedPayout(ctx, satelliteID, now)
+		if err != nil {
+			return nil, err
+		}
+		estimates = append(estimates, estimate)
+	}
+	return estimates, nil
+}
+
+// nodeAgeMonths returns the age of the node on a satellite in period.
+// The age is based on the latest statement and falls back to when the satellite was added.
+func (service *Service) nodeAgeMonths(ctx context.Context, satelliteID storj.NodeID, period time.Time) (_ int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	statements, err := service.db.AllSatellite(ctx, satelliteID)
+	if err != nil {
+		return 0, err
+	}
+	if len(statements) > 0 {
+		latest := statements[len(statements)-1]
+		return latest.NodeAgeMonths + payout.NodeAgeMonths(latest.Period, period) - 1, nil
+	}
+
+	satellite, err := service.satellites.GetSatellite(ctx, satelliteID)
+	if err != nil {
+		return 0, err
+	}
+	if satellite.AddedAt.IsZero() {
+		return 1, nil
+	}
+	return payout.NodeAgeMonths(satellite.AddedAt, period), nil
+}
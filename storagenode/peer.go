@@ -8,6 +8,7 @@ import (
 	"errors"
 	"net"
 	"net/http"
+	"path/filepath"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -25,30 +26,40 @@ import (
 	"storj.io/storj/pkg/debug"
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/private/lifecycle"
+	"storj.io/storj/private/nodeadminpb"
+	"storj.io/storj/private/storagemigrationpb"
 	"storj.io/storj/private/version"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/storage"
+	"storj.io/storj/storage/readcache"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleassets"
 	"storj.io/storj/storagenode/console/consoleserver"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/dbbackup"
 	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/inspector"
+	"storj.io/storj/storagenode/metrics"
 	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/nodeadmin"
 	"storj.io/storj/storagenode/nodestats"
 	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/payouts"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/preflight"
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/scrubber"
+	"storj.io/storj/storagenode/storagemigration"
 	"storj.io/storj/storagenode/storageusage"
 	"storj.io/storj/storagenode/trust"
+	"storj.io/storj/storagenode/untrusted"
 )
 
 var (
@@ -61,6 +72,8 @@ var (
 type DB interface {
 	// CreateTables initializes the database
 	CreateTables(ctx context.Context) error
+	// Backup copies the databases into the backup directory
+	Backup(ctx context.Context) error
 	// Close closes the database
 	Close() error
 
@@ -70,12 +83,15 @@ type DB interface {
 	V0PieceInfo() pieces.V0PieceInfoDB
 	PieceExpirationDB() pieces.PieceExpirationDB
 	PieceSpaceUsedDB() pieces.PieceSpaceUsedDB
+	PieceIndex() pieces.PieceIndexDB
+	CorruptPieces() scrubber.DB
 	Bandwidth() bandwidth.DB
 	UsedSerials() piecestore.UsedSerials
 	Reputation() reputation.DB
 	StorageUsage() storageusage.DB
 	Satellites() satellites.DB
 	Notifications() notifications.DB
+	Payouts() payouts.DB
 }
 
 // Config is all the configuration parameters for a Storage Node
@@ -96,6 +112,8 @@ type Config struct {
 
 	Retain retain.Config
 
+	Scrubber scrubber.Config
+
 	Nodestats nodestats.Config
 
 	Console consoleserver.Config
@@ -104,7 +122,15 @@ type Config struct {
 
 	Bandwidth bandwidth.Config
 
+	DatabaseBackup dbbackup.Config
+
+	Payouts payouts.Config
+
 	GracefulExit gracefulexit.Config
+
+	Untrusted untrusted.Config
+
+	Notifications notifications.Config
 }
 
 // Verify verifies whether configuration is consistent and acceptable.
@@ -128,6 +154,20 @@ func (config *Config) Verify(log *zap.Logger) error {
 		}
 	}
 
+	if _, err := config.Storage.Locations(); err != nil {
+		return errs.New("invalid storage.extra-paths: %v", err)
+	}
+
+	switch config.Storage.Backend {
+	case "", piecestore.BackendFiles:
+	case piecestore.BackendPacks:
+		if config.Storage.ExtraPaths != "" {
+			return errs.New("storage.backend %q can't be combined with storage.extra-paths", config.Storage.Backend)
+		}
+	default:
+		return errs.New("invalid storage.backend: %q", config.Storage.Backend)
+	}
+
 	return nil
 }
 
@@ -188,8 +228,13 @@ type Peer struct {
 		// TODO: lift things outside of it to organize better
 		Trust         *trust.Pool
 		Store         *pieces.Store
+		Expirations   *pieces.ExpirationBuckets
 		TrashChore    *pieces.TrashChore
+		Compaction    *pieces.CompactionChore
+		IndexChore    *pieces.IndexChore
 		BlobsCache    *pieces.BlobsUsageCache
+		ReadCache     *readcache.Cache
+		Migration     *storagemigration.Service
 		CacheService  *pieces.CacheService
 		RetainService *retain.Service
 		Endpoint      *piecestore.Endpoint
@@ -200,6 +245,14 @@ type Peer struct {
 
 	Collector *collector.Service
 
+	Untrusted *untrusted.Service
+
+	Metrics *metrics.Service
+
+	NodeAdmin *nodeadmin.Endpoint
+
+	Scrubber *scrubber.Service
+
 	NodeStats struct {
 		Service *nodestats.Service
 		Cache   *nodestats.Cache
@@ -215,13 +268,20 @@ type Peer struct {
 	GracefulExit struct {
 		Endpoint *gracefulexit.Endpoint
 		Chore    *gracefulexit.Chore
+		Service  *gracefulexit.Service
 	}
 
 	Notifications struct {
 		Service *notifications.Service
 	}
 
+	Payouts struct {
+		Service *payouts.Service
+	}
+
 	Bandwidth *bandwidth.Service
+
+	DatabaseBackup *dbbackup.Chore
 }
 
 // New creates a new Storage Node.
@@ -313,7 +373,16 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 	}
 
 	{ // setup notification service.
-		peer.Notifications.Service = notifications.NewService(peer.Log, peer.DB.Notifications())
+		routes, err := notifications.NewRoutes(peer.Log.Named("notifications"), config.Notifications)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Notifications.Service = notifications.NewService(peer.Log.Named("notifications"), peer.DB.Notifications(), routes...)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "notifications",
+			Run:   peer.Notifications.Service.Run,
+			Close: peer.Notifications.Service.Close,
+		})
 	}
 
 	{ // setup contact service
@@ -344,7 +413,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		peer.Contact.PingStats = new(contact.PingStats)
 		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), self)
 
-		peer.Contact.Chore = contact.NewChore(peer.Log.Named("contact:chore"), config.Contact.Interval, peer.Storage2.Trust, peer.Dialer, peer.Contact.Service)
+		peer.Contact.Chore = contact.NewChore(peer.Log.Named("contact:chore"), config.Contact.Interval, peer.Storage2.Trust, peer.Dialer, peer.Contact.Service, peer.Notifications.Service)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "contact:chore",
 			Run:   peer.Contact.Chore.Run,
@@ -358,13 +427,51 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 	}
 
 	{ // setup storage
-		peer.Storage2.BlobsCache = pieces.NewBlobsUsageCache(peer.Log.Named("blobscache"), peer.DB.Pieces())
+		peer.Storage2.Migration, err = storagemigration.NewService(
+			peer.Log.Named("storagemigration"),
+			config.Storage.Path,
+			storagemigration.Location{Path: config.Storage.Path, Backend: config.Storage.Backend},
+			peer.DB.Pieces(),
+			peer.DB.V0PieceInfo(),
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name:  "pieces:migration",
+			Run:   peer.Storage2.Migration.Run,
+			Close: peer.Storage2.Migration.Close,
+		})
+
+		var blobs storage.Blobs = peer.Storage2.Migration.Blobs()
+		if config.Storage.ReadCache.Enabled() {
+			peer.Storage2.ReadCache, err = readcache.New(peer.Log.Named("readcache"), blobs, config.Storage.ReadCache)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Services.Add(lifecycle.Item{
+				Name:  "pieces:readcache",
+				Close: peer.Storage2.ReadCache.Close,
+			})
+			blobs = peer.Storage2.ReadCache
+		}
+
+		peer.Storage2.BlobsCache = pieces.NewBlobsUsageCache(peer.Log.Named("blobscache"), blobs)
+
+		peer.Storage2.Expirations, err = pieces.NewExpirationBuckets(peer.Log.Named("pieces:expirations"),
+			filepath.Join(config.Storage.Path, "piece-expirations"),
+			peer.DB.PieceExpirationDB(),
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		peer.Storage2.Store = pieces.NewStore(peer.Log.Named("pieces"),
 			peer.Storage2.BlobsCache,
 			peer.DB.V0PieceInfo(),
-			peer.DB.PieceExpirationDB(),
+			peer.Storage2.Expirations,
 			peer.DB.PieceSpaceUsedDB(),
+			peer.DB.PieceIndex(),
 		)
 
 		peer.Storage2.TrashChore = pieces.NewTrashChore(
@@ -380,6 +487,28 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			Close: peer.Storage2.TrashChore.Close,
 		})
 
+		peer.Storage2.Compaction = pieces.NewCompactionChore(
+			log.Named("pieces:compaction"),
+			config.Storage2.CompactionInterval,
+			peer.Storage2.Migration.Blobs(),
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "pieces:compaction",
+			Run:   peer.Storage2.Compaction.Run,
+			Close: peer.Storage2.Compaction.Close,
+		})
+
+		peer.Storage2.IndexChore = pieces.NewIndexChore(
+			log.Named("pieces:index"),
+			config.Storage2.IndexReconcileInterval,
+			peer.Storage2.Store,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "pieces:index",
+			Run:   peer.Storage2.IndexChore.Run,
+			Close: peer.Storage2.IndexChore.Close,
+		})
+
 		peer.Storage2.CacheService = pieces.NewService(
 			log.Named("piecestore:cache"),
 			peer.Storage2.BlobsCache,
@@ -398,6 +527,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			log.Named("piecestore:monitor"),
 			peer.Storage2.Store,
 			peer.Contact.Service,
+			peer.Notifications.Service,
 			peer.DB.Bandwidth(),
 			config.Storage.AllocatedDiskSpace.Int64(),
 			config.Storage.AllocatedBandwidth.Int64(),
@@ -472,6 +602,25 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			debug.Cycle("Orders Cleanup", peer.Storage2.Orders.Cleanup))
 	}
 
+	{ // setup piece integrity scrubber
+		peer.Scrubber = scrubber.NewService(
+			peer.Log.Named("scrubber"),
+			config.Scrubber,
+			peer.DB.CorruptPieces(),
+			peer.Storage2.Store,
+			peer.Storage2.Trust,
+			peer.Dialer,
+			filepath.Join(config.Storage.Path, "quarantine"),
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "scrubber",
+			Run:   peer.Scrubber.Run,
+			Close: peer.Scrubber.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Scrubber", peer.Scrubber.Loop))
+	}
+
 	{ // setup node stats service
 		peer.NodeStats.Service = nodestats.NewService(
 			peer.Log.Named("nodestats:service"),
@@ -485,9 +634,11 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			nodestats.CacheStorage{
 				Reputation:   peer.DB.Reputation(),
 				StorageUsage: peer.DB.StorageUsage(),
+				Payouts:      peer.DB.Payouts(),
 			},
 			peer.NodeStats.Service,
 			peer.Storage2.Trust,
+			peer.Notifications.Service,
 		)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "nodestats:cache",
@@ -498,6 +649,79 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			debug.Cycle("Node Stats Cache Reputation", peer.NodeStats.Cache.Reputation))
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Node Stats Cache Storage", peer.NodeStats.Cache.Storage))
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Node Stats Cache Payouts", peer.NodeStats.Cache.Payouts))
+	}
+
+	{ // setup payouts service
+		peer.Payouts.Service, err = payouts.NewService(
+			peer.Log.Named("payouts:service"),
+			peer.DB.Payouts(),
+			peer.DB.Bandwidth(),
+			peer.DB.StorageUsage(),
+			peer.DB.Satellites(),
+			peer.Storage2.Trust,
+			config.Payouts,
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
+	{ // setup graceful exit service
+		peer.GracefulExit.Endpoint = gracefulexit.NewEndpoint(
+			peer.Log.Named("gracefulexit:endpoint"),
+			peer.Storage2.Trust,
+			peer.DB.Satellites(),
+			peer.Storage2.BlobsCache,
+		)
+		pb.RegisterNodeGracefulExitServer(peer.Server.PrivateGRPC(), peer.GracefulExit.Endpoint)
+		pb.DRPCRegisterNodeGracefulExit(peer.Server.PrivateDRPC(), peer.GracefulExit.Endpoint)
+
+		storagemigrationpb.DRPCRegisterStorageMigration(peer.Server.PrivateDRPC(),
+			storagemigration.NewEndpoint(peer.Log.Named("storagemigration:endpoint"), peer.Storage2.Migration))
+
+		peer.GracefulExit.Chore = gracefulexit.NewChore(
+			peer.Log.Named("gracefulexit:chore"),
+			config.GracefulExit,
+			peer.Storage2.Store,
+			peer.Storage2.Trust,
+			peer.Dialer,
+			peer.DB.Satellites(),
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "gracefulexit:chore",
+			Run:   peer.GracefulExit.Chore.Run,
+			Close: peer.GracefulExit.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Graceful Exit", peer.GracefulExit.Chore.Loop))
+
+		peer.GracefulExit.Service = gracefulexit.NewService(
+			peer.Log.Named("gracefulexit:service"),
+			peer.Storage2.Trust,
+			peer.DB.Satellites(),
+			peer.Storage2.BlobsCache,
+			peer.GracefulExit.Chore,
+		)
+	}
+
+	{ // setup untrusted satellite data cleanup
+		peer.Untrusted = untrusted.NewService(
+			peer.Log.Named("untrusted"),
+			config.Untrusted,
+			peer.Storage2.Trust,
+			peer.Storage2.Store,
+			peer.DB.Satellites(),
+			peer.DB.Orders(),
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "untrusted",
+			Run:   peer.Untrusted.Run,
+			Close: peer.Untrusted.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Untrusted Satellites", peer.Untrusted.Loop))
 	}
 
 	{ // setup storage node operator dashboard
@@ -520,6 +744,18 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			return nil, errs.Combine(err, peer.Close())
 		}
 
+		peer.Metrics = metrics.NewService(
+			peer.Log.Named("metrics"),
+			peer.Storage2.Trust,
+			peer.Storage2.Store,
+			peer.DB.Reputation(),
+			peer.DB.Bandwidth(),
+			peer.Storage2.Endpoint,
+			peer.Version,
+			versionInfo,
+			config.Storage.AllocatedDiskSpace.Int64(),
+		)
+
 		peer.Console.Listener, err = net.Listen("tcp", config.Console.Address)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -535,6 +771,11 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Log.Named("console:endpoint"),
 			assets,
 			peer.Notifications.Service,
+			peer.Payouts.Service,
+			peer.Scrubber,
+			peer.GracefulExit.Service,
+			peer.Untrusted,
+			peer.Metrics,
 			peer.Console.Service,
 			peer.Console.Listener,
 		)
@@ -560,34 +801,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		pb.DRPCRegisterPieceStoreInspector(peer.Server.PrivateDRPC(), peer.Storage2.Inspector)
 	}
 
-	{ // setup graceful exit service
-		peer.GracefulExit.Endpoint = gracefulexit.NewEndpoint(
-			peer.Log.Named("gracefulexit:endpoint"),
-			peer.Storage2.Trust,
-			peer.DB.Satellites(),
-			peer.Storage2.BlobsCache,
-		)
-		pb.RegisterNodeGracefulExitServer(peer.Server.PrivateGRPC(), peer.GracefulExit.Endpoint)
-		pb.DRPCRegisterNodeGracefulExit(peer.Server.PrivateDRPC(), peer.GracefulExit.Endpoint)
-
-		peer.GracefulExit.Chore = gracefulexit.NewChore(
-			peer.Log.Named("gracefulexit:chore"),
-			config.GracefulExit,
-			peer.Storage2.Store,
-			peer.Storage2.Trust,
-			peer.Dialer,
-			peer.DB.Satellites(),
-		)
-		peer.Services.Add(lifecycle.Item{
-			Name:  "gracefulexit:chore",
-			Run:   peer.GracefulExit.Chore.Run,
-			Close: peer.GracefulExit.Chore.Close,
-		})
-		peer.Debug.Server.Panel.Add(
-			debug.Cycle("Graceful Exit", peer.GracefulExit.Chore.Loop))
-	}
-
-	peer.Collector = collector.NewService(peer.Log.Named("collector"), peer.Storage2.Store, peer.DB.UsedSerials(), config.Collector)
+	peer.Collector = collector.NewService(peer.Log.Named("collector"), peer.Storage2.Store, peer.Storage2.Expirations, peer.DB.UsedSerials(), config.Collector)
 	peer.Services.Add(lifecycle.Item{
 		Name:  "collector",
 		Run:   peer.Collector.Run,
@@ -605,6 +819,45 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 	peer.Debug.Server.Panel.Add(
 		debug.Cycle("Bandwidth", peer.Bandwidth.Loop))
 
+	if config.DatabaseBackup.Interval > 0 {
+		peer.DatabaseBackup = dbbackup.NewChore(peer.Log.Named("dbbackup"), peer.DB, config.DatabaseBackup)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "dbbackup",
+			Run:   peer.DatabaseBackup.Run,
+			Close: peer.DatabaseBackup.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Database Backup", peer.DatabaseBackup.Loop))
+	}
+
+	{ // setup node admin
+		chores := []nodeadmin.Chore{
+			{Name: "trash", Interval: 24 * time.Hour, Trigger: peer.Storage2.TrashChore.TriggerWait},
+			{Name: "collector", Interval: config.Collector.Interval, Trigger: func(context.Context) { peer.Collector.Loop.TriggerWait() }},
+			{Name: "cache", Interval: config.Storage2.CacheSyncInterval, Trigger: func(context.Context) { peer.Storage2.CacheService.Loop.TriggerWait() }},
+			{Name: "contact", Interval: config.Contact.Interval, Trigger: peer.Contact.Chore.TriggerWait},
+			{Name: "untrusted", Interval: config.Untrusted.Interval, Trigger: func(context.Context) { peer.Untrusted.Loop.TriggerWait() }},
+		}
+		if peer.DatabaseBackup != nil {
+			chores = append(chores, nodeadmin.Chore{Name: "dbbackup", Interval: config.DatabaseBackup.Interval, Trigger: func(context.Context) { peer.DatabaseBackup.Loop.TriggerWait() }})
+		}
+
+		peer.NodeAdmin = nodeadmin.NewEndpoint(
+			peer.Log.Named("nodeadmin"),
+			peer.Identity.ID,
+			versionInfo,
+			peer.Storage2.Trust,
+			peer.DB.Satellites(),
+			peer.Storage2.Store,
+			peer.Storage2.BlobsCache,
+			peer.Storage2.RetainService,
+			peer.Untrusted,
+			peer.Notifications.Service,
+			chores,
+		)
+		nodeadminpb.DRPCRegisterNodeAdmin(peer.Server.PrivateDRPC(), peer.NodeAdmin)
+	}
+
 	return peer, nil
 }
 
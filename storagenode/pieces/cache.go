@@ -85,11 +85,28 @@ func (service *CacheService) Run(ctx context.Context) (err error) {
 		if err := service.PersistCacheTotals(ctx); err != nil {
 			service.log.Error("error persisting cache totals to the database: ", zap.Error(err))
 		}
+
+		// blob stores spanning multiple directories track the space used per
+		// directory, refreshing it also retries directories which failed
+		service.refreshUsage(ctx)
+
 		service.InitFence.Release()
 		return err
 	})
 }
 
+// refreshUsage recalculates the space used per directory and retries failed
+// directories, when the blob store spans multiple directories.
+func (service *CacheService) refreshUsage(ctx context.Context) {
+	if refresher, ok := service.usageCache.Blobs.(interface {
+		RefreshUsage(ctx context.Context) error
+	}); ok {
+		if err := refresher.RefreshUsage(ctx); err != nil {
+			service.log.Error("error refreshing space used by storage locations: ", zap.Error(err))
+		}
+	}
+}
+
 // PersistCacheTotals saves the current totals of the space used cache to the database
 // so that if the storagenode restarts it can retrieve the latest space used
 // values without needing to recalculate since that could take a long time
@@ -484,6 +501,12 @@ func (blobs *BlobsUsageCache) Close() error {
 	return nil
 }
 
+// OpenUncached opens a reader for the blob, which isn't served from a read
+// cache of the underlying blob store.
+func (blobs *BlobsUsageCache) OpenUncached(ctx context.Context, ref storage.BlobRef) (_ storage.BlobReader, err error) {
+	return openUncached(ctx, blobs.Blobs, ref)
+}
+
 // TestCreateV0 creates a new V0 blob that can be written. This is only appropriate in test situations.
 func (blobs *BlobsUsageCache) TestCreateV0(ctx context.Context, ref storage.BlobRef) (_ storage.BlobWriter, err error) {
 	fStore := blobs.Blobs.(interface {
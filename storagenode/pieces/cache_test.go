@@ -4,6 +4,8 @@
 package pieces_test
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
 	"time"
 
@@ -111,7 +113,7 @@ func TestCacheInit(t *testing.T) {
 		cache := pieces.NewBlobsUsageCacheTest(log, nil, 0, 0, 0, nil)
 		cacheService := pieces.NewService(log,
 			cache,
-			pieces.NewStore(log, cache, nil, nil, spaceUsedDB),
+			pieces.NewStore(log, cache, nil, nil, spaceUsedDB, nil),
 			1*time.Hour,
 		)
 
@@ -149,7 +151,7 @@ func TestCacheInit(t *testing.T) {
 		cache = pieces.NewBlobsUsageCacheTest(log, nil, expectedPiecesTotal, expectedPiecesContentSize, expectedTrash, expectedTotalBySA)
 		cacheService = pieces.NewService(log,
 			cache,
-			pieces.NewStore(log, cache, nil, nil, spaceUsedDB),
+			pieces.NewStore(log, cache, nil, nil, spaceUsedDB, nil),
 			1*time.Hour,
 		)
 		err = cacheService.PersistCacheTotals(ctx)
@@ -159,7 +161,7 @@ func TestCacheInit(t *testing.T) {
 		cache = pieces.NewBlobsUsageCacheTest(log, nil, 0, 0, 0, nil)
 		cacheService = pieces.NewService(log,
 			cache,
-			pieces.NewStore(log, cache, nil, nil, spaceUsedDB),
+			pieces.NewStore(log, cache, nil, nil, spaceUsedDB, nil),
 			1*time.Hour,
 		)
 		// Confirm that when we call Init after the cache has been persisted
@@ -222,7 +224,7 @@ func TestCachServiceRun(t *testing.T) {
 		cache := pieces.NewBlobsUsageCache(log, blobstore)
 		cacheService := pieces.NewService(log,
 			cache,
-			pieces.NewStore(log, cache, nil, nil, spaceUsedDB),
+			pieces.NewStore(log, cache, nil, nil, spaceUsedDB, nil),
 			1*time.Hour,
 		)
 
@@ -308,7 +310,7 @@ func TestPersistCacheTotals(t *testing.T) {
 		cache := pieces.NewBlobsUsageCacheTest(log, nil, expectedPiecesTotal, expectedPiecesContentSize, expectedTrash, expectedTotalsBySA)
 		cacheService := pieces.NewService(log,
 			cache,
-			pieces.NewStore(log, cache, nil, nil, spaceUsedDB),
+			pieces.NewStore(log, cache, nil, nil, spaceUsedDB, nil),
 			1*time.Hour,
 		)
 		err = cacheService.PersistCacheTotals(ctx)
@@ -708,3 +710,45 @@ func TestConcurrency(t *testing.T) {
 		require.NoError(t, group.Wait())
 	})
 }
+
+func TestCacheServiceRefreshesLocations(t *testing.T) {
+	log := zaptest.NewLogger(t)
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		// the second location can't be initialized while a file is in its place
+		failedPath := ctx.File("second")
+		require.NoError(t, ioutil.WriteFile(failedPath, nil, 0644))
+
+		multi, err := filestore.NewMulti(log, []filestore.Location{
+			{Path: ctx.Dir("first"), Allocated: memory.GB.Int64()},
+			{Path: failedPath, Allocated: memory.GB.Int64()},
+		})
+		require.NoError(t, err)
+
+		cache := pieces.NewBlobsUsageCache(log, multi)
+		store := pieces.NewStore(log, cache, nil, nil, db.PieceSpaceUsedDB(), nil)
+		cacheService := pieces.NewService(log, cache, store, time.Hour)
+
+		var eg errgroup.Group
+		eg.Go(func() error {
+			return cacheService.Run(ctx)
+		})
+		cacheService.InitFence.Wait(ctx)
+
+		locations := store.Locations(ctx)
+		require.Len(t, locations, 2)
+		assert.NoError(t, locations[0].Err)
+		assert.Error(t, locations[1].Err)
+
+		// the failed location is retried on the next refresh
+		require.NoError(t, os.Remove(failedPath))
+		cacheService.Loop.TriggerWait()
+
+		locations = store.Locations(ctx)
+		require.Len(t, locations, 2)
+		assert.NoError(t, locations[1].Err)
+		assert.Equal(t, memory.GB.Int64(), locations[1].Allocated)
+
+		require.NoError(t, cacheService.Close())
+		require.NoError(t, eg.Wait())
+	})
+}
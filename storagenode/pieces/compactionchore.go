@@ -0,0 +1,76 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/storage"
+)
+
+// CompactionChore is the chore that periodically compacts the blob storage,
+// for blob storage which supports it. For pack files this removes dead
+// records, for separate files this retries deleting files which failed to be
+// deleted.
+type CompactionChore struct {
+	log      *zap.Logger
+	interval time.Duration
+	blobs    storage.Blobs
+	cycle    *sync2.Cycle
+	started  sync2.Fence
+}
+
+// NewCompactionChore instantiates a new CompactionChore.
+func NewCompactionChore(log *zap.Logger, interval time.Duration, blobs storage.Blobs) *CompactionChore {
+	return &CompactionChore{
+		log:      log,
+		interval: interval,
+		blobs:    blobs,
+	}
+}
+
+// Run starts the cycle
+func (chore *CompactionChore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	collector, ok := chore.blobs.(interface {
+		GarbageCollect(ctx context.Context) error
+	})
+
+	chore.cycle = sync2.NewCycle(chore.interval)
+	chore.cycle.Start(ctx, &errgroup.Group{}, func(ctx context.Context) error {
+		if !ok {
+			return nil
+		}
+
+		chore.log.Debug("starting compaction")
+		if err := collector.GarbageCollect(ctx); err != nil {
+			chore.log.Error("compaction failed", zap.Error(err))
+		}
+		return nil
+	})
+	chore.started.Release()
+	return err
+}
+
+// TriggerWait ensures that the cycle is done at least once and waits for
+// completion.  If the cycle is currently running it waits for the previous to
+// complete and then runs.
+func (chore *CompactionChore) TriggerWait(ctx context.Context) {
+	chore.started.Wait(ctx)
+	chore.cycle.TriggerWait()
+}
+
+// Close the chore
+func (chore *CompactionChore) Close() error {
+	if chore.cycle != nil {
+		chore.cycle.Close()
+	}
+	return nil
+}
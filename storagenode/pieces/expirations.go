@@ -0,0 +1,284 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"context"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/fpath"
+	"storj.io/common/storj"
+)
+
+const (
+	// expirationBucketFormat is the name format of expiration bucket files.
+	expirationBucketFormat = "2006-01-02T15"
+	// expirationBucketExt is the extension of expiration bucket files.
+	expirationBucketExt = ".exp"
+	// expirationRecordSize is the size of a record in an expiration bucket file:
+	// the satellite id, the piece id and the piece size.
+	expirationRecordSize = len(storj.NodeID{}) + len(storj.PieceID{}) + 8
+)
+
+// ErrExpirationBuckets is the error class for expiration bucket errors.
+var ErrExpirationBuckets = errs.Class("expiration buckets")
+
+// ExpirationBuckets stores piece expirations in one file per hour, so that all
+// expirations of an hour can be dropped at once after collecting them, instead
+// of deleting them from a database one at a time.
+//
+// Expirations of pieces which are deleted or trashed before they expire stay in
+// their bucket, collecting them deletes nothing. Expirations which were stored
+// in the database before are still served from and updated in the database,
+// until they are all collected.
+//
+// architecture: Database
+type ExpirationBuckets struct {
+	log *zap.Logger
+	dir string
+	db  PieceExpirationDB
+
+	mu    sync.Mutex
+	locks map[string]*bucketLock
+}
+
+// bucketLock excludes adding expirations to a bucket while it's collected.
+type bucketLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// NewExpirationBuckets creates expiration buckets in dir, which fall back to db
+// for expirations stored before.
+func NewExpirationBuckets(log *zap.Logger, dir string, db PieceExpirationDB) (*ExpirationBuckets, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, ErrExpirationBuckets.Wrap(err)
+	}
+	return &ExpirationBuckets{
+		log:   log,
+		dir:   dir,
+		db:    db,
+		locks: map[string]*bucketLock{},
+	}, nil
+}
+
+// SetExpiration adds the expiration of a piece to the bucket of the hour it
+// expires in. The bucket is synced before returning, so that the piece is
+// collected even after a crash.
+func (buckets *ExpirationBuckets) SetExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, expiresAt time.Time, pieceSize int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var record [expirationRecordSize]byte
+	n := copy(record[:], satellite[:])
+	n += copy(record[n:], pieceID[:])
+	binary.BigEndian.PutUint64(record[n:], uint64(pieceSize))
+
+	path := buckets.bucketPath(expiresAt)
+	defer buckets.lockBucket(path)()
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return ErrExpirationBuckets.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrExpirationBuckets.Wrap(file.Close())) }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return ErrExpirationBuckets.Wrap(err)
+	}
+	// a partial record left over from a crash is overwritten, so that the
+	// following records stay aligned
+	offset := info.Size() - info.Size()%int64(expirationRecordSize)
+	if _, err := file.WriteAt(record[:], offset); err != nil {
+		return ErrExpirationBuckets.Wrap(err)
+	}
+	return ErrExpirationBuckets.Wrap(file.Sync())
+}
+
+// Buckets returns the start of the hours whose buckets contain only pieces which
+// expired before expiresBefore, oldest first.
+func (buckets *ExpirationBuckets) Buckets(ctx context.Context, expiresBefore time.Time) (_ []time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	infos, err := ioutil.ReadDir(buckets.dir)
+	if err != nil {
+		return nil, ErrExpirationBuckets.Wrap(err)
+	}
+
+	var hours []time.Time
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || !strings.HasSuffix(name, expirationBucketExt) {
+			continue
+		}
+		hour, err := time.Parse(expirationBucketFormat, strings.TrimSuffix(name, expirationBucketExt))
+		if err != nil {
+			buckets.log.Warn("invalid expiration bucket", zap.String("Name", name))
+			continue
+		}
+		if !hour.Add(time.Hour).After(expiresBefore) {
+			hours = append(hours, hour)
+		}
+	}
+	sort.Slice(hours, func(i, k int) bool { return hours[i].Before(hours[k]) })
+	return hours, nil
+}
+
+// CollectBucket calls collect with the expired pieces in the bucket of the hour
+// and replaces the contents of the bucket with the pieces collect returns, e.g.
+// the pieces whose deletion failed. No expirations are added to the bucket
+// meanwhile, so that they aren't lost when the bucket is replaced.
+func (buckets *ExpirationBuckets) CollectBucket(ctx context.Context, hour time.Time, collect func([]ExpiredInfo) ([]ExpiredInfo, error)) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path := buckets.bucketPath(hour)
+	defer buckets.lockBucket(path)()
+
+	infos, err := readBucket(path)
+	if err != nil {
+		return err
+	}
+	remaining, err := collect(infos)
+	if err != nil {
+		return err
+	}
+	return replaceBucket(path, remaining)
+}
+
+// ReadBucket returns the expired pieces in the bucket of the hour.
+func (buckets *ExpirationBuckets) ReadBucket(ctx context.Context, hour time.Time) (_ []ExpiredInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path := buckets.bucketPath(hour)
+	defer buckets.lockBucket(path)()
+
+	return readBucket(path)
+}
+
+// readBucket returns the expired pieces in the bucket file.
+func readBucket(path string) ([]ExpiredInfo, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, ErrExpirationBuckets.Wrap(err)
+	}
+
+	// a partial record at the end is left over from a crash while adding it
+	infos := make([]ExpiredInfo, 0, len(data)/expirationRecordSize)
+	for ; len(data) >= expirationRecordSize; data = data[expirationRecordSize:] {
+		var info ExpiredInfo
+		n := copy(info.SatelliteID[:], data)
+		n += copy(info.PieceID[:], data[n:])
+		info.PieceSize = int64(binary.BigEndian.Uint64(data[n:]))
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// ReplaceBucket replaces the contents of the bucket of the hour, e.g. with the
+// pieces whose deletion failed. An empty list deletes the bucket.
+func (buckets *ExpirationBuckets) ReplaceBucket(ctx context.Context, hour time.Time, infos []ExpiredInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path := buckets.bucketPath(hour)
+	defer buckets.lockBucket(path)()
+
+	return replaceBucket(path, infos)
+}
+
+// replaceBucket replaces the contents of the bucket file, an empty list deletes it.
+func replaceBucket(path string, infos []ExpiredInfo) error {
+	if len(infos) == 0 {
+		err := os.Remove(path)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return ErrExpirationBuckets.Wrap(err)
+	}
+
+	data := make([]byte, 0, len(infos)*expirationRecordSize)
+	for _, info := range infos {
+		var size [8]byte
+		binary.BigEndian.PutUint64(size[:], uint64(info.PieceSize))
+		data = append(data, info.SatelliteID[:]...)
+		data = append(data, info.PieceID[:]...)
+		data = append(data, size[:]...)
+	}
+	return ErrExpirationBuckets.Wrap(fpath.AtomicWriteFile(path, data, 0600))
+}
+
+// DeleteBucket drops all expirations in the bucket of the hour.
+func (buckets *ExpirationBuckets) DeleteBucket(ctx context.Context, hour time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return buckets.ReplaceBucket(ctx, hour, nil)
+}
+
+// lockBucket locks the bucket file at path and returns the function unlocking it.
+func (buckets *ExpirationBuckets) lockBucket(path string) (unlock func()) {
+	buckets.mu.Lock()
+	lock, ok := buckets.locks[path]
+	if !ok {
+		lock = &bucketLock{}
+		buckets.locks[path] = lock
+	}
+	lock.refs++
+	buckets.mu.Unlock()
+
+	lock.mu.Lock()
+	return func() {
+		lock.mu.Unlock()
+
+		buckets.mu.Lock()
+		lock.refs--
+		if lock.refs == 0 {
+			delete(buckets.locks, path)
+		}
+		buckets.mu.Unlock()
+	}
+}
+
+// bucketPath returns the path of the bucket file for the hour containing t.
+func (buckets *ExpirationBuckets) bucketPath(t time.Time) string {
+	return filepath.Join(buckets.dir, t.UTC().Truncate(time.Hour).Format(expirationBucketFormat)+expirationBucketExt)
+}
+
+// GetExpired gets piece IDs stored in the database before that expire or have
+// expired before the given time. Pieces in buckets are collected per bucket.
+func (buckets *ExpirationBuckets) GetExpired(ctx context.Context, expiresBefore time.Time, limit int64) (_ []ExpiredInfo, err error) {
+	return buckets.db.GetExpired(ctx, expiresBefore, limit)
+}
+
+// DeleteExpiration removes an expiration record stored in the database.
+func (buckets *ExpirationBuckets) DeleteExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (found bool, err error) {
+	return buckets.db.DeleteExpiration(ctx, satellite, pieceID)
+}
+
+// DeleteFailed marks an expiration record stored in the database as having
+// experienced a failure in deleting the piece from the disk.
+func (buckets *ExpirationBuckets) DeleteFailed(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, failedAt time.Time) error {
+	return buckets.db.DeleteFailed(ctx, satelliteID, pieceID, failedAt)
+}
+
+// Trash marks an expiration record stored in the database as in the trash.
+func (buckets *ExpirationBuckets) Trash(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) error {
+	return buckets.db.Trash(ctx, satelliteID, pieceID)
+}
+
+// RestoreTrash marks all expiration records stored in the database as not
+// being in the trash.
+func (buckets *ExpirationBuckets) RestoreTrash(ctx context.Context, satelliteID storj.NodeID) error {
+	return buckets.db.RestoreTrash(ctx, satelliteID)
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestExpirationBuckets(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		dir := ctx.Dir("expirations")
+		buckets, err := pieces.NewExpirationBuckets(zaptest.NewLogger(t), dir, db.PieceExpirationDB())
+		require.NoError(t, err)
+
+		hour := time.Date(2020, 5, 1, 13, 0, 0, 0, time.UTC)
+		satellite := testrand.NodeID()
+
+		first := pieces.ExpiredInfo{SatelliteID: satellite, PieceID: testrand.PieceID(), PieceSize: 1000}
+		second := pieces.ExpiredInfo{SatelliteID: testrand.NodeID(), PieceID: testrand.PieceID(), PieceSize: 2000}
+		later := pieces.ExpiredInfo{SatelliteID: satellite, PieceID: testrand.PieceID(), PieceSize: 3000}
+		require.NoError(t, buckets.SetExpiration(ctx, first.SatelliteID, first.PieceID, hour.Add(10*time.Minute), first.PieceSize))
+		require.NoError(t, buckets.SetExpiration(ctx, second.SatelliteID, second.PieceID, hour.Add(59*time.Minute), second.PieceSize))
+		require.NoError(t, buckets.SetExpiration(ctx, later.SatelliteID, later.PieceID, hour.Add(2*time.Hour), later.PieceSize))
+
+		// expirations in the database are still returned
+		legacy := testrand.PieceID()
+		require.NoError(t, db.PieceExpirationDB().SetExpiration(ctx, satellite, legacy, hour, 0))
+		expired, err := buckets.GetExpired(ctx, hour.Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, expired, 1)
+		assert.Equal(t, legacy, expired[0].PieceID)
+
+		// only buckets which expired completely are returned
+		hours, err := buckets.Buckets(ctx, hour.Add(30*time.Minute))
+		require.NoError(t, err)
+		assert.Empty(t, hours)
+
+		hours, err = buckets.Buckets(ctx, hour.Add(2*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, hours, 1)
+		assert.True(t, hour.Equal(hours[0]))
+
+		hours, err = buckets.Buckets(ctx, hour.Add(24*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, hours, 2)
+		assert.True(t, hour.Equal(hours[0]))
+		assert.True(t, hour.Add(2*time.Hour).Equal(hours[1]))
+
+		infos, err := buckets.ReadBucket(ctx, hour)
+		require.NoError(t, err)
+		assert.Equal(t, []pieces.ExpiredInfo{first, second}, infos)
+
+		// a partial record left over from a crash is ignored
+		file, err := os.OpenFile(filepath.Join(dir, "2020-05-01T13.exp"), os.O_WRONLY|os.O_APPEND, 0)
+		require.NoError(t, err)
+		_, err = file.Write([]byte{1, 2, 3})
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		infos, err = buckets.ReadBucket(ctx, hour)
+		require.NoError(t, err)
+		assert.Equal(t, []pieces.ExpiredInfo{first, second}, infos)
+
+		// and overwritten by the next expiration
+		third := pieces.ExpiredInfo{SatelliteID: satellite, PieceID: testrand.PieceID(), PieceSize: 4000}
+		require.NoError(t, buckets.SetExpiration(ctx, third.SatelliteID, third.PieceID, hour.Add(30*time.Minute), third.PieceSize))
+		infos, err = buckets.ReadBucket(ctx, hour)
+		require.NoError(t, err)
+		assert.Equal(t, []pieces.ExpiredInfo{first, second, third}, infos)
+
+		// expirations added while the bucket is collected are kept
+		added := pieces.ExpiredInfo{SatelliteID: satellite, PieceID: testrand.PieceID(), PieceSize: 5000}
+		setDone := make(chan error, 1)
+		require.NoError(t, buckets.CollectBucket(ctx, hour, func(infos []pieces.ExpiredInfo) ([]pieces.ExpiredInfo, error) {
+			assert.Equal(t, []pieces.ExpiredInfo{first, second, third}, infos)
+			go func() {
+				setDone <- buckets.SetExpiration(ctx, added.SatelliteID, added.PieceID, hour.Add(45*time.Minute), added.PieceSize)
+			}()
+			select {
+			case err := <-setDone:
+				t.Errorf("expiration added to the bucket while it's collected: %v", err)
+			case <-time.After(100 * time.Millisecond):
+			}
+			return []pieces.ExpiredInfo{first, second}, nil
+		}))
+		require.NoError(t, <-setDone)
+		infos, err = buckets.ReadBucket(ctx, hour)
+		require.NoError(t, err)
+		assert.Equal(t, []pieces.ExpiredInfo{first, second, added}, infos)
+
+		require.NoError(t, buckets.ReplaceBucket(ctx, hour, []pieces.ExpiredInfo{second}))
+		infos, err = buckets.ReadBucket(ctx, hour)
+		require.NoError(t, err)
+		assert.Equal(t, []pieces.ExpiredInfo{second}, infos)
+
+		require.NoError(t, buckets.DeleteBucket(ctx, hour))
+		infos, err = buckets.ReadBucket(ctx, hour)
+		require.NoError(t, err)
+		assert.Empty(t, infos)
+
+		hours, err = buckets.Buckets(ctx, hour.Add(24*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, hours, 1)
+		assert.True(t, hour.Add(2*time.Hour).Equal(hours[0]))
+	})
+}
@@ -0,0 +1,333 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"context"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+)
+
+// reconcileBatchSize is the number of pieces written to the index at once
+// while reconciling.
+const reconcileBatchSize = 1000
+
+// IndexedPiece is an entry of the piece index.
+type IndexedPiece struct {
+	SatelliteID   storj.NodeID
+	PieceID       storj.PieceID
+	FormatVersion storage.FormatVersion
+
+	Total       int64 // the size of the piece on disk, including the piece header
+	ContentSize int64 // the size of the piece content, excluding the piece header
+
+	// CreationTime is the creation time from the piece header, or the zero
+	// time when it's not known.
+	CreationTime time.Time
+	// ModTime is the time the piece was stored on this node.
+	ModTime time.Time
+}
+
+// PieceIndexDB stores an index of the locally stored pieces, which allows
+// listing pieces and calculating the space used without walking the blob
+// storage. Only pieces stored with storage format V1 or higher which are not
+// in the trash are indexed.
+//
+// The index is kept up to date by Store and repaired by ReconcileIndex. It's
+// only used once it has been reconciled at least once.
+//
+// architecture: Database
+type PieceIndexDB interface {
+	// Add adds the piece to the index, replacing any existing entry.
+	Add(ctx context.Context, piece IndexedPiece) error
+	// Delete removes the piece from the index.
+	Delete(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) error
+	// WalkSatellitePieces executes walkFunc for each indexed piece of the satellite, ordered
+	// by piece ID. If walkFunc returns a non-nil error, WalkSatellitePieces will stop
+	// iterating and return the error immediately.
+	WalkSatellitePieces(ctx context.Context, satelliteID storj.NodeID, walkFunc func(IndexedPiece) error) error
+	// SpaceUsedBySatellite returns the space used by the indexed pieces of each satellite.
+	SpaceUsedBySatellite(ctx context.Context) (map[storj.NodeID]SatelliteUsage, error)
+	// Reconcile adds the pieces found in the blob storage while reconciling, replacing
+	// any existing entries, and marks them as seen by the reconciliation started at
+	// reconcileStart.
+	Reconcile(ctx context.Context, pieces []IndexedPiece, reconcileStart time.Time) error
+	// FinishReconcile removes the pieces which weren't seen or added since reconcileStart,
+	// and records reconcileStart as the time of the last completed reconciliation.
+	FinishReconcile(ctx context.Context, reconcileStart time.Time) (removed int64, err error)
+	// ReconciledAt returns the start time of the last completed reconciliation, or the
+	// zero time when the index was never reconciled.
+	ReconciledAt(ctx context.Context) (time.Time, error)
+	// Invalidate marks the index as never reconciled, so it's not used until the next
+	// completed reconciliation.
+	Invalidate(ctx context.Context) error
+}
+
+// IndexReconciledAt returns the start time of the last completed reconciliation of the piece
+// index, or the zero time when the index was never reconciled or the store has no index.
+func (store *Store) IndexReconciledAt(ctx context.Context) (_ time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if store.index == nil {
+		return time.Time{}, nil
+	}
+	reconciledAt, err := store.index.ReconciledAt(ctx)
+	return reconciledAt, Error.Wrap(err)
+}
+
+// ReconcileIndex repairs the piece index from the blob storage: pieces which are missing from
+// the index are added, and indexed pieces which are no longer stored are removed.
+func (store *Store) ReconcileIndex(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if store.index == nil {
+		return nil
+	}
+
+	// updates failing from now on are repaired by the next reconciliation
+	atomic.StoreInt32(&store.indexDirty, 0)
+	defer func() {
+		if err != nil {
+			atomic.StoreInt32(&store.indexDirty, 1)
+		}
+	}()
+
+	reconcileStart := time.Now()
+	satellites, err := store.getAllStoringSatellites(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var indexed int
+	batch := make([]IndexedPiece, 0, reconcileBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := store.index.Reconcile(ctx, batch, reconcileStart); err != nil {
+			return err
+		}
+		// a piece deleted after it was walked may have been removed from the
+		// index before the batch was written. Checking the blobs after writing
+		// the batch removes such entries, pieces deleted later are removed from
+		// the index by the deletion itself.
+		for _, piece := range batch {
+			_, err := store.blobs.StatWithStorageFormat(ctx, storage.BlobRef{
+				Namespace: piece.SatelliteID.Bytes(),
+				Key:       piece.PieceID.Bytes(),
+			}, piece.FormatVersion)
+			if !os.IsNotExist(err) {
+				indexed++
+				continue
+			}
+			if err := store.index.Delete(ctx, piece.SatelliteID, piece.PieceID); err != nil {
+				return err
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for _, satellite := range satellites {
+		err := store.walkSatelliteBlobs(ctx, satellite, func(access StoredPieceAccess) error {
+			piece, err := indexedPieceFromBlob(ctx, satellite, access)
+			if err != nil {
+				if !os.IsNotExist(err) {
+					// the piece is dropped from the index, which only keeps it
+					// from being garbage collected until the next reconciliation.
+					store.log.Warn("failed to stat piece while reconciling the piece index",
+						zap.Stringer("Satellite ID", satellite), zap.Stringer("Piece ID", access.PieceID()), zap.Error(err))
+				}
+				return nil
+			}
+			batch = append(batch, piece)
+			if len(batch) >= reconcileBatchSize {
+				return flush()
+			}
+			return nil
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	if err := flush(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	removed, err := store.index.FinishReconcile(ctx, reconcileStart)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if atomic.LoadInt32(&store.indexDirty) != 0 {
+		// an update failed while reconciling, the index may already be stale
+		return Error.Wrap(store.index.Invalidate(ctx))
+	}
+
+	store.log.Info("reconciled piece index", zap.Int("indexed", indexed), zap.Int64("removed", removed))
+	return nil
+}
+
+// indexReady returns whether the piece index can be used instead of walking the blob storage.
+func (store *Store) indexReady(ctx context.Context) bool {
+	reconciledAt, err := store.IndexReconciledAt(ctx)
+	if err != nil {
+		store.log.Warn("failed to read piece index status, walking the blob storage instead", zap.Error(err))
+		return false
+	}
+	return !reconciledAt.IsZero() && atomic.LoadInt32(&store.indexDirty) == 0
+}
+
+// markIndexDirty stops using the index until it's reconciled again, since an
+// update of the index failed. The mark is persisted, so the reconciliation is
+// also forced after a restart.
+func (store *Store) markIndexDirty(ctx context.Context) {
+	atomic.StoreInt32(&store.indexDirty, 1)
+	if err := store.index.Invalidate(ctx); err != nil {
+		store.log.Warn("failed to invalidate the piece index", zap.Error(err))
+	}
+}
+
+// addToIndex adds the piece to the index. Failures mark the index dirty, so it's
+// repaired by the next reconciliation.
+func (store *Store) addToIndex(ctx context.Context, piece IndexedPiece) {
+	if store.index == nil {
+		return
+	}
+	if err := store.index.Add(ctx, piece); err != nil {
+		store.log.Warn("failed to add piece to the piece index",
+			zap.Stringer("Satellite ID", piece.SatelliteID), zap.Stringer("Piece ID", piece.PieceID), zap.Error(err))
+		store.markIndexDirty(ctx)
+	}
+}
+
+// removeFromIndex removes the piece from the index. Failures mark the index
+// dirty, so it's repaired by the next reconciliation.
+func (store *Store) removeFromIndex(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) {
+	if store.index == nil {
+		return
+	}
+	if err := store.index.Delete(ctx, satellite, pieceID); err != nil {
+		store.log.Warn("failed to remove piece from the piece index",
+			zap.Stringer("Satellite ID", satellite), zap.Stringer("Piece ID", pieceID), zap.Error(err))
+		store.markIndexDirty(ctx)
+	}
+}
+
+// reindex adds the currently stored blob of the piece to the index.
+func (store *Store) reindex(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) {
+	if store.index == nil {
+		return
+	}
+	blobInfo, err := store.blobs.Stat(ctx, storage.BlobRef{
+		Namespace: satellite.Bytes(),
+		Key:       pieceID.Bytes(),
+	})
+	if err == nil && blobInfo.StorageFormatVersion() < filestore.FormatV1 {
+		return
+	}
+	var piece IndexedPiece
+	if err == nil {
+		piece, err = indexedPieceFromBlob(ctx, satellite, storedPieceAccess{BlobInfo: blobInfo, store: store, pieceID: pieceID})
+	}
+	if err != nil {
+		store.log.Warn("failed to stat piece for the piece index",
+			zap.Stringer("Satellite ID", satellite), zap.Stringer("Piece ID", pieceID), zap.Error(err))
+		store.markIndexDirty(ctx)
+		return
+	}
+	store.addToIndex(ctx, piece)
+}
+
+// indexedPieceFromBlob creates an index entry from the stored blob of a piece.
+func indexedPieceFromBlob(ctx context.Context, satellite storj.NodeID, access StoredPieceAccess) (IndexedPiece, error) {
+	stat, err := access.Stat(ctx)
+	if err != nil {
+		return IndexedPiece{}, err
+	}
+	contentSize := stat.Size()
+	if access.StorageFormatVersion() >= filestore.FormatV1 {
+		contentSize -= V1PieceHeaderReservedArea
+	}
+	return IndexedPiece{
+		SatelliteID:   satellite,
+		PieceID:       access.PieceID(),
+		FormatVersion: access.StorageFormatVersion(),
+		Total:         stat.Size(),
+		ContentSize:   contentSize,
+		ModTime:       stat.ModTime(),
+	}, nil
+}
+
+// indexedPieceAccess implements StoredPieceAccess for an entry of the piece index.
+// The blob is only looked up when the entry doesn't contain the requested information.
+type indexedPieceAccess struct {
+	store *Store
+	piece IndexedPiece
+}
+
+// BlobRef returns the relevant storage.BlobRef locator
+func (access indexedPieceAccess) BlobRef() storage.BlobRef {
+	return storage.BlobRef{
+		Namespace: access.piece.SatelliteID.Bytes(),
+		Key:       access.piece.PieceID.Bytes(),
+	}
+}
+
+// StorageFormatVersion indicates the storage format version used to store the piece
+func (access indexedPieceAccess) StorageFormatVersion() storage.FormatVersion {
+	return access.piece.FormatVersion
+}
+
+// FullPath gives the full path to the on-disk blob file
+func (access indexedPieceAccess) FullPath(ctx context.Context) (string, error) {
+	blobInfo, err := access.store.blobs.StatWithStorageFormat(ctx, access.BlobRef(), access.piece.FormatVersion)
+	if err != nil {
+		return "", err
+	}
+	return blobInfo.FullPath(ctx)
+}
+
+// Stat does a stat on the on-disk blob file
+func (access indexedPieceAccess) Stat(ctx context.Context) (os.FileInfo, error) {
+	blobInfo, err := access.store.blobs.StatWithStorageFormat(ctx, access.BlobRef(), access.piece.FormatVersion)
+	if err != nil {
+		return nil, err
+	}
+	return blobInfo.Stat(ctx)
+}
+
+// PieceID returns the piece ID of the piece
+func (access indexedPieceAccess) PieceID() storj.PieceID {
+	return access.piece.PieceID
+}
+
+// Satellite returns the satellite ID that owns the piece
+func (access indexedPieceAccess) Satellite() (storj.NodeID, error) {
+	return access.piece.SatelliteID, nil
+}
+
+// Size gives the size of the piece on disk, and the size of the content (not including the piece header, if applicable)
+func (access indexedPieceAccess) Size(ctx context.Context) (int64, int64, error) {
+	return access.piece.Total, access.piece.ContentSize, nil
+}
+
+// CreationTime returns the piece creation time as given in the original PieceHash. When the
+// index doesn't know it, this requires opening the file and unmarshaling the piece header.
+func (access indexedPieceAccess) CreationTime(ctx context.Context) (cTime time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !access.piece.CreationTime.IsZero() {
+		return access.piece.CreationTime, nil
+	}
+	return access.store.pieceCreationTime(ctx, access.piece.SatelliteID, access.piece.PieceID, access.piece.FormatVersion)
+}
+
+// ModTime returns the time the piece was stored on this node, as recorded by the index.
+func (access indexedPieceAccess) ModTime(ctx context.Context) (time.Time, error) {
+	return access.piece.ModTime, nil
+}
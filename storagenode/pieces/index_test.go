@@ -0,0 +1,180 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestPieceIndex(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), db.PieceIndex())
+
+		satellite := testrand.NodeID()
+		creationTime := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+
+		writePiece := func(pieceID storj.PieceID, size memory.Size) {
+			writer, err := store.Writer(ctx, satellite, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.BytesInt(size.Int()))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{CreationTime: creationTime}))
+		}
+
+		listPieces := func() map[storj.PieceID]pieces.StoredPieceAccess {
+			listed := map[storj.PieceID]pieces.StoredPieceAccess{}
+			err := store.WalkSatellitePieces(ctx, satellite, func(access pieces.StoredPieceAccess) error {
+				listed[access.PieceID()] = access
+				return nil
+			})
+			require.NoError(t, err)
+			return listed
+		}
+
+		// a piece stored before the index was used
+		existing := testrand.PieceID()
+		writePiece(existing, memory.KiB)
+		require.NoError(t, db.PieceIndex().Delete(ctx, satellite, existing))
+
+		// the index isn't used before it's reconciled
+		reconciledAt, err := store.IndexReconciledAt(ctx)
+		require.NoError(t, err)
+		assert.True(t, reconciledAt.IsZero())
+		assert.Contains(t, listPieces(), existing)
+
+		require.NoError(t, store.ReconcileIndex(ctx))
+		reconciledAt, err = store.IndexReconciledAt(ctx)
+		require.NoError(t, err)
+		assert.False(t, reconciledAt.IsZero())
+
+		// committed pieces are added to the index
+		committed := testrand.PieceID()
+		writePiece(committed, 2*memory.KiB)
+
+		listed := listPieces()
+		require.Len(t, listed, 2)
+
+		total, contentSize, err := listed[committed].Size(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2*memory.KiB.Int64(), contentSize)
+		assert.Equal(t, contentSize+pieces.V1PieceHeaderReservedArea, total)
+
+		pieceCreation, err := listed[committed].CreationTime(ctx)
+		require.NoError(t, err)
+		assert.True(t, creationTime.Equal(pieceCreation))
+
+		// the creation time of reconciled pieces is read from the piece header
+		pieceCreation, err = listed[existing].CreationTime(ctx)
+		require.NoError(t, err)
+		assert.True(t, creationTime.Equal(pieceCreation))
+
+		piecesTotal, piecesContentSize, bySatellite, err := store.SpaceUsedTotalAndBySatellite(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 3*memory.KiB.Int64(), piecesContentSize)
+		assert.Equal(t, piecesContentSize+2*pieces.V1PieceHeaderReservedArea, piecesTotal)
+		assert.Equal(t, piecesTotal, bySatellite[satellite].Total)
+
+		// trashed pieces are removed from the index until they are restored
+		require.NoError(t, store.Trash(ctx, satellite, committed))
+		assert.NotContains(t, listPieces(), committed)
+		require.NoError(t, store.RestoreTrash(ctx, satellite))
+		assert.Contains(t, listPieces(), committed)
+
+		// deleted pieces are removed from the index
+		require.NoError(t, store.Delete(ctx, satellite, committed))
+		assert.NotContains(t, listPieces(), committed)
+
+		// drift is repaired by reconciling
+		require.NoError(t, db.Pieces().Delete(ctx, storage.BlobRef{
+			Namespace: satellite.Bytes(),
+			Key:       existing.Bytes(),
+		}))
+		unindexed := testrand.PieceID()
+		writePiece(unindexed, memory.KiB)
+		require.NoError(t, db.PieceIndex().Delete(ctx, satellite, unindexed))
+
+		listed = listPieces()
+		assert.Contains(t, listed, existing)
+		assert.NotContains(t, listed, unindexed)
+
+		require.NoError(t, store.ReconcileIndex(ctx))
+
+		listed = listPieces()
+		assert.NotContains(t, listed, existing)
+		assert.Contains(t, listed, unindexed)
+	})
+}
+
+// failingPieceIndex is a piece index where adding pieces fails.
+type failingPieceIndex struct {
+	pieces.PieceIndexDB
+}
+
+func (index failingPieceIndex) Add(ctx context.Context, piece pieces.IndexedPiece) error {
+	return errs.New("add failed")
+}
+
+func TestPieceIndexDirty(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), failingPieceIndex{db.PieceIndex()})
+		satellite := testrand.NodeID()
+
+		require.NoError(t, store.ReconcileIndex(ctx))
+		reconciledAt, err := store.IndexReconciledAt(ctx)
+		require.NoError(t, err)
+		require.False(t, reconciledAt.IsZero())
+
+		// a failed update invalidates the index
+		pieceID := testrand.PieceID()
+		writer, err := store.Writer(ctx, satellite, pieceID)
+		require.NoError(t, err)
+		_, err = writer.Write(testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+
+		reconciledAt, err = store.IndexReconciledAt(ctx)
+		require.NoError(t, err)
+		assert.True(t, reconciledAt.IsZero())
+
+		// the blob storage is walked instead
+		var listed []storj.PieceID
+		err = store.WalkSatellitePieces(ctx, satellite, func(access pieces.StoredPieceAccess) error {
+			listed = append(listed, access.PieceID())
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []storj.PieceID{pieceID}, listed)
+
+		// reconciling repairs the index
+		require.NoError(t, store.ReconcileIndex(ctx))
+		reconciledAt, err = store.IndexReconciledAt(ctx)
+		require.NoError(t, err)
+		assert.False(t, reconciledAt.IsZero())
+
+		indexed := 0
+		err = db.PieceIndex().WalkSatellitePieces(ctx, satellite, func(piece pieces.IndexedPiece) error {
+			indexed++
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, indexed)
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/sync2"
+)
+
+// IndexChore is the chore that periodically reconciles the piece index with
+// the blob storage, to repair any drift, e.g. after a crash.
+type IndexChore struct {
+	log      *zap.Logger
+	interval time.Duration
+	store    *Store
+	cycle    *sync2.Cycle
+	started  sync2.Fence
+}
+
+// NewIndexChore instantiates a new IndexChore.
+func NewIndexChore(log *zap.Logger, interval time.Duration, store *Store) *IndexChore {
+	return &IndexChore{
+		log:      log,
+		interval: interval,
+		store:    store,
+	}
+}
+
+// Run starts the cycle
+func (chore *IndexChore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	first := true
+	chore.cycle = sync2.NewCycle(chore.interval)
+	chore.cycle.Start(ctx, &errgroup.Group{}, func(ctx context.Context) error {
+		if first {
+			first = false
+			// reconciling requires walking all pieces, so it's not repeated on
+			// every restart
+			reconciledAt, err := chore.store.IndexReconciledAt(ctx)
+			if err != nil {
+				chore.log.Error("failed to read piece index status", zap.Error(err))
+			} else if time.Since(reconciledAt) < chore.interval {
+				return nil
+			}
+		}
+
+		chore.log.Debug("starting piece index reconciliation")
+		if err := chore.store.ReconcileIndex(ctx); err != nil {
+			chore.log.Error("piece index reconciliation failed", zap.Error(err))
+		}
+		return nil
+	})
+	chore.started.Release()
+	return err
+}
+
+// TriggerWait ensures that the cycle is done at least once and waits for
+// completion.  If the cycle is currently running it waits for the previous to
+// complete and then runs.
+func (chore *IndexChore) TriggerWait(ctx context.Context) {
+	chore.started.Wait(ctx)
+	chore.cycle.TriggerWait()
+}
+
+// Close the chore
+func (chore *IndexChore) Close() error {
+	if chore.cycle != nil {
+		chore.cycle.Close()
+	}
+	return nil
+}
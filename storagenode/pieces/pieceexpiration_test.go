@@ -47,11 +47,11 @@ func TestPieceExpirationDB(t *testing.T) {
 		expireAt := time.Now()
 
 		// SetExpiration normal usage
-		err = expireDB.SetExpiration(ctx, satelliteID, pieceID, expireAt)
+		err = expireDB.SetExpiration(ctx, satelliteID, pieceID, expireAt, 0)
 		require.NoError(t, err)
 
 		// SetExpiration duplicate
-		err = expireDB.SetExpiration(ctx, satelliteID, pieceID, expireAt.Add(time.Hour))
+		err = expireDB.SetExpiration(ctx, satelliteID, pieceID, expireAt.Add(time.Hour), 0)
 		require.Error(t, err)
 
 		// GetExpired normal usage
@@ -8,6 +8,7 @@ import (
 	"encoding/binary"
 	"hash"
 	"io"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/zeebo/errs"
@@ -70,6 +71,11 @@ type Writer struct {
 	blobs     storage.Blobs
 	satellite storj.NodeID
 	closed    bool
+
+	// store and pieceID are set when the writer was created by a Store, which
+	// indexes the piece once it's committed.
+	store   *Store
+	pieceID storj.PieceID
 }
 
 // NewWriter creates a new writer for storage.BlobWriter.
@@ -126,8 +132,19 @@ func (w *Writer) Commit(ctx context.Context, pieceHeader *pb.PieceHeader) (err e
 	defer func() {
 		if err != nil {
 			err = Error.Wrap(errs.Combine(err, w.blob.Cancel(ctx)))
-		} else {
-			err = Error.Wrap(w.blob.Commit(ctx))
+			return
+		}
+		err = Error.Wrap(w.blob.Commit(ctx))
+		if err == nil && w.store != nil && w.blob.StorageFormatVersion() >= filestore.FormatV1 {
+			w.store.addToIndex(ctx, IndexedPiece{
+				SatelliteID:   w.satellite,
+				PieceID:       w.pieceID,
+				FormatVersion: w.blob.StorageFormatVersion(),
+				Total:         w.pieceSize + V1PieceHeaderReservedArea,
+				ContentSize:   w.pieceSize,
+				CreationTime:  pieceHeader.CreationTime,
+				ModTime:       time.Now(),
+			})
 		}
 	}()
 
@@ -296,6 +313,7 @@ func (r *Reader) Read(data []byte) (int, error) {
 		if _, err := r.blob.Seek(V1PieceHeaderReservedArea, io.SeekStart); err != nil {
 			return 0, Error.Wrap(err)
 		}
+		r.pos = V1PieceHeaderReservedArea
 	}
 	n, err := r.blob.Read(data)
 	r.pos += int64(n)
@@ -7,6 +7,7 @@ import (
 	"context"
 	"io"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -48,6 +49,8 @@ type Info struct {
 type ExpiredInfo struct {
 	SatelliteID storj.NodeID
 	PieceID     storj.PieceID
+	// PieceSize is the size of the piece, when it's known.
+	PieceSize int64
 
 	// This can be removed when we no longer need to support the pieceinfo db. Its only purpose
 	// is to keep track of whether expired entries came from piece_expirations or pieceinfo.
@@ -60,8 +63,9 @@ type ExpiredInfo struct {
 type PieceExpirationDB interface {
 	// GetExpired gets piece IDs that expire or have expired before the given time
 	GetExpired(ctx context.Context, expiresBefore time.Time, limit int64) ([]ExpiredInfo, error)
-	// SetExpiration sets an expiration time for the given piece ID on the given satellite
-	SetExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, expiresAt time.Time) error
+	// SetExpiration sets an expiration time for the given piece ID on the given satellite. The
+	// piece size is only used for accounting, it may not be stored.
+	SetExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, expiresAt time.Time, pieceSize int64) error
 	// DeleteExpiration removes an expiration record for the given piece ID on the given satellite
 	DeleteExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (found bool, err error)
 	// DeleteFailed marks an expiration record as having experienced a failure in deleting the
@@ -165,6 +169,11 @@ type Store struct {
 	v0PieceInfo    V0PieceInfoDB
 	expirationInfo PieceExpirationDB
 	spaceUsedDB    PieceSpaceUsedDB
+	index          PieceIndexDB
+
+	// indexDirty is set when an update of the piece index failed, until
+	// the index is reconciled again.
+	indexDirty int32
 }
 
 // StoreForTest is a wrapper around Store to be used only in test scenarios. It enables writing
@@ -174,13 +183,14 @@ type StoreForTest struct {
 }
 
 // NewStore creates a new piece store
-func NewStore(log *zap.Logger, blobs storage.Blobs, v0PieceInfo V0PieceInfoDB, expirationInfo PieceExpirationDB, pieceSpaceUsedDB PieceSpaceUsedDB) *Store {
+func NewStore(log *zap.Logger, blobs storage.Blobs, v0PieceInfo V0PieceInfoDB, expirationInfo PieceExpirationDB, pieceSpaceUsedDB PieceSpaceUsedDB, pieceIndex PieceIndexDB) *Store {
 	return &Store{
 		log:            log,
 		blobs:          blobs,
 		v0PieceInfo:    v0PieceInfo,
 		expirationInfo: expirationInfo,
 		spaceUsedDB:    pieceSpaceUsedDB,
+		index:          pieceIndex,
 	}
 }
 
@@ -195,8 +205,18 @@ func (store *Store) Writer(ctx context.Context, satellite storj.NodeID, pieceID
 		return nil, Error.Wrap(err)
 	}
 
+	return store.newWriter(blobWriter, satellite, pieceID)
+}
+
+// newWriter creates a piece writer which adds the piece to the piece index once it's committed.
+func (store *Store) newWriter(blobWriter storage.BlobWriter, satellite storj.NodeID, pieceID storj.PieceID) (*Writer, error) {
 	writer, err := NewWriter(store.log.Named("blob-writer"), blobWriter, store.blobs, satellite)
-	return writer, Error.Wrap(err)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	writer.store = store
+	writer.pieceID = pieceID
+	return writer, nil
 }
 
 // WriterForFormatVersion allows opening a piece writer with a specified storage format version.
@@ -227,8 +247,7 @@ func (store StoreForTest) WriterForFormatVersion(ctx context.Context, satellite
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
-	writer, err := NewWriter(store.log.Named("blob-writer"), blobWriter, store.blobs, satellite)
-	return writer, Error.Wrap(err)
+	return store.newWriter(blobWriter, satellite, pieceID)
 }
 
 // Reader returns a new piece reader.
@@ -249,6 +268,39 @@ func (store *Store) Reader(ctx context.Context, satellite storj.NodeID, pieceID
 	return reader, Error.Wrap(err)
 }
 
+// UncachedReader returns a new piece reader for a located piece, which isn't
+// served from a read cache, so the stored piece itself is read.
+func (store *Store) UncachedReader(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (_ *Reader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	blob, err := openUncached(ctx, store.blobs, storage.BlobRef{
+		Namespace: satellite.Bytes(),
+		Key:       pieceID.Bytes(),
+	})
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, err
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	reader, err := NewReader(blob)
+	return reader, Error.Wrap(err)
+}
+
+// uncachedOpener is implemented by blob stores which serve blobs from a read
+// cache, to open the stored blob itself.
+type uncachedOpener interface {
+	OpenUncached(ctx context.Context, ref storage.BlobRef) (storage.BlobReader, error)
+}
+
+// openUncached opens the blob bypassing the read cache of blobs, if it has one.
+func openUncached(ctx context.Context, blobs storage.Blobs, ref storage.BlobRef) (storage.BlobReader, error) {
+	if opener, ok := blobs.(uncachedOpener); ok {
+		return opener.OpenUncached(ctx, ref)
+	}
+	return blobs.Open(ctx, ref)
+}
+
 // ReaderWithStorageFormat returns a new piece reader for a located piece, which avoids the
 // potential need to check multiple storage formats to find the right blob.
 func (store *Store) ReaderWithStorageFormat(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, formatVersion storage.FormatVersion) (_ *Reader, err error) {
@@ -276,6 +328,7 @@ func (store *Store) Delete(ctx context.Context, satellite storj.NodeID, pieceID
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	store.removeFromIndex(ctx, satellite, pieceID)
 
 	// delete records in both the piece_expirations and pieceinfo DBs, wherever we find it.
 	// both of these calls should return no error if the requested record is not found.
@@ -314,45 +367,98 @@ func (store *Store) Trash(ctx context.Context, satellite storj.NodeID, pieceID s
 	}
 
 	err = store.expirationInfo.Trash(ctx, satellite, pieceID)
-	err = errs.Combine(err, store.blobs.Trash(ctx, storage.BlobRef{
+	trashErr := store.blobs.Trash(ctx, storage.BlobRef{
 		Namespace: satellite.Bytes(),
 		Key:       pieceID.Bytes(),
-	}))
+	})
+	if trashErr == nil {
+		store.removeFromIndex(ctx, satellite, pieceID)
+	}
 
-	return Error.Wrap(err)
+	return Error.Wrap(errs.Combine(err, trashErr))
 }
 
 // EmptyTrash deletes pieces in the trash that have been in there longer than trashExpiryInterval
-func (store *Store) EmptyTrash(ctx context.Context, satelliteID storj.NodeID, trashedBefore time.Time) (err error) {
+// and returns the total bytes emptied.
+func (store *Store) EmptyTrash(ctx context.Context, satelliteID storj.NodeID, trashedBefore time.Time) (bytesEmptied int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	_, deletedIDs, err := store.blobs.EmptyTrash(ctx, satelliteID[:], trashedBefore)
+	bytesEmptied, deletedIDs, err := store.blobs.EmptyTrash(ctx, satelliteID[:], trashedBefore)
 	if err != nil {
-		return Error.Wrap(err)
+		return bytesEmptied, Error.Wrap(err)
 	}
 
 	for _, deletedID := range deletedIDs {
 		pieceID, pieceIDErr := storj.PieceIDFromBytes(deletedID)
 		if pieceIDErr != nil {
-			return Error.Wrap(pieceIDErr)
+			return bytesEmptied, Error.Wrap(pieceIDErr)
 		}
 		_, deleteErr := store.expirationInfo.DeleteExpiration(ctx, satelliteID, pieceID)
 		err = errs.Combine(err, deleteErr)
 	}
-	return Error.Wrap(err)
+	return bytesEmptied, Error.Wrap(err)
 }
 
 // RestoreTrash restores all pieces in the trash
 func (store *Store) RestoreTrash(ctx context.Context, satelliteID storj.NodeID) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	_, err = store.blobs.RestoreTrash(ctx, satelliteID.Bytes())
+	restored, err := store.blobs.RestoreTrash(ctx, satelliteID.Bytes())
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	for _, key := range restored {
+		pieceID, err := storj.PieceIDFromBytes(key)
+		if err != nil {
+			continue
+		}
+		store.reindex(ctx, satelliteID, pieceID)
+	}
 	return Error.Wrap(store.expirationInfo.RestoreTrash(ctx, satelliteID))
 }
 
+// Quarantine copies the stored blob of the specified piece, including its header, into
+// quarantineDir and then deletes the piece. It returns the path of the quarantined copy.
+//
+// Quarantined pieces are no longer served or accounted for; they're only kept for inspection
+// by the operator.
+func (store *Store) Quarantine(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, quarantineDir string) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the stored piece is quarantined, not a copy of it in the read cache
+	blob, err := openUncached(ctx, store.blobs, storage.BlobRef{
+		Namespace: satellite.Bytes(),
+		Key:       pieceID.Bytes(),
+	})
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", err
+		}
+		return "", Error.Wrap(err)
+	}
+
+	dir := filepath.Join(quarantineDir, satellite.String())
+	path := filepath.Join(dir, pieceID.String())
+	err = func() (err error) {
+		defer func() { err = errs.Combine(err, blob.Close()) }()
+
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(file, blob)
+		return errs.Combine(err, file.Close())
+	}()
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	return path, store.Delete(ctx, satellite, pieceID)
+}
+
 // MigrateV0ToV1 will migrate a piece stored with storage format v0 to storage
 // format v1. If the piece is not stored as a v0 piece it will return an error.
 // The follow failures are possible:
@@ -452,11 +558,29 @@ func (store *Store) GetHashAndLimit(ctx context.Context, satellite storj.NodeID,
 // and return the error immediately. The ctx parameter is intended specifically to allow canceling
 // iteration early.
 //
-// Note that this method includes all locally stored pieces, both V0 and higher.
+// Note that this method includes all locally stored pieces, both V0 and higher. Pieces stored
+// with V1 or higher are listed from the piece index, once it has been reconciled.
 func (store *Store) WalkSatellitePieces(ctx context.Context, satellite storj.NodeID, walkFunc func(StoredPieceAccess) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	// first iterate over all in V1 storage, then all in V0
-	err = store.blobs.WalkNamespace(ctx, satellite.Bytes(), func(blobInfo storage.BlobInfo) error {
+	if store.indexReady(ctx) {
+		err = store.index.WalkSatellitePieces(ctx, satellite, func(piece IndexedPiece) error {
+			return walkFunc(indexedPieceAccess{store: store, piece: piece})
+		})
+	} else {
+		err = store.walkSatelliteBlobs(ctx, satellite, walkFunc)
+	}
+	if err == nil {
+		err = store.walkSatelliteV0Pieces(ctx, satellite, walkFunc)
+	}
+	return err
+}
+
+// walkSatelliteBlobs executes walkFunc for each piece of the satellite stored in the blob
+// storage with storage format V1 or higher.
+func (store *Store) walkSatelliteBlobs(ctx context.Context, satellite storj.NodeID, walkFunc func(StoredPieceAccess) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.blobs.WalkNamespace(ctx, satellite.Bytes(), func(blobInfo storage.BlobInfo) error {
 		if blobInfo.StorageFormatVersion() < filestore.FormatV1 {
 			// we'll address this piece while iterating over the V0 pieces below.
 			return nil
@@ -470,10 +594,16 @@ func (store *Store) WalkSatellitePieces(ctx context.Context, satellite storj.Nod
 		}
 		return walkFunc(pieceAccess)
 	})
-	if err == nil && store.v0PieceInfo != nil {
-		err = store.v0PieceInfo.WalkSatelliteV0Pieces(ctx, store.blobs, satellite, walkFunc)
+}
+
+// walkSatelliteV0Pieces executes walkFunc for each piece of the satellite stored with
+// storage format V0.
+func (store *Store) walkSatelliteV0Pieces(ctx context.Context, satellite storj.NodeID, walkFunc func(StoredPieceAccess) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if store.v0PieceInfo == nil {
+		return nil
 	}
-	return err
+	return store.v0PieceInfo.WalkSatelliteV0Pieces(ctx, store.blobs, satellite, walkFunc)
 }
 
 // GetExpired gets piece IDs that are expired and were created before the given time
@@ -495,8 +625,8 @@ func (store *Store) GetExpired(ctx context.Context, expiredAt time.Time, limit i
 }
 
 // SetExpiration records an expiration time for the specified piece ID owned by the specified satellite
-func (store *Store) SetExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, expiresAt time.Time) (err error) {
-	return store.expirationInfo.SetExpiration(ctx, satellite, pieceID, expiresAt)
+func (store *Store) SetExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, expiresAt time.Time, pieceSize int64) (err error) {
+	return store.expirationInfo.SetExpiration(ctx, satellite, pieceID, expiresAt, pieceSize)
 }
 
 // DeleteFailed marks piece as a failed deletion.
@@ -561,6 +691,28 @@ func (store *Store) SpaceUsedForPiecesAndTrash(ctx context.Context) (int64, erro
 	return piecesTotal + trashTotal, nil
 }
 
+// Locations returns the status of the directories of the blob store, when it
+// spans multiple directories.
+func (store *Store) Locations(ctx context.Context) []filestore.LocationStatus {
+	blobs := store.blobs
+	if cache, ok := blobs.(*BlobsUsageCache); ok {
+		blobs = cache.Blobs
+	}
+	if locator, ok := blobs.(interface {
+		Locations(ctx context.Context) []filestore.LocationStatus
+	}); ok {
+		return locator.Locations(ctx)
+	}
+	return nil
+}
+
+// StoringSatellites returns the satellites which have a namespace in the blob store.
+func (store *Store) StoringSatellites(ctx context.Context) (_ []storj.NodeID, err error) {
+	defer mon.Task()(&ctx)(&err)
+	satellites, err := store.getAllStoringSatellites(ctx)
+	return satellites, Error.Wrap(err)
+}
+
 func (store *Store) getAllStoringSatellites(ctx context.Context) ([]storj.NodeID, error) {
 	namespaces, err := store.blobs.ListNamespaces(ctx)
 	if err != nil {
@@ -614,14 +766,25 @@ func (store *Store) SpaceUsedTotalAndBySatellite(ctx context.Context) (piecesTot
 		return 0, 0, nil, Error.New("failed to enumerate satellites: %w", err)
 	}
 
+	// the piece index already knows the sizes of all pieces, except V0 pieces
+	var indexed map[storj.NodeID]SatelliteUsage
+	walk := store.WalkSatellitePieces
+	if store.indexReady(ctx) {
+		indexed, err = store.index.SpaceUsedBySatellite(ctx)
+		if err != nil {
+			return 0, 0, nil, Error.Wrap(err)
+		}
+		walk = store.walkSatelliteV0Pieces
+	}
+
 	totalBySatellite = map[storj.NodeID]SatelliteUsage{}
 	var group errs.Group
 
 	for _, satelliteID := range satelliteIDs {
-		var satPiecesTotal int64
-		var satPiecesContentSize int64
+		satPiecesTotal := indexed[satelliteID].Total
+		satPiecesContentSize := indexed[satelliteID].ContentSize
 
-		err := store.WalkSatellitePieces(ctx, satelliteID, func(access StoredPieceAccess) error {
+		err := walk(ctx, satelliteID, func(access StoredPieceAccess) error {
 			pieceTotal, pieceContentSize, err := access.Size(ctx)
 			if err != nil {
 				return err
@@ -721,10 +884,17 @@ func (access storedPieceAccess) CreationTime(ctx context.Context) (cTime time.Ti
 	if err != nil {
 		return time.Time{}, err
 	}
-	reader, err := access.store.ReaderWithStorageFormat(ctx, satellite, access.PieceID(), access.StorageFormatVersion())
+	return access.store.pieceCreationTime(ctx, satellite, access.PieceID(), access.StorageFormatVersion())
+}
+
+// pieceCreationTime reads the piece creation time from the piece header.
+func (store *Store) pieceCreationTime(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, formatVersion storage.FormatVersion) (_ time.Time, err error) {
+	reader, err := store.ReaderWithStorageFormat(ctx, satellite, pieceID, formatVersion)
 	if err != nil {
 		return time.Time{}, err
 	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
 	header, err := reader.GetPieceHeader()
 	if err != nil {
 		return time.Time{}, err
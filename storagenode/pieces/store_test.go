@@ -44,7 +44,7 @@ func TestPieces(t *testing.T) {
 	blobs := filestore.New(zaptest.NewLogger(t), dir)
 	defer ctx.Check(blobs.Close)
 
-	store := pieces.NewStore(zaptest.NewLogger(t), blobs, nil, nil, nil)
+	store := pieces.NewStore(zaptest.NewLogger(t), blobs, nil, nil, nil, nil)
 
 	satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
 	pieceID := storj.NewPieceID()
@@ -320,7 +320,7 @@ func TestTrashAndRestore(t *testing.T) {
 		v0PieceInfo, ok := db.V0PieceInfo().(pieces.V0PieceInfoDBForTest)
 		require.True(t, ok, "V0PieceInfoDB can not satisfy V0PieceInfoDBForTest")
 
-		store := pieces.NewStore(zaptest.NewLogger(t), blobs, v0PieceInfo, db.PieceExpirationDB(), nil)
+		store := pieces.NewStore(zaptest.NewLogger(t), blobs, v0PieceInfo, db.PieceExpirationDB(), nil, nil)
 		tStore := &pieces.StoreForTest{store}
 
 		var satelliteURLs []trust.SatelliteURL
@@ -336,7 +336,7 @@ func TestTrashAndRestore(t *testing.T) {
 			for _, piece := range satellite.pieces {
 				// If test has expiration, add to expiration db
 				if !piece.expiration.IsZero() {
-					require.NoError(t, store.SetExpiration(ctx, satellite.satelliteID, piece.pieceID, piece.expiration))
+					require.NoError(t, store.SetExpiration(ctx, satellite.satelliteID, piece.pieceID, piece.expiration, 0))
 				}
 
 				for _, file := range piece.files {
@@ -555,7 +555,7 @@ func TestPieceVersionMigrate(t *testing.T) {
 		require.NoError(t, err)
 		defer ctx.Check(blobs.Close)
 
-		store := pieces.NewStore(zaptest.NewLogger(t), blobs, v0PieceInfo, nil, nil)
+		store := pieces.NewStore(zaptest.NewLogger(t), blobs, v0PieceInfo, nil, nil, nil)
 
 		// write as a v0 piece
 		tStore := &pieces.StoreForTest{store}
@@ -638,7 +638,7 @@ func TestMultipleStorageFormatVersions(t *testing.T) {
 	require.NoError(t, err)
 	defer ctx.Check(blobs.Close)
 
-	store := pieces.NewStore(zaptest.NewLogger(t), blobs, nil, nil, nil)
+	store := pieces.NewStore(zaptest.NewLogger(t), blobs, nil, nil, nil, nil)
 
 	const pieceSize = 1024
 
@@ -690,7 +690,7 @@ func TestGetExpired(t *testing.T) {
 		require.True(t, ok, "V0PieceInfoDB can not satisfy V0PieceInfoDBForTest")
 		expirationInfo := db.PieceExpirationDB()
 
-		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), v0PieceInfo, expirationInfo, db.PieceSpaceUsedDB())
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), v0PieceInfo, expirationInfo, db.PieceSpaceUsedDB(), nil)
 
 		now := time.Now().UTC()
 		testDates := []struct {
@@ -719,9 +719,9 @@ func TestGetExpired(t *testing.T) {
 		require.NoError(t, err)
 
 		// put testPieces 2 and 3 in the piece_expirations db
-		err = expirationInfo.SetExpiration(ctx, testPieces[2].SatelliteID, testPieces[2].PieceID, testPieces[2].PieceExpiration)
+		err = expirationInfo.SetExpiration(ctx, testPieces[2].SatelliteID, testPieces[2].PieceID, testPieces[2].PieceExpiration, 0)
 		require.NoError(t, err)
-		err = expirationInfo.SetExpiration(ctx, testPieces[3].SatelliteID, testPieces[3].PieceID, testPieces[3].PieceExpiration)
+		err = expirationInfo.SetExpiration(ctx, testPieces[3].SatelliteID, testPieces[3].PieceID, testPieces[3].PieceExpiration, 0)
 		require.NoError(t, err)
 
 		// GetExpired with limit 0 gives empty result
@@ -757,7 +757,7 @@ func TestOverwriteV0WithV1(t *testing.T) {
 		require.True(t, ok, "V0PieceInfoDB can not satisfy V0PieceInfoDBForTest")
 		expirationInfo := db.PieceExpirationDB()
 
-		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), v0PieceInfo, expirationInfo, db.PieceSpaceUsedDB())
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), v0PieceInfo, expirationInfo, db.PieceSpaceUsedDB(), nil)
 
 		satelliteID := testrand.NodeID()
 		pieceID := testrand.PieceID()
@@ -48,7 +48,7 @@ func (chore *TrashChore) Run(ctx context.Context) (err error) {
 
 		for _, satelliteID := range chore.trust.GetSatellites(ctx) {
 			trashedBefore := time.Now().Add(-chore.trashExpiryInterval)
-			err := chore.store.EmptyTrash(ctx, satelliteID, trashedBefore)
+			_, err := chore.store.EmptyTrash(ctx, satelliteID, trashedBefore)
 			if err != nil {
 				chore.log.Error("emptying trash failed", zap.Error(err))
 			}
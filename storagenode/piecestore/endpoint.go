@@ -27,10 +27,13 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/storj/private/context2"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storage/readcache"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/ratelimit"
 	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/trust"
 )
@@ -41,13 +44,46 @@ var (
 
 var _ pb.PiecestoreServer = (*Endpoint)(nil)
 
+const (
+	// BackendFiles stores every piece in a separate file.
+	BackendFiles = "files"
+	// BackendPacks appends pieces to large pack files.
+	BackendPacks = "packs"
+)
+
 // OldConfig contains everything necessary for a server
 type OldConfig struct {
 	Path                   string         `help:"path to store data in" default:"$CONFDIR/storage"`
+	ExtraPaths             string         `help:"additional directories to store pieces in with their allocated disk space, as a comma separated list of path=size (e.g. /mnt/disk2=2TB)" default:""`
+	Backend                string         `help:"how pieces are stored on disk: files stores every piece in a separate file, packs appends pieces to large pack files" default:"files"`
 	WhitelistedSatellites  storj.NodeURLs `help:"a comma-separated list of approved satellite node urls (unused)" devDefault:"" releaseDefault:""`
 	AllocatedDiskSpace     memory.Size    `user:"true" help:"total allocated disk space in bytes" default:"1TB"`
 	AllocatedBandwidth     memory.Size    `user:"true" help:"total allocated bandwidth in bytes" default:"2TB"`
 	KBucketRefreshInterval time.Duration  `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
+
+	ReadCache readcache.Config
+}
+
+// Locations returns the directories used for storing pieces, when extra paths are configured.
+// The allocated disk space not assigned to extra paths is assigned to Path.
+func (config OldConfig) Locations() ([]filestore.Location, error) {
+	extra, err := filestore.ParseLocations(config.ExtraPaths)
+	if err != nil {
+		return nil, err
+	}
+	if len(extra) == 0 {
+		return nil, nil
+	}
+
+	remaining := config.AllocatedDiskSpace.Int64()
+	for _, location := range extra {
+		remaining -= location.Allocated
+	}
+	if remaining <= 0 {
+		return nil, errs.New("allocated disk space %s must be larger than the space allocated to extra paths", config.AllocatedDiskSpace)
+	}
+
+	return append([]filestore.Location{{Path: config.Path, Allocated: remaining}}, extra...), nil
 }
 
 // Config defines parameters for piecestore endpoint.
@@ -58,11 +94,14 @@ type Config struct {
 	CacheSyncInterval      time.Duration `help:"how often the space used cache is synced to persistent storage" releaseDefault:"1h0m0s" devDefault:"0h1m0s"`
 	StreamOperationTimeout time.Duration `help:"how long to spend waiting for a stream operation before canceling" default:"30m"`
 	RetainTimeBuffer       time.Duration `help:"allows for small differences in the satellite and storagenode clocks" default:"48h0m0s"`
+	CompactionInterval     time.Duration `help:"how often pack files are compacted and failed piece deletions are retried" default:"1h0m0s"`
+	IndexReconcileInterval time.Duration `help:"how often the piece index is reconciled with the stored pieces" default:"168h0m0s"`
 
 	Trust trust.Config
 
-	Monitor monitor.Config
-	Orders  orders.Config
+	Monitor   monitor.Config
+	Orders    orders.Config
+	RateLimit ratelimit.Config
 }
 
 type pingStatsSource interface {
@@ -87,6 +126,7 @@ type Endpoint struct {
 	orders      orders.DB
 	usage       bandwidth.DB
 	usedSerials UsedSerials
+	limiter     *ratelimit.Limiter
 
 	// liveRequests tracks the total number of incoming rpc requests. For gRPC
 	// requests only, this number is compared to config.MaxConcurrentRequests
@@ -110,6 +150,11 @@ func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, moni
 		grpcReqLimit = 7
 	}
 
+	limiter, err := ratelimit.NewLimiter(config.RateLimit)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Endpoint{
 		log:          log,
 		config:       config,
@@ -125,6 +170,7 @@ func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, moni
 		orders:      orders,
 		usage:       usage,
 		usedSerials: usedSerials,
+		limiter:     limiter,
 
 		liveRequests: 0,
 	}, nil
@@ -132,6 +178,11 @@ func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, moni
 
 var monLiveRequests = mon.TaskNamed("live-request")
 
+// LiveRequests returns the number of requests being handled.
+func (endpoint *Endpoint) LiveRequests() int32 {
+	return atomic.LoadInt32(&endpoint.liveRequests)
+}
+
 // Delete handles deleting a piece on piece store requested by uplink.
 //
 // DEPRECATED in favor of DeletePieces.
@@ -311,6 +362,12 @@ func (endpoint *Endpoint) doUpload(stream uploadStream, requestLimit int) (err e
 		return err
 	}
 
+	if !endpoint.limiter.AllowRequest(limit.SatelliteId, limit.Action) {
+		endpoint.log.Debug("upload rejected, too many requests for satellite", zap.Stringer("Satellite ID", limit.SatelliteId))
+		mon.Meter("upload_rate_limited").Mark(1)
+		return rpcstatus.Error(rpcstatus.Unavailable, "storage node overloaded")
+	}
+
 	availableBandwidth, err := endpoint.monitor.AvailableBandwidth(ctx)
 	if err != nil {
 		return rpcstatus.Wrap(rpcstatus.Internal, err)
@@ -321,6 +378,18 @@ func (endpoint *Endpoint) doUpload(stream uploadStream, requestLimit int) (err e
 		return rpcstatus.Wrap(rpcstatus.Internal, err)
 	}
 
+	// reserve the space for the whole piece, so that concurrent uploads don't
+	// overcommit the disk
+	reservation, err := endpoint.monitor.ReserveSpace(ctx, limit.Limit+pieces.V1PieceHeaderReservedArea)
+	if err != nil {
+		if monitor.ErrReadOnly.Has(err) || monitor.ErrNotEnoughSpace.Has(err) {
+			endpoint.log.Error("upload rejected, not enough space", zap.Stringer("Satellite ID", limit.SatelliteId), zap.Error(err))
+			return rpcstatus.Wrap(rpcstatus.Unavailable, err)
+		}
+		return rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+	defer reservation.Release()
+
 	var pieceWriter *pieces.Writer
 	defer func() {
 		endTime := time.Now().UTC()
@@ -430,6 +499,10 @@ func (endpoint *Endpoint) doUpload(stream uploadStream, requestLimit int) (err e
 				return rpcstatus.Error(rpcstatus.Internal, "out of space")
 			}
 
+			if err := endpoint.limiter.WaitIngress(ctx, limit.SatelliteId, limit.Action, chunkSize); err != nil {
+				return rpcstatus.Wrap(rpcstatus.Canceled, err)
+			}
+
 			if _, err := pieceWriter.Write(message.Chunk.Data); err != nil {
 				return rpcstatus.Wrap(rpcstatus.Internal, err)
 			}
@@ -457,7 +530,7 @@ func (endpoint *Endpoint) doUpload(stream uploadStream, requestLimit int) (err e
 					return rpcstatus.Wrap(rpcstatus.Internal, err)
 				}
 				if !limit.PieceExpiration.IsZero() {
-					err := endpoint.store.SetExpiration(ctx, limit.SatelliteId, limit.PieceId, limit.PieceExpiration)
+					err := endpoint.store.SetExpiration(ctx, limit.SatelliteId, limit.PieceId, limit.PieceExpiration, pieceWriter.Size())
 					if err != nil {
 						return rpcstatus.Wrap(rpcstatus.Internal, err)
 					}
@@ -556,6 +629,12 @@ func (endpoint *Endpoint) doDownload(stream downloadStream) (err error) {
 		return err
 	}
 
+	if !endpoint.limiter.AllowRequest(limit.SatelliteId, limit.Action) {
+		endpoint.log.Debug("download rejected, too many requests for satellite", zap.Stringer("Satellite ID", limit.SatelliteId))
+		mon.Meter("download_rate_limited").Mark(1)
+		return rpcstatus.Error(rpcstatus.Unavailable, "storage node overloaded")
+	}
+
 	var pieceReader *pieces.Reader
 	defer func() {
 		endTime := time.Now().UTC()
@@ -663,6 +742,10 @@ func (endpoint *Endpoint) doDownload(stream downloadStream) (err error) {
 				return rpcstatus.Wrap(rpcstatus.Internal, err)
 			}
 
+			if err := endpoint.limiter.WaitEgress(ctx, limit.SatelliteId, limit.Action, chunkSize); err != nil {
+				return rpcstatus.Wrap(rpcstatus.Canceled, err)
+			}
+
 			err = rpctimeout.Run(ctx, endpoint.config.StreamOperationTimeout, func(_ context.Context) (err error) {
 				return stream.Send(&pb.PieceDownloadResponse{
 					Chunk: &pb.PieceDownloadResponse_Chunk{
@@ -11,6 +11,7 @@ var mon = monkit.Package()
 
 // Config for preflight checks
 type Config struct {
-	LocalTimeCheck bool `help:"whether or not preflight check for local system clock is enabled on the satellite side. When disabling this feature, your storagenode may not setup correctly." default:"true"`
-	DatabaseCheck  bool `help:"whether or not preflight check for database is enabled." default:"true"`
+	LocalTimeCheck         bool `help:"whether or not preflight check for local system clock is enabled on the satellite side. When disabling this feature, your storagenode may not setup correctly." default:"true"`
+	DatabaseCheck          bool `help:"whether or not preflight check for database is enabled." default:"true"`
+	DatabaseIntegrityCheck bool `help:"whether or not the full integrity check of the databases runs on startup, instead of only the quick check." default:"false"`
 }
@@ -0,0 +1,176 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"storj.io/common/memory"
+)
+
+// Priority defines in which order waiters take tokens from a bucket.
+type Priority int
+
+const (
+	// PriorityCustomer is the priority of uploads and downloads by customers.
+	PriorityCustomer = Priority(iota)
+	// PriorityRepair is the priority of repair and graceful exit transfers,
+	// which take tokens before customer transfers.
+	PriorityRepair
+	// PriorityAudit is the priority of audits, which never wait for tokens,
+	// so that a saturated node doesn't fail its audits.
+	PriorityAudit
+
+	priorityCount = int(PriorityAudit) + 1
+)
+
+// Bucket is a token bucket for bytes, which allows waiters of a higher priority
+// to take tokens first.
+//
+// A waiter takes all the tokens it needs at once as soon as the bucket isn't
+// empty, which may put the bucket into debt. This allows waiting for more tokens
+// than the bucket can hold, while the average rate stays within the limit.
+type Bucket struct {
+	now func() time.Time
+
+	mu      sync.Mutex
+	rate    float64
+	tokens  float64
+	updated time.Time
+	waiting [priorityCount]int
+	changed chan struct{}
+}
+
+// NewBucket creates a new bucket which is refilled with limit tokens per
+// second. A zero limit means that the bucket is unlimited.
+func NewBucket(limit memory.Size) *Bucket {
+	bucket := &Bucket{
+		now:     time.Now,
+		changed: make(chan struct{}),
+	}
+	bucket.updated = bucket.now()
+	bucket.rate = limit.Float64()
+	bucket.tokens = bucket.rate
+	return bucket
+}
+
+// Limit returns the current limit of the bucket.
+func (bucket *Bucket) Limit() memory.Size {
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+	return memory.Size(bucket.rate)
+}
+
+// SetLimit changes the limit of the bucket. A zero limit means that the bucket
+// is unlimited.
+func (bucket *Bucket) SetLimit(limit memory.Size) {
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+
+	if bucket.rate == limit.Float64() {
+		return
+	}
+	bucket.refill()
+	bucket.rate = limit.Float64()
+	if bucket.tokens > bucket.rate {
+		bucket.tokens = bucket.rate
+	}
+	bucket.notify()
+}
+
+// Wait waits until n tokens can be taken from the bucket and takes them.
+// Audits take their tokens without waiting.
+func (bucket *Bucket) Wait(ctx context.Context, priority Priority, n int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+
+	if priority >= PriorityAudit {
+		bucket.take(n)
+		return nil
+	}
+
+	bucket.waiting[priority]++
+	defer func() {
+		bucket.waiting[priority]--
+		bucket.notify()
+	}()
+
+	for {
+		bucket.refill()
+		if bucket.rate == 0 || (bucket.tokens >= 0 && !bucket.preferred(priority)) {
+			bucket.take(n)
+			return nil
+		}
+
+		// when a waiter of a higher priority is waiting, this waiter is woken up
+		// after it has taken its tokens
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if bucket.tokens < 0 {
+			timer = time.NewTimer(time.Duration(-bucket.tokens / bucket.rate * float64(time.Second)))
+			timeout = timer.C
+		}
+		changed := bucket.changed
+
+		bucket.mu.Unlock()
+		select {
+		case <-timeout:
+		case <-changed:
+		case <-ctx.Done():
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		bucket.mu.Lock()
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+// preferred returns whether a waiter of a higher priority is waiting.
+func (bucket *Bucket) preferred(priority Priority) bool {
+	for higher := int(priority) + 1; higher < priorityCount; higher++ {
+		if bucket.waiting[higher] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// take takes n tokens from the bucket.
+func (bucket *Bucket) take(n int64) {
+	if bucket.rate == 0 {
+		return
+	}
+	bucket.refill()
+	bucket.tokens -= float64(n)
+}
+
+// refill adds the tokens accumulated since the last update, up to one second
+// worth of tokens.
+func (bucket *Bucket) refill() {
+	now := bucket.now()
+	elapsed := now.Sub(bucket.updated)
+	bucket.updated = now
+	if elapsed <= 0 {
+		return
+	}
+
+	bucket.tokens += elapsed.Seconds() * bucket.rate
+	if bucket.tokens > bucket.rate {
+		bucket.tokens = bucket.rate
+	}
+}
+
+// notify wakes up all waiters.
+func (bucket *Bucket) notify() {
+	close(bucket.changed)
+	bucket.changed = make(chan struct{})
+}
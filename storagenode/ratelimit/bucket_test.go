@@ -0,0 +1,101 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/storj/storagenode/ratelimit"
+)
+
+func TestBucketUnlimited(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	bucket := ratelimit.NewBucket(0)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, bucket.Wait(ctx, ratelimit.PriorityCustomer, memory.GiB.Int64()))
+	}
+}
+
+func TestBucketThrottles(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	bucket := ratelimit.NewBucket(10 * memory.KiB)
+
+	// the bucket starts full and may go into debt
+	start := time.Now()
+	require.NoError(t, bucket.Wait(ctx, ratelimit.PriorityCustomer, 12*memory.KiB.Int64()))
+	require.NoError(t, bucket.Wait(ctx, ratelimit.PriorityCustomer, memory.KiB.Int64()))
+	assert.True(t, time.Since(start) >= 150*time.Millisecond)
+
+	// waiting for the debt is canceled with the context
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	err := bucket.Wait(timeoutCtx, ratelimit.PriorityCustomer, memory.KiB.Int64())
+	require.Equal(t, context.DeadlineExceeded, err)
+
+	// audits don't wait
+	start = time.Now()
+	require.NoError(t, bucket.Wait(ctx, ratelimit.PriorityAudit, memory.KiB.Int64()))
+	assert.True(t, time.Since(start) < 50*time.Millisecond)
+}
+
+func TestBucketPriority(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	bucket := ratelimit.NewBucket(10 * memory.KiB)
+	require.NoError(t, bucket.Wait(ctx, ratelimit.PriorityCustomer, 12*memory.KiB.Int64()))
+
+	done := make(chan ratelimit.Priority, 2)
+	wait := func(priority ratelimit.Priority) func() error {
+		return func() error {
+			err := bucket.Wait(ctx, priority, 5*memory.KiB.Int64())
+			done <- priority
+			return err
+		}
+	}
+
+	ctx.Go(wait(ratelimit.PriorityCustomer))
+	time.Sleep(50 * time.Millisecond)
+	ctx.Go(wait(ratelimit.PriorityRepair))
+
+	// the repair started waiting later, but takes the tokens first
+	assert.Equal(t, ratelimit.PriorityRepair, <-done)
+	assert.Equal(t, ratelimit.PriorityCustomer, <-done)
+}
+
+func TestBucketSetLimit(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	bucket := ratelimit.NewBucket(memory.KiB)
+	require.NoError(t, bucket.Wait(ctx, ratelimit.PriorityCustomer, 10*memory.KiB.Int64()))
+
+	waited := make(chan struct{})
+	ctx.Go(func() error {
+		defer close(waited)
+		return bucket.Wait(ctx, ratelimit.PriorityCustomer, memory.KiB.Int64())
+	})
+
+	// removing the limit wakes up the waiters
+	time.Sleep(10 * time.Millisecond)
+	bucket.SetLimit(0)
+	assert.Equal(t, memory.Size(0), bucket.Limit())
+
+	select {
+	case <-waited:
+	case <-time.After(5 * time.Second):
+		t.Fatal("waiter wasn't woken up")
+	}
+}
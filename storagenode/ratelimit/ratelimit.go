@@ -0,0 +1,159 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package ratelimit implements throttling the piece transfers of the storage node.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+)
+
+var (
+	// Error is the default error class for the rate limiter.
+	Error = errs.Class("ratelimit error")
+
+	mon = monkit.Package()
+)
+
+// Config defines parameters for throttling piece transfers.
+type Config struct {
+	Ingress           memory.Size `help:"how many bytes per second may be uploaded to the node, 0 means unlimited" default:"0"`
+	Egress            memory.Size `help:"how many bytes per second may be downloaded from the node, 0 means unlimited" default:"0"`
+	SatelliteIngress  memory.Size `help:"how many bytes per second may be uploaded for a single satellite, 0 means unlimited" default:"0"`
+	SatelliteEgress   memory.Size `help:"how many bytes per second may be downloaded for a single satellite, 0 means unlimited" default:"0"`
+	SatelliteRequests int         `help:"how many customer uploads and downloads per second are accepted for a single satellite, 0 means unlimited" default:"0"`
+	Schedule          string      `help:"comma separated list of start-end=ingress/egress in local time, which override the ingress and egress limits during the time of day (e.g. 08:00-18:00=2MiB/4MiB)" default:""`
+}
+
+// ActionPriority returns the priority of transfers of the order limit action.
+func ActionPriority(action pb.PieceAction) Priority {
+	switch action {
+	case pb.PieceAction_GET_AUDIT:
+		return PriorityAudit
+	case pb.PieceAction_GET_REPAIR, pb.PieceAction_PUT_REPAIR, pb.PieceAction_PUT_GRACEFUL_EXIT:
+		return PriorityRepair
+	default:
+		return PriorityCustomer
+	}
+}
+
+// Limiter throttles uploads and downloads with global and per-satellite limits.
+// Repair and audit transfers are prioritized over customer transfers.
+type Limiter struct {
+	config   Config
+	schedule Schedule
+	now      func() time.Time
+
+	ingress *Bucket
+	egress  *Bucket
+
+	mu         sync.Mutex
+	satellites map[storj.NodeID]*satelliteLimits
+}
+
+// satelliteLimits contains the limits of a single satellite.
+type satelliteLimits struct {
+	ingress  *Bucket
+	egress   *Bucket
+	requests *rate.Limiter
+}
+
+// NewLimiter creates a new limiter.
+func NewLimiter(config Config) (*Limiter, error) {
+	schedule, err := ParseSchedule(config.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	if config.Ingress < 0 || config.Egress < 0 || config.SatelliteIngress < 0 || config.SatelliteEgress < 0 || config.SatelliteRequests < 0 {
+		return nil, Error.New("limits must not be negative")
+	}
+
+	return &Limiter{
+		config:   config,
+		schedule: schedule,
+		now:      time.Now,
+
+		ingress: NewBucket(config.Ingress),
+		egress:  NewBucket(config.Egress),
+
+		satellites: map[storj.NodeID]*satelliteLimits{},
+	}, nil
+}
+
+// AllowRequest returns whether a transfer with the action may be started for
+// the satellite. Only customer requests are limited.
+func (limiter *Limiter) AllowRequest(satellite storj.NodeID, action pb.PieceAction) bool {
+	if limiter.config.SatelliteRequests <= 0 || ActionPriority(action) > PriorityCustomer {
+		return true
+	}
+	return limiter.satellite(satellite).requests.Allow()
+}
+
+// WaitIngress waits until n bytes may be received for an upload.
+func (limiter *Limiter) WaitIngress(ctx context.Context, satellite storj.NodeID, action pb.PieceAction, n int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limiter.update()
+	priority := ActionPriority(action)
+	if err := limiter.satellite(satellite).ingress.Wait(ctx, priority, n); err != nil {
+		return err
+	}
+	return limiter.ingress.Wait(ctx, priority, n)
+}
+
+// WaitEgress waits until n bytes may be sent for a download.
+func (limiter *Limiter) WaitEgress(ctx context.Context, satellite storj.NodeID, action pb.PieceAction, n int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limiter.update()
+	priority := ActionPriority(action)
+	if err := limiter.satellite(satellite).egress.Wait(ctx, priority, n); err != nil {
+		return err
+	}
+	return limiter.egress.Wait(ctx, priority, n)
+}
+
+// update applies the limits of the schedule for the current time of day.
+func (limiter *Limiter) update() {
+	if len(limiter.schedule) == 0 {
+		return
+	}
+
+	ingress, egress := limiter.config.Ingress, limiter.config.Egress
+	if window, ok := limiter.schedule.Find(limiter.now()); ok {
+		ingress, egress = window.Ingress, window.Egress
+	}
+	limiter.ingress.SetLimit(ingress)
+	limiter.egress.SetLimit(egress)
+}
+
+// satellite returns the limits of the satellite.
+func (limiter *Limiter) satellite(satellite storj.NodeID) *satelliteLimits {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	limits, ok := limiter.satellites[satellite]
+	if !ok {
+		requests := rate.Inf
+		if limiter.config.SatelliteRequests > 0 {
+			requests = rate.Limit(limiter.config.SatelliteRequests)
+		}
+		limits = &satelliteLimits{
+			ingress:  NewBucket(limiter.config.SatelliteIngress),
+			egress:   NewBucket(limiter.config.SatelliteEgress),
+			requests: rate.NewLimiter(requests, limiter.config.SatelliteRequests),
+		}
+		limiter.satellites[satellite] = limits
+	}
+	return limits
+}
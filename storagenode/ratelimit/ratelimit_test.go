@@ -0,0 +1,38 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ratelimit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/ratelimit"
+)
+
+func TestLimiterRequests(t *testing.T) {
+	limiter, err := ratelimit.NewLimiter(ratelimit.Config{SatelliteRequests: 2})
+	require.NoError(t, err)
+
+	satellite, other := testrand.NodeID(), testrand.NodeID()
+	assert.True(t, limiter.AllowRequest(satellite, pb.PieceAction_PUT))
+	assert.True(t, limiter.AllowRequest(satellite, pb.PieceAction_GET))
+	assert.False(t, limiter.AllowRequest(satellite, pb.PieceAction_GET))
+
+	// repair and audit requests and other satellites aren't affected
+	assert.True(t, limiter.AllowRequest(satellite, pb.PieceAction_PUT_REPAIR))
+	assert.True(t, limiter.AllowRequest(satellite, pb.PieceAction_GET_AUDIT))
+	assert.True(t, limiter.AllowRequest(other, pb.PieceAction_GET))
+}
+
+func TestLimiterInvalidConfig(t *testing.T) {
+	_, err := ratelimit.NewLimiter(ratelimit.Config{Schedule: "invalid"})
+	require.Error(t, err)
+
+	_, err = ratelimit.NewLimiter(ratelimit.Config{SatelliteRequests: -1})
+	require.Error(t, err)
+}
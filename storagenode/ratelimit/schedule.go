@@ -0,0 +1,120 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ratelimit
+
+import (
+	"strings"
+	"time"
+
+	"storj.io/common/memory"
+)
+
+// Window overrides the global ingress and egress limits during a time of day.
+type Window struct {
+	// Start and End are the offsets of the window since midnight in local time.
+	// When End is before Start, the window spans midnight.
+	Start time.Duration
+	End   time.Duration
+
+	Ingress memory.Size
+	Egress  memory.Size
+}
+
+// Contains returns whether the time of day of t is inside the window.
+func (window Window) Contains(t time.Time) bool {
+	hour, minute, second := t.Clock()
+	offset := time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second
+	if window.Start < window.End {
+		return window.Start <= offset && offset < window.End
+	}
+	return offset >= window.Start || offset < window.End
+}
+
+// Schedule is a list of windows, the first window containing the current time
+// of day applies.
+type Schedule []Window
+
+// Find returns the first window which contains the time of day of t.
+func (schedule Schedule) Find(t time.Time) (Window, bool) {
+	for _, window := range schedule {
+		if window.Contains(t) {
+			return window, true
+		}
+	}
+	return Window{}, false
+}
+
+// ParseSchedule parses a comma separated list of start-end=ingress/egress,
+// e.g. 08:00-18:00=2MiB/4MiB. A zero limit means unlimited.
+func ParseSchedule(value string) (Schedule, error) {
+	var schedule Schedule
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		times, limits := split(part, "=")
+		start, end := split(times, "-")
+		ingress, egress := split(limits, "/")
+		if times == "" || limits == "" || end == "" || egress == "" {
+			return nil, Error.New("invalid schedule %q, expected start-end=ingress/egress", part)
+		}
+
+		var window Window
+		var err error
+		if window.Start, err = parseClock(start); err != nil {
+			return nil, Error.New("invalid start of schedule %q: %v", part, err)
+		}
+		if window.End, err = parseClock(end); err != nil {
+			return nil, Error.New("invalid end of schedule %q: %v", part, err)
+		}
+		if window.Start == window.End {
+			return nil, Error.New("schedule %q is empty", part)
+		}
+		if window.Ingress, err = parseLimit(ingress); err != nil {
+			return nil, Error.New("invalid ingress of schedule %q: %v", part, err)
+		}
+		if window.Egress, err = parseLimit(egress); err != nil {
+			return nil, Error.New("invalid egress of schedule %q: %v", part, err)
+		}
+
+		schedule = append(schedule, window)
+	}
+	return schedule, nil
+}
+
+// split splits value around the first separator and trims both parts.
+func split(value, separator string) (string, string) {
+	index := strings.Index(value, separator)
+	if index < 0 {
+		return strings.TrimSpace(value), ""
+	}
+	return strings.TrimSpace(value[:index]), strings.TrimSpace(value[index+len(separator):])
+}
+
+// parseClock parses a time of day in the form of 15:04.
+func parseClock(value string) (time.Duration, error) {
+	clock, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute, nil
+}
+
+// parseLimit parses a number of bytes per second.
+func parseLimit(value string) (memory.Size, error) {
+	// memory.ParseString doesn't handle sizes without any digits
+	if value == "" || value[0] < '0' || value[0] > '9' {
+		return 0, Error.New("expected a size")
+	}
+	limit, err := memory.ParseString(value)
+	if err != nil {
+		return 0, err
+	}
+	if limit < 0 {
+		return 0, Error.New("limit must not be negative")
+	}
+	return memory.Size(limit), nil
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/storj/storagenode/ratelimit"
+)
+
+func TestParseSchedule(t *testing.T) {
+	schedule, err := ratelimit.ParseSchedule("")
+	require.NoError(t, err)
+	assert.Empty(t, schedule)
+
+	schedule, err = ratelimit.ParseSchedule("08:00-18:00=2MiB/4MiB, 22:30-06:00 = 0/1MB")
+	require.NoError(t, err)
+	assert.Equal(t, ratelimit.Schedule{
+		{Start: 8 * time.Hour, End: 18 * time.Hour, Ingress: 2 * memory.MiB, Egress: 4 * memory.MiB},
+		{Start: 22*time.Hour + 30*time.Minute, End: 6 * time.Hour, Ingress: 0, Egress: memory.MB},
+	}, schedule)
+
+	for _, invalid := range []string{
+		"08:00-18:00",
+		"08:00=1MiB/1MiB",
+		"08:00-18:00=1MiB",
+		"8am-18:00=1MiB/1MiB",
+		"08:00-25:00=1MiB/1MiB",
+		"08:00-08:00=1MiB/1MiB",
+		"08:00-18:00=MiB/1MiB",
+		"08:00-18:00=1MiB/-1MiB",
+	} {
+		_, err := ratelimit.ParseSchedule(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestScheduleFind(t *testing.T) {
+	schedule, err := ratelimit.ParseSchedule("08:00-18:00=2MiB/4MiB,22:00-06:00=1MiB/1MiB")
+	require.NoError(t, err)
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2020, 1, 1, hour, minute, 0, 0, time.Local)
+	}
+
+	for _, test := range []struct {
+		time    time.Time
+		found   bool
+		ingress memory.Size
+	}{
+		{at(7, 59), false, 0},
+		{at(8, 0), true, 2 * memory.MiB},
+		{at(17, 59), true, 2 * memory.MiB},
+		{at(18, 0), false, 0},
+		{at(23, 0), true, memory.MiB},
+		{at(0, 0), true, memory.MiB},
+		{at(5, 59), true, memory.MiB},
+		{at(6, 0), false, 0},
+	} {
+		window, found := schedule.Find(test.time)
+		assert.Equal(t, test.found, found, test.time)
+		assert.Equal(t, test.ingress, window.Ingress, test.time)
+	}
+}
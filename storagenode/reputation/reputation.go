@@ -28,8 +28,12 @@ type Stats struct {
 
 	Uptime Metric
 	Audit  Metric
+	// UnknownAudit only tracks the reputation of audits with an unknown
+	// result, its counts are not reported by the satellite.
+	UnknownAudit Metric
 
 	Disqualified *time.Time
+	Suspended    *time.Time
 
 	UpdatedAt time.Time
 }
@@ -38,7 +38,13 @@ func TestReputationDBGetInsert(t *testing.T) {
 				Beta:         9,
 				Score:        10,
 			},
+			UnknownAudit: reputation.Metric{
+				Alpha: 11,
+				Beta:  12,
+				Score: 13,
+			},
 			Disqualified: &timestamp,
+			Suspended:    &timestamp,
 			UpdatedAt:    timestamp,
 		}
 
@@ -53,10 +59,12 @@ func TestReputationDBGetInsert(t *testing.T) {
 
 			assert.Equal(t, res.SatelliteID, stats.SatelliteID)
 			assert.Equal(t, res.Disqualified, stats.Disqualified)
+			assert.Equal(t, res.Suspended, stats.Suspended)
 			assert.Equal(t, res.UpdatedAt, stats.UpdatedAt)
 
 			compareReputationMetric(t, &res.Uptime, &stats.Uptime)
 			compareReputationMetric(t, &res.Audit, &stats.Audit)
+			compareReputationMetric(t, &res.UnknownAudit, &stats.UnknownAudit)
 		})
 	})
 }
@@ -6,6 +6,7 @@ package retain
 import (
 	"context"
 	"runtime"
+	"sort"
 	"sync"
 	"time"
 
@@ -93,7 +94,7 @@ type Service struct {
 
 	cond    sync.Cond
 	queued  map[storj.NodeID]Request
-	working map[storj.NodeID]struct{}
+	working map[storj.NodeID]Request
 	group   errgroup.Group
 
 	closedOnce sync.Once
@@ -111,7 +112,7 @@ func NewService(log *zap.Logger, store *pieces.Store, config Config) *Service {
 
 		cond:    *sync.NewCond(&sync.Mutex{}),
 		queued:  make(map[storj.NodeID]Request),
-		working: make(map[storj.NodeID]struct{}),
+		working: make(map[storj.NodeID]Request),
 		closed:  make(chan struct{}),
 
 		store: store,
@@ -255,7 +256,7 @@ func (s *Service) next() (Request, bool) {
 		}
 		delete(s.queued, id)
 		// Mark this satellite as being worked on.
-		s.working[request.SatelliteID] = struct{}{}
+		s.working[request.SatelliteID] = request
 		return request, true
 	}
 	return Request{}, false
@@ -290,6 +291,43 @@ func (s *Service) TestWaitUntilEmpty() {
 	}
 }
 
+// QueuedRequest is a retain request which is queued or being processed.
+type QueuedRequest struct {
+	SatelliteID   storj.NodeID
+	CreatedBefore time.Time
+	// Processing is whether the request is being processed.
+	Processing bool
+}
+
+// Queued returns the retain requests which are queued or being processed,
+// ordered by satellite.
+func (s *Service) Queued() []QueuedRequest {
+	s.cond.L.Lock()
+	defer s.cond.L.Unlock()
+
+	requests := make([]QueuedRequest, 0, len(s.working)+len(s.queued))
+	for _, request := range s.working {
+		requests = append(requests, QueuedRequest{
+			SatelliteID:   request.SatelliteID,
+			CreatedBefore: request.CreatedBefore,
+			Processing:    true,
+		})
+	}
+	for _, request := range s.queued {
+		requests = append(requests, QueuedRequest{
+			SatelliteID:   request.SatelliteID,
+			CreatedBefore: request.CreatedBefore,
+		})
+	}
+	sort.Slice(requests, func(i, k int) bool {
+		if requests[i].SatelliteID == requests[k].SatelliteID {
+			return requests[i].Processing
+		}
+		return requests[i].SatelliteID.Less(requests[k].SatelliteID)
+	})
+	return requests
+}
+
 // Status returns the retain status.
 func (s *Service) Status() Status {
 	return s.config.Status
@@ -341,6 +379,10 @@ func (s *Service) Status() Status {
 // nontrivial amount, mtimes on existing blobs should also be adjusted (by the same interval,
 // ideally, but just running "touch" on all blobs is sufficient to avoid incorrect deletion of
 // data).
+//
+// Once the piece index is in use, ModTime is the time the piece was committed, or the file mtime
+// for pieces added by reconciling the index. Both are after the piece was written, so the
+// reasoning above still holds.
 func (s *Service) retainPieces(ctx context.Context, req Request) (err error) {
 	// if retain status is disabled, return immediately
 	if s.config.Status == Disabled {
@@ -37,6 +37,27 @@ type ExitProgress struct {
 	Status            int32
 }
 
+// PartialExit contains the status of a partial exit, which moves some of
+// the data of a satellite to other nodes without leaving the satellite.
+type PartialExit struct {
+	SatelliteID    storj.NodeID
+	RequestedBytes int64
+	InitiatedAt    time.Time
+	FinishedAt     *time.Time
+	BytesDeleted   int64
+}
+
+// Untrusted contains the cleanup state of the data of a satellite which is
+// no longer trusted. The data is moved to the trash once TrashedAt is set and
+// removed once DeletedAt is set.
+type Untrusted struct {
+	SatelliteID    storj.NodeID
+	UntrustedAt    time.Time
+	TrashedAt      *time.Time
+	DeletedAt      *time.Time
+	BytesReclaimed int64
+}
+
 // Satellite contains the satellite and status
 type Satellite struct {
 	SatelliteID storj.NodeID
@@ -60,4 +81,23 @@ type DB interface {
 	CompleteGracefulExit(ctx context.Context, satelliteID storj.NodeID, finishedAt time.Time, exitStatus Status, completionReceipt []byte) error
 	// ListGracefulExits lists all graceful exit records
 	ListGracefulExits(ctx context.Context) ([]ExitProgress, error)
+	// InitiatePartialExit updates the database to reflect the beginning of a partial exit
+	InitiatePartialExit(ctx context.Context, satelliteID storj.NodeID, initiatedAt time.Time, requestedBytes int64) error
+	// UpdatePartialExit increments the total bytes deleted during a partial exit
+	UpdatePartialExit(ctx context.Context, satelliteID storj.NodeID, bytesDeleted int64) error
+	// CompletePartialExit updates the database when a partial exit is finished or canceled
+	CompletePartialExit(ctx context.Context, satelliteID storj.NodeID, finishedAt time.Time) error
+	// ListPartialExits lists all partial exit records
+	ListPartialExits(ctx context.Context) ([]PartialExit, error)
+	// MarkUntrusted records that the satellite is no longer trusted, unless it is already recorded
+	MarkUntrusted(ctx context.Context, satelliteID storj.NodeID, untrustedAt time.Time) error
+	// MarkUntrustedTrashed records that the data of an untrusted satellite was moved to the trash
+	MarkUntrustedTrashed(ctx context.Context, satelliteID storj.NodeID, trashedAt time.Time, bytesTrashed int64) error
+	// MarkUntrustedDeleted records that the data of an untrusted satellite was deleted, bytesDeleted
+	// replaces the bytes counted when the data was moved to the trash
+	MarkUntrustedDeleted(ctx context.Context, satelliteID storj.NodeID, deletedAt time.Time, bytesDeleted int64) error
+	// RemoveUntrusted removes the untrusted record of a satellite which is trusted again
+	RemoveUntrusted(ctx context.Context, satelliteID storj.NodeID) error
+	// ListUntrusted lists all untrusted satellite records
+	ListUntrusted(ctx context.Context) ([]Untrusted, error)
 }
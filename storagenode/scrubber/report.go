@@ -0,0 +1,90 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scrubber
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/corruptionpb"
+)
+
+// reportBatchSize is the maximum number of pieces reported in a single request.
+const reportBatchSize = 1000
+
+// DB stores the corrupted pieces which weren't reported to their satellites
+// yet, so that they're still reported after a restart.
+//
+// architecture: Database
+type DB interface {
+	// Add adds a corrupted piece of the satellite to the pending reports.
+	Add(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, foundAt time.Time) error
+	// List returns the pieces of the pending reports by satellite.
+	List(ctx context.Context) (map[storj.NodeID][]storj.PieceID, error)
+	// Delete removes the pending reports of the pieces of the satellite.
+	Delete(ctx context.Context, satelliteID storj.NodeID, pieceIDs []storj.PieceID) error
+	// Count returns the number of pending reports.
+	Count(ctx context.Context) (int, error)
+}
+
+// report sends the pending corrupted pieces to their satellites. Pieces which
+// couldn't be reported are retried later.
+func (service *Service) report(ctx context.Context) {
+	pending, err := service.db.List(ctx)
+	if err != nil {
+		service.log.Error("unable to list corrupted pieces to report", zap.Error(err))
+		return
+	}
+
+	for satellite, pieceIDs := range pending {
+		for len(pieceIDs) > 0 {
+			batch := pieceIDs
+			if len(batch) > reportBatchSize {
+				batch = batch[:reportBatchSize]
+			}
+			if err := service.reportBatch(ctx, satellite, batch); err != nil {
+				service.log.Warn("unable to report corrupted pieces",
+					zap.Stringer("Satellite ID", satellite),
+					zap.Int("Count", len(pieceIDs)),
+					zap.Error(err))
+				break
+			}
+			if err := service.db.Delete(ctx, satellite, batch); err != nil {
+				// the pieces are reported again, which the satellite ignores
+				service.log.Error("unable to remove reported corrupted pieces",
+					zap.Stringer("Satellite ID", satellite),
+					zap.Error(err))
+				break
+			}
+			pieceIDs = pieceIDs[len(batch):]
+		}
+	}
+}
+
+// reportBatch reports corrupted pieces to the satellite.
+func (service *Service) reportBatch(ctx context.Context, satellite storj.NodeID, pieceIDs []storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	address, err := service.trust.GetAddress(ctx, satellite)
+	if err != nil {
+		return errs.New("unable to find satellite %s: %w", satellite, err)
+	}
+
+	conn, err := service.dialer.DialAddressID(ctx, address, satellite)
+	if err != nil {
+		return errs.New("unable to connect to the satellite %s: %w", satellite, err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	request := &corruptionpb.ReportCorruptPiecesRequest{}
+	for _, pieceID := range pieceIDs {
+		request.PieceIds = append(request.PieceIds, pieceID.Bytes())
+	}
+	_, err = corruptionpb.NewDRPCCorruptionClient(conn.Raw()).ReportCorruptPieces(ctx, request)
+	return err
+}
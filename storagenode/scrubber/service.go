@@ -0,0 +1,303 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package scrubber implements verifying the integrity of the pieces stored on
+// the storage node.
+package scrubber
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/memory"
+	"storj.io/common/pkcrypto"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// Error is the default error class for the scrubber.
+	Error = errs.Class("scrubber error")
+	// ErrCorrupted is the error class of pieces which don't match their piece header.
+	ErrCorrupted = errs.Class("piece corrupted")
+
+	mon = monkit.Package()
+)
+
+// readBufferSize is the size of the buffer used for reading pieces, and therefore
+// also the burst of the throughput limit.
+const readBufferSize = 32 * memory.KiB
+
+// Config defines parameters for the piece integrity scrubber.
+type Config struct {
+	Interval        time.Duration `help:"how frequently a verification pass over all stored pieces is started, 0 disables the scrubber" default:"168h0m0s"`
+	Throughput      memory.Size   `help:"how many bytes per second are read at most while verifying pieces, 0 means unlimited" default:"4.0 MiB"`
+	ReportCorrupted bool          `help:"report corrupted pieces to their satellite, so that they're repaired before they're audited" default:"true"`
+}
+
+// PassStatus contains the results of a verification pass.
+type PassStatus struct {
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+
+	// Verified is the number of pieces that were read and matched their hash.
+	Verified      int64 `json:"verified"`
+	VerifiedBytes int64 `json:"verifiedBytes"`
+	// Corrupted is the number of pieces that didn't match their hash and were quarantined.
+	Corrupted int64 `json:"corrupted"`
+	// Failed is the number of pieces that couldn't be verified, e.g. because of I/O errors.
+	Failed int64 `json:"failed"`
+}
+
+// Status contains the progress and results of the scrubber.
+type Status struct {
+	Enabled bool `json:"enabled"`
+	// Current is the status of the pass in progress, if any.
+	Current *PassStatus `json:"current"`
+	// Last is the status of the last completed pass, if any.
+	Last *PassStatus `json:"last"`
+	// PendingReports is the number of corrupted pieces which weren't reported to their
+	// satellite yet.
+	PendingReports int `json:"pendingReports"`
+}
+
+// Service periodically reads all stored pieces and verifies them against the
+// hash in their piece header. Corrupted pieces are moved into quarantine and
+// optionally reported to their satellite.
+//
+// architecture: Chore
+type Service struct {
+	log           *zap.Logger
+	config        Config
+	db            DB
+	store         *pieces.Store
+	trust         *trust.Pool
+	dialer        rpc.Dialer
+	quarantineDir string
+	limiter       *rate.Limiter
+
+	Loop *sync2.Cycle
+
+	mu      sync.Mutex
+	current *PassStatus
+	last    *PassStatus
+}
+
+// NewService creates a new scrubber service. Corrupted pieces are copied into
+// quarantineDir before they're deleted.
+func NewService(log *zap.Logger, config Config, db DB, store *pieces.Store, trust *trust.Pool, dialer rpc.Dialer, quarantineDir string) *Service {
+	limit := rate.Inf
+	if config.Throughput > 0 {
+		limit = rate.Limit(config.Throughput.Int64())
+	}
+	return &Service{
+		log:           log,
+		config:        config,
+		db:            db,
+		store:         store,
+		trust:         trust,
+		dialer:        dialer,
+		quarantineDir: quarantineDir,
+		limiter:       rate.NewLimiter(limit, readBufferSize.Int()),
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the scrubber until the context is canceled.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if service.config.Interval <= 0 {
+		service.log.Info("piece scrubber is disabled")
+		return nil
+	}
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := service.Scrub(ctx); err != nil {
+			service.log.Error("piece verification pass failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the scrubber.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// Status returns the progress and results of the scrubber.
+func (service *Service) Status(ctx context.Context) (_ Status, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pending, err := service.db.Count(ctx)
+	if err != nil {
+		return Status{}, Error.Wrap(err)
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	status := Status{
+		Enabled:        service.config.Interval > 0,
+		PendingReports: pending,
+	}
+	if service.current != nil {
+		current := *service.current
+		status.Current = &current
+	}
+	if service.last != nil {
+		last := *service.last
+		status.Last = &last
+	}
+	return status, nil
+}
+
+// Scrub verifies all pieces of the trusted satellites once, and reports the
+// corrupted pieces to their satellites.
+func (service *Service) Scrub(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	service.current = &PassStatus{StartedAt: time.Now()}
+	service.mu.Unlock()
+
+	for _, satellite := range service.trust.GetSatellites(ctx) {
+		err = service.store.WalkSatellitePieces(ctx, satellite, func(access pieces.StoredPieceAccess) error {
+			service.scrubPiece(ctx, satellite, access.PieceID())
+			return ctx.Err()
+		})
+		if err != nil {
+			break
+		}
+		// report between satellites, so that a long pass doesn't delay the repair
+		service.report(ctx)
+	}
+
+	service.mu.Lock()
+	if err == nil {
+		service.current.FinishedAt = time.Now()
+		service.last = service.current
+	}
+	pass := *service.current
+	service.current = nil
+	service.mu.Unlock()
+
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.log.Info("piece verification pass finished",
+		zap.Int64("Verified", pass.Verified),
+		zap.Int64("Corrupted", pass.Corrupted),
+		zap.Int64("Failed", pass.Failed),
+		zap.Duration("Duration", pass.FinishedAt.Sub(pass.StartedAt)))
+	return nil
+}
+
+// scrubPiece verifies a single piece and quarantines it when it's corrupted.
+func (service *Service) scrubPiece(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) {
+	log := service.log.With(zap.Stringer("Satellite ID", satellite), zap.Stringer("Piece ID", pieceID))
+
+	size, err := service.verify(ctx, satellite, pieceID)
+	switch {
+	case err == nil:
+		service.update(func(pass *PassStatus) {
+			pass.Verified++
+			pass.VerifiedBytes += size
+		})
+		return
+	case errs.IsFunc(err, os.IsNotExist), ctx.Err() != nil:
+		// the piece was deleted after it was listed, or the pass was canceled
+		return
+	case !ErrCorrupted.Has(err):
+		log.Warn("unable to verify piece", zap.Error(err))
+		service.update(func(pass *PassStatus) { pass.Failed++ })
+		return
+	}
+
+	path, err := service.store.Quarantine(ctx, satellite, pieceID, service.quarantineDir)
+	if err != nil {
+		log.Error("piece is corrupted, but it couldn't be quarantined", zap.Error(err))
+		service.update(func(pass *PassStatus) { pass.Failed++ })
+		return
+	}
+	log.Warn("corrupted piece moved to quarantine", zap.String("Path", path))
+	mon.Meter("scrubber_piece_corrupted").Mark(1)
+
+	service.update(func(pass *PassStatus) { pass.Corrupted++ })
+	if !service.config.ReportCorrupted {
+		return
+	}
+	if err := service.db.Add(ctx, satellite, pieceID, time.Now()); err != nil {
+		log.Error("unable to store the report of the corrupted piece", zap.Error(err))
+	}
+}
+
+// verify reads the piece and compares its content with the hash in its piece
+// header, or its piece info for V0 pieces. It returns the size of the piece.
+func (service *Service) verify(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// a copy of the piece in the read cache could hide a corrupted piece on disk
+	reader, err := service.store.UncachedReader(ctx, satellite, pieceID)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	pieceHash, _, err := service.store.GetHashAndLimit(ctx, satellite, pieceID, reader)
+	if err != nil {
+		if reader.StorageFormatVersion() >= filestore.FormatV1 {
+			return 0, ErrCorrupted.Wrap(err)
+		}
+		return 0, err
+	}
+	if _, err := reader.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	hash := pkcrypto.NewHash()
+	buf := make([]byte, readBufferSize.Int())
+	for {
+		n, readErr := reader.Read(buf)
+		if n > 0 {
+			if err := service.limiter.WaitN(ctx, n); err != nil {
+				return 0, err
+			}
+			_, _ = hash.Write(buf[:n])
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return 0, readErr
+		}
+	}
+
+	if !bytes.Equal(hash.Sum(nil), pieceHash.Hash) {
+		return 0, ErrCorrupted.New("hash mismatch")
+	}
+	return reader.Size(), nil
+}
+
+// update updates the status of the current pass.
+func (service *Service) update(fn func(pass *PassStatus)) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	fn(service.current)
+}
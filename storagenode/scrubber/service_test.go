@@ -0,0 +1,98 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scrubber_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/pkcrypto"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/scrubber"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+	"storj.io/storj/storagenode/trust"
+)
+
+func TestScrub(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), db.PieceIndex())
+
+		satellite := testrand.NodeID()
+		pool, err := trust.NewPool(log, trust.Dialer(rpc.Dialer{}), trust.Config{
+			Sources: []trust.Source{&trust.StaticURLSource{
+				URL: trust.SatelliteURL{ID: satellite, Host: "localhost", Port: 7777},
+			}},
+			CachePath: ctx.File("trust-cache.json"),
+		})
+		require.NoError(t, err)
+		require.NoError(t, pool.Refresh(ctx))
+
+		writePiece := func(pieceID storj.PieceID) {
+			data := testrand.BytesInt(memory.KiB.Int())
+			writer, err := store.Writer(ctx, satellite, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{Hash: pkcrypto.SHA256Hash(data)}))
+		}
+
+		intact, corrupted := testrand.PieceID(), testrand.PieceID()
+		writePiece(intact)
+		writePiece(corrupted)
+
+		// flip the last byte of the piece content
+		blobInfo, err := db.Pieces().Stat(ctx, storage.BlobRef{Namespace: satellite.Bytes(), Key: corrupted.Bytes()})
+		require.NoError(t, err)
+		path, err := blobInfo.FullPath(ctx)
+		require.NoError(t, err)
+		content, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+		content[len(content)-1]++
+		require.NoError(t, ioutil.WriteFile(path, content, 0600))
+
+		quarantineDir := ctx.Dir("quarantine")
+		service := scrubber.NewService(log, scrubber.Config{Interval: 1, ReportCorrupted: true}, db.CorruptPieces(), store, pool, rpc.Dialer{}, quarantineDir)
+		require.NoError(t, service.Scrub(ctx))
+
+		status, err := service.Status(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, status.Current)
+		require.NotNil(t, status.Last)
+		assert.EqualValues(t, 1, status.Last.Verified)
+		assert.Equal(t, memory.KiB.Int64(), status.Last.VerifiedBytes)
+		assert.EqualValues(t, 1, status.Last.Corrupted)
+		assert.EqualValues(t, 0, status.Last.Failed)
+		// the satellite is unreachable, so the report is kept in the database
+		assert.Equal(t, 1, status.PendingReports)
+		pending, err := db.CorruptPieces().List(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[storj.NodeID][]storj.PieceID{satellite: {corrupted}}, pending)
+
+		// the corrupted piece is moved into quarantine
+		_, err = store.Reader(ctx, satellite, corrupted)
+		assert.True(t, os.IsNotExist(err))
+		quarantined, err := ioutil.ReadFile(filepath.Join(quarantineDir, satellite.String(), corrupted.String()))
+		require.NoError(t, err)
+		assert.Equal(t, content, quarantined)
+
+		reader, err := store.Reader(ctx, satellite, intact)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+	})
+}
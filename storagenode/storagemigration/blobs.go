@@ -0,0 +1,446 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagemigration
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+)
+
+var _ storage.Blobs = (*Blobs)(nil)
+
+// Blobs is a blob store which can be migrated to another blob store while it's
+// in use.
+//
+// While a migration is in progress, new blobs are only written to the
+// destination, blobs are read from the destination first and from the source
+// second, and deletions apply to both.
+//
+// architecture: Database
+type Blobs struct {
+	mu          sync.RWMutex
+	source      storage.Blobs
+	destination storage.Blobs
+	opened      []storage.Blobs
+	copying     map[string]*copyState
+
+	// trashMu excludes restoring and emptying the trash while a trashed blob
+	// is copied to the destination.
+	trashMu sync.RWMutex
+}
+
+// copyState is a blob being copied to the destination. When the blob is deleted
+// or trashed while it's copied, the copy is removed again.
+type copyState struct {
+	canceled bool
+}
+
+// NewBlobs creates a blob store for source, which isn't migrated yet.
+func NewBlobs(source storage.Blobs) *Blobs {
+	return &Blobs{
+		source:  source,
+		copying: map[string]*copyState{},
+	}
+}
+
+// begin starts migrating to the destination, which is closed together with the
+// blob store.
+func (blobs *Blobs) begin(destination storage.Blobs) {
+	blobs.mu.Lock()
+	defer blobs.mu.Unlock()
+	blobs.destination = destination
+	blobs.opened = append(blobs.opened, destination)
+}
+
+// finish atomically switches over to the destination. The source isn't closed,
+// since readers may still use it.
+func (blobs *Blobs) finish() {
+	blobs.mu.Lock()
+	defer blobs.mu.Unlock()
+	blobs.source, blobs.destination = blobs.destination, nil
+}
+
+// stores returns the source, and the destination while migrating.
+func (blobs *Blobs) stores() (source, destination storage.Blobs) {
+	blobs.mu.RLock()
+	defer blobs.mu.RUnlock()
+	return blobs.source, blobs.destination
+}
+
+// active returns the blob store new blobs are written to.
+func (blobs *Blobs) active() storage.Blobs {
+	source, destination := blobs.stores()
+	if destination != nil {
+		return destination
+	}
+	return source
+}
+
+// startCopy records that the blob is being copied to the destination.
+func (blobs *Blobs) startCopy(ref storage.BlobRef) *copyState {
+	blobs.mu.Lock()
+	defer blobs.mu.Unlock()
+	state := &copyState{}
+	blobs.copying[copyKey(ref)] = state
+	return state
+}
+
+// finishCopy returns whether the copied blob must be removed from the
+// destination, because it was deleted or trashed while it was copied.
+func (blobs *Blobs) finishCopy(ref storage.BlobRef, state *copyState) (canceled bool) {
+	blobs.mu.Lock()
+	defer blobs.mu.Unlock()
+	delete(blobs.copying, copyKey(ref))
+	return state.canceled
+}
+
+// cancelCopy cancels copying the blob, when it's being copied.
+func (blobs *Blobs) cancelCopy(ref storage.BlobRef) {
+	blobs.mu.Lock()
+	defer blobs.mu.Unlock()
+	if state, ok := blobs.copying[copyKey(ref)]; ok {
+		state.canceled = true
+	}
+}
+
+func copyKey(ref storage.BlobRef) string {
+	return string(ref.Namespace) + "/" + string(ref.Key)
+}
+
+// Create creates a new blob in the destination while migrating.
+func (blobs *Blobs) Create(ctx context.Context, ref storage.BlobRef, size int64) (_ storage.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return blobs.active().Create(ctx, ref, size)
+}
+
+// Open opens a reader for the blob, from the destination or the source.
+func (blobs *Blobs) Open(ctx context.Context, ref storage.BlobRef) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	source, destination := blobs.stores()
+	if destination != nil {
+		reader, err := destination.Open(ctx, ref)
+		if !isNotExist(err) {
+			return reader, err
+		}
+	}
+	return source.Open(ctx, ref)
+}
+
+// OpenWithStorageFormat opens a reader for the blob with the storage format
+// version, from the destination or the source.
+func (blobs *Blobs) OpenWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	source, destination := blobs.stores()
+	if destination != nil {
+		reader, err := destination.OpenWithStorageFormat(ctx, ref, formatVer)
+		if !isNotExist(err) {
+			return reader, err
+		}
+	}
+	return source.OpenWithStorageFormat(ctx, ref, formatVer)
+}
+
+// Stat looks up the blob, in the destination or the source.
+func (blobs *Blobs) Stat(ctx context.Context, ref storage.BlobRef) (_ storage.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	source, destination := blobs.stores()
+	if destination != nil {
+		info, err := destination.Stat(ctx, ref)
+		if !isNotExist(err) {
+			return info, err
+		}
+	}
+	return source.Stat(ctx, ref)
+}
+
+// StatWithStorageFormat looks up the blob with the storage format version, in
+// the destination or the source.
+func (blobs *Blobs) StatWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (_ storage.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	source, destination := blobs.stores()
+	if destination != nil {
+		info, err := destination.StatWithStorageFormat(ctx, ref, formatVer)
+		if !isNotExist(err) {
+			return info, err
+		}
+	}
+	return source.StatWithStorageFormat(ctx, ref, formatVer)
+}
+
+// Delete deletes the blob from the destination and the source.
+func (blobs *Blobs) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	blobs.cancelCopy(ref)
+	source, destination := blobs.stores()
+	if destination != nil {
+		if err := destination.Delete(ctx, ref); err != nil {
+			return err
+		}
+	}
+	return source.Delete(ctx, ref)
+}
+
+// DeleteWithStorageFormat deletes the blob with the storage format version from
+// the destination and the source.
+func (blobs *Blobs) DeleteWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	blobs.cancelCopy(ref)
+	source, destination := blobs.stores()
+	if destination != nil {
+		if err := destination.DeleteWithStorageFormat(ctx, ref, formatVer); err != nil {
+			return err
+		}
+	}
+	return source.DeleteWithStorageFormat(ctx, ref, formatVer)
+}
+
+// Trash moves the blob to the trash, in the destination and the source.
+func (blobs *Blobs) Trash(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	blobs.cancelCopy(ref)
+	source, destination := blobs.stores()
+	if destination == nil {
+		return source.Trash(ctx, ref)
+	}
+
+	// the blob usually exists only in one of them
+	destinationErr := destination.Trash(ctx, ref)
+	sourceErr := source.Trash(ctx, ref)
+	if isNotExist(destinationErr) && isNotExist(sourceErr) {
+		return sourceErr
+	}
+	if isNotExist(destinationErr) {
+		destinationErr = nil
+	}
+	if isNotExist(sourceErr) {
+		sourceErr = nil
+	}
+	return errs.Combine(destinationErr, sourceErr)
+}
+
+// RestoreTrash restores the trashed blobs of the namespace, in the destination
+// and the source.
+func (blobs *Blobs) RestoreTrash(ctx context.Context, namespace []byte) (_ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	blobs.trashMu.Lock()
+	defer blobs.trashMu.Unlock()
+	source, destination := blobs.stores()
+	if destination == nil {
+		return source.RestoreTrash(ctx, namespace)
+	}
+
+	restored, err := destination.RestoreTrash(ctx, namespace)
+	if err != nil {
+		return restored, err
+	}
+	sourceRestored, err := source.RestoreTrash(ctx, namespace)
+	return append(restored, sourceRestored...), err
+}
+
+// EmptyTrash removes the blobs trashed before trashedBefore, in the destination
+// and the source.
+func (blobs *Blobs) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (_ int64, _ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	blobs.trashMu.Lock()
+	defer blobs.trashMu.Unlock()
+	source, destination := blobs.stores()
+	if destination == nil {
+		return source.EmptyTrash(ctx, namespace, trashedBefore)
+	}
+
+	emptied, keys, err := destination.EmptyTrash(ctx, namespace, trashedBefore)
+	if err != nil {
+		return emptied, keys, err
+	}
+	sourceEmptied, sourceKeys, err := source.EmptyTrash(ctx, namespace, trashedBefore)
+	return emptied + sourceEmptied, append(keys, sourceKeys...), err
+}
+
+// FreeSpace returns the free space where new blobs are written.
+func (blobs *Blobs) FreeSpace() (int64, error) {
+	return blobs.active().FreeSpace()
+}
+
+// SpaceUsedForTrash returns the space used by the trash. While migrating, blobs
+// which are already copied are counted twice.
+func (blobs *Blobs) SpaceUsedForTrash(ctx context.Context) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return blobs.sum(func(store storage.Blobs) (int64, error) {
+		return store.SpaceUsedForTrash(ctx)
+	})
+}
+
+// SpaceUsedForBlobs returns the space used by all blobs. While migrating, blobs
+// which are already copied are counted twice.
+func (blobs *Blobs) SpaceUsedForBlobs(ctx context.Context) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return blobs.sum(func(store storage.Blobs) (int64, error) {
+		return store.SpaceUsedForBlobs(ctx)
+	})
+}
+
+// SpaceUsedForBlobsInNamespace returns the space used by the blobs of the
+// namespace. While migrating, blobs which are already copied are counted twice.
+func (blobs *Blobs) SpaceUsedForBlobsInNamespace(ctx context.Context, namespace []byte) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return blobs.sum(func(store storage.Blobs) (int64, error) {
+		return store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+	})
+}
+
+func (blobs *Blobs) sum(spaceUsed func(store storage.Blobs) (int64, error)) (int64, error) {
+	source, destination := blobs.stores()
+	total, err := spaceUsed(source)
+	if err != nil || destination == nil {
+		return total, err
+	}
+	destinationTotal, err := spaceUsed(destination)
+	return total + destinationTotal, err
+}
+
+// ListNamespaces lists the namespaces of the destination and the source.
+func (blobs *Blobs) ListNamespaces(ctx context.Context) (_ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	source, destination := blobs.stores()
+	namespaces, err := source.ListNamespaces(ctx)
+	if err != nil || destination == nil {
+		return namespaces, err
+	}
+
+	destinationNamespaces, err := destination.ListNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	for _, namespace := range namespaces {
+		seen[string(namespace)] = true
+	}
+	for _, namespace := range destinationNamespaces {
+		if !seen[string(namespace)] {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces, nil
+}
+
+// WalkNamespace executes walkFunc for each blob of the namespace. While
+// migrating, blobs which are already copied are only walked in the destination.
+func (blobs *Blobs) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(storage.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	source, destination := blobs.stores()
+	if destination == nil {
+		return source.WalkNamespace(ctx, namespace, walkFunc)
+	}
+
+	walked := map[string]bool{}
+	err = destination.WalkNamespace(ctx, namespace, func(info storage.BlobInfo) error {
+		walked[string(info.BlobRef().Key)] = true
+		return walkFunc(info)
+	})
+	if err != nil {
+		return err
+	}
+	return source.WalkNamespace(ctx, namespace, func(info storage.BlobInfo) error {
+		if walked[string(info.BlobRef().Key)] {
+			return nil
+		}
+		return walkFunc(info)
+	})
+}
+
+// WalkTrash executes walkFunc for each trashed blob of the namespace. While
+// migrating, blobs which are already copied are only walked in the destination.
+func (blobs *Blobs) WalkTrash(ctx context.Context, namespace []byte, walkFunc func(storage.TrashInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	source, destination := blobs.stores()
+	if destination == nil {
+		return source.WalkTrash(ctx, namespace, walkFunc)
+	}
+
+	walked := map[string]bool{}
+	err = destination.WalkTrash(ctx, namespace, func(info storage.TrashInfo) error {
+		walked[string(info.BlobRef().Key)] = true
+		return walkFunc(info)
+	})
+	if err != nil {
+		return err
+	}
+	return source.WalkTrash(ctx, namespace, func(info storage.TrashInfo) error {
+		if walked[string(info.BlobRef().Key)] {
+			return nil
+		}
+		return walkFunc(info)
+	})
+}
+
+// GarbageCollect compacts the blob store new blobs are written to, when it
+// supports compaction.
+func (blobs *Blobs) GarbageCollect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if collector, ok := blobs.active().(interface {
+		GarbageCollect(ctx context.Context) error
+	}); ok {
+		return collector.GarbageCollect(ctx)
+	}
+	return nil
+}
+
+// RefreshUsage refreshes the space used per directory, when the blob store new
+// blobs are written to tracks it.
+func (blobs *Blobs) RefreshUsage(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if refresher, ok := blobs.active().(interface {
+		RefreshUsage(ctx context.Context) error
+	}); ok {
+		return refresher.RefreshUsage(ctx)
+	}
+	return nil
+}
+
+// Locations returns the status of the directories of the blob store new blobs
+// are written to, when it spans multiple directories.
+func (blobs *Blobs) Locations(ctx context.Context) []filestore.LocationStatus {
+	if locator, ok := blobs.active().(interface {
+		Locations(ctx context.Context) []filestore.LocationStatus
+	}); ok {
+		return locator.Locations(ctx)
+	}
+	return nil
+}
+
+// TestCreateV0 creates a new V0 blob that can be written. This is only appropriate in test situations.
+func (blobs *Blobs) TestCreateV0(ctx context.Context, ref storage.BlobRef) (_ storage.BlobWriter, err error) {
+	creator, ok := blobs.active().(interface {
+		TestCreateV0(ctx context.Context, ref storage.BlobRef) (_ storage.BlobWriter, err error)
+	})
+	if !ok {
+		return nil, Error.New("blob store doesn't support V0 blobs")
+	}
+	return creator.TestCreateV0(ctx, ref)
+}
+
+// Close closes the blob stores which were opened as migration destinations. The
+// blob store it was created for is left open.
+func (blobs *Blobs) Close() error {
+	blobs.mu.Lock()
+	defer blobs.mu.Unlock()
+
+	var group errs.Group
+	for _, opened := range blobs.opened {
+		group.Add(opened.Close())
+	}
+	blobs.opened = nil
+	return group.Err()
+}
+
+func isNotExist(err error) bool {
+	return err != nil && errs.IsFunc(err, os.IsNotExist)
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagemigration
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/storagemigrationpb"
+)
+
+// Endpoint implements the private storage migration endpoint, which is used by
+// the migrate-storage command.
+type Endpoint struct {
+	log     *zap.Logger
+	service *Service
+}
+
+// NewEndpoint creates a new storage migration endpoint.
+func NewEndpoint(log *zap.Logger, service *Service) *Endpoint {
+	return &Endpoint{
+		log:     log,
+		service: service,
+	}
+}
+
+// StartMigration starts migrating the pieces to another directory or backend.
+func (endpoint *Endpoint) StartMigration(ctx context.Context, req *storagemigrationpb.StartMigrationRequest) (_ *storagemigrationpb.StartMigrationResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = endpoint.service.Start(ctx, Location{Path: req.Path, Backend: req.Backend})
+	if err != nil {
+		endpoint.log.Debug("failed to start storage migration", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+	}
+	return &storagemigrationpb.StartMigrationResponse{}, nil
+}
+
+// GetMigrationStatus returns the progress of the storage migration.
+func (endpoint *Endpoint) GetMigrationStatus(ctx context.Context, req *storagemigrationpb.GetMigrationStatusRequest) (_ *storagemigrationpb.GetMigrationStatusResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	status := endpoint.service.Status()
+	resp := &storagemigrationpb.GetMigrationStatusResponse{
+		Running:     status.Running,
+		Copied:      status.Copied,
+		CopiedBytes: status.CopiedBytes,
+		Finished:    !status.FinishedAt.IsZero(),
+		Error:       status.Error,
+	}
+	if status.Destination != nil {
+		resp.Path = status.Destination.Path
+		resp.Backend = status.Destination.Backend
+	}
+	return resp, nil
+}
@@ -0,0 +1,447 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package storagemigration implements migrating the stored pieces to another
+// directory or backend while the storage node keeps serving them.
+package storagemigration
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/pkcrypto"
+	"storj.io/common/storj"
+	"storj.io/storj/storage"
+	"storj.io/storj/storagenode/pieces"
+)
+
+var (
+	// Error is the default error class for the storage migration.
+	Error = errs.Class("storage migration error")
+	// ErrVerification is the error class of blobs whose copy doesn't match the original.
+	ErrVerification = errs.Class("copy verification failed")
+
+	mon = monkit.Package()
+)
+
+// trashImporter is implemented by blob stores which can store blobs directly in
+// their trash.
+type trashImporter interface {
+	ImportTrash(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion, trashedAt time.Time, data io.Reader, size int64) error
+}
+
+// Status contains the progress of the storage migration.
+type Status struct {
+	// Destination is the location pieces are being migrated to, if any.
+	Destination *Location
+	Running     bool
+	StartedAt   time.Time
+	FinishedAt  time.Time
+
+	// Copied is the number of blobs copied to the destination.
+	Copied      int64
+	CopiedBytes int64
+	// Error is the error which stopped the last migration, if any.
+	Error string
+}
+
+// Service copies the pieces to another directory or backend while the node keeps
+// serving them, and switches over to the destination once all pieces are copied.
+//
+// The state of the migration is persisted in the storage directory, so that an
+// interrupted migration continues when the node is restarted, and the node keeps
+// using the destination after the migration.
+//
+// architecture: Service
+type Service struct {
+	log        *zap.Logger
+	storageDir string
+	v0Pieces   pieces.V0PieceInfoDB
+	blobs      *Blobs
+	start      chan struct{}
+
+	mu      sync.Mutex
+	current Location
+	state   State
+	status  Status
+}
+
+// NewService creates a new storage migration service for the blob store at the
+// configured location. When a migration was interrupted, the destination is used
+// for new blobs immediately, and the migration continues when the service runs.
+func NewService(log *zap.Logger, storageDir string, configured Location, blobs storage.Blobs, v0Pieces pieces.V0PieceInfoDB) (*Service, error) {
+	state, err := LoadState(storageDir)
+	if err != nil {
+		return nil, err
+	}
+
+	service := &Service{
+		log:        log,
+		storageDir: storageDir,
+		v0Pieces:   v0Pieces,
+		blobs:      NewBlobs(blobs),
+		start:      make(chan struct{}, 1),
+
+		current: configured,
+		state:   state,
+	}
+	if state.Pieces != nil {
+		service.current = *state.Pieces
+	}
+
+	if state.Migrating != nil {
+		destination, err := OpenBlobs(log, *state.Migrating)
+		if err != nil {
+			return nil, err
+		}
+		service.blobs.begin(destination)
+		service.status.Destination = state.Migrating
+		service.start <- struct{}{}
+	}
+
+	return service, nil
+}
+
+// Blobs returns the blob store which is migrated.
+func (service *Service) Blobs() *Blobs { return service.blobs }
+
+// Run runs the migrations until the context is canceled.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		select {
+		case <-service.start:
+		case <-ctx.Done():
+			return nil
+		}
+
+		if err := service.migrate(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			service.log.Error("storage migration failed", zap.Error(err))
+		}
+	}
+}
+
+// Close closes the blob stores opened for migrations.
+func (service *Service) Close() error {
+	return service.blobs.Close()
+}
+
+// Status returns the progress of the storage migration.
+func (service *Service) Status() Status {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.status
+}
+
+// Start starts migrating the pieces to the destination. Starting the migration
+// which is already in progress again continues it after an error.
+func (service *Service) Start(ctx context.Context, destination Location) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := destination.Verify(); err != nil {
+		return err
+	}
+	destination.Path = filepath.Clean(destination.Path)
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if migrating := service.state.Migrating; migrating != nil {
+		if *migrating != destination {
+			return Error.New("migration to %q is already in progress", migrating.Path)
+		}
+		if !service.status.Running {
+			service.signal()
+		}
+		return nil
+	}
+	if destination == service.current || destination.Path == filepath.Clean(service.current.Path) {
+		return Error.New("pieces are already stored in %q", destination.Path)
+	}
+
+	if err := service.checkV0Pieces(ctx); err != nil {
+		return err
+	}
+
+	blobs, err := OpenBlobs(service.log, destination)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	state := service.state
+	state.Migrating = &destination
+	if err := SaveState(service.storageDir, state); err != nil {
+		return errs.Combine(err, blobs.Close())
+	}
+	service.state = state
+
+	service.blobs.begin(blobs)
+	service.status = Status{Destination: &destination}
+	service.signal()
+
+	service.log.Info("storage migration started", zap.String("Path", destination.Path), zap.String("Backend", destination.Backend))
+	return nil
+}
+
+// signal wakes up Run to continue the migration.
+func (service *Service) signal() {
+	select {
+	case service.start <- struct{}{}:
+	default:
+	}
+}
+
+// checkV0Pieces returns an error when pieces are stored with storage format V0,
+// since they can't be listed with the blob store.
+func (service *Service) checkV0Pieces(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	source, _ := service.blobs.stores()
+	namespaces, err := source.ListNamespaces(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	errFound := errs.New("found")
+	for _, namespace := range namespaces {
+		satellite, err := storj.NodeIDFromBytes(namespace)
+		if err != nil {
+			continue
+		}
+		err = service.v0Pieces.WalkSatelliteV0Pieces(ctx, source, satellite, func(pieces.StoredPieceAccess) error {
+			return errFound
+		})
+		if errs.Is(err, errFound) {
+			return Error.New("pieces stored with storage format V0 can't be migrated")
+		}
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// migrate copies all blobs from the source to the destination and switches over
+// to the destination.
+func (service *Service) migrate(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	destination := service.state.Migrating
+	service.status.Running = true
+	service.status.StartedAt = time.Now()
+	service.status.Error = ""
+	service.mu.Unlock()
+
+	defer func() {
+		service.mu.Lock()
+		defer service.mu.Unlock()
+		service.status.Running = false
+		if err != nil {
+			service.status.Error = err.Error()
+		}
+	}()
+
+	if destination == nil {
+		return nil
+	}
+
+	source, target := service.blobs.stores()
+	importer, ok := target.(trashImporter)
+	if !ok {
+		return Error.New("blob store doesn't support importing trash")
+	}
+
+	namespaces, err := source.ListNamespaces(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, namespace := range namespaces {
+		err := source.WalkNamespace(ctx, namespace, func(info storage.BlobInfo) error {
+			return service.copyBlob(ctx, source, target, info.BlobRef(), info.StorageFormatVersion())
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		err = source.WalkTrash(ctx, namespace, func(info storage.TrashInfo) error {
+			return service.copyTrash(ctx, source, target, importer, info)
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	state := State{Pieces: destination}
+	if err := SaveState(service.storageDir, state); err != nil {
+		return err
+	}
+	service.state = state
+	service.current = *destination
+	service.blobs.finish()
+	service.status.FinishedAt = time.Now()
+
+	service.log.Info("storage migration finished, the previous piece directory can be removed",
+		zap.String("Path", destination.Path),
+		zap.Int64("Copied", service.status.Copied))
+	return nil
+}
+
+// copyBlob copies a single blob from the source to the destination and verifies
+// the copy.
+func (service *Service) copyBlob(ctx context.Context, source, destination storage.Blobs, ref storage.BlobRef, formatVer storage.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := destination.StatWithStorageFormat(ctx, ref, formatVer); err == nil {
+		// copied before the migration was interrupted, or created during the migration
+		return nil
+	} else if !isNotExist(err) {
+		return err
+	}
+
+	state := service.blobs.startCopy(ref)
+	hash, size, err := service.copyData(ctx, source, destination, ref, formatVer)
+	if canceled := service.blobs.finishCopy(ref, state); canceled || isNotExist(err) {
+		// the blob was deleted or trashed while it was copied
+		return errs.Combine(err, destination.DeleteWithStorageFormat(ctx, ref, formatVer))
+	}
+	if err != nil {
+		return err
+	}
+
+	copied, _, err := readHash(ctx, destination, ref, formatVer)
+	if err == nil && !bytes.Equal(hash, copied) {
+		err = ErrVerification.New("%x/%x", ref.Namespace, ref.Key)
+	}
+	if err != nil {
+		return errs.Combine(err, destination.DeleteWithStorageFormat(ctx, ref, formatVer))
+	}
+
+	service.mu.Lock()
+	service.status.Copied++
+	service.status.CopiedBytes += size
+	service.mu.Unlock()
+	mon.Meter("storage_migration_copied_bytes").Mark64(size)
+	return nil
+}
+
+// copyTrash copies a single trashed blob from the trash of the source into the
+// trash of the destination, keeping the time it was trashed. The source isn't
+// modified.
+func (service *Service) copyTrash(ctx context.Context, source, destination storage.Blobs, importer trashImporter, info storage.TrashInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the trash can't be restored while the blob is copied, since it would be
+	// restored only in the source
+	service.blobs.trashMu.RLock()
+	size, found, err := importTrash(ctx, importer, info)
+	service.blobs.trashMu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		// the blob was emptied from the trash or restored since it was listed,
+		// a restored blob must be copied as well
+		ref, formatVer := info.BlobRef(), info.StorageFormatVersion()
+		if _, err := source.StatWithStorageFormat(ctx, ref, formatVer); err != nil {
+			if isNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		return service.copyBlob(ctx, source, destination, ref, formatVer)
+	}
+
+	service.mu.Lock()
+	service.status.Copied++
+	service.status.CopiedBytes += size
+	service.mu.Unlock()
+	mon.Meter("storage_migration_copied_bytes").Mark64(size)
+	return nil
+}
+
+// importTrash imports the trashed blob into the trash of the destination and
+// returns its size. found is false when the blob isn't in the trash anymore.
+func importTrash(ctx context.Context, importer trashImporter, info storage.TrashInfo) (size int64, found bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := info.Open(ctx)
+	if isNotExist(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	size, err = reader.Size()
+	if err != nil {
+		return 0, true, err
+	}
+	err = importer.ImportTrash(ctx, info.BlobRef(), info.StorageFormatVersion(), info.TrashedAt(), reader, size)
+	return size, true, err
+}
+
+// copyData copies the content of the blob and returns its hash and size.
+func (service *Service) copyData(ctx context.Context, source, destination storage.Blobs, ref storage.BlobRef, formatVer storage.FormatVersion) (_ []byte, _ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := source.OpenWithStorageFormat(ctx, ref, formatVer)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	size, err := reader.Size()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	writer, err := destination.Create(ctx, ref, size)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	hash := pkcrypto.NewHash()
+	if _, err := io.Copy(io.MultiWriter(writer, hash), reader); err != nil {
+		return nil, 0, errs.Combine(err, writer.Cancel(ctx))
+	}
+	if err := writer.Commit(ctx); err != nil {
+		return nil, 0, err
+	}
+	return hash.Sum(nil), size, nil
+}
+
+// readHash reads the blob and returns its hash and size.
+func readHash(ctx context.Context, blobs storage.Blobs, ref storage.BlobRef, formatVer storage.FormatVersion) (_ []byte, _ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := blobs.OpenWithStorageFormat(ctx, ref, formatVer)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	hash := pkcrypto.NewHash()
+	size, err := io.Copy(hash, reader)
+	if err != nil {
+		return nil, 0, err
+	}
+	return hash.Sum(nil), size, nil
+}
@@ -0,0 +1,175 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagemigration_test
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storage/packstore"
+	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/storagemigration"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+func TestMigration(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+	storageDir := ctx.Dir("storage")
+	config := storagenodedb.Config{
+		Storage: storageDir,
+		Info:    filepath.Join(storageDir, "piecestore.db"),
+		Info2:   filepath.Join(storageDir, "info.db"),
+		Pieces:  storageDir,
+	}
+	db, err := storagenodedb.New(log, config)
+	require.NoError(t, err)
+	require.NoError(t, db.CreateTables(ctx))
+
+	service, err := storagemigration.NewService(log, storageDir,
+		storagemigration.Location{Path: storageDir, Backend: piecestore.BackendFiles},
+		db.Pieces(), db.V0PieceInfo())
+	require.NoError(t, err)
+	blobs := service.Blobs()
+
+	namespace := testrand.NodeID().Bytes()
+	write := func() (storage.BlobRef, []byte) {
+		ref := storage.BlobRef{Namespace: namespace, Key: testrand.PieceID().Bytes()}
+		data := testrand.BytesInt(testrand.Intn(4*memory.KiB.Int()) + 1)
+		writer, err := blobs.Create(ctx, ref, int64(len(data)))
+		require.NoError(t, err)
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+		return ref, data
+	}
+	read := func(ref storage.BlobRef) ([]byte, error) {
+		reader, err := blobs.Open(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		defer ctx.Check(reader.Close)
+		return ioutil.ReadAll(reader)
+	}
+
+	expected := map[string][]byte{}
+	refs := map[string]storage.BlobRef{}
+	for i := 0; i < 10; i++ {
+		ref, data := write()
+		expected[string(ref.Key)], refs[string(ref.Key)] = data, ref
+	}
+	trashed, trashedData := write()
+	require.NoError(t, blobs.Trash(ctx, trashed))
+	trashedAt := func(blobs storage.Blobs) (trashedAt time.Time) {
+		require.NoError(t, blobs.WalkTrash(ctx, namespace, func(info storage.TrashInfo) error {
+			assert.Equal(t, trashed.Key, info.BlobRef().Key)
+			trashedAt = info.TrashedAt()
+			return nil
+		}))
+		return trashedAt
+	}
+	sourceTrashedAt := trashedAt(blobs)
+	require.False(t, sourceTrashedAt.IsZero())
+
+	// the destination can't be the current location
+	err = service.Start(ctx, storagemigration.Location{Path: storageDir, Backend: piecestore.BackendPacks})
+	require.Error(t, err)
+	err = service.Start(ctx, storagemigration.Location{Path: ctx.Dir("other"), Backend: "unknown"})
+	require.Error(t, err)
+
+	destination := storagemigration.Location{Path: ctx.Dir("packs"), Backend: piecestore.BackendPacks}
+	require.NoError(t, service.Start(ctx, destination))
+
+	state, err := storagemigration.LoadState(storageDir)
+	require.NoError(t, err)
+	require.Equal(t, &destination, state.Migrating)
+
+	// another migration can't start while one is in progress
+	err = service.Start(ctx, storagemigration.Location{Path: ctx.Dir("other"), Backend: piecestore.BackendFiles})
+	require.Error(t, err)
+
+	// blobs are readable and writable while migrating
+	for key, data := range expected {
+		actual, err := read(refs[key])
+		require.NoError(t, err)
+		assert.Equal(t, data, actual)
+	}
+	ref, data := write()
+	expected[string(ref.Key)], refs[string(ref.Key)] = data, ref
+	for key, ref := range refs {
+		require.NoError(t, blobs.Delete(ctx, ref))
+		delete(expected, key)
+		delete(refs, key)
+		break
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	ctx.Go(func() error { return service.Run(runCtx) })
+	for service.Status().FinishedAt.IsZero() {
+		require.Empty(t, service.Status().Error)
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+
+	status := service.Status()
+	// the blob written during the migration isn't copied, but the trashed one is
+	assert.EqualValues(t, len(expected), status.Copied)
+
+	state, err = storagemigration.LoadState(storageDir)
+	require.NoError(t, err)
+	assert.Equal(t, storagemigration.State{Pieces: &destination}, state)
+
+	// the trashed blob is copied to the trash of the destination, and it's
+	// left in the trash of the source
+	assert.True(t, sourceTrashedAt.Equal(trashedAt(service.Blobs())))
+	source, err := filestore.NewAt(log, storageDir)
+	require.NoError(t, err)
+	assert.True(t, sourceTrashedAt.Equal(trashedAt(source)))
+
+	require.NoError(t, service.Close())
+	require.NoError(t, db.Close())
+
+	// the node keeps using the destination after a restart
+	db, err = storagenodedb.New(log, config)
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+	_, ok := db.Pieces().(*packstore.Store)
+	require.True(t, ok)
+
+	service, err = storagemigration.NewService(log, storageDir,
+		storagemigration.Location{Path: storageDir, Backend: piecestore.BackendFiles},
+		db.Pieces(), db.V0PieceInfo())
+	require.NoError(t, err)
+	defer ctx.Check(service.Close)
+	blobs = service.Blobs()
+
+	for key, data := range expected {
+		actual, err := read(refs[key])
+		require.NoError(t, err)
+		assert.Equal(t, data, actual)
+	}
+	_, err = read(trashed)
+	require.Error(t, err)
+
+	restored, err := blobs.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{trashed.Key}, restored)
+	actual, err := read(trashed)
+	require.NoError(t, err)
+	assert.Equal(t, trashedData, actual)
+}
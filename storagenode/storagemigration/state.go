@@ -0,0 +1,94 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagemigration
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/fpath"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storage/packstore"
+	"storj.io/storj/storagenode/piecestore"
+)
+
+// StateFile is the name of the file in the storage directory, which records the
+// state of the storage migration.
+const StateFile = "storage-migration.json"
+
+// Location is a directory and the backend pieces are stored with.
+type Location struct {
+	Path    string `json:"path"`
+	Backend string `json:"backend"`
+}
+
+// Verify checks that the location is usable as a migration destination.
+func (location Location) Verify() error {
+	if !filepath.IsAbs(location.Path) {
+		return Error.New("path %q must be absolute", location.Path)
+	}
+	switch location.Backend {
+	case piecestore.BackendFiles, piecestore.BackendPacks:
+		return nil
+	default:
+		return Error.New("invalid backend %q", location.Backend)
+	}
+}
+
+// State is the persisted state of the storage migration.
+type State struct {
+	// Pieces is the location pieces are stored in after a completed migration. It
+	// overrides the configured location.
+	Pieces *Location `json:"pieces,omitempty"`
+	// Migrating is the location pieces are being migrated to.
+	Migrating *Location `json:"migrating,omitempty"`
+}
+
+// LoadState loads the state of the storage migration from the storage directory.
+// The state is empty when no migration was ever started.
+func LoadState(storageDir string) (State, error) {
+	var state State
+	data, err := ioutil.ReadFile(filepath.Join(storageDir, StateFile))
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return state, Error.Wrap(err)
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return state, Error.New("malformed state: %v", err)
+	}
+	return state, nil
+}
+
+// SaveState atomically replaces the state of the storage migration in the
+// storage directory.
+func SaveState(storageDir string, state State) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(fpath.AtomicWriteFile(filepath.Join(storageDir, StateFile), data, 0600))
+}
+
+// OpenBlobs opens the blob store at the location.
+func OpenBlobs(log *zap.Logger, location Location) (storage.Blobs, error) {
+	switch location.Backend {
+	case piecestore.BackendPacks:
+		packs, err := packstore.NewAt(log.Named("packs"), location.Path)
+		if err != nil {
+			return nil, err
+		}
+		return packs, nil
+	case "", piecestore.BackendFiles:
+		return filestore.NewAt(log.Named("blobs"), location.Path)
+	default:
+		return nil, Error.New("invalid backend %q", location.Backend)
+	}
+}
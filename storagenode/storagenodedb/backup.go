@@ -0,0 +1,241 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/dbutil/sqliteutil"
+	"storj.io/storj/private/tagsql"
+)
+
+// ErrRepair represents errors from repairing corrupt databases.
+var ErrRepair = errs.Class("storage node database repair error")
+
+// backupDirName is the name of the directory next to the databases, where the
+// backups of the databases are stored.
+const backupDirName = "db-backups"
+
+// rebuildableDatabases are the databases whose contents are rebuilt by the
+// storage node when they are lost: the space used is recalculated by the space
+// used cache on startup and used serials are only kept until the orders expire.
+var rebuildableDatabases = map[string]bool{
+	PieceSpaceUsedDBName: true,
+	UsedSerialsDBName:    true,
+}
+
+// Backup copies each database into the backup directory with the sqlite3 online
+// backup API. Backups failing the quick check don't replace the previous backup.
+func (db *DB) Backup(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, dbName := range db.databaseNames() {
+		if err := db.backupDatabase(ctx, dbName); err != nil {
+			group.Add(errs.New("%s: %v", dbName, err))
+		}
+	}
+	return ErrDatabase.Wrap(group.Err())
+}
+
+// backupDatabase copies the database into a temporary file and replaces the
+// previous backup with it, once the copy passed the quick check.
+func (db *DB) backupDatabase(ctx context.Context, dbName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := os.MkdirAll(db.backupDirectory(), 0700); err != nil {
+		return err
+	}
+
+	path := db.backupPathFromDBName(dbName)
+	tmpPath := path + ".tmp"
+	if err := os.Remove(tmpPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	dest, err := tagsql.Open(db.driver(), "file:"+tmpPath)
+	if err != nil {
+		return err
+	}
+
+	err = sqliteutil.Backup(ctx, db.rawDatabaseFromName(dbName), dest)
+	if err == nil {
+		err = sqliteutil.QuickCheck(ctx, dest)
+	}
+	err = errs.Combine(err, dest.Close())
+	if err != nil {
+		return errs.Combine(err, os.Remove(tmpPath))
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// Repair is a database which was corrupt and was replaced.
+type Repair struct {
+	// Database is the name of the database.
+	Database string
+	// CorruptPath is where the corrupt database file was moved to.
+	CorruptPath string
+	// BackupTime is when the backup, the database was restored from, was
+	// made. It is zero when the database was recreated empty.
+	BackupTime time.Time
+}
+
+// Repair runs the quick check on each database and replaces the corrupt
+// databases with their backup, or with an empty database when their contents
+// are rebuilt by the storage node. The corrupt files are kept next to the
+// database. It must be called before the databases are used.
+func (db *DB) Repair(ctx context.Context) (_ []Repair, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var repairs []Repair
+	for _, dbName := range db.databaseNames() {
+		err := sqliteutil.QuickCheck(ctx, db.rawDatabaseFromName(dbName))
+		if err == nil {
+			continue
+		}
+		if !sqliteutil.IsCorruptError(err) {
+			return repairs, ErrRepair.New("%s: %v", dbName, err)
+		}
+
+		db.log.Error("database is corrupt", zap.String("Database", dbName), zap.Error(err))
+		repair, err := db.repairDatabase(ctx, dbName)
+		if err != nil {
+			return repairs, ErrRepair.New("%s: %v", dbName, err)
+		}
+		if repair.BackupTime.IsZero() {
+			db.log.Warn("recreated corrupt database", zap.String("Database", dbName), zap.String("Corrupt", repair.CorruptPath))
+		} else {
+			db.log.Warn("restored corrupt database from backup", zap.String("Database", dbName), zap.String("Corrupt", repair.CorruptPath),
+				zap.Time("Backup", repair.BackupTime))
+		}
+		repairs = append(repairs, repair)
+	}
+	return repairs, nil
+}
+
+// repairDatabase moves the corrupt database aside and replaces it with its
+// backup or an empty database.
+func (db *DB) repairDatabase(ctx context.Context, dbName string) (_ Repair, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	backupPath := db.backupPathFromDBName(dbName)
+	backup, err := os.Stat(backupPath)
+	if err != nil && !os.IsNotExist(err) {
+		return Repair{}, err
+	}
+	if backup == nil && !rebuildableDatabases[dbName] {
+		return Repair{}, errs.New("no backup of the database exists in %q", db.backupDirectory())
+	}
+
+	if err := db.closeDatabase(dbName); err != nil {
+		db.log.Warn("failed to close corrupt database", zap.String("Database", dbName), zap.Error(err))
+	}
+
+	path := db.filepathFromDBName(dbName)
+	repair := Repair{
+		Database:    dbName,
+		CorruptPath: path + ".corrupt-" + time.Now().UTC().Format("20060102T150405"),
+	}
+	// the write-ahead log belongs to the corrupt database
+	for _, suffix := range []string{"", "-wal", "-shm"} {
+		err := os.Rename(path+suffix, repair.CorruptPath+suffix)
+		if err != nil && !os.IsNotExist(err) {
+			return Repair{}, errs.Combine(err, db.openDatabase(dbName))
+		}
+	}
+
+	if backup != nil {
+		if err := copyFile(backupPath, path); err != nil {
+			return Repair{}, errs.Combine(err, db.openDatabase(dbName))
+		}
+		repair.BackupTime = backup.ModTime()
+	} else {
+		if err := db.rebuildDatabase(ctx, dbName); err != nil {
+			return Repair{}, errs.Combine(err, db.openDatabase(dbName))
+		}
+	}
+
+	if err := db.openDatabase(dbName); err != nil {
+		return Repair{}, err
+	}
+	return repair, sqliteutil.QuickCheck(ctx, db.rawDatabaseFromName(dbName))
+}
+
+// rebuildDatabase creates the empty database at its path by running the
+// migration in a scratch directory, so that it has the schema and version of
+// the latest migration.
+func (db *DB) rebuildDatabase(ctx context.Context, dbName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dir, err := ioutil.TempDir(db.dbDirectory, "db-rebuild-")
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, os.RemoveAll(dir)) }()
+
+	scratch, err := New(db.log.Named("rebuild"), Config{
+		Storage: dir,
+		Info:    filepath.Join(dir, "piecestore.db"),
+		Info2:   filepath.Join(dir, "info.db"),
+		Driver:  db.config.Driver,
+		Pieces:  dir,
+	})
+	if err != nil {
+		return err
+	}
+	err = scratch.CreateTables(ctx)
+	if err := errs.Combine(err, scratch.Close()); err != nil {
+		return err
+	}
+
+	return copyFile(scratch.filepathFromDBName(dbName), db.filepathFromDBName(dbName))
+}
+
+// databaseNames returns the names of the databases in a stable order.
+func (db *DB) databaseNames() []string {
+	names := make([]string, 0, len(db.SQLDBs))
+	for dbName := range db.SQLDBs {
+		names = append(names, dbName)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// backupDirectory returns the directory of the database backups.
+func (db *DB) backupDirectory() string {
+	return filepath.Join(db.dbDirectory, backupDirName)
+}
+
+// backupPathFromDBName returns the path of the backup of the database.
+func (db *DB) backupPathFromDBName(dbName string) string {
+	return filepath.Join(db.backupDirectory(), db.filenameFromDBName(dbName))
+}
+
+// copyFile copies the file at src to dst.
+func copyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, in.Close()) }()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		return errs.Combine(err, out.Close())
+	}
+	return errs.Combine(out.Sync(), out.Close())
+}
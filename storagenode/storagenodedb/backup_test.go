@@ -0,0 +1,132 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+func TestBackupAndRepair(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+
+	storageDir := ctx.Dir("storage")
+	cfg := storagenodedb.Config{
+		Pieces:  storageDir,
+		Storage: storageDir,
+		Info:    filepath.Join(storageDir, "piecestore.db"),
+		Info2:   filepath.Join(storageDir, "info.db"),
+	}
+
+	corrupt := func(dbName string) {
+		path := filepath.Join(storageDir, dbName+".db")
+		require.NoError(t, ioutil.WriteFile(path, testrand.BytesInt(8192), 0600))
+	}
+
+	satellite := testrand.NodeID()
+	now := time.Now()
+
+	db, err := storagenodedb.New(log, cfg)
+	require.NoError(t, err)
+	require.NoError(t, db.CreateTables(ctx))
+
+	require.NoError(t, db.Bandwidth().Add(ctx, satellite, pb.PieceAction_GET, 100, now))
+	require.NoError(t, db.UsedSerials().Add(ctx, satellite, testrand.SerialNumber(), now.Add(time.Hour)))
+	require.NoError(t, db.PieceSpaceUsedDB().Init(ctx))
+	require.NoError(t, db.PieceSpaceUsedDB().UpdatePieceTotals(ctx, 1000, 900))
+	require.NoError(t, db.Backup(ctx))
+
+	// changed after the backup
+	require.NoError(t, db.Bandwidth().Add(ctx, satellite, pb.PieceAction_GET, 50, now))
+	require.NoError(t, db.Close())
+
+	// the space used is rebuilt without a backup
+	require.NoError(t, os.Remove(filepath.Join(storageDir, "db-backups", storagenodedb.PieceSpaceUsedDBName+".db")))
+	corrupt(storagenodedb.BandwidthDBName)
+	corrupt(storagenodedb.PieceSpaceUsedDBName)
+
+	db, err = storagenodedb.New(log, cfg)
+	require.NoError(t, err)
+
+	repairs, err := db.Repair(ctx)
+	require.NoError(t, err)
+	require.Len(t, repairs, 2)
+	assert.Equal(t, storagenodedb.BandwidthDBName, repairs[0].Database)
+	assert.False(t, repairs[0].BackupTime.IsZero())
+	assert.FileExists(t, repairs[0].CorruptPath)
+	assert.Equal(t, storagenodedb.PieceSpaceUsedDBName, repairs[1].Database)
+	assert.True(t, repairs[1].BackupTime.IsZero())
+
+	require.NoError(t, db.CreateTables(ctx))
+	require.NoError(t, db.Preflight(ctx))
+	require.NoError(t, db.CheckIntegrity(ctx))
+
+	usage, err := db.Bandwidth().Summary(ctx, now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), usage.Get)
+
+	serials := 0
+	require.NoError(t, db.UsedSerials().IterateAll(ctx, func(storj.NodeID, storj.SerialNumber, time.Time) { serials++ }))
+	assert.Equal(t, 1, serials)
+
+	total, _, err := db.PieceSpaceUsedDB().GetPieceTotals(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+
+	// nothing to repair anymore
+	repairs, err = db.Repair(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, repairs)
+	require.NoError(t, db.Close())
+
+	// used serials are rebuilt without a backup
+	require.NoError(t, os.Remove(filepath.Join(storageDir, "db-backups", storagenodedb.UsedSerialsDBName+".db")))
+	corrupt(storagenodedb.UsedSerialsDBName)
+
+	db, err = storagenodedb.New(log, cfg)
+	require.NoError(t, err)
+
+	repairs, err = db.Repair(ctx)
+	require.NoError(t, err)
+	require.Len(t, repairs, 1)
+	assert.Equal(t, storagenodedb.UsedSerialsDBName, repairs[0].Database)
+	assert.True(t, repairs[0].BackupTime.IsZero())
+
+	require.NoError(t, db.CreateTables(ctx))
+	require.NoError(t, db.Preflight(ctx))
+
+	serials = 0
+	require.NoError(t, db.UsedSerials().IterateAll(ctx, func(storj.NodeID, storj.SerialNumber, time.Time) { serials++ }))
+	assert.Zero(t, serials)
+	require.NoError(t, db.Close())
+
+	// databases, which can't be rebuilt, aren't replaced without a backup
+	require.NoError(t, os.Remove(filepath.Join(storageDir, "db-backups", storagenodedb.BandwidthDBName+".db")))
+	corrupt(storagenodedb.BandwidthDBName)
+
+	db, err = storagenodedb.New(log, cfg)
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	_, err = db.Repair(ctx)
+	require.Error(t, err)
+	assert.True(t, storagenodedb.ErrRepair.Has(err))
+	assert.Error(t, db.Preflight(ctx))
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/tagsql"
+	"storj.io/storj/storagenode/scrubber"
+)
+
+// ensures that corruptPiecesDB implements scrubber.DB interface.
+var _ scrubber.DB = (*corruptPiecesDB)(nil)
+
+// ErrCorruptPieces represents errors from the corrupt pieces database.
+var ErrCorruptPieces = errs.Class("corrupt pieces error")
+
+// CorruptPiecesDBName represents the database name.
+const CorruptPiecesDBName = "corrupt_pieces"
+
+// corruptPiecesDB stores the corrupted pieces which weren't reported to their satellites yet.
+//
+// architecture: Database
+type corruptPiecesDB struct {
+	dbContainerImpl
+}
+
+// Add adds a corrupted piece of the satellite to the pending reports.
+func (db *corruptPiecesDB) Add(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, foundAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO corrupt_pieces (satellite_id, piece_id, found_at)
+			VALUES (?, ?, ?)
+		ON CONFLICT (satellite_id, piece_id) DO NOTHING
+	`, satelliteID, pieceID, foundAt.UTC())
+	return ErrCorruptPieces.Wrap(err)
+}
+
+// List returns the pieces of the pending reports by satellite.
+func (db *corruptPiecesDB) List(ctx context.Context) (_ map[storj.NodeID][]storj.PieceID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT satellite_id, piece_id
+			FROM corrupt_pieces
+			ORDER BY found_at
+	`)
+	if err != nil {
+		return nil, ErrCorruptPieces.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	pending := map[storj.NodeID][]storj.PieceID{}
+	for rows.Next() {
+		var satelliteID storj.NodeID
+		var pieceID storj.PieceID
+		if err := rows.Scan(&satelliteID, &pieceID); err != nil {
+			return nil, ErrCorruptPieces.Wrap(err)
+		}
+		pending[satelliteID] = append(pending[satelliteID], pieceID)
+	}
+	return pending, ErrCorruptPieces.Wrap(rows.Err())
+}
+
+// Delete removes the pending reports of the pieces of the satellite.
+func (db *corruptPiecesDB) Delete(ctx context.Context, satelliteID storj.NodeID, pieceIDs []storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withTx(ctx, db.GetDB(), func(tx tagsql.Tx) error {
+		for _, pieceID := range pieceIDs {
+			_, err := tx.ExecContext(ctx, `
+				DELETE FROM corrupt_pieces
+					WHERE satellite_id = ? AND piece_id = ?
+			`, satelliteID, pieceID)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return ErrCorruptPieces.Wrap(err)
+}
+
+// Count returns the number of pending reports.
+func (db *corruptPiecesDB) Count(ctx context.Context) (count int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.QueryRowContext(ctx, `SELECT COUNT(*) FROM corrupt_pieces`).Scan(&count)
+	return count, ErrCorruptPieces.Wrap(err)
+}
@@ -22,14 +22,18 @@ import (
 	"storj.io/storj/private/tagsql"
 	"storj.io/storj/storage"
 	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storage/packstore"
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/payouts"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/scrubber"
+	"storj.io/storj/storagenode/storagemigration"
 	"storj.io/storj/storagenode/storageusage"
 )
 
@@ -80,6 +84,11 @@ type Config struct {
 	Info2   string
 	Driver  string // if unset, uses sqlite3
 	Pieces  string
+
+	// PieceLocations, when set, are the directories pieces are spread across instead of Pieces.
+	PieceLocations []filestore.Location
+	// PieceBackend is how pieces are stored in Pieces, see piecestore.BackendFiles and piecestore.BackendPacks.
+	PieceBackend string
 }
 
 // DB contains access to different database tables
@@ -102,17 +111,52 @@ type DB struct {
 	usedSerialsDB     *usedSerialsDB
 	satellitesDB      *satellitesDB
 	notificationsDB   *notificationDB
+	payoutsDB         *payoutsDB
+	pieceIndexDB      *pieceIndexDB
+	corruptPiecesDB   *corruptPiecesDB
 
 	SQLDBs map[string]DBContainer
 }
 
 // New creates a new master database for storage node
 func New(log *zap.Logger, config Config) (*DB, error) {
-	piecesDir, err := filestore.NewDir(config.Pieces)
+	// pieces stay where a completed storage migration moved them
+	state, err := storagemigration.LoadState(config.Storage)
 	if err != nil {
 		return nil, err
 	}
-	pieces := filestore.New(log, piecesDir)
+	if state.Pieces != nil {
+		config.Pieces = state.Pieces.Path
+		config.PieceBackend = state.Pieces.Backend
+		config.PieceLocations = nil
+	}
+
+	var pieces storage.Blobs
+	switch {
+	case config.PieceBackend == piecestore.BackendPacks:
+		if len(config.PieceLocations) > 0 {
+			return nil, errs.New("pack backend doesn't support multiple piece locations")
+		}
+		packs, err := packstore.NewAt(log.Named("packs"), config.Pieces)
+		if err != nil {
+			return nil, err
+		}
+		pieces = packs
+	case config.PieceBackend != "" && config.PieceBackend != piecestore.BackendFiles:
+		return nil, errs.New("unknown piece backend %q", config.PieceBackend)
+	case len(config.PieceLocations) > 0:
+		multi, err := filestore.NewMulti(log.Named("blobs"), config.PieceLocations)
+		if err != nil {
+			return nil, err
+		}
+		pieces = multi
+	default:
+		piecesDir, err := filestore.NewDir(config.Pieces)
+		if err != nil {
+			return nil, err
+		}
+		pieces = filestore.New(log, piecesDir)
+	}
 
 	deprecatedInfoDB := &deprecatedInfoDB{}
 	v0PieceInfoDB := &v0PieceInfoDB{}
@@ -125,6 +169,9 @@ func New(log *zap.Logger, config Config) (*DB, error) {
 	usedSerialsDB := &usedSerialsDB{}
 	satellitesDB := &satellitesDB{}
 	notificationsDB := &notificationDB{}
+	payoutsDB := &payoutsDB{}
+	pieceIndexDB := &pieceIndexDB{}
+	corruptPiecesDB := &corruptPiecesDB{}
 
 	db := &DB{
 		log:    log,
@@ -145,6 +192,9 @@ func New(log *zap.Logger, config Config) (*DB, error) {
 		usedSerialsDB:     usedSerialsDB,
 		satellitesDB:      satellitesDB,
 		notificationsDB:   notificationsDB,
+		payoutsDB:         payoutsDB,
+		pieceIndexDB:      pieceIndexDB,
+		corruptPiecesDB:   corruptPiecesDB,
 
 		SQLDBs: map[string]DBContainer{
 			DeprecatedInfoDBName:  deprecatedInfoDB,
@@ -158,6 +208,9 @@ func New(log *zap.Logger, config Config) (*DB, error) {
 			UsedSerialsDBName:     usedSerialsDB,
 			SatellitesDBName:      satellitesDB,
 			NotificationsDBName:   notificationsDB,
+			PayoutsDBName:         payoutsDB,
+			PieceIndexDBName:      pieceIndexDB,
+			CorruptPiecesDBName:   corruptPiecesDB,
 		},
 	}
 
@@ -228,6 +281,21 @@ func (db *DB) openDatabases() error {
 	if err != nil {
 		return errs.Combine(err, db.closeDatabases())
 	}
+
+	err = db.openDatabase(PayoutsDBName)
+	if err != nil {
+		return errs.Combine(err, db.closeDatabases())
+	}
+
+	err = db.openDatabase(PieceIndexDBName)
+	if err != nil {
+		return errs.Combine(err, db.closeDatabases())
+	}
+
+	err = db.openDatabase(CorruptPiecesDBName)
+	if err != nil {
+		return errs.Combine(err, db.closeDatabases())
+	}
 	return nil
 }
 
@@ -242,12 +310,7 @@ func (db *DB) openDatabase(dbName string) error {
 		return ErrDatabase.Wrap(err)
 	}
 
-	driver := db.config.Driver
-	if driver == "" {
-		driver = "sqlite3"
-	}
-
-	sqlDB, err := tagsql.Open(driver, "file:"+path+"?_journal=WAL&_busy_timeout=10000")
+	sqlDB, err := tagsql.Open(db.driver(), "file:"+path+"?_journal=WAL&_busy_timeout=10000")
 	if err != nil {
 		return ErrDatabase.Wrap(err)
 	}
@@ -260,6 +323,14 @@ func (db *DB) openDatabase(dbName string) error {
 	return nil
 }
 
+// driver returns the name of the database driver.
+func (db *DB) driver() string {
+	if db.config.Driver == "" {
+		return "sqlite3"
+	}
+	return db.config.Driver
+}
+
 // filenameFromDBName returns a constructed filename for the specified database name.
 func (db *DB) filenameFromDBName(dbName string) string {
 	return dbName + ".db"
@@ -275,6 +346,47 @@ func (db *DB) CreateTables(ctx context.Context) error {
 	return migration.Run(ctx, db.log.Named("migration"))
 }
 
+// ConvertPieces moves pieces stored as separate files into pack files, when
+// the pack backend is used and pieces of the file backend are left.
+func (db *DB) ConvertPieces(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	packs, ok := db.pieces.(*packstore.Store)
+	if !ok {
+		return nil
+	}
+
+	// don't create the directories of the file backend when they don't exist
+	if _, err := os.Stat(filepath.Join(db.config.Pieces, "blobs")); os.IsNotExist(err) {
+		return nil
+	}
+
+	files, err := filestore.NewAt(db.log.Named("blobs"), db.config.Pieces)
+	if err != nil {
+		return ErrDatabase.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, files.Close()) }()
+
+	converted, err := packstore.Convert(ctx, db.log.Named("packs"), files, packs)
+	if converted > 0 {
+		db.log.Info("converted pieces to pack files", zap.Int("count", converted))
+	}
+	return ErrDatabase.Wrap(err)
+}
+
+// CheckIntegrity runs the full integrity check on each database, which reads
+// the whole database files. Repair only runs the quick check.
+func (db *DB) CheckIntegrity(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, dbName := range db.databaseNames() {
+		if err := sqliteutil.CheckIntegrity(ctx, db.rawDatabaseFromName(dbName)); err != nil {
+			return ErrPreflight.New("%s: %v", dbName, err)
+		}
+	}
+	return nil
+}
+
 // Preflight conducts a pre-flight check to ensure correct schemas and minimal read+write functionality of the database tables.
 func (db *DB) Preflight(ctx context.Context) (err error) {
 	for dbName, dbContainer := range db.SQLDBs {
@@ -352,7 +464,7 @@ func (db *DB) Preflight(ctx context.Context) (err error) {
 
 // Close closes any resources.
 func (db *DB) Close() error {
-	return db.closeDatabases()
+	return errs.Combine(db.closeDatabases(), db.pieces.Close())
 }
 
 // closeDatabases closes all the SQLite database connections and removes them from the associated maps.
@@ -429,6 +541,21 @@ func (db *DB) Notifications() notifications.DB {
 	return db.notificationsDB
 }
 
+// Payouts returns the instance of the Payouts database.
+func (db *DB) Payouts() payouts.DB {
+	return db.payoutsDB
+}
+
+// PieceIndex returns the instance of the PieceIndex database.
+func (db *DB) PieceIndex() pieces.PieceIndexDB {
+	return db.pieceIndexDB
+}
+
+// CorruptPieces returns the instance of the CorruptPieces database.
+func (db *DB) CorruptPieces() scrubber.DB {
+	return db.corruptPiecesDB
+}
+
 // RawDatabases are required for testing purposes
 func (db *DB) RawDatabases() map[string]DBContainer {
 	return db.SQLDBs
@@ -1043,6 +1170,122 @@ func (db *DB) Migration(ctx context.Context) *migrate.Migration {
 					`UPDATE piece_space_used SET content_size = 0 WHERE content_size < 0`,
 				},
 			},
+			{
+				DB:          db.payoutsDB,
+				Description: "Create payout_statements table",
+				Version:     32,
+				Action: migrate.SQL{
+					`CREATE TABLE payout_statements (
+						satellite_id BLOB NOT NULL,
+						period TIMESTAMP NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						node_age_months INTEGER NOT NULL,
+						usage_at_rest REAL NOT NULL,
+						usage_put INTEGER NOT NULL,
+						usage_get INTEGER NOT NULL,
+						usage_put_repair INTEGER NOT NULL,
+						usage_get_repair INTEGER NOT NULL,
+						usage_get_audit INTEGER NOT NULL,
+						comp_at_rest INTEGER NOT NULL,
+						comp_put INTEGER NOT NULL,
+						comp_get INTEGER NOT NULL,
+						comp_put_repair INTEGER NOT NULL,
+						comp_get_repair INTEGER NOT NULL,
+						comp_get_audit INTEGER NOT NULL,
+						held_percent INTEGER NOT NULL,
+						held INTEGER NOT NULL,
+						disposed INTEGER NOT NULL,
+						owed INTEGER NOT NULL,
+						graceful_exit INTEGER NOT NULL,
+						PRIMARY KEY (satellite_id, period)
+					)`,
+				},
+			},
+			{
+				DB:          db.pieceIndexDB,
+				Description: "Create piece_index and piece_index_status tables",
+				Version:     33,
+				Action: migrate.SQL{
+					`CREATE TABLE piece_index (
+						satellite_id BLOB NOT NULL,
+						piece_id BLOB NOT NULL,
+						format_version INTEGER NOT NULL,
+						piece_size INTEGER NOT NULL,
+						content_size INTEGER NOT NULL,
+						piece_creation TIMESTAMP,
+						mod_time TIMESTAMP NOT NULL,
+						updated_at INTEGER NOT NULL,
+						PRIMARY KEY (satellite_id, piece_id)
+					)`,
+					`CREATE TABLE piece_index_status (
+						reconciled_at TIMESTAMP NOT NULL
+					)`,
+				},
+			},
+			{
+				DB:          db.satellitesDB,
+				Description: "Create satellite_partial_exits table",
+				Version:     34,
+				Action: migrate.SQL{
+					`CREATE TABLE satellite_partial_exits (
+						satellite_id BLOB NOT NULL,
+						requested_bytes INTEGER NOT NULL,
+						initiated_at TIMESTAMP NOT NULL,
+						finished_at TIMESTAMP,
+						bytes_deleted INTEGER NOT NULL,
+						PRIMARY KEY (satellite_id)
+					)`,
+				},
+			},
+			{
+				DB:          db.satellitesDB,
+				Description: "Create untrusted_satellites table",
+				Version:     35,
+				Action: migrate.SQL{
+					`CREATE TABLE untrusted_satellites (
+						satellite_id BLOB NOT NULL,
+						untrusted_at TIMESTAMP NOT NULL,
+						trashed_at TIMESTAMP,
+						deleted_at TIMESTAMP,
+						bytes_reclaimed INTEGER NOT NULL,
+						PRIMARY KEY (satellite_id)
+					)`,
+				},
+			},
+			{
+				DB:          db.corruptPiecesDB,
+				Description: "Create corrupt_pieces table",
+				Version:     36,
+				Action: migrate.SQL{
+					`CREATE TABLE corrupt_pieces (
+						satellite_id BLOB NOT NULL,
+						piece_id BLOB NOT NULL,
+						found_at TIMESTAMP NOT NULL,
+						PRIMARY KEY (satellite_id, piece_id)
+					)`,
+				},
+			},
+			{
+				DB:          db.reputationDB,
+				Description: "Add unknown audit reputation and suspended to reputation table",
+				Version:     37,
+				Action: migrate.SQL{
+					`ALTER TABLE reputation ADD COLUMN unknown_audit_reputation_alpha REAL NOT NULL DEFAULT 1.0`,
+					`ALTER TABLE reputation ADD COLUMN unknown_audit_reputation_beta REAL NOT NULL DEFAULT 0.0`,
+					`ALTER TABLE reputation ADD COLUMN unknown_audit_reputation_score REAL NOT NULL DEFAULT 1.0`,
+					`ALTER TABLE reputation ADD COLUMN suspended TIMESTAMP`,
+				},
+			},
+			{
+				DB:          db.notificationsDB,
+				Description: "Add delivered_at to notifications table",
+				Version:     38,
+				Action: migrate.SQL{
+					`ALTER TABLE notifications ADD COLUMN delivered_at TIMESTAMP`,
+					// don't deliver the notifications from before the upgrade again
+					`UPDATE notifications SET delivered_at = created_at`,
+				},
+			},
 		},
 	}
 }
@@ -100,7 +100,9 @@ func (db *notificationDB) List(ctx context.Context, cursor notifications.Cursor)
 	}
 
 	query := `
-		SELECT * FROM 
+		SELECT
+			id, sender_id, type, title, message, read_at, created_at
+		FROM
 			notifications
 		ORDER BY 
 			created_at
@@ -221,3 +223,85 @@ func (db *notificationDB) UnreadAmount(ctx context.Context) (_ int, err error) {
 
 	return amount, nil
 }
+
+// ListUndelivered returns the notifications created since the given time
+// which weren't delivered over all their channels yet, oldest first.
+func (db *notificationDB) ListUndelivered(ctx context.Context, since time.Time, limit int) (_ []notifications.Notification, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `
+		SELECT
+			id, sender_id, type, title, message, read_at, created_at
+		FROM
+			notifications
+		WHERE
+			delivered_at IS NULL AND created_at >= ?
+		ORDER BY
+			created_at
+		LIMIT ?
+	`
+
+	rows, err := db.QueryContext(ctx, query, since.UTC(), limit)
+	if err != nil {
+		return nil, ErrNotificationsDB.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, ErrNotificationsDB.Wrap(rows.Close()))
+	}()
+
+	var undelivered []notifications.Notification
+	for rows.Next() {
+		var notification notifications.Notification
+		var notificationIDBytes []uint8
+
+		err = rows.Scan(
+			&notificationIDBytes,
+			&notification.SenderID,
+			&notification.Type,
+			&notification.Title,
+			&notification.Message,
+			&notification.ReadAt,
+			&notification.CreatedAt,
+		)
+		if err != nil {
+			return nil, ErrNotificationsDB.Wrap(err)
+		}
+
+		notification.ID, err = dbutil.BytesToUUID(notificationIDBytes)
+		if err != nil {
+			return nil, ErrNotificationsDB.Wrap(err)
+		}
+
+		undelivered = append(undelivered, notification)
+	}
+
+	return undelivered, ErrNotificationsDB.Wrap(rows.Err())
+}
+
+// MarkDelivered marks the notification as delivered over all its channels.
+func (db *notificationDB) MarkDelivered(ctx context.Context, notificationID uuid.UUID, deliveredAt time.Time) (err error) {
+	defer mon.Task()(&ctx, notificationID)(&err)
+
+	query := `
+		UPDATE
+			notifications
+		SET
+			delivered_at = ?
+		WHERE
+			id = ?;
+	`
+	result, err := db.ExecContext(ctx, query, deliveredAt.UTC(), notificationID[:])
+	if err != nil {
+		return ErrNotificationsDB.Wrap(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return ErrNotificationsDB.Wrap(err)
+	}
+	if rowsAffected != 1 {
+		return ErrNotificationsDB.Wrap(ErrNoRows)
+	}
+
+	return nil
+}
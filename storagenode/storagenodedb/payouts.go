@@ -0,0 +1,136 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/payouts"
+)
+
+// ensures that payoutsDB implements payouts.DB interface.
+var _ payouts.DB = (*payoutsDB)(nil)
+
+// PayoutsDBName represents the database name.
+const PayoutsDBName = "payouts"
+
+// ErrPayoutsDB represents errors from the payouts database.
+var ErrPayoutsDB = errs.Class("payoutsDB error")
+
+// payoutsDB stores payout statements received from satellites.
+//
+// architecture: Database
+type payoutsDB struct {
+	dbContainerImpl
+}
+
+// payoutStatementColumns are the columns of payout_statements in the order they are scanned.
+const payoutStatementColumns = `satellite_id, period, created_at, node_age_months,
+	usage_at_rest, usage_put, usage_get, usage_put_repair, usage_get_repair, usage_get_audit,
+	comp_at_rest, comp_put, comp_get, comp_put_repair, comp_get_repair, comp_get_audit,
+	held_percent, held, disposed, owed, graceful_exit`
+
+// Store inserts or updates a payout statement.
+func (db *payoutsDB) Store(ctx context.Context, statement payouts.Statement) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `INSERT OR REPLACE INTO payout_statements (` + payoutStatementColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	_, err = db.ExecContext(ctx, query,
+		statement.SatelliteID, statement.Period.UTC(), statement.CreatedAt.UTC(), statement.NodeAgeMonths,
+		statement.UsageAtRest, statement.UsagePut, statement.UsageGet,
+		statement.UsagePutRepair, statement.UsageGetRepair, statement.UsageGetAudit,
+		statement.CompAtRest, statement.CompPut, statement.CompGet,
+		statement.CompPutRepair, statement.CompGetRepair, statement.CompGetAudit,
+		statement.HeldPercent, statement.Held, statement.Disposed, statement.Owed, statement.GracefulExit,
+	)
+	return ErrPayoutsDB.Wrap(err)
+}
+
+// Get returns the payout statement of a satellite for the period.
+func (db *payoutsDB) Get(ctx context.Context, satelliteID storj.NodeID, period time.Time) (_ payouts.Statement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `SELECT ` + payoutStatementColumns + `
+		FROM payout_statements
+		WHERE satellite_id = ? AND period = ?`
+
+	statement, err := scanPayoutStatement(db.QueryRowContext(ctx, query, satelliteID, period.UTC()))
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return payouts.Statement{}, payouts.ErrNoStatement.New("%s %s", satelliteID, period.Format("2006-01"))
+		}
+		return payouts.Statement{}, ErrPayoutsDB.Wrap(err)
+	}
+	return statement, nil
+}
+
+// AllSatellite returns payout statements of a satellite ordered by period.
+func (db *payoutsDB) AllSatellite(ctx context.Context, satelliteID storj.NodeID) (_ []payouts.Statement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `SELECT ` + payoutStatementColumns + `
+		FROM payout_statements
+		WHERE satellite_id = ?
+		ORDER BY period`
+
+	return db.queryStatements(ctx, query, satelliteID)
+}
+
+// All returns payout statements of all satellites ordered by satellite and period.
+func (db *payoutsDB) All(ctx context.Context) (_ []payouts.Statement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `SELECT ` + payoutStatementColumns + `
+		FROM payout_statements
+		ORDER BY satellite_id, period`
+
+	return db.queryStatements(ctx, query)
+}
+
+// queryStatements runs query and scans all resulting statements.
+func (db *payoutsDB) queryStatements(ctx context.Context, query string, args ...interface{}) (_ []payouts.Statement, err error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, ErrPayoutsDB.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var statements []payouts.Statement
+	for rows.Next() {
+		statement, err := scanPayoutStatement(rows)
+		if err != nil {
+			return nil, ErrPayoutsDB.Wrap(err)
+		}
+		statements = append(statements, statement)
+	}
+
+	return statements, ErrPayoutsDB.Wrap(rows.Err())
+}
+
+// rowScanner is implemented by both a single row and rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanPayoutStatement scans a statement selected with payoutStatementColumns.
+func scanPayoutStatement(row rowScanner) (statement payouts.Statement, err error) {
+	err = row.Scan(
+		&statement.SatelliteID, &statement.Period, &statement.CreatedAt, &statement.NodeAgeMonths,
+		&statement.UsageAtRest, &statement.UsagePut, &statement.UsageGet,
+		&statement.UsagePutRepair, &statement.UsageGetRepair, &statement.UsageGetAudit,
+		&statement.CompAtRest, &statement.CompPut, &statement.CompGet,
+		&statement.CompPutRepair, &statement.CompGetRepair, &statement.CompGetAudit,
+		&statement.HeldPercent, &statement.Held, &statement.Disposed, &statement.Owed, &statement.GracefulExit,
+	)
+	statement.Period = statement.Period.UTC()
+	statement.CreatedAt = statement.CreatedAt.UTC()
+	return statement, err
+}
@@ -56,8 +56,9 @@ func (db *pieceExpirationDB) GetExpired(ctx context.Context, expiresBefore time.
 	return expiredPieceIDs, rows.Err()
 }
 
-// SetExpiration sets an expiration time for the given piece ID on the given satellite
-func (db *pieceExpirationDB) SetExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, expiresAt time.Time) (err error) {
+// SetExpiration sets an expiration time for the given piece ID on the given satellite. The piece
+// size isn't stored.
+func (db *pieceExpirationDB) SetExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, expiresAt time.Time, pieceSize int64) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	_, err = db.ExecContext(ctx, `
@@ -0,0 +1,229 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/tagsql"
+	"storj.io/storj/storage"
+	"storj.io/storj/storagenode/pieces"
+)
+
+// ErrPieceIndex represents errors from the piece index database.
+var ErrPieceIndex = errs.Class("piece index error")
+
+// PieceIndexDBName represents the database name.
+const PieceIndexDBName = "piece_index"
+
+// pieceIndexWalkBatchSize is the number of entries read at once when walking the index.
+const pieceIndexWalkBatchSize = 1000
+
+type pieceIndexDB struct {
+	dbContainerImpl
+}
+
+// Add adds the piece to the index, replacing any existing entry.
+func (db *pieceIndexDB) Add(ctx context.Context, piece pieces.IndexedPiece) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return ErrPieceIndex.Wrap(upsertIndexedPiece(ctx, db.GetDB(), piece, time.Now()))
+}
+
+// upsertIndexedPiece adds or replaces an index entry. The update time is stored in
+// nanoseconds, since it's compared with the start of a reconciliation.
+func upsertIndexedPiece(ctx context.Context, db interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}, piece pieces.IndexedPiece, updatedAt time.Time) error {
+	var creationTime *time.Time
+	if !piece.CreationTime.IsZero() {
+		t := piece.CreationTime.UTC()
+		creationTime = &t
+	}
+
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO piece_index (satellite_id, piece_id, format_version, piece_size, content_size, piece_creation, mod_time, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (satellite_id, piece_id) DO UPDATE SET
+			format_version = excluded.format_version,
+			piece_size = excluded.piece_size,
+			content_size = excluded.content_size,
+			piece_creation = COALESCE(excluded.piece_creation, piece_index.piece_creation),
+			mod_time = excluded.mod_time,
+			updated_at = excluded.updated_at
+	`, piece.SatelliteID, piece.PieceID, int(piece.FormatVersion), piece.Total, piece.ContentSize, creationTime, piece.ModTime.UTC(), updatedAt.UnixNano())
+	return err
+}
+
+// Delete removes the piece from the index.
+func (db *pieceIndexDB) Delete(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx, `
+		DELETE FROM piece_index
+			WHERE satellite_id = ? AND piece_id = ?
+	`, satelliteID, pieceID)
+	return ErrPieceIndex.Wrap(err)
+}
+
+// WalkSatellitePieces executes walkFunc for each indexed piece of the satellite, ordered by
+// piece ID. The entries are read in batches, so walkFunc may modify the index.
+func (db *pieceIndexDB) WalkSatellitePieces(ctx context.Context, satelliteID storj.NodeID, walkFunc func(pieces.IndexedPiece) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var after storj.PieceID
+	for {
+		batch, err := db.listPieces(ctx, satelliteID, after, pieceIndexWalkBatchSize)
+		if err != nil {
+			return ErrPieceIndex.Wrap(err)
+		}
+		for _, piece := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := walkFunc(piece); err != nil {
+				return err
+			}
+		}
+		if len(batch) < pieceIndexWalkBatchSize {
+			return nil
+		}
+		after = batch[len(batch)-1].PieceID
+	}
+}
+
+// listPieces returns up to limit indexed pieces of the satellite, with piece IDs after the given one.
+func (db *pieceIndexDB) listPieces(ctx context.Context, satelliteID storj.NodeID, after storj.PieceID, limit int) (_ []pieces.IndexedPiece, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT piece_id, format_version, piece_size, content_size, piece_creation, mod_time
+			FROM piece_index
+			WHERE satellite_id = ? AND piece_id > ?
+			ORDER BY piece_id
+			LIMIT ?
+	`, satelliteID, after, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var batch []pieces.IndexedPiece
+	for rows.Next() {
+		piece := pieces.IndexedPiece{SatelliteID: satelliteID}
+		var formatVersion int
+		var creationTime *time.Time
+		err := rows.Scan(&piece.PieceID, &formatVersion, &piece.Total, &piece.ContentSize, &creationTime, &piece.ModTime)
+		if err != nil {
+			return nil, err
+		}
+		piece.FormatVersion = storage.FormatVersion(formatVersion)
+		if creationTime != nil {
+			piece.CreationTime = *creationTime
+		}
+		batch = append(batch, piece)
+	}
+	return batch, rows.Err()
+}
+
+// SpaceUsedBySatellite returns the space used by the indexed pieces of each satellite.
+func (db *pieceIndexDB) SpaceUsedBySatellite(ctx context.Context) (_ map[storj.NodeID]pieces.SatelliteUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT satellite_id, SUM(piece_size), SUM(content_size)
+			FROM piece_index
+			GROUP BY satellite_id
+	`)
+	if err != nil {
+		return nil, ErrPieceIndex.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	usage := map[storj.NodeID]pieces.SatelliteUsage{}
+	for rows.Next() {
+		var satelliteID storj.NodeID
+		var satelliteUsage pieces.SatelliteUsage
+		if err := rows.Scan(&satelliteID, &satelliteUsage.Total, &satelliteUsage.ContentSize); err != nil {
+			return nil, ErrPieceIndex.Wrap(err)
+		}
+		usage[satelliteID] = satelliteUsage
+	}
+	return usage, ErrPieceIndex.Wrap(rows.Err())
+}
+
+// Reconcile adds the pieces found while reconciling, replacing any existing entries, and
+// marks them as seen by the reconciliation started at reconcileStart.
+func (db *pieceIndexDB) Reconcile(ctx context.Context, batch []pieces.IndexedPiece, reconcileStart time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// entries added while reconciling have a later update time, so the
+	// reconciliation doesn't remove them
+	return ErrPieceIndex.Wrap(withTx(ctx, db.GetDB(), func(tx tagsql.Tx) error {
+		for _, piece := range batch {
+			if err := upsertIndexedPiece(ctx, tx, piece, reconcileStart); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// FinishReconcile removes the pieces which weren't seen or added since reconcileStart, and
+// records reconcileStart as the time of the last completed reconciliation.
+func (db *pieceIndexDB) FinishReconcile(ctx context.Context, reconcileStart time.Time) (removed int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withTx(ctx, db.GetDB(), func(tx tagsql.Tx) error {
+		result, err := tx.ExecContext(ctx, `
+			DELETE FROM piece_index
+				WHERE updated_at < ?
+		`, reconcileStart.UnixNano())
+		if err != nil {
+			return err
+		}
+		removed, err = result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `DELETE FROM piece_index_status`)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO piece_index_status (reconciled_at) VALUES (?)
+		`, reconcileStart.UTC())
+		return err
+	})
+	return removed, ErrPieceIndex.Wrap(err)
+}
+
+// ReconciledAt returns the start time of the last completed reconciliation, or the zero time
+// when the index was never reconciled.
+func (db *pieceIndexDB) ReconciledAt(ctx context.Context) (reconciledAt time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.QueryRowContext(ctx, `
+		SELECT reconciled_at FROM piece_index_status
+	`).Scan(&reconciledAt)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+	return reconciledAt, ErrPieceIndex.Wrap(err)
+}
+
+// Invalidate marks the index as never reconciled, so it's not used until the next
+// completed reconciliation.
+func (db *pieceIndexDB) Invalidate(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx, `DELETE FROM piece_index_status`)
+	return ErrPieceIndex.Wrap(err)
+}
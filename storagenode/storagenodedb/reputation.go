@@ -40,15 +40,23 @@ func (db *reputationDB) Store(ctx context.Context, stats reputation.Stats) (err
 			audit_reputation_alpha,
 			audit_reputation_beta,
 			audit_reputation_score,
+			unknown_audit_reputation_alpha,
+			unknown_audit_reputation_beta,
+			unknown_audit_reputation_score,
 			disqualified,
+			suspended,
 			updated_at
-		) VALUES(?,?,?,?,?,?,?,?,?,?,?,?,?)`
+		) VALUES(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)`
 
 	// ensure we insert utc
 	if stats.Disqualified != nil {
 		utc := stats.Disqualified.UTC()
 		stats.Disqualified = &utc
 	}
+	if stats.Suspended != nil {
+		utc := stats.Suspended.UTC()
+		stats.Suspended = &utc
+	}
 
 	_, err = db.ExecContext(ctx, query,
 		stats.SatelliteID,
@@ -62,7 +70,11 @@ func (db *reputationDB) Store(ctx context.Context, stats reputation.Stats) (err
 		stats.Audit.Alpha,
 		stats.Audit.Beta,
 		stats.Audit.Score,
+		stats.UnknownAudit.Alpha,
+		stats.UnknownAudit.Beta,
+		stats.UnknownAudit.Score,
 		stats.Disqualified,
+		stats.Suspended,
 		stats.UpdatedAt.UTC(),
 	)
 
@@ -88,7 +100,11 @@ func (db *reputationDB) Get(ctx context.Context, satelliteID storj.NodeID) (_ *r
 			audit_reputation_alpha,
 			audit_reputation_beta,
 			audit_reputation_score,
+			unknown_audit_reputation_alpha,
+			unknown_audit_reputation_beta,
+			unknown_audit_reputation_score,
 			disqualified,
+			suspended,
 			updated_at
 		FROM reputation WHERE satellite_id = ?`,
 		satelliteID,
@@ -105,7 +121,11 @@ func (db *reputationDB) Get(ctx context.Context, satelliteID storj.NodeID) (_ *r
 		&stats.Audit.Alpha,
 		&stats.Audit.Beta,
 		&stats.Audit.Score,
+		&stats.UnknownAudit.Alpha,
+		&stats.UnknownAudit.Beta,
+		&stats.UnknownAudit.Score,
 		&stats.Disqualified,
+		&stats.Suspended,
 		&stats.UpdatedAt,
 	)
 
@@ -131,7 +151,11 @@ func (db *reputationDB) All(ctx context.Context) (_ []reputation.Stats, err erro
 			audit_reputation_alpha,
 			audit_reputation_beta,
 			audit_reputation_score,
+			unknown_audit_reputation_alpha,
+			unknown_audit_reputation_beta,
+			unknown_audit_reputation_score,
 			disqualified,
+			suspended,
 			updated_at
 		FROM reputation`
 
@@ -157,7 +181,11 @@ func (db *reputationDB) All(ctx context.Context) (_ []reputation.Stats, err erro
 			&stats.Audit.Alpha,
 			&stats.Audit.Beta,
 			&stats.Audit.Score,
+			&stats.UnknownAudit.Alpha,
+			&stats.UnknownAudit.Beta,
+			&stats.UnknownAudit.Score,
 			&stats.Disqualified,
+			&stats.Suspended,
 			&stats.UpdatedAt,
 		)
 
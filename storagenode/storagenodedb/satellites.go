@@ -114,3 +114,109 @@ func (db *satellitesDB) ListGracefulExits(ctx context.Context) (exitList []satel
 
 	return exitList, rows.Err()
 }
+
+// InitiatePartialExit updates the database to reflect the beginning of a partial exit
+func (db *satellitesDB) InitiatePartialExit(ctx context.Context, satelliteID storj.NodeID, initiatedAt time.Time, requestedBytes int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	query := `INSERT OR REPLACE INTO satellite_partial_exits (satellite_id, requested_bytes, initiated_at, finished_at, bytes_deleted) VALUES (?,?,?,NULL,0)`
+	_, err = db.ExecContext(ctx, query, satelliteID, requestedBytes, initiatedAt.UTC())
+	return ErrSatellitesDB.Wrap(err)
+}
+
+// UpdatePartialExit increments the total bytes deleted during a partial exit
+func (db *satellitesDB) UpdatePartialExit(ctx context.Context, satelliteID storj.NodeID, addToBytesDeleted int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	query := `UPDATE satellite_partial_exits SET bytes_deleted = bytes_deleted + ? WHERE satellite_id = ?`
+	_, err = db.ExecContext(ctx, query, addToBytesDeleted, satelliteID)
+	return ErrSatellitesDB.Wrap(err)
+}
+
+// CompletePartialExit updates the database when a partial exit is finished or canceled
+func (db *satellitesDB) CompletePartialExit(ctx context.Context, satelliteID storj.NodeID, finishedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	query := `UPDATE satellite_partial_exits SET finished_at = ? WHERE satellite_id = ? AND finished_at IS NULL`
+	_, err = db.ExecContext(ctx, query, finishedAt.UTC(), satelliteID)
+	return ErrSatellitesDB.Wrap(err)
+}
+
+// ListPartialExits lists all partial exit records
+func (db *satellitesDB) ListPartialExits(ctx context.Context) (exitList []satellites.PartialExit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `SELECT satellite_id, requested_bytes, initiated_at, finished_at, bytes_deleted FROM satellite_partial_exits`
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, ErrSatellitesDB.Wrap(err)
+	}
+	defer func() {
+		err = ErrSatellitesDB.Wrap(errs.Combine(err, rows.Close()))
+	}()
+
+	for rows.Next() {
+		var exit satellites.PartialExit
+		err := rows.Scan(&exit.SatelliteID, &exit.RequestedBytes, &exit.InitiatedAt, &exit.FinishedAt, &exit.BytesDeleted)
+		if err != nil {
+			return nil, err
+		}
+		exitList = append(exitList, exit)
+	}
+
+	return exitList, rows.Err()
+}
+
+// MarkUntrusted records that the satellite is no longer trusted, unless it is already recorded
+func (db *satellitesDB) MarkUntrusted(ctx context.Context, satelliteID storj.NodeID, untrustedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	query := `INSERT OR IGNORE INTO untrusted_satellites (satellite_id, untrusted_at, trashed_at, deleted_at, bytes_reclaimed) VALUES (?,?,NULL,NULL,0)`
+	_, err = db.ExecContext(ctx, query, satelliteID, untrustedAt.UTC())
+	return ErrSatellitesDB.Wrap(err)
+}
+
+// MarkUntrustedTrashed records that the data of an untrusted satellite was moved to the trash
+func (db *satellitesDB) MarkUntrustedTrashed(ctx context.Context, satelliteID storj.NodeID, trashedAt time.Time, bytesTrashed int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	query := `UPDATE untrusted_satellites SET trashed_at = ?, bytes_reclaimed = bytes_reclaimed + ? WHERE satellite_id = ?`
+	_, err = db.ExecContext(ctx, query, trashedAt.UTC(), bytesTrashed, satelliteID)
+	return ErrSatellitesDB.Wrap(err)
+}
+
+// MarkUntrustedDeleted records that the data of an untrusted satellite was deleted, bytesDeleted
+// replaces the bytes counted when the data was moved to the trash
+func (db *satellitesDB) MarkUntrustedDeleted(ctx context.Context, satelliteID storj.NodeID, deletedAt time.Time, bytesDeleted int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	query := `UPDATE untrusted_satellites SET deleted_at = ?, bytes_reclaimed = ? WHERE satellite_id = ?`
+	_, err = db.ExecContext(ctx, query, deletedAt.UTC(), bytesDeleted, satelliteID)
+	return ErrSatellitesDB.Wrap(err)
+}
+
+// RemoveUntrusted removes the untrusted record of a satellite which is trusted again
+func (db *satellitesDB) RemoveUntrusted(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	_, err = db.ExecContext(ctx, `DELETE FROM untrusted_satellites WHERE satellite_id = ?`, satelliteID)
+	return ErrSatellitesDB.Wrap(err)
+}
+
+// ListUntrusted lists all untrusted satellite records
+func (db *satellitesDB) ListUntrusted(ctx context.Context) (untrustedList []satellites.Untrusted, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `SELECT satellite_id, untrusted_at, trashed_at, deleted_at, bytes_reclaimed FROM untrusted_satellites`
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, ErrSatellitesDB.Wrap(err)
+	}
+	defer func() {
+		err = ErrSatellitesDB.Wrap(errs.Combine(err, rows.Close()))
+	}()
+
+	for rows.Next() {
+		var untrusted satellites.Untrusted
+		err := rows.Scan(&untrusted.SatelliteID, &untrusted.UntrustedAt, &untrusted.TrashedAt, &untrusted.DeletedAt, &untrusted.BytesReclaimed)
+		if err != nil {
+			return nil, err
+		}
+		untrustedList = append(untrustedList, untrusted)
+	}
+
+	return untrustedList, rows.Err()
+}
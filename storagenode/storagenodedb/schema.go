@@ -67,6 +67,31 @@ func Schema() map[string]*dbschema.Schema {
 				&dbschema.Index{Name: "idx_bandwidth_usage_satellite", Table: "bandwidth_usage", Columns: []string{"satellite_id"}, Unique: false, Partial: ""},
 			},
 		},
+		"corrupt_pieces": &dbschema.Schema{
+			Tables: []*dbschema.Table{
+				&dbschema.Table{
+					Name:       "corrupt_pieces",
+					PrimaryKey: []string{"piece_id", "satellite_id"},
+					Columns: []*dbschema.Column{
+						&dbschema.Column{
+							Name:       "found_at",
+							Type:       "TIMESTAMP",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "piece_id",
+							Type:       "BLOB",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "satellite_id",
+							Type:       "BLOB",
+							IsNullable: false,
+						},
+					},
+				},
+			},
+		},
 		"info": &dbschema.Schema{},
 		"notifications": &dbschema.Schema{
 			Tables: []*dbschema.Table{
@@ -79,6 +104,11 @@ func Schema() map[string]*dbschema.Schema {
 							Type:       "TIMESTAMP",
 							IsNullable: false,
 						},
+						&dbschema.Column{
+							Name:       "delivered_at",
+							Type:       "TIMESTAMP",
+							IsNullable: true,
+						},
 						&dbschema.Column{
 							Name:       "id",
 							Type:       "BLOB",
@@ -198,6 +228,121 @@ func Schema() map[string]*dbschema.Schema {
 				&dbschema.Index{Name: "idx_orders", Table: "unsent_order", Columns: []string{"satellite_id", "serial_number"}, Unique: false, Partial: ""},
 			},
 		},
+		"payouts": &dbschema.Schema{
+			Tables: []*dbschema.Table{
+				&dbschema.Table{
+					Name:       "payout_statements",
+					PrimaryKey: []string{"period", "satellite_id"},
+					Columns: []*dbschema.Column{
+						&dbschema.Column{
+							Name:       "comp_at_rest",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "comp_get",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "comp_get_audit",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "comp_get_repair",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "comp_put",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "comp_put_repair",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "created_at",
+							Type:       "TIMESTAMP",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "disposed",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "graceful_exit",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "held",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "held_percent",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "node_age_months",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "owed",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "period",
+							Type:       "TIMESTAMP",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "satellite_id",
+							Type:       "BLOB",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "usage_at_rest",
+							Type:       "REAL",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "usage_get",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "usage_get_audit",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "usage_get_repair",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "usage_put",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "usage_put_repair",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+					},
+				},
+			},
+		},
 		"piece_expiration": &dbschema.Schema{
 			Tables: []*dbschema.Table{
 				&dbschema.Table{
@@ -238,6 +383,66 @@ func Schema() map[string]*dbschema.Schema {
 				&dbschema.Index{Name: "idx_piece_expirations_trashed", Table: "piece_expirations", Columns: []string{"satellite_id", "trash"}, Unique: false, Partial: "trash = 1"},
 			},
 		},
+		"piece_index": &dbschema.Schema{
+			Tables: []*dbschema.Table{
+				&dbschema.Table{
+					Name:       "piece_index",
+					PrimaryKey: []string{"piece_id", "satellite_id"},
+					Columns: []*dbschema.Column{
+						&dbschema.Column{
+							Name:       "content_size",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "format_version",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "mod_time",
+							Type:       "TIMESTAMP",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "piece_creation",
+							Type:       "TIMESTAMP",
+							IsNullable: true,
+						},
+						&dbschema.Column{
+							Name:       "piece_id",
+							Type:       "BLOB",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "piece_size",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "satellite_id",
+							Type:       "BLOB",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "updated_at",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+					},
+				},
+				&dbschema.Table{
+					Name: "piece_index_status",
+					Columns: []*dbschema.Column{
+						&dbschema.Column{
+							Name:       "reconciled_at",
+							Type:       "TIMESTAMP",
+							IsNullable: false,
+						},
+					},
+				},
+			},
+		},
 		"piece_spaced_used": &dbschema.Schema{
 			Tables: []*dbschema.Table{
 				&dbschema.Table{
@@ -365,6 +570,26 @@ func Schema() map[string]*dbschema.Schema {
 							Type:       "BLOB",
 							IsNullable: false,
 						},
+						&dbschema.Column{
+							Name:       "suspended",
+							Type:       "TIMESTAMP",
+							IsNullable: true,
+						},
+						&dbschema.Column{
+							Name:       "unknown_audit_reputation_alpha",
+							Type:       "REAL",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "unknown_audit_reputation_beta",
+							Type:       "REAL",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "unknown_audit_reputation_score",
+							Type:       "REAL",
+							IsNullable: false,
+						},
 						&dbschema.Column{
 							Name:       "updated_at",
 							Type:       "TIMESTAMP",
@@ -437,6 +662,37 @@ func Schema() map[string]*dbschema.Schema {
 						},
 					},
 				},
+				&dbschema.Table{
+					Name:       "satellite_partial_exits",
+					PrimaryKey: []string{"satellite_id"},
+					Columns: []*dbschema.Column{
+						&dbschema.Column{
+							Name:       "bytes_deleted",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "finished_at",
+							Type:       "TIMESTAMP",
+							IsNullable: true,
+						},
+						&dbschema.Column{
+							Name:       "initiated_at",
+							Type:       "TIMESTAMP",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "requested_bytes",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "satellite_id",
+							Type:       "BLOB",
+							IsNullable: false,
+						},
+					},
+				},
 				&dbschema.Table{
 					Name:       "satellites",
 					PrimaryKey: []string{"node_id"},
@@ -458,6 +714,37 @@ func Schema() map[string]*dbschema.Schema {
 						},
 					},
 				},
+				&dbschema.Table{
+					Name:       "untrusted_satellites",
+					PrimaryKey: []string{"satellite_id"},
+					Columns: []*dbschema.Column{
+						&dbschema.Column{
+							Name:       "bytes_reclaimed",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "deleted_at",
+							Type:       "TIMESTAMP",
+							IsNullable: true,
+						},
+						&dbschema.Column{
+							Name:       "satellite_id",
+							Type:       "BLOB",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "trashed_at",
+							Type:       "TIMESTAMP",
+							IsNullable: true,
+						},
+						&dbschema.Column{
+							Name:       "untrusted_at",
+							Type:       "TIMESTAMP",
+							IsNullable: false,
+						},
+					},
+				},
 			},
 		},
 		"storage_usage": &dbschema.Schema{
@@ -515,3 +802,4 @@ func Schema() map[string]*dbschema.Schema {
 		},
 	}
 }
+
@@ -46,6 +46,13 @@ var States = MultiDBStates{
 		&v29,
 		&v30,
 		&v31,
+		&v32,
+		&v33,
+		&v34,
+		&v35,
+		&v36,
+		&v37,
+		&v38,
 	},
 }
 
@@ -0,0 +1,57 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testdata
+
+import (
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+var v32 = MultiDBState{
+	Version: 32,
+	DBStates: DBStates{
+		storagenodedb.UsedSerialsDBName:     v31.DBStates[storagenodedb.UsedSerialsDBName],
+		storagenodedb.StorageUsageDBName:    v31.DBStates[storagenodedb.StorageUsageDBName],
+		storagenodedb.ReputationDBName:      v31.DBStates[storagenodedb.ReputationDBName],
+		storagenodedb.PieceSpaceUsedDBName:  v31.DBStates[storagenodedb.PieceSpaceUsedDBName],
+		storagenodedb.PieceInfoDBName:       v31.DBStates[storagenodedb.PieceInfoDBName],
+		storagenodedb.PieceExpirationDBName: v31.DBStates[storagenodedb.PieceExpirationDBName],
+		storagenodedb.OrdersDBName:          v31.DBStates[storagenodedb.OrdersDBName],
+		storagenodedb.BandwidthDBName:       v31.DBStates[storagenodedb.BandwidthDBName],
+		storagenodedb.SatellitesDBName:      v31.DBStates[storagenodedb.SatellitesDBName],
+		storagenodedb.DeprecatedInfoDBName:  v31.DBStates[storagenodedb.DeprecatedInfoDBName],
+		storagenodedb.NotificationsDBName:   v31.DBStates[storagenodedb.NotificationsDBName],
+		storagenodedb.PayoutsDBName: &DBState{
+			SQL: `
+				-- table to hold payout statements received from satellites
+				CREATE TABLE payout_statements (
+					satellite_id BLOB NOT NULL,
+					period TIMESTAMP NOT NULL,
+					created_at TIMESTAMP NOT NULL,
+					node_age_months INTEGER NOT NULL,
+					usage_at_rest REAL NOT NULL,
+					usage_put INTEGER NOT NULL,
+					usage_get INTEGER NOT NULL,
+					usage_put_repair INTEGER NOT NULL,
+					usage_get_repair INTEGER NOT NULL,
+					usage_get_audit INTEGER NOT NULL,
+					comp_at_rest INTEGER NOT NULL,
+					comp_put INTEGER NOT NULL,
+					comp_get INTEGER NOT NULL,
+					comp_put_repair INTEGER NOT NULL,
+					comp_get_repair INTEGER NOT NULL,
+					comp_get_audit INTEGER NOT NULL,
+					held_percent INTEGER NOT NULL,
+					held INTEGER NOT NULL,
+					disposed INTEGER NOT NULL,
+					owed INTEGER NOT NULL,
+					graceful_exit INTEGER NOT NULL,
+					PRIMARY KEY (satellite_id, period)
+				);
+			`,
+			NewData: `
+				INSERT INTO payout_statements VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000','2020-01-01 00:00:00+00:00','2020-02-04 00:00:00+00:00',2,720000000000000.0,0,1000000000000,0,10000000000,1000000000,1500000,0,20000000,0,100000,10000,75,16207500,0,5402500,0);
+			`,
+		},
+	},
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testdata
+
+import (
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+var v34 = MultiDBState{
+	Version: 34,
+	DBStates: DBStates{
+		storagenodedb.UsedSerialsDBName:     v33.DBStates[storagenodedb.UsedSerialsDBName],
+		storagenodedb.StorageUsageDBName:    v33.DBStates[storagenodedb.StorageUsageDBName],
+		storagenodedb.ReputationDBName:      v33.DBStates[storagenodedb.ReputationDBName],
+		storagenodedb.PieceSpaceUsedDBName:  v33.DBStates[storagenodedb.PieceSpaceUsedDBName],
+		storagenodedb.PieceInfoDBName:       v33.DBStates[storagenodedb.PieceInfoDBName],
+		storagenodedb.PieceExpirationDBName: v33.DBStates[storagenodedb.PieceExpirationDBName],
+		storagenodedb.OrdersDBName:          v33.DBStates[storagenodedb.OrdersDBName],
+		storagenodedb.BandwidthDBName:       v33.DBStates[storagenodedb.BandwidthDBName],
+		storagenodedb.SatellitesDBName: &DBState{
+			SQL: `
+				CREATE TABLE satellites (
+					node_id BLOB NOT NULL,
+					added_at TIMESTAMP NOT NULL,
+					status INTEGER NOT NULL,
+					PRIMARY KEY (node_id)
+				);
+
+				CREATE TABLE satellite_exit_progress (
+					satellite_id BLOB NOT NULL,
+					initiated_at TIMESTAMP,
+					finished_at TIMESTAMP,
+					starting_disk_usage INTEGER NOT NULL,
+					bytes_deleted INTEGER NOT NULL,
+					completion_receipt BLOB,
+					PRIMARY KEY (satellite_id)
+				);
+
+				-- table to hold the partial exits of the node from satellites
+				CREATE TABLE satellite_partial_exits (
+					satellite_id BLOB NOT NULL,
+					requested_bytes INTEGER NOT NULL,
+					initiated_at TIMESTAMP NOT NULL,
+					finished_at TIMESTAMP,
+					bytes_deleted INTEGER NOT NULL,
+					PRIMARY KEY (satellite_id)
+				);
+
+				INSERT INTO satellites VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000','2019-09-10 20:00:00+00:00', 0);
+				INSERT INTO satellite_exit_progress VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000','2019-09-10 20:00:00+00:00', null, 100, 0, null);
+			`,
+			NewData: `
+				INSERT INTO satellite_partial_exits VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000',1000000,'2020-01-01 00:00:00+00:00',null,0);
+			`,
+		},
+		storagenodedb.DeprecatedInfoDBName: v33.DBStates[storagenodedb.DeprecatedInfoDBName],
+		storagenodedb.NotificationsDBName:  v33.DBStates[storagenodedb.NotificationsDBName],
+		storagenodedb.PayoutsDBName:        v33.DBStates[storagenodedb.PayoutsDBName],
+		storagenodedb.PieceIndexDBName: &DBState{
+			SQL: `
+				-- table to index the locally stored pieces
+				CREATE TABLE piece_index (
+					satellite_id BLOB NOT NULL,
+					piece_id BLOB NOT NULL,
+					format_version INTEGER NOT NULL,
+					piece_size INTEGER NOT NULL,
+					content_size INTEGER NOT NULL,
+					piece_creation TIMESTAMP,
+					mod_time TIMESTAMP NOT NULL,
+					updated_at INTEGER NOT NULL,
+					PRIMARY KEY (satellite_id, piece_id)
+				);
+				-- table to hold the time of the last completed index reconciliation
+				CREATE TABLE piece_index_status (
+					reconciled_at TIMESTAMP NOT NULL
+				);
+				INSERT INTO piece_index VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000',X'd5e757fd8d207d1c46583fb58330f803dc961b71147308ff75ff1e72a0df6b0b',1,1512,1000,'2020-01-01 00:00:00+00:00','2020-01-01 00:00:01+00:00',1577836801000000000);
+				INSERT INTO piece_index_status VALUES('2020-01-01 00:00:00+00:00');
+			`,
+		},
+	},
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testdata
+
+import (
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+var v35 = MultiDBState{
+	Version: 35,
+	DBStates: DBStates{
+		storagenodedb.UsedSerialsDBName:     v34.DBStates[storagenodedb.UsedSerialsDBName],
+		storagenodedb.StorageUsageDBName:    v34.DBStates[storagenodedb.StorageUsageDBName],
+		storagenodedb.ReputationDBName:      v34.DBStates[storagenodedb.ReputationDBName],
+		storagenodedb.PieceSpaceUsedDBName:  v34.DBStates[storagenodedb.PieceSpaceUsedDBName],
+		storagenodedb.PieceInfoDBName:       v34.DBStates[storagenodedb.PieceInfoDBName],
+		storagenodedb.PieceExpirationDBName: v34.DBStates[storagenodedb.PieceExpirationDBName],
+		storagenodedb.OrdersDBName:          v34.DBStates[storagenodedb.OrdersDBName],
+		storagenodedb.BandwidthDBName:       v34.DBStates[storagenodedb.BandwidthDBName],
+		storagenodedb.SatellitesDBName: &DBState{
+			SQL: `
+				CREATE TABLE satellites (
+					node_id BLOB NOT NULL,
+					added_at TIMESTAMP NOT NULL,
+					status INTEGER NOT NULL,
+					PRIMARY KEY (node_id)
+				);
+
+				CREATE TABLE satellite_exit_progress (
+					satellite_id BLOB NOT NULL,
+					initiated_at TIMESTAMP,
+					finished_at TIMESTAMP,
+					starting_disk_usage INTEGER NOT NULL,
+					bytes_deleted INTEGER NOT NULL,
+					completion_receipt BLOB,
+					PRIMARY KEY (satellite_id)
+				);
+
+				-- table to hold the partial exits of the node from satellites
+				CREATE TABLE satellite_partial_exits (
+					satellite_id BLOB NOT NULL,
+					requested_bytes INTEGER NOT NULL,
+					initiated_at TIMESTAMP NOT NULL,
+					finished_at TIMESTAMP,
+					bytes_deleted INTEGER NOT NULL,
+					PRIMARY KEY (satellite_id)
+				);
+
+				-- table to hold satellites that store data but are no longer trusted
+				CREATE TABLE untrusted_satellites (
+					satellite_id BLOB NOT NULL,
+					untrusted_at TIMESTAMP NOT NULL,
+					trashed_at TIMESTAMP,
+					deleted_at TIMESTAMP,
+					bytes_reclaimed INTEGER NOT NULL,
+					PRIMARY KEY (satellite_id)
+				);
+
+				INSERT INTO satellites VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000','2019-09-10 20:00:00+00:00', 0);
+				INSERT INTO satellite_exit_progress VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000','2019-09-10 20:00:00+00:00', null, 100, 0, null);
+				INSERT INTO satellite_partial_exits VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000',1000000,'2020-01-01 00:00:00+00:00',null,0);
+			`,
+			NewData: `
+				INSERT INTO untrusted_satellites VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000','2020-01-01 00:00:00+00:00','2020-01-31 00:00:00+00:00',null,1000);
+			`,
+		},
+		storagenodedb.DeprecatedInfoDBName: v34.DBStates[storagenodedb.DeprecatedInfoDBName],
+		storagenodedb.NotificationsDBName:  v34.DBStates[storagenodedb.NotificationsDBName],
+		storagenodedb.PayoutsDBName:        v34.DBStates[storagenodedb.PayoutsDBName],
+		storagenodedb.PieceIndexDBName:     v34.DBStates[storagenodedb.PieceIndexDBName],
+	},
+}
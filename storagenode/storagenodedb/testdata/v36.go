@@ -0,0 +1,85 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testdata
+
+import (
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+var v36 = MultiDBState{
+	Version: 36,
+	DBStates: DBStates{
+		storagenodedb.UsedSerialsDBName:     v35.DBStates[storagenodedb.UsedSerialsDBName],
+		storagenodedb.StorageUsageDBName:    v35.DBStates[storagenodedb.StorageUsageDBName],
+		storagenodedb.ReputationDBName:      v35.DBStates[storagenodedb.ReputationDBName],
+		storagenodedb.PieceSpaceUsedDBName:  v35.DBStates[storagenodedb.PieceSpaceUsedDBName],
+		storagenodedb.PieceInfoDBName:       v35.DBStates[storagenodedb.PieceInfoDBName],
+		storagenodedb.PieceExpirationDBName: v35.DBStates[storagenodedb.PieceExpirationDBName],
+		storagenodedb.OrdersDBName:          v35.DBStates[storagenodedb.OrdersDBName],
+		storagenodedb.BandwidthDBName:       v35.DBStates[storagenodedb.BandwidthDBName],
+		storagenodedb.SatellitesDBName: &DBState{
+			SQL: `
+				CREATE TABLE satellites (
+					node_id BLOB NOT NULL,
+					added_at TIMESTAMP NOT NULL,
+					status INTEGER NOT NULL,
+					PRIMARY KEY (node_id)
+				);
+
+				CREATE TABLE satellite_exit_progress (
+					satellite_id BLOB NOT NULL,
+					initiated_at TIMESTAMP,
+					finished_at TIMESTAMP,
+					starting_disk_usage INTEGER NOT NULL,
+					bytes_deleted INTEGER NOT NULL,
+					completion_receipt BLOB,
+					PRIMARY KEY (satellite_id)
+				);
+
+				-- table to hold the partial exits of the node from satellites
+				CREATE TABLE satellite_partial_exits (
+					satellite_id BLOB NOT NULL,
+					requested_bytes INTEGER NOT NULL,
+					initiated_at TIMESTAMP NOT NULL,
+					finished_at TIMESTAMP,
+					bytes_deleted INTEGER NOT NULL,
+					PRIMARY KEY (satellite_id)
+				);
+
+				-- table to hold satellites that store data but are no longer trusted
+				CREATE TABLE untrusted_satellites (
+					satellite_id BLOB NOT NULL,
+					untrusted_at TIMESTAMP NOT NULL,
+					trashed_at TIMESTAMP,
+					deleted_at TIMESTAMP,
+					bytes_reclaimed INTEGER NOT NULL,
+					PRIMARY KEY (satellite_id)
+				);
+
+				INSERT INTO satellites VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000','2019-09-10 20:00:00+00:00', 0);
+				INSERT INTO satellite_exit_progress VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000','2019-09-10 20:00:00+00:00', null, 100, 0, null);
+				INSERT INTO satellite_partial_exits VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000',1000000,'2020-01-01 00:00:00+00:00',null,0);
+				INSERT INTO untrusted_satellites VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000','2020-01-01 00:00:00+00:00','2020-01-31 00:00:00+00:00',null,1000);
+			`,
+		},
+		storagenodedb.DeprecatedInfoDBName: v35.DBStates[storagenodedb.DeprecatedInfoDBName],
+		storagenodedb.NotificationsDBName:  v35.DBStates[storagenodedb.NotificationsDBName],
+		storagenodedb.PayoutsDBName:        v35.DBStates[storagenodedb.PayoutsDBName],
+		storagenodedb.PieceIndexDBName:     v35.DBStates[storagenodedb.PieceIndexDBName],
+		storagenodedb.CorruptPiecesDBName: &DBState{
+			SQL: `
+				-- table to hold the corrupted pieces which weren't reported to their satellites yet
+				CREATE TABLE corrupt_pieces (
+					satellite_id BLOB NOT NULL,
+					piece_id BLOB NOT NULL,
+					found_at TIMESTAMP NOT NULL,
+					PRIMARY KEY (satellite_id, piece_id)
+				);
+			`,
+			NewData: `
+				INSERT INTO corrupt_pieces VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000',X'd5e757fd8d207d1c46583fb58330f803dc961b71147308ff75ff1e72a0df6b0b','2020-01-01 00:00:00+00:00');
+			`,
+		},
+	},
+}
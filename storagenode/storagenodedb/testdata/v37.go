@@ -0,0 +1,64 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testdata
+
+import (
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+var v37 = MultiDBState{
+	Version: 37,
+	DBStates: DBStates{
+		storagenodedb.UsedSerialsDBName:  v36.DBStates[storagenodedb.UsedSerialsDBName],
+		storagenodedb.StorageUsageDBName: v36.DBStates[storagenodedb.StorageUsageDBName],
+		storagenodedb.ReputationDBName: &DBState{
+			SQL: `
+				-- tables to store nodestats cache
+				CREATE TABLE reputation (
+					satellite_id BLOB NOT NULL,
+					uptime_success_count INTEGER NOT NULL,
+					uptime_total_count INTEGER NOT NULL,
+					uptime_reputation_alpha REAL NOT NULL,
+					uptime_reputation_beta REAL NOT NULL,
+					uptime_reputation_score REAL NOT NULL,
+					audit_success_count INTEGER NOT NULL,
+					audit_total_count INTEGER NOT NULL,
+					audit_reputation_alpha REAL NOT NULL,
+					audit_reputation_beta REAL NOT NULL,
+					audit_reputation_score REAL NOT NULL,
+					disqualified TIMESTAMP,
+					updated_at TIMESTAMP NOT NULL,
+					unknown_audit_reputation_alpha REAL NOT NULL DEFAULT 1.0,
+					unknown_audit_reputation_beta REAL NOT NULL DEFAULT 0.0,
+					unknown_audit_reputation_score REAL NOT NULL DEFAULT 1.0,
+					suspended TIMESTAMP,
+					PRIMARY KEY (satellite_id)
+				);
+				INSERT INTO reputation VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000',1,1,1.0,1.0,1.0,1,1,1.0,1.0,1.0,'2019-07-19 20:00:00+00:00','2019-08-23 20:00:00+00:00',1.0,0.0,1.0,NULL);
+			`,
+		},
+		storagenodedb.PieceSpaceUsedDBName:  v36.DBStates[storagenodedb.PieceSpaceUsedDBName],
+		storagenodedb.PieceInfoDBName:       v36.DBStates[storagenodedb.PieceInfoDBName],
+		storagenodedb.PieceExpirationDBName: v36.DBStates[storagenodedb.PieceExpirationDBName],
+		storagenodedb.OrdersDBName:          v36.DBStates[storagenodedb.OrdersDBName],
+		storagenodedb.BandwidthDBName:       v36.DBStates[storagenodedb.BandwidthDBName],
+		storagenodedb.SatellitesDBName:      v36.DBStates[storagenodedb.SatellitesDBName],
+		storagenodedb.DeprecatedInfoDBName:  v36.DBStates[storagenodedb.DeprecatedInfoDBName],
+		storagenodedb.NotificationsDBName:   v36.DBStates[storagenodedb.NotificationsDBName],
+		storagenodedb.PayoutsDBName:         v36.DBStates[storagenodedb.PayoutsDBName],
+		storagenodedb.PieceIndexDBName:      v36.DBStates[storagenodedb.PieceIndexDBName],
+		storagenodedb.CorruptPiecesDBName: &DBState{
+			SQL: `
+				-- table to hold the corrupted pieces which weren't reported to their satellites yet
+				CREATE TABLE corrupt_pieces (
+					satellite_id BLOB NOT NULL,
+					piece_id BLOB NOT NULL,
+					found_at TIMESTAMP NOT NULL,
+					PRIMARY KEY (satellite_id, piece_id)
+				);
+				INSERT INTO corrupt_pieces VALUES(X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000',X'd5e757fd8d207d1c46583fb58330f803dc961b71147308ff75ff1e72a0df6b0b','2020-01-01 00:00:00+00:00');
+			`,
+		},
+	},
+}
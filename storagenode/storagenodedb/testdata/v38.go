@@ -0,0 +1,46 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testdata
+
+import (
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+var v38 = MultiDBState{
+	Version: 38,
+	DBStates: DBStates{
+		storagenodedb.UsedSerialsDBName:     v37.DBStates[storagenodedb.UsedSerialsDBName],
+		storagenodedb.StorageUsageDBName:    v37.DBStates[storagenodedb.StorageUsageDBName],
+		storagenodedb.ReputationDBName:      v37.DBStates[storagenodedb.ReputationDBName],
+		storagenodedb.PieceSpaceUsedDBName:  v37.DBStates[storagenodedb.PieceSpaceUsedDBName],
+		storagenodedb.PieceInfoDBName:       v37.DBStates[storagenodedb.PieceInfoDBName],
+		storagenodedb.PieceExpirationDBName: v37.DBStates[storagenodedb.PieceExpirationDBName],
+		storagenodedb.OrdersDBName:          v37.DBStates[storagenodedb.OrdersDBName],
+		storagenodedb.BandwidthDBName:       v37.DBStates[storagenodedb.BandwidthDBName],
+		storagenodedb.SatellitesDBName:      v37.DBStates[storagenodedb.SatellitesDBName],
+		storagenodedb.DeprecatedInfoDBName:  v37.DBStates[storagenodedb.DeprecatedInfoDBName],
+		storagenodedb.NotificationsDBName: &DBState{
+			SQL: `
+				-- table to hold notifications data
+				CREATE TABLE notifications (
+					id         BLOB NOT NULL,
+					sender_id  BLOB NOT NULL,
+					type       INTEGER NOT NULL,
+					title      TEXT NOT NULL,
+					message    TEXT NOT NULL,
+					read_at    TIMESTAMP,
+					created_at TIMESTAMP NOT NULL,
+					delivered_at TIMESTAMP,
+					PRIMARY KEY (id)
+				);
+			`,
+			NewData: `
+				INSERT INTO notifications VALUES(X'd5e757fd8d207d1c46583fb58330f803',X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000',4,'Audit score dropped','message',NULL,'2020-01-01 00:00:00+00:00',NULL);
+			`,
+		},
+		storagenodedb.PayoutsDBName:       v37.DBStates[storagenodedb.PayoutsDBName],
+		storagenodedb.PieceIndexDBName:    v37.DBStates[storagenodedb.PieceIndexDBName],
+		storagenodedb.CorruptPiecesDBName: v37.DBStates[storagenodedb.CorruptPiecesDBName],
+	},
+}
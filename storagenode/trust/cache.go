@@ -9,10 +9,12 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/zeebo/errs"
 
 	"storj.io/common/fpath"
+	"storj.io/common/storj"
 )
 
 // Cache caches source information about trusted satellites
@@ -66,6 +68,72 @@ func (cache *Cache) Set(key string, entries []Entry) {
 	cache.data.Entries[key] = entries
 }
 
+// Bootstrapped returns whether satellites were accepted before. Until then,
+// the satellites of the first fetched lists are accepted without the operator.
+func (cache *Cache) Bootstrapped() bool {
+	return cache.data.Accepted != nil
+}
+
+// Bootstrap records that the satellites of the first fetched lists were
+// accepted, even if there weren't any.
+func (cache *Cache) Bootstrap() {
+	if cache.data.Accepted == nil {
+		cache.data.Accepted = []AcceptedSatellite{}
+	}
+}
+
+// IsAccepted returns whether the operator accepted the satellite
+func (cache *Cache) IsAccepted(id storj.NodeID) bool {
+	for _, accepted := range cache.data.Accepted {
+		if accepted.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Accept records that the satellite was accepted
+func (cache *Cache) Accept(id storj.NodeID, acceptedAt time.Time) {
+	if cache.IsAccepted(id) {
+		return
+	}
+	cache.data.Accepted = append(cache.data.Accepted, AcceptedSatellite{
+		ID:         id,
+		AcceptedAt: acceptedAt,
+	})
+}
+
+// Retain removes the accepted satellites which aren't in ids anymore, so that
+// they need to be accepted again if they are added back. It returns whether
+// any were removed.
+func (cache *Cache) Retain(ids map[storj.NodeID]struct{}) bool {
+	if cache.data.Accepted == nil {
+		return false
+	}
+	retained := cache.data.Accepted[:0]
+	for _, accepted := range cache.data.Accepted {
+		if _, ok := ids[accepted.ID]; ok {
+			retained = append(retained, accepted)
+		}
+	}
+	removed := len(retained) != len(cache.data.Accepted)
+	cache.data.Accepted = retained
+	return removed
+}
+
+// Serial returns the serial of the last signed list accepted from the source
+func (cache *Cache) Serial(key string) uint64 {
+	return cache.data.Serials[key]
+}
+
+// SetSerial sets the serial of the last signed list accepted from the source
+func (cache *Cache) SetSerial(key string, serial uint64) {
+	if cache.data.Serials == nil {
+		cache.data.Serials = make(map[string]uint64)
+	}
+	cache.data.Serials[key] = serial
+}
+
 // Save persists the cache to disk
 func (cache *Cache) Save(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -75,6 +143,19 @@ func (cache *Cache) Save(ctx context.Context) (err error) {
 // CacheData represents the data stored in the cache
 type CacheData struct {
 	Entries map[string][]Entry `json:"entries"`
+
+	// Accepted are the satellites accepted to be trusted. It is nil until
+	// satellites were accepted for the first time.
+	Accepted []AcceptedSatellite `json:"accepted"`
+
+	// Serials are the serials of the last signed lists accepted by source.
+	Serials map[string]uint64 `json:"serials,omitempty"`
+}
+
+// AcceptedSatellite is a satellite accepted to be trusted
+type AcceptedSatellite struct {
+	ID         storj.NodeID `json:"id"`
+	AcceptedAt time.Time    `json:"acceptedAt"`
 }
 
 // NewCacheData returns an new CacheData
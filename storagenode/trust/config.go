@@ -16,6 +16,12 @@ type Config struct {
 	Exclusions      Exclusions    `help:"list of trust exclusions" devDefault:"" releaseDefault:""`
 	RefreshInterval time.Duration `help:"how often the trust pool should be refreshed" default:"6h"`
 	CachePath       string        `help:"file path where trust lists should be cached" default:"${CONFDIR}/trust-cache.json"`
+	// SigningKeys are the keys trust lists from HTTP(S) and file sources must
+	// be signed with, in a detached signature next to the list.
+	SigningKeys PublicKeys `help:"list of base64 encoded ed25519 public keys trust lists must be signed with; lists don't need to be signed if empty" default:""`
+	// RequireAcceptance holds satellites which are added to the lists after
+	// the first refresh back, until the operator accepts them.
+	RequireAcceptance bool `help:"require the operator to accept satellites added to trust lists" default:"true"`
 }
 
 // Sources is a list of sources that implements pflag.Value
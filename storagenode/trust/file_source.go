@@ -4,7 +4,9 @@
 package trust
 
 import (
+	"bytes"
 	"context"
+	"io/ioutil"
 	"os"
 
 	"github.com/zeebo/errs"
@@ -43,7 +45,34 @@ func (source *FileSource) FetchEntries(ctx context.Context) (_ []Entry, err erro
 	if err != nil {
 		return nil, err
 	}
+	return fileEntries(urls), nil
+}
+
+// FetchSignedEntries implements the SignedSource interface and returns the
+// entries, the list from the file source on disk and the detached signature
+// at the path of the list with SignatureSuffix appended, if there is one.
+func (source *FileSource) FetchSignedEntries(ctx context.Context) (_ []Entry, list []byte, signature []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list, err = ioutil.ReadFile(source.path)
+	if err != nil {
+		return nil, nil, nil, ErrFileSource.Wrap(err)
+	}
+
+	signature, err = ioutil.ReadFile(source.path + SignatureSuffix)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, nil, nil, ErrFileSource.Wrap(err)
+	}
+
+	urls, err := ParseSatelliteURLList(ctx, bytes.NewReader(list))
+	if err != nil {
+		return nil, nil, nil, ErrFileSource.Wrap(err)
+	}
+	return fileEntries(urls), list, signature, nil
+}
 
+// fileEntries returns authoritative entries for the URLs.
+func fileEntries(urls []SatelliteURL) []Entry {
 	var entries []Entry
 	for _, url := range urls {
 		entries = append(entries, Entry{
@@ -51,7 +80,7 @@ func (source *FileSource) FetchEntries(ctx context.Context) (_ []Entry, err erro
 			Authoritative: true,
 		})
 	}
-	return entries, nil
+	return entries
 }
 
 // LoadSatelliteURLList loads a list of Satellite URLs from a path on disk
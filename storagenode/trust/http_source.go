@@ -5,8 +5,10 @@ package trust
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"io"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"net/url"
@@ -58,20 +60,40 @@ func (source *HTTPSource) Static() bool { return false }
 func (source *HTTPSource) FetchEntries(ctx context.Context) (_ []Entry, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	resp, err := http.Get(source.url.String())
+	list, err := source.fetch(ctx, source.url, false)
 	if err != nil {
-		return nil, ErrHTTPSource.Wrap(err)
+		return nil, err
 	}
-	defer func() {
-		// Errors closing the response body can be ignored since they don't
-		// impact the correctness of the function.
-		_ = resp.Body.Close()
-	}()
-	if resp.StatusCode != http.StatusOK {
-		return nil, ErrHTTPSource.New("%q: unexpected status code %d: %q", source.url, resp.StatusCode, tryReadLine(resp.Body))
+	return source.parseEntries(ctx, list)
+}
+
+// FetchSignedEntries implements the SignedSource interface and returns the
+// entries, the list retrieved over HTTP(S) and the detached signature at the
+// URL of the list with SignatureSuffix appended, if there is one.
+func (source *HTTPSource) FetchSignedEntries(ctx context.Context) (_ []Entry, list []byte, signature []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list, err = source.fetch(ctx, source.url, false)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	signatureURL := *source.url
+	signatureURL.Path += SignatureSuffix
+	signature, err = source.fetch(ctx, &signatureURL, true)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	urls, err := ParseSatelliteURLList(ctx, resp.Body)
+	entries, err := source.parseEntries(ctx, list)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return entries, list, signature, nil
+}
+
+func (source *HTTPSource) parseEntries(ctx context.Context, list []byte) ([]Entry, error) {
+	urls, err := ParseSatelliteURLList(ctx, bytes.NewReader(list))
 	if err != nil {
 		return nil, ErrHTTPSource.New("cannot parse list at %q: %w", source.url, err)
 	}
@@ -88,6 +110,34 @@ func (source *HTTPSource) FetchEntries(ctx context.Context) (_ []Entry, err erro
 	return entries, nil
 }
 
+// fetch returns the body retrieved from the URL. If optional is set, a
+// missing body isn't an error.
+func (source *HTTPSource) fetch(ctx context.Context, u *url.URL, optional bool) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	resp, err := http.Get(u.String())
+	if err != nil {
+		return nil, ErrHTTPSource.Wrap(err)
+	}
+	defer func() {
+		// Errors closing the response body can be ignored since they don't
+		// impact the correctness of the function.
+		_ = resp.Body.Close()
+	}()
+	if optional && resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrHTTPSource.New("%q: unexpected status code %d: %q", u, resp.StatusCode, tryReadLine(resp.Body))
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrHTTPSource.Wrap(err)
+	}
+	return body, nil
+}
+
 // URLMatchesHTTPSourceHost takes the Satellite URL host and the host of the
 // HTTPSource URL and determines if the SatelliteURL matches or is in the
 // same domain as the HTTPSource URL.
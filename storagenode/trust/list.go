@@ -5,18 +5,31 @@ package trust
 
 import (
 	"context"
+	"time"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/common/storj"
 )
 
+// ErrNotPending is an error class for accepting satellites which aren't pending
+var ErrNotPending = errs.Class("satellite is not pending")
+
 // List represents a dynamic trust list
 type List struct {
 	log     *zap.Logger
 	sources Sources
 	rules   Rules
 	cache   *Cache
+
+	// keys are the public keys lists of signed sources must be signed with.
+	// Lists don't need to be signed if it's empty.
+	keys PublicKeys
+	// requireAcceptance holds satellites added to the lists back until they
+	// are accepted.
+	requireAcceptance bool
+	pending           []Entry
 }
 
 // NewList takes one or more sources, optional rules, and a cache and returns a new List.
@@ -73,6 +86,10 @@ func (list *List) FetchURLs(ctx context.Context) ([]storj.NodeURL, error) {
 		entries = append(entries, entry)
 	}
 
+	if list.requireAcceptance {
+		entries = list.filterAccepted(ctx, entries)
+	}
+
 	var urls []storj.NodeURL
 	for _, entry := range entries {
 		urls = append(urls, entry.SatelliteURL.NodeURL())
@@ -87,7 +104,7 @@ func (list *List) fetchEntries(ctx context.Context) (_ []Entry, err error) {
 	for _, source := range list.sources {
 		sourceLog := list.log.With(zap.String("source", source.String()))
 
-		entries, err := source.FetchEntries(ctx)
+		entries, err := list.fetchSource(ctx, source)
 		if err != nil {
 			var ok bool
 			entries, ok = list.lookupCache(source)
@@ -110,6 +127,122 @@ func (list *List) fetchEntries(ctx context.Context) (_ []Entry, err error) {
 	return allEntries, nil
 }
 
+// fetchSource fetches the entries of the source and records their provenance.
+// If keys are configured, the lists of signed sources must be signed with one
+// of them.
+func (list *List) fetchSource(ctx context.Context, source Source) (_ []Entry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var fetched []Entry
+	var signedBy string
+	if signed, ok := source.(SignedSource); ok && len(list.keys) > 0 {
+		var data, signature []byte
+		fetched, data, signature, err = signed.FetchSignedEntries(ctx)
+		if err != nil {
+			return nil, err
+		}
+		key, parsed, err := list.keys.Verify(data, signature, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		// refuse lists older than the last accepted one, which could be
+		// replayed to bring back removed satellites.
+		if last := list.cache.Serial(source.String()); parsed.Serial < last {
+			return nil, ErrSignature.New("list serial %d is older than the last accepted serial %d", parsed.Serial, last)
+		}
+		list.cache.SetSerial(source.String(), parsed.Serial)
+		signedBy = key.String()
+	} else {
+		fetched, err = source.FetchEntries(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fetchedAt := time.Now().UTC()
+	entries := make([]Entry, 0, len(fetched))
+	for _, entry := range fetched {
+		entry.Source = source.String()
+		entry.SignedBy = signedBy
+		entry.FetchedAt = fetchedAt
+		entry.static = source.Static()
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// filterAccepted returns the entries of accepted satellites and holds the
+// others back as pending. Entries of static sources are configured by the
+// operator and accepted implicitly, as are all entries of the first fetch.
+// Satellites which aren't in the entries anymore lose their acceptance.
+func (list *List) filterAccepted(ctx context.Context, entries []Entry) []Entry {
+	bootstrap := !list.cache.Bootstrapped()
+	now := time.Now().UTC()
+
+	changed := bootstrap
+	list.cache.Bootstrap()
+	accepted := make([]Entry, 0, len(entries))
+	list.pending = nil
+	for _, entry := range entries {
+		id := entry.SatelliteURL.ID
+		if !list.cache.IsAccepted(id) {
+			if !bootstrap && !entry.static {
+				list.log.Warn("Satellite added to trust list is pending acceptance",
+					zap.Stringer("id", id),
+					zap.String("address", entry.SatelliteURL.Address()),
+					zap.String("source", entry.Source))
+				list.pending = append(list.pending, entry)
+				continue
+			}
+			list.cache.Accept(id, now)
+			changed = true
+		}
+		accepted = append(accepted, entry)
+	}
+
+	// satellites which left the lists need to be accepted again when they
+	// are added back.
+	ids := make(map[storj.NodeID]struct{}, len(entries))
+	for _, entry := range entries {
+		ids[entry.SatelliteURL.ID] = struct{}{}
+	}
+	if list.cache.Retain(ids) {
+		changed = true
+	}
+
+	if changed {
+		if err := list.saveCache(ctx); err != nil {
+			list.log.Warn("Unable to save list cache", zap.Error(err))
+		}
+	}
+	return accepted
+}
+
+// Pending returns the entries of satellites which were added to the lists,
+// but weren't accepted yet.
+func (list *List) Pending() []Entry {
+	return append([]Entry(nil), list.pending...)
+}
+
+// Accept accepts a pending satellite and returns its entry.
+func (list *List) Accept(ctx context.Context, id storj.NodeID) (_ Entry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for i, entry := range list.pending {
+		if entry.SatelliteURL.ID != id {
+			continue
+		}
+		list.cache.Accept(id, time.Now().UTC())
+		if err := list.saveCache(ctx); err != nil {
+			return Entry{}, Error.Wrap(err)
+		}
+		list.pending = append(list.pending[:i:i], list.pending[i+1:]...)
+		list.log.Info("Satellite accepted", zap.Stringer("id", id), zap.String("source", entry.Source))
+		return entry, nil
+	}
+	return Entry{}, ErrNotPending.New("%s", id)
+}
+
 func (list *List) lookupCache(source Source) ([]Entry, bool) {
 	// Static sources are not cached
 	if source.Static() {
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -218,6 +219,12 @@ func TestListCacheInteraction(t *testing.T) {
 		}
 	}
 
+	// entries fetched from the normal source are cached with their source
+	fromNormal := func(entry trust.Entry) trust.Entry {
+		entry.Source = "normal"
+		return entry
+	}
+
 	badNormal := &fakeSource{
 		name:   "normal",
 		static: false,
@@ -244,7 +251,7 @@ func TestListCacheInteraction(t *testing.T) {
 			sources: []trust.Source{makeNormal(entry1)},
 			urls:    []storj.NodeURL{url1},
 			cacheAfter: map[string][]trust.Entry{
-				"normal": {entry1},
+				"normal": {fromNormal(entry1)},
 			},
 		},
 		{
@@ -261,7 +268,7 @@ func TestListCacheInteraction(t *testing.T) {
 			},
 			urls: []storj.NodeURL{url2},
 			cacheAfter: map[string][]trust.Entry{
-				"normal": {entry2},
+				"normal": {fromNormal(entry2)},
 			},
 		},
 		{
@@ -318,6 +325,12 @@ func TestListCacheInteraction(t *testing.T) {
 			if !tt.killCacheEarly {
 				cacheAfter, err := trust.LoadCacheData(cache.Path())
 				require.NoError(t, err)
+				// the fetch time varies
+				for _, entries := range cacheAfter.Entries {
+					for i := range entries {
+						entries[i].FetchedAt = time.Time{}
+					}
+				}
 				require.Equal(t, &trust.CacheData{Entries: tt.cacheAfter}, cacheAfter)
 			}
 		})
@@ -70,6 +70,7 @@ type Pool struct {
 
 	satellitesMu sync.RWMutex
 	satellites   map[storj.NodeID]*satelliteInfoCache
+	refreshed    bool
 }
 
 // satelliteInfoCache caches identity information about a satellite
@@ -92,6 +93,8 @@ func NewPool(log *zap.Logger, resolver IdentityResolver, config Config) (*Pool,
 	if err != nil {
 		return nil, err
 	}
+	list.keys = config.SigningKeys
+	list.requireAcceptance = config.RequireAcceptance
 
 	return &Pool{
 		log:             log,
@@ -160,6 +163,16 @@ func (pool *Pool) GetSatellites(ctx context.Context) (satellites []storj.NodeID)
 	return satellites
 }
 
+// Refreshed returns whether the set of trusted satellites was successfully
+// refreshed at least once.
+func (pool *Pool) Refreshed(ctx context.Context) bool {
+	defer mon.Task()(&ctx)(nil)
+
+	pool.satellitesMu.RLock()
+	defer pool.satellitesMu.RUnlock()
+	return pool.refreshed
+}
+
 // GetAddress returns the address of a satellite in the trusted list
 func (pool *Pool) GetAddress(ctx context.Context, id storj.NodeID) (_ string, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -171,6 +184,39 @@ func (pool *Pool) GetAddress(ctx context.Context, id storj.NodeID) (_ string, er
 	return info.url.Address, nil
 }
 
+// PendingSatellites returns the entries of satellites which were added to
+// the trust lists, but aren't trusted until the operator accepts them.
+func (pool *Pool) PendingSatellites(ctx context.Context) []Entry {
+	defer mon.Task()(&ctx)(nil)
+
+	pool.listMu.Lock()
+	defer pool.listMu.Unlock()
+	return pool.list.Pending()
+}
+
+// AcceptSatellite trusts a pending satellite.
+func (pool *Pool) AcceptSatellite(ctx context.Context, id storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pool.listMu.Lock()
+	entry, err := pool.list.Accept(ctx, id)
+	pool.listMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	pool.satellitesMu.Lock()
+	defer pool.satellitesMu.Unlock()
+
+	if _, ok := pool.satellites[id]; !ok {
+		pool.log.Debug("Satellite is trusted", zap.String("id", id.String()))
+		pool.satellites[id] = &satelliteInfoCache{
+			url: entry.SatelliteURL.NodeURL(),
+		}
+	}
+	return nil
+}
+
 // Refresh refreshes the set of trusted satellites in the pool. Concurrent
 // callers will be synchronized so only one proceeds at a time.
 func (pool *Pool) Refresh(ctx context.Context) error {
@@ -216,6 +262,7 @@ func (pool *Pool) Refresh(ctx context.Context) error {
 		}
 	}
 
+	pool.refreshed = true
 	return nil
 }
 
@@ -0,0 +1,173 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package trust
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+var (
+	// ErrSignature is an error class for trust list signature errors
+	ErrSignature = errs.Class("trust list signature")
+)
+
+// SignatureSuffix is appended to the URL or path of a trust list to locate
+// its detached signature.
+const SignatureSuffix = ".sig"
+
+// PublicKey is an ed25519 public key trust lists can be signed with
+type PublicKey ed25519.PublicKey
+
+// ParsePublicKey parses a base64 encoded ed25519 public key
+func ParsePublicKey(s string) (PublicKey, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, ErrSignature.New("invalid public key %q: %w", s, err)
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return nil, ErrSignature.New("invalid public key %q: expected %d bytes, got %d", s, ed25519.PublicKeySize, len(key))
+	}
+	return PublicKey(key), nil
+}
+
+// String returns the base64 encoding of the key
+func (key PublicKey) String() string {
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+// PublicKeys is a list of public keys that implements pflag.Value
+type PublicKeys []PublicKey
+
+// String returns the string representation of the config
+func (keys PublicKeys) String() string {
+	s := make([]string, 0, len(keys))
+	for _, key := range keys {
+		s = append(s, key.String())
+	}
+	return strings.Join(s, ",")
+}
+
+// Set implements pflag.Value by parsing a comma separated list of keys
+func (keys *PublicKeys) Set(value string) error {
+	var entries []string
+	if value != "" {
+		entries = strings.Split(value, ",")
+	}
+
+	var toSet PublicKeys
+	for _, entry := range entries {
+		key, err := ParsePublicKey(entry)
+		if err != nil {
+			return err
+		}
+		toSet = append(toSet, key)
+	}
+
+	*keys = toSet
+	return nil
+}
+
+// Type returns the type of the pflag.Value
+func (keys PublicKeys) Type() string {
+	return "trust-public-keys"
+}
+
+// ListSignature is the detached signature of a trust list. The serial and the
+// expiration are signed along with the list, so that an older or outdated
+// list can't be passed off for the current one.
+type ListSignature struct {
+	Serial    uint64
+	ExpiresAt time.Time
+	Signature []byte
+}
+
+// ParseListSignature parses a detached signature, which consists of the
+// serial, expires and signature lines.
+func ParseListSignature(data []byte) (*ListSignature, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, ErrSignature.New("list is not signed")
+	}
+
+	fields := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			return nil, ErrSignature.New("malformed signature line %q", line)
+		}
+		fields[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, ErrSignature.Wrap(err)
+	}
+
+	serial, err := strconv.ParseUint(fields["serial"], 10, 64)
+	if err != nil {
+		return nil, ErrSignature.New("malformed serial: %w", err)
+	}
+	expiresAt, err := time.Parse(time.RFC3339, fields["expires"])
+	if err != nil {
+		return nil, ErrSignature.New("malformed expiration: %w", err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(fields["signature"])
+	if err != nil {
+		return nil, ErrSignature.New("malformed signature: %w", err)
+	}
+	return &ListSignature{
+		Serial:    serial,
+		ExpiresAt: expiresAt,
+		Signature: sig,
+	}, nil
+}
+
+// signedMessage returns the message signed for a list with the serial and
+// the expiration.
+func signedMessage(list []byte, serial uint64, expiresAt time.Time) []byte {
+	header := fmt.Sprintf("serial: %d\nexpires: %s\n", serial, expiresAt.UTC().Format(time.RFC3339))
+	return append([]byte(header), list...)
+}
+
+// Verify checks the detached signature of the list against the keys and
+// returns the key the list was signed with along with the parsed signature.
+// Lists which expired before now are refused.
+func (keys PublicKeys) Verify(list, signature []byte, now time.Time) (PublicKey, *ListSignature, error) {
+	parsed, err := ParseListSignature(signature)
+	if err != nil {
+		return nil, nil, err
+	}
+	message := signedMessage(list, parsed.Serial, parsed.ExpiresAt)
+	for _, key := range keys {
+		if !ed25519.Verify(ed25519.PublicKey(key), message, parsed.Signature) {
+			continue
+		}
+		if now.After(parsed.ExpiresAt) {
+			return nil, nil, ErrSignature.New("list expired at %s", parsed.ExpiresAt.Format(time.RFC3339))
+		}
+		return key, parsed, nil
+	}
+	return nil, nil, ErrSignature.New("list is not signed by a trusted key")
+}
+
+// SignList returns the detached signature of the list with the serial and the
+// expiration, which is expected next to the list with SignatureSuffix
+// appended. The serial must increase with every published list.
+func SignList(key ed25519.PrivateKey, list []byte, serial uint64, expiresAt time.Time) []byte {
+	expiresAt = expiresAt.UTC().Truncate(time.Second)
+	sig := ed25519.Sign(key, signedMessage(list, serial, expiresAt))
+	return []byte(fmt.Sprintf("serial: %d\nexpires: %s\nsignature: %s\n",
+		serial, expiresAt.Format(time.RFC3339), base64.StdEncoding.EncodeToString(sig)))
+}
@@ -0,0 +1,192 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package trust_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/trust"
+)
+
+func TestPublicKeysSet(t *testing.T) {
+	public, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	key := trust.PublicKey(public)
+
+	var keys trust.PublicKeys
+	require.NoError(t, keys.Set(key.String()))
+	require.Equal(t, trust.PublicKeys{key}, keys)
+	require.Equal(t, key.String(), keys.String())
+
+	require.NoError(t, keys.Set(""))
+	require.Empty(t, keys)
+
+	require.Error(t, keys.Set("not base64"))
+	require.Error(t, keys.Set("AAAA"))
+}
+
+func TestPublicKeysVerify(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	otherPublic, otherPrivate, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	list := []byte("1@foo.test:7777\n")
+	keys := trust.PublicKeys{trust.PublicKey(otherPublic), trust.PublicKey(public)}
+
+	key, signature, err := keys.Verify(list, trust.SignList(private, list, 3, expiresAt), now)
+	require.NoError(t, err)
+	require.Equal(t, trust.PublicKey(public), key)
+	require.EqualValues(t, 3, signature.Serial)
+	require.WithinDuration(t, expiresAt, signature.ExpiresAt, time.Second)
+
+	_, _, err = keys.Verify(list, nil, now)
+	require.True(t, trust.ErrSignature.Has(err))
+
+	_, _, err = keys.Verify([]byte("2@bar.test:7777\n"), trust.SignList(private, list, 3, expiresAt), now)
+	require.True(t, trust.ErrSignature.Has(err))
+
+	_, _, err = trust.PublicKeys{trust.PublicKey(otherPublic)}.Verify(list, trust.SignList(private, list, 3, expiresAt), now)
+	require.True(t, trust.ErrSignature.Has(err))
+
+	_, _, err = keys.Verify(list, trust.SignList(otherPrivate, list, 3, expiresAt), now)
+	require.NoError(t, err)
+
+	// the serial and the expiration are signed
+	tampered := strings.Replace(string(trust.SignList(private, list, 3, expiresAt)), "serial: 3", "serial: 4", 1)
+	_, _, err = keys.Verify(list, []byte(tampered), now)
+	require.True(t, trust.ErrSignature.Has(err))
+
+	// expired lists are refused
+	_, _, err = keys.Verify(list, trust.SignList(private, list, 3, expiresAt), expiresAt.Add(time.Second))
+	require.True(t, trust.ErrSignature.Has(err))
+	require.Contains(t, err.Error(), "list expired")
+}
+
+func TestListSignedFileSource(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	public, private, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	url := makeSatelliteURL("foo.test")
+	list := []byte(url.String() + "\n")
+	path := ctx.File("trusted-satellites.txt")
+	require.NoError(t, ioutil.WriteFile(path, list, 0644))
+
+	newPool := func() *trust.Pool {
+		pool, err := trust.NewPool(zaptest.NewLogger(t), newFakeIdentityResolver(), trust.Config{
+			Sources:     []trust.Source{trust.NewFileSource(path)},
+			CachePath:   ctx.File("trust-cache.json"),
+			SigningKeys: trust.PublicKeys{trust.PublicKey(public)},
+		})
+		require.NoError(t, err)
+		return pool
+	}
+
+	// an unsigned list is refused
+	err = newPool().Refresh(context.Background())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "list is not signed")
+
+	// a signed list is trusted
+	expiresAt := time.Now().Add(time.Hour)
+	require.NoError(t, ioutil.WriteFile(path+trust.SignatureSuffix, trust.SignList(private, list, 2, expiresAt), 0644))
+	pool := newPool()
+	require.NoError(t, pool.Refresh(context.Background()))
+	require.Equal(t, []storj.NodeID{url.ID}, pool.GetSatellites(context.Background()))
+
+	// a modified list is refused
+	other := makeSatelliteURL("bar.test")
+	require.NoError(t, ioutil.WriteFile(path, []byte(other.String()+"\n"), 0644))
+	err = newPool().Refresh(context.Background())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "list is not signed by a trusted key")
+
+	// a list older than the last accepted one is refused
+	require.NoError(t, ioutil.WriteFile(path, []byte(other.String()+"\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(path+trust.SignatureSuffix, trust.SignList(private, []byte(other.String()+"\n"), 1, expiresAt), 0644))
+	err = newPool().Refresh(context.Background())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "older than the last accepted serial")
+
+	// a newer list is trusted
+	require.NoError(t, ioutil.WriteFile(path+trust.SignatureSuffix, trust.SignList(private, []byte(other.String()+"\n"), 3, expiresAt), 0644))
+	pool = newPool()
+	require.NoError(t, pool.Refresh(context.Background()))
+	require.Equal(t, []storj.NodeID{other.ID}, pool.GetSatellites(context.Background()))
+}
+
+func TestPoolAcceptSatellite(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	entry := func(host string) trust.Entry {
+		return trust.Entry{SatelliteURL: makeSatelliteURL(host)}
+	}
+	first, added := entry("first.test"), entry("added.test")
+
+	source := &fakeSource{name: "https://foo.test/trusted-satellites", entries: []trust.Entry{first}}
+	config := trust.Config{
+		Sources:           []trust.Source{source},
+		CachePath:         ctx.File("trust-cache.json"),
+		RequireAcceptance: true,
+	}
+	pool, err := trust.NewPool(zaptest.NewLogger(t), newFakeIdentityResolver(), config)
+	require.NoError(t, err)
+
+	// the satellites of the first refresh are accepted
+	require.NoError(t, pool.Refresh(context.Background()))
+	require.NoError(t, pool.VerifySatelliteID(context.Background(), first.SatelliteURL.ID))
+	require.Empty(t, pool.PendingSatellites(context.Background()))
+
+	// added satellites are pending until accepted
+	source.entries = []trust.Entry{first, added}
+	require.NoError(t, pool.Refresh(context.Background()))
+	require.Error(t, pool.VerifySatelliteID(context.Background(), added.SatelliteURL.ID))
+
+	pending := pool.PendingSatellites(context.Background())
+	require.Len(t, pending, 1)
+	require.Equal(t, added.SatelliteURL, pending[0].SatelliteURL)
+	require.Equal(t, source.name, pending[0].Source)
+	require.False(t, pending[0].FetchedAt.IsZero())
+
+	err = pool.AcceptSatellite(context.Background(), testrand.NodeID())
+	require.True(t, trust.ErrNotPending.Has(err))
+
+	require.NoError(t, pool.AcceptSatellite(context.Background(), added.SatelliteURL.ID))
+	require.NoError(t, pool.VerifySatelliteID(context.Background(), added.SatelliteURL.ID))
+	require.Empty(t, pool.PendingSatellites(context.Background()))
+
+	// the acceptance is persisted
+	pool, err = trust.NewPool(zaptest.NewLogger(t), newFakeIdentityResolver(), config)
+	require.NoError(t, err)
+	require.NoError(t, pool.Refresh(context.Background()))
+	require.NoError(t, pool.VerifySatelliteID(context.Background(), added.SatelliteURL.ID))
+	require.Empty(t, pool.PendingSatellites(context.Background()))
+
+	// a satellite which left the list needs to be accepted again when it is added back
+	source.entries = []trust.Entry{first}
+	require.NoError(t, pool.Refresh(context.Background()))
+	require.Error(t, pool.VerifySatelliteID(context.Background(), added.SatelliteURL.ID))
+
+	source.entries = []trust.Entry{first, added}
+	require.NoError(t, pool.Refresh(context.Background()))
+	require.Error(t, pool.VerifySatelliteID(context.Background(), added.SatelliteURL.ID))
+	require.Len(t, pool.PendingSatellites(context.Background()), 1)
+}
@@ -6,6 +6,7 @@ package trust
 import (
 	"context"
 	"regexp"
+	"time"
 
 	"github.com/zeebo/errs"
 )
@@ -18,6 +19,19 @@ type Entry struct {
 	// Authoritative indicates whether this entry came from an authoritative
 	// source. This impacts how URLS are aggregated.
 	Authoritative bool `json:"authoritative"`
+
+	// Source is the trust source the entry was fetched from
+	Source string `json:"source,omitempty"`
+
+	// SignedBy is the public key the list containing the entry was signed
+	// with. It is empty if the signature wasn't verified.
+	SignedBy string `json:"signedBy,omitempty"`
+
+	// FetchedAt is when the entry was fetched from the source
+	FetchedAt time.Time `json:"fetchedAt"`
+
+	// static is whether the entry came from a static source
+	static bool
 }
 
 // Source is a trust source for trusted Satellites
@@ -33,6 +47,16 @@ type Source interface {
 	FetchEntries(context.Context) ([]Entry, error)
 }
 
+// SignedSource is a trust source whose lists can have a detached signature
+type SignedSource interface {
+	Source
+
+	// FetchSignedEntries returns the list of trust entries from the source,
+	// the list they were parsed from and its detached signature. The
+	// signature is nil if the list isn't signed.
+	FetchSignedEntries(context.Context) (entries []Entry, list []byte, signature []byte, err error)
+}
+
 // NewSource takes a configuration string returns a Source for that string.
 func NewSource(config string) (Source, error) {
 	schema, ok := isReserved(config)
@@ -0,0 +1,348 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package untrusted implements the cleanup of data stored for satellites
+// which are no longer trusted.
+package untrusted
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// Error is the default error class for untrusted satellite data cleanup.
+	Error = errs.Class("untrusted satellites")
+	// ErrTrusted is the error class returned when purging data of a trusted satellite.
+	ErrTrusted = errs.Class("satellite is trusted")
+
+	mon = monkit.Package()
+)
+
+// Config defines the policy for data of satellites which are no longer trusted.
+//
+// The data is kept for GracePeriod after the satellite was first noticed as
+// untrusted, then moved to the trash and deleted after TrashPeriod. Payout
+// and bandwidth history of the satellite is kept.
+type Config struct {
+	Interval    time.Duration `help:"how frequently data of untrusted satellites is checked" default:"1h0m0s"`
+	Keep        bool          `help:"keep data of untrusted satellites forever instead of removing it" default:"false"`
+	GracePeriod time.Duration `help:"how long data of an untrusted satellite is kept before it's moved to the trash" default:"720h0m0s"`
+	TrashPeriod time.Duration `help:"how long data of an untrusted satellite stays in the trash before it's deleted" default:"168h0m0s"`
+}
+
+// State is the cleanup state of the data of an untrusted satellite.
+type State string
+
+const (
+	// StateKept is the state of data which is kept until the grace period ends.
+	StateKept State = "kept"
+	// StateTrashed is the state of data which was moved to the trash.
+	StateTrashed State = "trashed"
+	// StateDeleted is the state of data which was deleted.
+	StateDeleted State = "deleted"
+)
+
+// Satellite contains the cleanup state of the data of an untrusted satellite.
+type Satellite struct {
+	SatelliteID storj.NodeID `json:"satelliteID"`
+	State       State        `json:"state"`
+	UntrustedAt time.Time    `json:"untrustedAt"`
+	// NextActionAt is when the data is moved to the trash or deleted. It's
+	// nil when there is nothing left to do or the data is kept forever.
+	NextActionAt   *time.Time `json:"nextActionAt"`
+	BytesReclaimed int64      `json:"bytesReclaimed"`
+}
+
+// Service periodically looks for data stored for satellites which are no
+// longer trusted and removes it according to the configured policy.
+//
+// architecture: Chore
+type Service struct {
+	log         *zap.Logger
+	config      Config
+	trust       *trust.Pool
+	store       *pieces.Store
+	satellites  satellites.DB
+	ordersDB    orders.DB
+	cleanupLock sync.Mutex
+
+	Loop *sync2.Cycle
+}
+
+// NewService creates a new untrusted satellite data cleanup service.
+func NewService(log *zap.Logger, config Config, trust *trust.Pool, store *pieces.Store, satellites satellites.DB, ordersDB orders.DB) *Service {
+	return &Service{
+		log:        log,
+		config:     config,
+		trust:      trust,
+		store:      store,
+		satellites: satellites,
+		ordersDB:   ordersDB,
+		Loop:       sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the cleanup service.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		err := service.Cleanup(ctx, time.Now())
+		if err != nil {
+			service.log.Error("error during cleanup of untrusted satellites", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the cleanup service.
+func (service *Service) Close() (err error) {
+	service.Loop.Close()
+	return nil
+}
+
+// Cleanup records satellites which store data but are no longer trusted and
+// moves their data to the trash or deletes it once it's due at now.
+func (service *Service) Cleanup(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.cleanupLock.Lock()
+	defer service.cleanupLock.Unlock()
+
+	// without a successfully refreshed trust list every satellite would look
+	// untrusted, so don't risk removing data of satellites which are trusted
+	satelliteIDs := service.trust.GetSatellites(ctx)
+	if !service.trust.Refreshed(ctx) || len(satelliteIDs) == 0 {
+		service.log.Debug("Skipping cleanup of untrusted satellites, no trusted satellites are known")
+		return nil
+	}
+
+	trusted := make(map[storj.NodeID]bool)
+	for _, id := range satelliteIDs {
+		trusted[id] = true
+	}
+	// the data of satellites waiting for the operator to accept them is
+	// left alone until they're accepted or removed from the trust lists
+	pending := make(map[storj.NodeID]bool)
+	for _, entry := range service.trust.PendingSatellites(ctx) {
+		pending[entry.SatelliteURL.ID] = true
+	}
+
+	stored, err := service.store.StoringSatellites(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	records, err := service.satellites.ListUntrusted(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	known := make(map[storj.NodeID]bool)
+	var untrusted []satellites.Untrusted
+	for _, record := range records {
+		known[record.SatelliteID] = true
+		if pending[record.SatelliteID] {
+			continue
+		}
+		if !trusted[record.SatelliteID] {
+			untrusted = append(untrusted, record)
+			continue
+		}
+
+		// the satellite is trusted again, bring back whatever is left
+		service.log.Info("Satellite is trusted again", zap.Stringer("Satellite ID", record.SatelliteID))
+		if record.TrashedAt != nil && record.DeletedAt == nil {
+			if err := service.store.RestoreTrash(ctx, record.SatelliteID); err != nil {
+				return Error.Wrap(err)
+			}
+		}
+		if err := service.satellites.RemoveUntrusted(ctx, record.SatelliteID); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	for _, id := range stored {
+		if trusted[id] || pending[id] || known[id] {
+			continue
+		}
+		service.log.Warn("Found data of a satellite which is no longer trusted",
+			zap.Stringer("Satellite ID", id),
+			zap.Bool("keep", service.config.Keep),
+			zap.Duration("grace period", service.config.GracePeriod))
+		if err := service.satellites.MarkUntrusted(ctx, id, now); err != nil {
+			return Error.Wrap(err)
+		}
+		untrusted = append(untrusted, satellites.Untrusted{SatelliteID: id, UntrustedAt: now})
+	}
+
+	if service.config.Keep {
+		return nil
+	}
+
+	var group errs.Group
+	for _, record := range untrusted {
+		switch {
+		case record.TrashedAt == nil && !now.Before(record.UntrustedAt.Add(service.config.GracePeriod)):
+			group.Add(service.trash(ctx, record.SatelliteID, now))
+		case record.TrashedAt != nil && record.DeletedAt == nil && !now.Before(record.TrashedAt.Add(service.config.TrashPeriod)):
+			group.Add(service.delete(ctx, record.SatelliteID, now, 0))
+		}
+	}
+	return Error.Wrap(group.Err())
+}
+
+// trash moves all pieces of the satellite to the trash.
+func (service *Service) trash(ctx context.Context, satelliteID storj.NodeID, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var trashed int64
+	err = service.store.WalkSatellitePieces(ctx, satelliteID, func(access pieces.StoredPieceAccess) error {
+		_, size, err := access.Size(ctx)
+		if err != nil {
+			service.log.Debug("failed to stat piece", zap.Stringer("Satellite ID", satelliteID), zap.Stringer("Piece ID", access.PieceID()), zap.Error(err))
+		}
+		if err := service.store.Trash(ctx, satelliteID, access.PieceID()); err != nil {
+			return err
+		}
+		trashed += size
+		return nil
+	})
+	if err != nil {
+		// the remaining pieces are moved to the trash on the next cycle
+		return err
+	}
+
+	service.log.Info("Moved data of untrusted satellite to the trash",
+		zap.Stringer("Satellite ID", satelliteID),
+		zap.Int64("bytes", trashed))
+	return service.satellites.MarkUntrustedTrashed(ctx, satelliteID, now, trashed)
+}
+
+// delete empties the trash of the satellite and drops its unsent orders.
+// deleted is the amount of data deleted before the trash was emptied.
+func (service *Service) delete(ctx context.Context, satelliteID storj.NodeID, now time.Time, deleted int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	emptied, err := service.store.EmptyTrash(ctx, satelliteID, now)
+	if err != nil {
+		return err
+	}
+	deleted += emptied
+
+	unsent, err := service.ordersDB.ListUnsentBySatellite(ctx)
+	if err != nil {
+		return err
+	}
+	requests := make([]orders.ArchiveRequest, 0, len(unsent[satelliteID]))
+	for _, info := range unsent[satelliteID] {
+		requests = append(requests, orders.ArchiveRequest{
+			Satellite: satelliteID,
+			Serial:    info.Limit.SerialNumber,
+			Status:    orders.StatusRejected,
+		})
+	}
+	if len(requests) > 0 {
+		if err := service.ordersDB.Archive(ctx, now.UTC(), requests...); err != nil {
+			return err
+		}
+	}
+
+	service.log.Info("Deleted data of untrusted satellite",
+		zap.Stringer("Satellite ID", satelliteID),
+		zap.Int64("bytes", deleted),
+		zap.Int("unsent orders", len(requests)))
+	return service.satellites.MarkUntrustedDeleted(ctx, satelliteID, now, deleted)
+}
+
+// Purge deletes all data stored for an untrusted satellite right away,
+// regardless of the configured policy. It returns the number of bytes
+// deleted.
+func (service *Service) Purge(ctx context.Context, satelliteID storj.NodeID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.cleanupLock.Lock()
+	defer service.cleanupLock.Unlock()
+
+	if err := service.trust.VerifySatelliteID(ctx, satelliteID); err == nil {
+		return 0, ErrTrusted.New("%s", satelliteID)
+	}
+
+	now := time.Now()
+	if err := service.satellites.MarkUntrusted(ctx, satelliteID, now); err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	var deleted int64
+	err = service.store.WalkSatellitePieces(ctx, satelliteID, func(access pieces.StoredPieceAccess) error {
+		_, size, err := access.Size(ctx)
+		if err != nil {
+			service.log.Debug("failed to stat piece", zap.Stringer("Satellite ID", satelliteID), zap.Stringer("Piece ID", access.PieceID()), zap.Error(err))
+		}
+		if err := service.store.Delete(ctx, satelliteID, access.PieceID()); err != nil {
+			return err
+		}
+		deleted += size
+		return nil
+	})
+	if err != nil {
+		return deleted, Error.Wrap(err)
+	}
+
+	return deleted, Error.Wrap(service.delete(ctx, satelliteID, now, deleted))
+}
+
+// List returns the cleanup state of the data of all untrusted satellites.
+func (service *Service) List(ctx context.Context) (_ []Satellite, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	records, err := service.satellites.ListUntrusted(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	list := make([]Satellite, 0, len(records))
+	for _, record := range records {
+		satellite := Satellite{
+			SatelliteID:    record.SatelliteID,
+			State:          StateKept,
+			UntrustedAt:    record.UntrustedAt,
+			BytesReclaimed: record.BytesReclaimed,
+		}
+
+		var next time.Time
+		switch {
+		case record.DeletedAt != nil:
+			satellite.State = StateDeleted
+		case record.TrashedAt != nil:
+			satellite.State = StateTrashed
+			next = record.TrashedAt.Add(service.config.TrashPeriod)
+		default:
+			next = record.UntrustedAt.Add(service.config.GracePeriod)
+		}
+		if !next.IsZero() && !service.config.Keep {
+			satellite.NextActionAt = &next
+		}
+
+		list = append(list, satellite)
+	}
+
+	sort.Slice(list, func(i, k int) bool {
+		return list[i].UntrustedAt.Before(list[k].UntrustedAt)
+	})
+	return list, nil
+}
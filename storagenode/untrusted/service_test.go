@@ -0,0 +1,232 @@
+// Copyright (C) 2020 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package untrusted_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+	"storj.io/storj/storagenode/trust"
+	"storj.io/storj/storagenode/untrusted"
+)
+
+func TestCleanup(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), db.PieceIndex())
+
+		trusted, removed := testrand.NodeID(), testrand.NodeID()
+		pool, err := trust.NewPool(log, trust.Dialer(rpc.Dialer{}), trust.Config{
+			Sources: []trust.Source{&trust.StaticURLSource{
+				URL: trust.SatelliteURL{ID: trusted, Host: "localhost", Port: 7777},
+			}},
+			CachePath: ctx.File("trust-cache.json"),
+		})
+		require.NoError(t, err)
+		require.NoError(t, pool.Refresh(ctx))
+
+		writePiece := func(satellite storj.NodeID) storj.PieceID {
+			pieceID := testrand.PieceID()
+			writer, err := store.Writer(ctx, satellite, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.BytesInt(memory.KiB.Int()))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+			return pieceID
+		}
+		trustedPiece := writePiece(trusted)
+		removedPiece := writePiece(removed)
+
+		require.NoError(t, db.Orders().Enqueue(ctx, &orders.Info{
+			Limit: &pb.OrderLimit{
+				SatelliteId:     removed,
+				SerialNumber:    testrand.SerialNumber(),
+				OrderExpiration: time.Now().Add(time.Hour),
+			},
+			Order: &pb.Order{},
+		}))
+
+		config := untrusted.Config{
+			Interval:    time.Hour,
+			GracePeriod: 24 * time.Hour,
+			TrashPeriod: 24 * time.Hour,
+		}
+		service := untrusted.NewService(log, config, pool, store, db.Satellites(), db.Orders())
+
+		now := time.Now()
+		require.NoError(t, service.Cleanup(ctx, now))
+
+		list, err := service.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, removed, list[0].SatelliteID)
+		assert.Equal(t, untrusted.StateKept, list[0].State)
+		require.NotNil(t, list[0].NextActionAt)
+		assert.WithinDuration(t, now.Add(config.GracePeriod), *list[0].NextActionAt, time.Second)
+
+		// the data is kept during the grace period
+		_, err = store.Reader(ctx, removed, removedPiece)
+		require.NoError(t, err)
+
+		// the data is moved to the trash after the grace period
+		now = now.Add(config.GracePeriod)
+		require.NoError(t, service.Cleanup(ctx, now))
+
+		_, err = store.Reader(ctx, removed, removedPiece)
+		require.Error(t, err)
+
+		list, err = service.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, untrusted.StateTrashed, list[0].State)
+		assert.Equal(t, memory.KiB.Int64(), list[0].BytesReclaimed)
+
+		// the trash is emptied and unsent orders dropped after the trash period
+		now = now.Add(config.TrashPeriod)
+		require.NoError(t, service.Cleanup(ctx, now))
+
+		list, err = service.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, untrusted.StateDeleted, list[0].State)
+		assert.Nil(t, list[0].NextActionAt)
+		assert.GreaterOrEqual(t, list[0].BytesReclaimed, memory.KiB.Int64())
+
+		require.NoError(t, store.RestoreTrash(ctx, removed))
+		_, err = store.Reader(ctx, removed, removedPiece)
+		require.Error(t, err)
+
+		unsent, err := db.Orders().ListUnsentBySatellite(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, unsent[removed])
+
+		// the data of trusted satellites is never touched
+		_, err = store.Reader(ctx, trusted, trustedPiece)
+		require.NoError(t, err)
+	})
+}
+
+func TestCleanupUnknownSatellites(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), db.PieceIndex())
+
+		trusted, pending, removed := testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+		source := &testSource{}
+		pool, err := trust.NewPool(log, trust.Dialer(rpc.Dialer{}), trust.Config{
+			Sources:           []trust.Source{source},
+			CachePath:         ctx.File("trust-cache.json"),
+			RequireAcceptance: true,
+		})
+		require.NoError(t, err)
+
+		for _, satellite := range []storj.NodeID{trusted, pending, removed} {
+			writer, err := store.Writer(ctx, satellite, testrand.PieceID())
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.BytesInt(memory.KiB.Int()))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+		}
+
+		service := untrusted.NewService(log, untrusted.Config{Interval: time.Hour}, pool, store, db.Satellites(), db.Orders())
+
+		// nothing is untrusted before the trust lists were fetched
+		require.NoError(t, service.Cleanup(ctx, time.Now()))
+		list, err := service.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+
+		// nor when the trust lists are empty
+		require.NoError(t, pool.Refresh(ctx))
+		require.NoError(t, service.Cleanup(ctx, time.Now()))
+		list, err = service.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+
+		// satellites pending acceptance aren't untrusted
+		source.entries = []trust.Entry{{SatelliteURL: trust.SatelliteURL{ID: trusted, Host: "trusted.test", Port: 7777}}}
+		source.entries = append(source.entries, trust.Entry{SatelliteURL: trust.SatelliteURL{ID: pending, Host: "pending.test", Port: 7777}})
+		require.NoError(t, pool.Refresh(ctx))
+		require.NoError(t, pool.AcceptSatellite(ctx, trusted))
+		require.Len(t, pool.PendingSatellites(ctx), 1)
+
+		require.NoError(t, service.Cleanup(ctx, time.Now()))
+		list, err = service.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, removed, list[0].SatelliteID)
+	})
+}
+
+type testSource struct {
+	entries []trust.Entry
+}
+
+func (source *testSource) String() string { return "https://trust.test/satellites" }
+
+func (source *testSource) Static() bool { return false }
+
+func (source *testSource) FetchEntries(context.Context) ([]trust.Entry, error) {
+	return source.entries, nil
+}
+
+func TestPurge(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), db.PieceIndex())
+
+		trusted, removed := testrand.NodeID(), testrand.NodeID()
+		pool, err := trust.NewPool(log, trust.Dialer(rpc.Dialer{}), trust.Config{
+			Sources: []trust.Source{&trust.StaticURLSource{
+				URL: trust.SatelliteURL{ID: trusted, Host: "localhost", Port: 7777},
+			}},
+			CachePath: ctx.File("trust-cache.json"),
+		})
+		require.NoError(t, err)
+		require.NoError(t, pool.Refresh(ctx))
+
+		for _, satellite := range []storj.NodeID{trusted, removed} {
+			writer, err := store.Writer(ctx, satellite, testrand.PieceID())
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.BytesInt(memory.KiB.Int()))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+		}
+
+		// the data is purged regardless of the policy
+		service := untrusted.NewService(log, untrusted.Config{Keep: true}, pool, store, db.Satellites(), db.Orders())
+
+		_, err = service.Purge(ctx, trusted)
+		require.True(t, untrusted.ErrTrusted.Has(err))
+
+		deleted, err := service.Purge(ctx, removed)
+		require.NoError(t, err)
+		assert.Equal(t, memory.KiB.Int64(), deleted)
+
+		_, contentSize, err := store.SpaceUsedBySatellite(ctx, removed)
+		require.NoError(t, err)
+		assert.Zero(t, contentSize)
+
+		list, err := service.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, untrusted.StateDeleted, list[0].State)
+		assert.Equal(t, deleted, list[0].BytesReclaimed)
+	})
+}
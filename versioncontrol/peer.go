@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"reflect"
 
+	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -23,6 +24,8 @@ import (
 const seedLength = 32
 
 var (
+	mon = monkit.Package()
+
 	// RolloutErr defines the rollout config error class.
 	RolloutErr = errs.Class("rollout config error")
 	// EmptySeedErr is used when the rollout contains an empty seed value.
@@ -66,8 +69,9 @@ type ProcessConfig struct {
 
 // VersionConfig single version configuration.
 type VersionConfig struct {
-	Version string `user:"true" help:"peer version" default:"v0.0.1"`
-	URL     string `user:"true" help:"URL for specific binary" default:""`
+	Version    string `user:"true" help:"peer version" default:"v0.0.1"`
+	URL        string `user:"true" help:"URL for specific binary" default:""`
+	Signatures string `user:"true" help:"comma separated base64 encoded ed25519 signatures of the binary archives by platform, e.g. windows_amd64:<signature>" default:""`
 }
 
 // RolloutConfig represents the state of a version rollout configuration of a process.
@@ -115,6 +119,28 @@ func (peer *Peer) HandleGet(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// HandleFailure records a failed update reported by a storage node updater.
+func (peer *Peer) HandleFailure(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var failure version.UpdateFailure
+	if err := json.NewDecoder(r.Body).Decode(&failure); err != nil {
+		http.Error(w, "invalid update failure", http.StatusBadRequest)
+		return
+	}
+
+	peer.Log.Warn("update failed",
+		zap.String("process", failure.Process),
+		zap.Stringer("node", failure.NodeID),
+		zap.String("from", failure.From),
+		zap.String("to", failure.To),
+		zap.String("reason", failure.Reason))
+	mon.Meter("update_failures").Mark(1)
+}
+
 // New creates a new VersionControl Server.
 func New(log *zap.Logger, config *Config) (peer *Peer, err error) {
 	if err := config.Binary.ValidateRollouts(log); err != nil {
@@ -191,6 +217,7 @@ func New(log *zap.Logger, config *Config) (peer *Peer, err error) {
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", peer.HandleGet)
+	mux.HandleFunc("/failures", peer.HandleFailure)
 	peer.Server.Endpoint = http.Server{
 		Handler: mux,
 	}
@@ -272,14 +299,25 @@ func (rollout RolloutConfig) Validate() error {
 }
 
 func configToProcess(binary ProcessConfig) (version.Process, error) {
+	minimumSignatures, err := version.ParseSignatures(binary.Minimum.Signatures)
+	if err != nil {
+		return version.Process{}, err
+	}
+	suggestedSignatures, err := version.ParseSignatures(binary.Suggested.Signatures)
+	if err != nil {
+		return version.Process{}, err
+	}
+
 	process := version.Process{
 		Minimum: version.Version{
-			Version: binary.Minimum.Version,
-			URL:     binary.Minimum.URL,
+			Version:    binary.Minimum.Version,
+			URL:        binary.Minimum.URL,
+			Signatures: minimumSignatures,
 		},
 		Suggested: version.Version{
-			Version: binary.Suggested.Version,
-			URL:     binary.Suggested.URL,
+			Version:    binary.Suggested.Version,
+			URL:        binary.Suggested.URL,
+			Signatures: suggestedSignatures,
 		},
 		Rollout: version.Rollout{
 			Cursor: version.PercentageToCursor(binary.Rollout.Cursor),